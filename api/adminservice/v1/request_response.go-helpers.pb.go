@@ -251,6 +251,80 @@ func (this *DescribeMutableStateResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type DescribeWorkflowUpdatesRequest to the protobuf v3 wire format
+func (val *DescribeWorkflowUpdatesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeWorkflowUpdatesRequest from the protobuf v3 wire format
+func (val *DescribeWorkflowUpdatesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeWorkflowUpdatesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeWorkflowUpdatesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeWorkflowUpdatesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeWorkflowUpdatesRequest
+	switch t := that.(type) {
+	case *DescribeWorkflowUpdatesRequest:
+		that1 = t
+	case DescribeWorkflowUpdatesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeWorkflowUpdatesResponse to the protobuf v3 wire format
+func (val *DescribeWorkflowUpdatesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeWorkflowUpdatesResponse from the protobuf v3 wire format
+func (val *DescribeWorkflowUpdatesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeWorkflowUpdatesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeWorkflowUpdatesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeWorkflowUpdatesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeWorkflowUpdatesResponse
+	switch t := that.(type) {
+	case *DescribeWorkflowUpdatesResponse:
+		that1 = t
+	case DescribeWorkflowUpdatesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type DescribeHistoryHostRequest to the protobuf v3 wire format
 func (val *DescribeHistoryHostRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -325,6 +399,80 @@ func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type DescribeHistoryHostHealthRequest to the protobuf v3 wire format
+func (val *DescribeHistoryHostHealthRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeHistoryHostHealthRequest from the protobuf v3 wire format
+func (val *DescribeHistoryHostHealthRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeHistoryHostHealthRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeHistoryHostHealthRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeHistoryHostHealthRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeHistoryHostHealthRequest
+	switch t := that.(type) {
+	case *DescribeHistoryHostHealthRequest:
+		that1 = t
+	case DescribeHistoryHostHealthRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeHistoryHostHealthResponse to the protobuf v3 wire format
+func (val *DescribeHistoryHostHealthResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeHistoryHostHealthResponse from the protobuf v3 wire format
+func (val *DescribeHistoryHostHealthResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeHistoryHostHealthResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeHistoryHostHealthResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeHistoryHostHealthResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeHistoryHostHealthResponse
+	switch t := that.(type) {
+	case *DescribeHistoryHostHealthResponse:
+		that1 = t
+	case DescribeHistoryHostHealthResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type CloseShardRequest to the protobuf v3 wire format
 func (val *CloseShardRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -1990,6 +2138,80 @@ func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type RecomputeMutableStateChecksumRequest to the protobuf v3 wire format
+func (val *RecomputeMutableStateChecksumRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RecomputeMutableStateChecksumRequest from the protobuf v3 wire format
+func (val *RecomputeMutableStateChecksumRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RecomputeMutableStateChecksumRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RecomputeMutableStateChecksumRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RecomputeMutableStateChecksumRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RecomputeMutableStateChecksumRequest
+	switch t := that.(type) {
+	case *RecomputeMutableStateChecksumRequest:
+		that1 = t
+	case RecomputeMutableStateChecksumRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RecomputeMutableStateChecksumResponse to the protobuf v3 wire format
+func (val *RecomputeMutableStateChecksumResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RecomputeMutableStateChecksumResponse from the protobuf v3 wire format
+func (val *RecomputeMutableStateChecksumResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RecomputeMutableStateChecksumResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RecomputeMutableStateChecksumResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RecomputeMutableStateChecksumResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RecomputeMutableStateChecksumResponse
+	switch t := that.(type) {
+	case *RecomputeMutableStateChecksumResponse:
+		that1 = t
+	case RecomputeMutableStateChecksumResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type ResendReplicationTasksRequest to the protobuf v3 wire format
 func (val *ResendReplicationTasksRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -2914,3 +3136,928 @@ func (this *ListQueuesResponse) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type DescribeKeepAliveParametersRequest to the protobuf v3 wire format
+func (val *DescribeKeepAliveParametersRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeKeepAliveParametersRequest from the protobuf v3 wire format
+func (val *DescribeKeepAliveParametersRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeKeepAliveParametersRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeKeepAliveParametersRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeKeepAliveParametersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeKeepAliveParametersRequest
+	switch t := that.(type) {
+	case *DescribeKeepAliveParametersRequest:
+		that1 = t
+	case DescribeKeepAliveParametersRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeKeepAliveParametersResponse to the protobuf v3 wire format
+func (val *DescribeKeepAliveParametersResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeKeepAliveParametersResponse from the protobuf v3 wire format
+func (val *DescribeKeepAliveParametersResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeKeepAliveParametersResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeKeepAliveParametersResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeKeepAliveParametersResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeKeepAliveParametersResponse
+	switch t := that.(type) {
+	case *DescribeKeepAliveParametersResponse:
+		that1 = t
+	case DescribeKeepAliveParametersResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CompactQueueSlicesRequest to the protobuf v3 wire format
+func (val *CompactQueueSlicesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CompactQueueSlicesRequest from the protobuf v3 wire format
+func (val *CompactQueueSlicesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CompactQueueSlicesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CompactQueueSlicesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CompactQueueSlicesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CompactQueueSlicesRequest
+	switch t := that.(type) {
+	case *CompactQueueSlicesRequest:
+		that1 = t
+	case CompactQueueSlicesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CompactQueueSlicesResponse to the protobuf v3 wire format
+func (val *CompactQueueSlicesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CompactQueueSlicesResponse from the protobuf v3 wire format
+func (val *CompactQueueSlicesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CompactQueueSlicesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CompactQueueSlicesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CompactQueueSlicesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CompactQueueSlicesResponse
+	switch t := that.(type) {
+	case *CompactQueueSlicesResponse:
+		that1 = t
+	case CompactQueueSlicesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeOutboundDestinationRequest to the protobuf v3 wire format
+func (val *DescribeOutboundDestinationRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeOutboundDestinationRequest from the protobuf v3 wire format
+func (val *DescribeOutboundDestinationRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeOutboundDestinationRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeOutboundDestinationRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeOutboundDestinationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeOutboundDestinationRequest
+	switch t := that.(type) {
+	case *DescribeOutboundDestinationRequest:
+		that1 = t
+	case DescribeOutboundDestinationRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeOutboundDestinationResponse to the protobuf v3 wire format
+func (val *DescribeOutboundDestinationResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeOutboundDestinationResponse from the protobuf v3 wire format
+func (val *DescribeOutboundDestinationResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeOutboundDestinationResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeOutboundDestinationResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeOutboundDestinationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeOutboundDestinationResponse
+	switch t := that.(type) {
+	case *DescribeOutboundDestinationResponse:
+		that1 = t
+	case DescribeOutboundDestinationResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type OutboundDestinationHostInfo to the protobuf v3 wire format
+func (val *OutboundDestinationHostInfo) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type OutboundDestinationHostInfo from the protobuf v3 wire format
+func (val *OutboundDestinationHostInfo) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *OutboundDestinationHostInfo) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two OutboundDestinationHostInfo values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *OutboundDestinationHostInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *OutboundDestinationHostInfo
+	switch t := that.(type) {
+	case *OutboundDestinationHostInfo:
+		that1 = t
+	case OutboundDestinationHostInfo:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type OutboundCircuitBreakerInfo to the protobuf v3 wire format
+func (val *OutboundCircuitBreakerInfo) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type OutboundCircuitBreakerInfo from the protobuf v3 wire format
+func (val *OutboundCircuitBreakerInfo) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *OutboundCircuitBreakerInfo) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two OutboundCircuitBreakerInfo values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *OutboundCircuitBreakerInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *OutboundCircuitBreakerInfo
+	switch t := that.(type) {
+	case *OutboundCircuitBreakerInfo:
+		that1 = t
+	case OutboundCircuitBreakerInfo:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ResetOutboundCircuitBreakerRequest to the protobuf v3 wire format
+func (val *ResetOutboundCircuitBreakerRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResetOutboundCircuitBreakerRequest from the protobuf v3 wire format
+func (val *ResetOutboundCircuitBreakerRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResetOutboundCircuitBreakerRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResetOutboundCircuitBreakerRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResetOutboundCircuitBreakerRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResetOutboundCircuitBreakerRequest
+	switch t := that.(type) {
+	case *ResetOutboundCircuitBreakerRequest:
+		that1 = t
+	case ResetOutboundCircuitBreakerRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ResetOutboundCircuitBreakerResponse to the protobuf v3 wire format
+func (val *ResetOutboundCircuitBreakerResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResetOutboundCircuitBreakerResponse from the protobuf v3 wire format
+func (val *ResetOutboundCircuitBreakerResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResetOutboundCircuitBreakerResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResetOutboundCircuitBreakerResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResetOutboundCircuitBreakerResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResetOutboundCircuitBreakerResponse
+	switch t := that.(type) {
+	case *ResetOutboundCircuitBreakerResponse:
+		that1 = t
+	case ResetOutboundCircuitBreakerResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ResetOutboundCircuitBreakerHostResult to the protobuf v3 wire format
+func (val *ResetOutboundCircuitBreakerHostResult) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResetOutboundCircuitBreakerHostResult from the protobuf v3 wire format
+func (val *ResetOutboundCircuitBreakerHostResult) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResetOutboundCircuitBreakerHostResult) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResetOutboundCircuitBreakerHostResult values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResetOutboundCircuitBreakerHostResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResetOutboundCircuitBreakerHostResult
+	switch t := that.(type) {
+	case *ResetOutboundCircuitBreakerHostResult:
+		that1 = t
+	case ResetOutboundCircuitBreakerHostResult:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UnloadTaskQueuePartitionRequest to the protobuf v3 wire format
+func (val *UnloadTaskQueuePartitionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UnloadTaskQueuePartitionRequest from the protobuf v3 wire format
+func (val *UnloadTaskQueuePartitionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UnloadTaskQueuePartitionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UnloadTaskQueuePartitionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UnloadTaskQueuePartitionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UnloadTaskQueuePartitionRequest
+	switch t := that.(type) {
+	case *UnloadTaskQueuePartitionRequest:
+		that1 = t
+	case UnloadTaskQueuePartitionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UnloadTaskQueuePartitionResponse to the protobuf v3 wire format
+func (val *UnloadTaskQueuePartitionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UnloadTaskQueuePartitionResponse from the protobuf v3 wire format
+func (val *UnloadTaskQueuePartitionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UnloadTaskQueuePartitionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UnloadTaskQueuePartitionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UnloadTaskQueuePartitionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UnloadTaskQueuePartitionResponse
+	switch t := that.(type) {
+	case *UnloadTaskQueuePartitionResponse:
+		that1 = t
+	case UnloadTaskQueuePartitionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListTaskQueuePollersRequest to the protobuf v3 wire format
+func (val *ListTaskQueuePollersRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListTaskQueuePollersRequest from the protobuf v3 wire format
+func (val *ListTaskQueuePollersRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListTaskQueuePollersRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListTaskQueuePollersRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListTaskQueuePollersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListTaskQueuePollersRequest
+	switch t := that.(type) {
+	case *ListTaskQueuePollersRequest:
+		that1 = t
+	case ListTaskQueuePollersRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListTaskQueuePollersResponse to the protobuf v3 wire format
+func (val *ListTaskQueuePollersResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListTaskQueuePollersResponse from the protobuf v3 wire format
+func (val *ListTaskQueuePollersResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListTaskQueuePollersResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListTaskQueuePollersResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListTaskQueuePollersResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListTaskQueuePollersResponse
+	switch t := that.(type) {
+	case *ListTaskQueuePollersResponse:
+		that1 = t
+	case ListTaskQueuePollersResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ForceResyncNamespaceRequest to the protobuf v3 wire format
+func (val *ForceResyncNamespaceRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ForceResyncNamespaceRequest from the protobuf v3 wire format
+func (val *ForceResyncNamespaceRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ForceResyncNamespaceRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ForceResyncNamespaceRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ForceResyncNamespaceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ForceResyncNamespaceRequest
+	switch t := that.(type) {
+	case *ForceResyncNamespaceRequest:
+		that1 = t
+	case ForceResyncNamespaceRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ForceResyncNamespaceResponse to the protobuf v3 wire format
+func (val *ForceResyncNamespaceResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ForceResyncNamespaceResponse from the protobuf v3 wire format
+func (val *ForceResyncNamespaceResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ForceResyncNamespaceResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ForceResyncNamespaceResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ForceResyncNamespaceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ForceResyncNamespaceResponse
+	switch t := that.(type) {
+	case *ForceResyncNamespaceResponse:
+		that1 = t
+	case ForceResyncNamespaceResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ForceResyncNamespaceShardResult to the protobuf v3 wire format
+func (val *ForceResyncNamespaceShardResult) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ForceResyncNamespaceShardResult from the protobuf v3 wire format
+func (val *ForceResyncNamespaceShardResult) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ForceResyncNamespaceShardResult) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ForceResyncNamespaceShardResult values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ForceResyncNamespaceShardResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ForceResyncNamespaceShardResult
+	switch t := that.(type) {
+	case *ForceResyncNamespaceShardResult:
+		that1 = t
+	case ForceResyncNamespaceShardResult:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetBuildIdTaskQueueMappingRequest to the protobuf v3 wire format
+func (val *GetBuildIdTaskQueueMappingRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetBuildIdTaskQueueMappingRequest from the protobuf v3 wire format
+func (val *GetBuildIdTaskQueueMappingRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetBuildIdTaskQueueMappingRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetBuildIdTaskQueueMappingRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetBuildIdTaskQueueMappingRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetBuildIdTaskQueueMappingRequest
+	switch t := that.(type) {
+	case *GetBuildIdTaskQueueMappingRequest:
+		that1 = t
+	case GetBuildIdTaskQueueMappingRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetBuildIdTaskQueueMappingResponse to the protobuf v3 wire format
+func (val *GetBuildIdTaskQueueMappingResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetBuildIdTaskQueueMappingResponse from the protobuf v3 wire format
+func (val *GetBuildIdTaskQueueMappingResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetBuildIdTaskQueueMappingResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetBuildIdTaskQueueMappingResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetBuildIdTaskQueueMappingResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetBuildIdTaskQueueMappingResponse
+	switch t := that.(type) {
+	case *GetBuildIdTaskQueueMappingResponse:
+		that1 = t
+	case GetBuildIdTaskQueueMappingResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeNamespacesRequest to the protobuf v3 wire format
+func (val *DescribeNamespacesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeNamespacesRequest from the protobuf v3 wire format
+func (val *DescribeNamespacesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeNamespacesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeNamespacesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeNamespacesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeNamespacesRequest
+	switch t := that.(type) {
+	case *DescribeNamespacesRequest:
+		that1 = t
+	case DescribeNamespacesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeNamespacesResponse to the protobuf v3 wire format
+func (val *DescribeNamespacesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeNamespacesResponse from the protobuf v3 wire format
+func (val *DescribeNamespacesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeNamespacesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeNamespacesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeNamespacesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeNamespacesResponse
+	switch t := that.(type) {
+	case *DescribeNamespacesResponse:
+		that1 = t
+	case DescribeNamespacesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeNamespacesResult to the protobuf v3 wire format
+func (val *DescribeNamespacesResult) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeNamespacesResult from the protobuf v3 wire format
+func (val *DescribeNamespacesResult) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeNamespacesResult) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeNamespacesResult values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeNamespacesResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeNamespacesResult
+	switch t := that.(type) {
+	case *DescribeNamespacesResult:
+		that1 = t
+	case DescribeNamespacesResult:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListNamespacesRequest to the protobuf v3 wire format
+func (val *ListNamespacesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListNamespacesRequest from the protobuf v3 wire format
+func (val *ListNamespacesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListNamespacesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListNamespacesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListNamespacesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListNamespacesRequest
+	switch t := that.(type) {
+	case *ListNamespacesRequest:
+		that1 = t
+	case ListNamespacesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListNamespacesResponse to the protobuf v3 wire format
+func (val *ListNamespacesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListNamespacesResponse from the protobuf v3 wire format
+func (val *ListNamespacesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListNamespacesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListNamespacesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListNamespacesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListNamespacesResponse
+	switch t := that.(type) {
+	case *ListNamespacesResponse:
+		that1 = t
+	case ListNamespacesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
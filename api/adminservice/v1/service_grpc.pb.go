@@ -45,7 +45,9 @@ const (
 	AdminService_RebuildMutableState_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/RebuildMutableState"
 	AdminService_ImportWorkflowExecution_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/ImportWorkflowExecution"
 	AdminService_DescribeMutableState_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/DescribeMutableState"
+	AdminService_DescribeWorkflowUpdates_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/DescribeWorkflowUpdates"
 	AdminService_DescribeHistoryHost_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryHost"
+	AdminService_DescribeHistoryHostHealth_FullMethodName         = "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryHostHealth"
 	AdminService_GetShard_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/GetShard"
 	AdminService_CloseShard_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/CloseShard"
 	AdminService_ListHistoryTasks_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/ListHistoryTasks"
@@ -68,6 +70,7 @@ const (
 	AdminService_PurgeDLQMessages_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQMessages"
 	AdminService_MergeDLQMessages_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/MergeDLQMessages"
 	AdminService_RefreshWorkflowTasks_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/RefreshWorkflowTasks"
+	AdminService_RecomputeMutableStateChecksum_FullMethodName     = "/temporal.server.api.adminservice.v1.AdminService/RecomputeMutableStateChecksum"
 	AdminService_ResendReplicationTasks_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/ResendReplicationTasks"
 	AdminService_GetTaskQueueTasks_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/GetTaskQueueTasks"
 	AdminService_DeleteWorkflowExecution_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution"
@@ -80,6 +83,16 @@ const (
 	AdminService_CancelDLQJob_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/CancelDLQJob"
 	AdminService_AddTasks_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/AddTasks"
 	AdminService_ListQueues_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/ListQueues"
+	AdminService_DescribeKeepAliveParameters_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/DescribeKeepAliveParameters"
+	AdminService_CompactQueueSlices_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/CompactQueueSlices"
+	AdminService_DescribeOutboundDestination_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/DescribeOutboundDestination"
+	AdminService_ResetOutboundCircuitBreaker_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/ResetOutboundCircuitBreaker"
+	AdminService_UnloadTaskQueuePartition_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/UnloadTaskQueuePartition"
+	AdminService_ListTaskQueuePollers_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/ListTaskQueuePollers"
+	AdminService_ForceResyncNamespace_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/ForceResyncNamespace"
+	AdminService_GetBuildIdTaskQueueMapping_FullMethodName        = "/temporal.server.api.adminservice.v1.AdminService/GetBuildIdTaskQueueMapping"
+	AdminService_DescribeNamespaces_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/DescribeNamespaces"
+	AdminService_ListNamespaces_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/ListNamespaces"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -94,8 +107,14 @@ type AdminServiceClient interface {
 	ImportWorkflowExecution(ctx context.Context, in *ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ImportWorkflowExecutionResponse, error)
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
 	DescribeMutableState(ctx context.Context, in *DescribeMutableStateRequest, opts ...grpc.CallOption) (*DescribeMutableStateResponse, error)
+	// DescribeWorkflowUpdates returns the number of in-flight and total updates of a workflow execution, along
+	// with the limits they are checked against.
+	DescribeWorkflowUpdates(ctx context.Context, in *DescribeWorkflowUpdatesRequest, opts ...grpc.CallOption) (*DescribeWorkflowUpdatesResponse, error)
 	// DescribeHistoryHost returns information about the internal states of a history host
 	DescribeHistoryHost(ctx context.Context, in *DescribeHistoryHostRequest, opts ...grpc.CallOption) (*DescribeHistoryHostResponse, error)
+	// DescribeHistoryHostHealth returns the health signals of a history host that are currently failing, such as
+	// persistence latency or a detected deadlock.
+	DescribeHistoryHostHealth(ctx context.Context, in *DescribeHistoryHostHealthRequest, opts ...grpc.CallOption) (*DescribeHistoryHostHealthResponse, error)
 	GetShard(ctx context.Context, in *GetShardRequest, opts ...grpc.CallOption) (*GetShardResponse, error)
 	CloseShard(ctx context.Context, in *CloseShardRequest, opts ...grpc.CallOption) (*CloseShardResponse, error)
 	ListHistoryTasks(ctx context.Context, in *ListHistoryTasksRequest, opts ...grpc.CallOption) (*ListHistoryTasksResponse, error)
@@ -147,6 +166,8 @@ type AdminServiceClient interface {
 	MergeDLQMessages(ctx context.Context, in *MergeDLQMessagesRequest, opts ...grpc.CallOption) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(ctx context.Context, in *RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*RefreshWorkflowTasksResponse, error)
+	// RecomputeMutableStateChecksum recomputes the checksum of a workflow's mutable state and persists it.
+	RecomputeMutableStateChecksum(ctx context.Context, in *RecomputeMutableStateChecksumRequest, opts ...grpc.CallOption) (*RecomputeMutableStateChecksumResponse, error)
 	// ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster.
 	ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error)
 	// GetTaskQueueTasks returns tasks from task queue.
@@ -165,6 +186,39 @@ type AdminServiceClient interface {
 	CancelDLQJob(ctx context.Context, in *CancelDLQJobRequest, opts ...grpc.CallOption) (*CancelDLQJobResponse, error)
 	AddTasks(ctx context.Context, in *AddTasksRequest, opts ...grpc.CallOption) (*AddTasksResponse, error)
 	ListQueues(ctx context.Context, in *ListQueuesRequest, opts ...grpc.CallOption) (*ListQueuesResponse, error)
+	// DescribeKeepAliveParameters returns the gRPC keepalive parameters in effect on the frontend host
+	// serving the request, after applying grpc-go defaults and minimums.
+	DescribeKeepAliveParameters(ctx context.Context, in *DescribeKeepAliveParametersRequest, opts ...grpc.CallOption) (*DescribeKeepAliveParametersResponse, error)
+	// CompactQueueSlices forces the queue of the given task category in a shard to merge its slices immediately
+	// and returns the resulting slice count. It is a no-op if the queue has a single slice.
+	CompactQueueSlices(ctx context.Context, in *CompactQueueSlicesRequest, opts ...grpc.CallOption) (*CompactQueueSlicesResponse, error)
+	// DescribeOutboundDestination returns the state and counts of the outbound queue circuit breakers for the given
+	// namespace and destination. Circuit breakers are kept per history host, so every history host is described
+	// unless a shard is given, in which case only the host owning it is.
+	DescribeOutboundDestination(ctx context.Context, in *DescribeOutboundDestinationRequest, opts ...grpc.CallOption) (*DescribeOutboundDestinationResponse, error)
+	// ResetOutboundCircuitBreaker forces the outbound queue circuit breakers for the given namespace and destination
+	// back to the closed state. Every history host is reset unless a shard is given, in which case only the host
+	// owning it is.
+	ResetOutboundCircuitBreaker(ctx context.Context, in *ResetOutboundCircuitBreakerRequest, opts ...grpc.CallOption) (*ResetOutboundCircuitBreakerResponse, error)
+	// UnloadTaskQueuePartition stops the given task queue partition on the matching host owning it, flushing its
+	// ack level, so that it is loaded fresh on next access.
+	UnloadTaskQueuePartition(ctx context.Context, in *UnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*UnloadTaskQueuePartitionResponse, error)
+	// ListTaskQueuePollers returns the identity, last access time, poll rate and poll count of the pollers recently
+	// seen by the given task queue partition. It is served from the in-memory poller history of the owning matching host.
+	ListTaskQueuePollers(ctx context.Context, in *ListTaskQueuePollersRequest, opts ...grpc.CallOption) (*ListTaskQueuePollersResponse, error)
+	// ForceResyncNamespace rewinds the replication streams of every history shard towards the target cluster to the
+	// oldest replication task still retained, and resends the tasks of the given namespace from there. It is meant
+	// for standby clusters whose state of the namespace is suspected to have diverged.
+	ForceResyncNamespace(ctx context.Context, in *ForceResyncNamespaceRequest, opts ...grpc.CallOption) (*ForceResyncNamespaceResponse, error)
+	// GetBuildIdTaskQueueMapping returns the task queues of a namespace currently mapped to the given build ID, which
+	// count against TaskQueuesPerBuildIdLimit.
+	GetBuildIdTaskQueueMapping(ctx context.Context, in *GetBuildIdTaskQueueMappingRequest, opts ...grpc.CallOption) (*GetBuildIdTaskQueueMappingResponse, error)
+	// DescribeNamespaces describes several namespaces in one call. A namespace that cannot be described is reported
+	// in its own result instead of failing the whole call.
+	DescribeNamespaces(ctx context.Context, in *DescribeNamespacesRequest, opts ...grpc.CallOption) (*DescribeNamespacesResponse, error)
+	// ListNamespaces lists namespaces like WorkflowService.ListNamespaces, and can also include only the namespaces
+	// carrying a given data key and, optionally, value.
+	ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error)
 }
 
 type adminServiceClient struct {
@@ -202,6 +256,15 @@ func (c *adminServiceClient) DescribeMutableState(ctx context.Context, in *Descr
 	return out, nil
 }
 
+func (c *adminServiceClient) DescribeWorkflowUpdates(ctx context.Context, in *DescribeWorkflowUpdatesRequest, opts ...grpc.CallOption) (*DescribeWorkflowUpdatesResponse, error) {
+	out := new(DescribeWorkflowUpdatesResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeWorkflowUpdates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) DescribeHistoryHost(ctx context.Context, in *DescribeHistoryHostRequest, opts ...grpc.CallOption) (*DescribeHistoryHostResponse, error) {
 	out := new(DescribeHistoryHostResponse)
 	err := c.cc.Invoke(ctx, AdminService_DescribeHistoryHost_FullMethodName, in, out, opts...)
@@ -211,6 +274,15 @@ func (c *adminServiceClient) DescribeHistoryHost(ctx context.Context, in *Descri
 	return out, nil
 }
 
+func (c *adminServiceClient) DescribeHistoryHostHealth(ctx context.Context, in *DescribeHistoryHostHealthRequest, opts ...grpc.CallOption) (*DescribeHistoryHostHealthResponse, error) {
+	out := new(DescribeHistoryHostHealthResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeHistoryHostHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) GetShard(ctx context.Context, in *GetShardRequest, opts ...grpc.CallOption) (*GetShardResponse, error) {
 	out := new(GetShardResponse)
 	err := c.cc.Invoke(ctx, AdminService_GetShard_FullMethodName, in, out, opts...)
@@ -409,6 +481,15 @@ func (c *adminServiceClient) RefreshWorkflowTasks(ctx context.Context, in *Refre
 	return out, nil
 }
 
+func (c *adminServiceClient) RecomputeMutableStateChecksum(ctx context.Context, in *RecomputeMutableStateChecksumRequest, opts ...grpc.CallOption) (*RecomputeMutableStateChecksumResponse, error) {
+	out := new(RecomputeMutableStateChecksumResponse)
+	err := c.cc.Invoke(ctx, AdminService_RecomputeMutableStateChecksum_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error) {
 	out := new(ResendReplicationTasksResponse)
 	err := c.cc.Invoke(ctx, AdminService_ResendReplicationTasks_FullMethodName, in, out, opts...)
@@ -539,6 +620,96 @@ func (c *adminServiceClient) ListQueues(ctx context.Context, in *ListQueuesReque
 	return out, nil
 }
 
+func (c *adminServiceClient) DescribeKeepAliveParameters(ctx context.Context, in *DescribeKeepAliveParametersRequest, opts ...grpc.CallOption) (*DescribeKeepAliveParametersResponse, error) {
+	out := new(DescribeKeepAliveParametersResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeKeepAliveParameters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CompactQueueSlices(ctx context.Context, in *CompactQueueSlicesRequest, opts ...grpc.CallOption) (*CompactQueueSlicesResponse, error) {
+	out := new(CompactQueueSlicesResponse)
+	err := c.cc.Invoke(ctx, AdminService_CompactQueueSlices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeOutboundDestination(ctx context.Context, in *DescribeOutboundDestinationRequest, opts ...grpc.CallOption) (*DescribeOutboundDestinationResponse, error) {
+	out := new(DescribeOutboundDestinationResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeOutboundDestination_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResetOutboundCircuitBreaker(ctx context.Context, in *ResetOutboundCircuitBreakerRequest, opts ...grpc.CallOption) (*ResetOutboundCircuitBreakerResponse, error) {
+	out := new(ResetOutboundCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, AdminService_ResetOutboundCircuitBreaker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UnloadTaskQueuePartition(ctx context.Context, in *UnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*UnloadTaskQueuePartitionResponse, error) {
+	out := new(UnloadTaskQueuePartitionResponse)
+	err := c.cc.Invoke(ctx, AdminService_UnloadTaskQueuePartition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListTaskQueuePollers(ctx context.Context, in *ListTaskQueuePollersRequest, opts ...grpc.CallOption) (*ListTaskQueuePollersResponse, error) {
+	out := new(ListTaskQueuePollersResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListTaskQueuePollers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ForceResyncNamespace(ctx context.Context, in *ForceResyncNamespaceRequest, opts ...grpc.CallOption) (*ForceResyncNamespaceResponse, error) {
+	out := new(ForceResyncNamespaceResponse)
+	err := c.cc.Invoke(ctx, AdminService_ForceResyncNamespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetBuildIdTaskQueueMapping(ctx context.Context, in *GetBuildIdTaskQueueMappingRequest, opts ...grpc.CallOption) (*GetBuildIdTaskQueueMappingResponse, error) {
+	out := new(GetBuildIdTaskQueueMappingResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetBuildIdTaskQueueMapping_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeNamespaces(ctx context.Context, in *DescribeNamespacesRequest, opts ...grpc.CallOption) (*DescribeNamespacesResponse, error) {
+	out := new(DescribeNamespacesResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeNamespaces_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error) {
+	out := new(ListNamespacesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListNamespaces_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -551,8 +722,14 @@ type AdminServiceServer interface {
 	ImportWorkflowExecution(context.Context, *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error)
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
 	DescribeMutableState(context.Context, *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error)
+	// DescribeWorkflowUpdates returns the number of in-flight and total updates of a workflow execution, along
+	// with the limits they are checked against.
+	DescribeWorkflowUpdates(context.Context, *DescribeWorkflowUpdatesRequest) (*DescribeWorkflowUpdatesResponse, error)
 	// DescribeHistoryHost returns information about the internal states of a history host
 	DescribeHistoryHost(context.Context, *DescribeHistoryHostRequest) (*DescribeHistoryHostResponse, error)
+	// DescribeHistoryHostHealth returns the health signals of a history host that are currently failing, such as
+	// persistence latency or a detected deadlock.
+	DescribeHistoryHostHealth(context.Context, *DescribeHistoryHostHealthRequest) (*DescribeHistoryHostHealthResponse, error)
 	GetShard(context.Context, *GetShardRequest) (*GetShardResponse, error)
 	CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error)
 	ListHistoryTasks(context.Context, *ListHistoryTasksRequest) (*ListHistoryTasksResponse, error)
@@ -604,6 +781,8 @@ type AdminServiceServer interface {
 	MergeDLQMessages(context.Context, *MergeDLQMessagesRequest) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
+	// RecomputeMutableStateChecksum recomputes the checksum of a workflow's mutable state and persists it.
+	RecomputeMutableStateChecksum(context.Context, *RecomputeMutableStateChecksumRequest) (*RecomputeMutableStateChecksumResponse, error)
 	// ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster.
 	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error)
 	// GetTaskQueueTasks returns tasks from task queue.
@@ -622,6 +801,39 @@ type AdminServiceServer interface {
 	CancelDLQJob(context.Context, *CancelDLQJobRequest) (*CancelDLQJobResponse, error)
 	AddTasks(context.Context, *AddTasksRequest) (*AddTasksResponse, error)
 	ListQueues(context.Context, *ListQueuesRequest) (*ListQueuesResponse, error)
+	// DescribeKeepAliveParameters returns the gRPC keepalive parameters in effect on the frontend host
+	// serving the request, after applying grpc-go defaults and minimums.
+	DescribeKeepAliveParameters(context.Context, *DescribeKeepAliveParametersRequest) (*DescribeKeepAliveParametersResponse, error)
+	// CompactQueueSlices forces the queue of the given task category in a shard to merge its slices immediately
+	// and returns the resulting slice count. It is a no-op if the queue has a single slice.
+	CompactQueueSlices(context.Context, *CompactQueueSlicesRequest) (*CompactQueueSlicesResponse, error)
+	// DescribeOutboundDestination returns the state and counts of the outbound queue circuit breakers for the given
+	// namespace and destination. Circuit breakers are kept per history host, so every history host is described
+	// unless a shard is given, in which case only the host owning it is.
+	DescribeOutboundDestination(context.Context, *DescribeOutboundDestinationRequest) (*DescribeOutboundDestinationResponse, error)
+	// ResetOutboundCircuitBreaker forces the outbound queue circuit breakers for the given namespace and destination
+	// back to the closed state. Every history host is reset unless a shard is given, in which case only the host
+	// owning it is.
+	ResetOutboundCircuitBreaker(context.Context, *ResetOutboundCircuitBreakerRequest) (*ResetOutboundCircuitBreakerResponse, error)
+	// UnloadTaskQueuePartition stops the given task queue partition on the matching host owning it, flushing its
+	// ack level, so that it is loaded fresh on next access.
+	UnloadTaskQueuePartition(context.Context, *UnloadTaskQueuePartitionRequest) (*UnloadTaskQueuePartitionResponse, error)
+	// ListTaskQueuePollers returns the identity, last access time, poll rate and poll count of the pollers recently
+	// seen by the given task queue partition. It is served from the in-memory poller history of the owning matching host.
+	ListTaskQueuePollers(context.Context, *ListTaskQueuePollersRequest) (*ListTaskQueuePollersResponse, error)
+	// ForceResyncNamespace rewinds the replication streams of every history shard towards the target cluster to the
+	// oldest replication task still retained, and resends the tasks of the given namespace from there. It is meant
+	// for standby clusters whose state of the namespace is suspected to have diverged.
+	ForceResyncNamespace(context.Context, *ForceResyncNamespaceRequest) (*ForceResyncNamespaceResponse, error)
+	// GetBuildIdTaskQueueMapping returns the task queues of a namespace currently mapped to the given build ID, which
+	// count against TaskQueuesPerBuildIdLimit.
+	GetBuildIdTaskQueueMapping(context.Context, *GetBuildIdTaskQueueMappingRequest) (*GetBuildIdTaskQueueMappingResponse, error)
+	// DescribeNamespaces describes several namespaces in one call. A namespace that cannot be described is reported
+	// in its own result instead of failing the whole call.
+	DescribeNamespaces(context.Context, *DescribeNamespacesRequest) (*DescribeNamespacesResponse, error)
+	// ListNamespaces lists namespaces like WorkflowService.ListNamespaces, and can also include only the namespaces
+	// carrying a given data key and, optionally, value.
+	ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -638,9 +850,15 @@ func (UnimplementedAdminServiceServer) ImportWorkflowExecution(context.Context,
 func (UnimplementedAdminServiceServer) DescribeMutableState(context.Context, *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeMutableState not implemented")
 }
+func (UnimplementedAdminServiceServer) DescribeWorkflowUpdates(context.Context, *DescribeWorkflowUpdatesRequest) (*DescribeWorkflowUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeWorkflowUpdates not implemented")
+}
 func (UnimplementedAdminServiceServer) DescribeHistoryHost(context.Context, *DescribeHistoryHostRequest) (*DescribeHistoryHostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeHistoryHost not implemented")
 }
+func (UnimplementedAdminServiceServer) DescribeHistoryHostHealth(context.Context, *DescribeHistoryHostHealthRequest) (*DescribeHistoryHostHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeHistoryHostHealth not implemented")
+}
 func (UnimplementedAdminServiceServer) GetShard(context.Context, *GetShardRequest) (*GetShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetShard not implemented")
 }
@@ -707,6 +925,9 @@ func (UnimplementedAdminServiceServer) MergeDLQMessages(context.Context, *MergeD
 func (UnimplementedAdminServiceServer) RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshWorkflowTasks not implemented")
 }
+func (UnimplementedAdminServiceServer) RecomputeMutableStateChecksum(context.Context, *RecomputeMutableStateChecksumRequest) (*RecomputeMutableStateChecksumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecomputeMutableStateChecksum not implemented")
+}
 func (UnimplementedAdminServiceServer) ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResendReplicationTasks not implemented")
 }
@@ -743,6 +964,36 @@ func (UnimplementedAdminServiceServer) AddTasks(context.Context, *AddTasksReques
 func (UnimplementedAdminServiceServer) ListQueues(context.Context, *ListQueuesRequest) (*ListQueuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListQueues not implemented")
 }
+func (UnimplementedAdminServiceServer) DescribeKeepAliveParameters(context.Context, *DescribeKeepAliveParametersRequest) (*DescribeKeepAliveParametersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeKeepAliveParameters not implemented")
+}
+func (UnimplementedAdminServiceServer) CompactQueueSlices(context.Context, *CompactQueueSlicesRequest) (*CompactQueueSlicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompactQueueSlices not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeOutboundDestination(context.Context, *DescribeOutboundDestinationRequest) (*DescribeOutboundDestinationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeOutboundDestination not implemented")
+}
+func (UnimplementedAdminServiceServer) ResetOutboundCircuitBreaker(context.Context, *ResetOutboundCircuitBreakerRequest) (*ResetOutboundCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetOutboundCircuitBreaker not implemented")
+}
+func (UnimplementedAdminServiceServer) UnloadTaskQueuePartition(context.Context, *UnloadTaskQueuePartitionRequest) (*UnloadTaskQueuePartitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnloadTaskQueuePartition not implemented")
+}
+func (UnimplementedAdminServiceServer) ListTaskQueuePollers(context.Context, *ListTaskQueuePollersRequest) (*ListTaskQueuePollersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskQueuePollers not implemented")
+}
+func (UnimplementedAdminServiceServer) ForceResyncNamespace(context.Context, *ForceResyncNamespaceRequest) (*ForceResyncNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceResyncNamespace not implemented")
+}
+func (UnimplementedAdminServiceServer) GetBuildIdTaskQueueMapping(context.Context, *GetBuildIdTaskQueueMappingRequest) (*GetBuildIdTaskQueueMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBuildIdTaskQueueMapping not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeNamespaces(context.Context, *DescribeNamespacesRequest) (*DescribeNamespacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeNamespaces not implemented")
+}
+func (UnimplementedAdminServiceServer) ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNamespaces not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -810,6 +1061,24 @@ func _AdminService_DescribeMutableState_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_DescribeWorkflowUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeWorkflowUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeWorkflowUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeWorkflowUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeWorkflowUpdates(ctx, req.(*DescribeWorkflowUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_DescribeHistoryHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeHistoryHostRequest)
 	if err := dec(in); err != nil {
@@ -828,6 +1097,24 @@ func _AdminService_DescribeHistoryHost_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_DescribeHistoryHostHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeHistoryHostHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeHistoryHostHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeHistoryHostHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeHistoryHostHealth(ctx, req.(*DescribeHistoryHostHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_GetShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetShardRequest)
 	if err := dec(in); err != nil {
@@ -1224,6 +1511,24 @@ func _AdminService_RefreshWorkflowTasks_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_RecomputeMutableStateChecksum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecomputeMutableStateChecksumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RecomputeMutableStateChecksum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RecomputeMutableStateChecksum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RecomputeMutableStateChecksum(ctx, req.(*RecomputeMutableStateChecksumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ResendReplicationTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResendReplicationTasksRequest)
 	if err := dec(in); err != nil {
@@ -1448,6 +1753,186 @@ func _AdminService_ListQueues_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_DescribeKeepAliveParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeKeepAliveParametersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeKeepAliveParameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeKeepAliveParameters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeKeepAliveParameters(ctx, req.(*DescribeKeepAliveParametersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CompactQueueSlices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactQueueSlicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CompactQueueSlices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CompactQueueSlices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CompactQueueSlices(ctx, req.(*CompactQueueSlicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeOutboundDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeOutboundDestinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeOutboundDestination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeOutboundDestination_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeOutboundDestination(ctx, req.(*DescribeOutboundDestinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResetOutboundCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetOutboundCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResetOutboundCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ResetOutboundCircuitBreaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResetOutboundCircuitBreaker(ctx, req.(*ResetOutboundCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UnloadTaskQueuePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnloadTaskQueuePartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnloadTaskQueuePartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UnloadTaskQueuePartition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnloadTaskQueuePartition(ctx, req.(*UnloadTaskQueuePartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListTaskQueuePollers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskQueuePollersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListTaskQueuePollers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListTaskQueuePollers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListTaskQueuePollers(ctx, req.(*ListTaskQueuePollersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ForceResyncNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceResyncNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ForceResyncNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ForceResyncNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ForceResyncNamespace(ctx, req.(*ForceResyncNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetBuildIdTaskQueueMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBuildIdTaskQueueMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetBuildIdTaskQueueMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetBuildIdTaskQueueMapping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetBuildIdTaskQueueMapping(ctx, req.(*GetBuildIdTaskQueueMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeNamespacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeNamespaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeNamespaces(ctx, req.(*DescribeNamespacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamespacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListNamespaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListNamespaces(ctx, req.(*ListNamespacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1467,10 +1952,18 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeMutableState",
 			Handler:    _AdminService_DescribeMutableState_Handler,
 		},
+		{
+			MethodName: "DescribeWorkflowUpdates",
+			Handler:    _AdminService_DescribeWorkflowUpdates_Handler,
+		},
 		{
 			MethodName: "DescribeHistoryHost",
 			Handler:    _AdminService_DescribeHistoryHost_Handler,
 		},
+		{
+			MethodName: "DescribeHistoryHostHealth",
+			Handler:    _AdminService_DescribeHistoryHostHealth_Handler,
+		},
 		{
 			MethodName: "GetShard",
 			Handler:    _AdminService_GetShard_Handler,
@@ -1559,6 +2052,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshWorkflowTasks",
 			Handler:    _AdminService_RefreshWorkflowTasks_Handler,
 		},
+		{
+			MethodName: "RecomputeMutableStateChecksum",
+			Handler:    _AdminService_RecomputeMutableStateChecksum_Handler,
+		},
 		{
 			MethodName: "ResendReplicationTasks",
 			Handler:    _AdminService_ResendReplicationTasks_Handler,
@@ -1603,6 +2100,46 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListQueues",
 			Handler:    _AdminService_ListQueues_Handler,
 		},
+		{
+			MethodName: "DescribeKeepAliveParameters",
+			Handler:    _AdminService_DescribeKeepAliveParameters_Handler,
+		},
+		{
+			MethodName: "CompactQueueSlices",
+			Handler:    _AdminService_CompactQueueSlices_Handler,
+		},
+		{
+			MethodName: "DescribeOutboundDestination",
+			Handler:    _AdminService_DescribeOutboundDestination_Handler,
+		},
+		{
+			MethodName: "ResetOutboundCircuitBreaker",
+			Handler:    _AdminService_ResetOutboundCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "UnloadTaskQueuePartition",
+			Handler:    _AdminService_UnloadTaskQueuePartition_Handler,
+		},
+		{
+			MethodName: "ListTaskQueuePollers",
+			Handler:    _AdminService_ListTaskQueuePollers_Handler,
+		},
+		{
+			MethodName: "ForceResyncNamespace",
+			Handler:    _AdminService_ForceResyncNamespace_Handler,
+		},
+		{
+			MethodName: "GetBuildIdTaskQueueMapping",
+			Handler:    _AdminService_GetBuildIdTaskQueueMapping_Handler,
+		},
+		{
+			MethodName: "DescribeNamespaces",
+			Handler:    _AdminService_DescribeNamespaces_Handler,
+		},
+		{
+			MethodName: "ListNamespaces",
+			Handler:    _AdminService_ListNamespaces_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -161,6 +161,26 @@ func (mr *MockAdminServiceClientMockRecorder) CloseShard(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceClient)(nil).CloseShard), varargs...)
 }
 
+// CompactQueueSlices mocks base method.
+func (m *MockAdminServiceClient) CompactQueueSlices(ctx context.Context, in *adminservice.CompactQueueSlicesRequest, opts ...grpc.CallOption) (*adminservice.CompactQueueSlicesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CompactQueueSlices", varargs...)
+	ret0, _ := ret[0].(*adminservice.CompactQueueSlicesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompactQueueSlices indicates an expected call of CompactQueueSlices.
+func (mr *MockAdminServiceClientMockRecorder) CompactQueueSlices(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactQueueSlices", reflect.TypeOf((*MockAdminServiceClient)(nil).CompactQueueSlices), varargs...)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *adminservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -241,6 +261,46 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeHistoryHost(ctx, in interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHost", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeHistoryHost), varargs...)
 }
 
+// DescribeHistoryHostHealth mocks base method.
+func (m *MockAdminServiceClient) DescribeHistoryHostHealth(ctx context.Context, in *adminservice.DescribeHistoryHostHealthRequest, opts ...grpc.CallOption) (*adminservice.DescribeHistoryHostHealthResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeHistoryHostHealth", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeHistoryHostHealthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeHistoryHostHealth indicates an expected call of DescribeHistoryHostHealth.
+func (mr *MockAdminServiceClientMockRecorder) DescribeHistoryHostHealth(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHostHealth", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeHistoryHostHealth), varargs...)
+}
+
+// DescribeKeepAliveParameters mocks base method.
+func (m *MockAdminServiceClient) DescribeKeepAliveParameters(ctx context.Context, in *adminservice.DescribeKeepAliveParametersRequest, opts ...grpc.CallOption) (*adminservice.DescribeKeepAliveParametersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeKeepAliveParameters", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeKeepAliveParametersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeKeepAliveParameters indicates an expected call of DescribeKeepAliveParameters.
+func (mr *MockAdminServiceClientMockRecorder) DescribeKeepAliveParameters(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeKeepAliveParameters", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeKeepAliveParameters), varargs...)
+}
+
 // DescribeMutableState mocks base method.
 func (m *MockAdminServiceClient) DescribeMutableState(ctx context.Context, in *adminservice.DescribeMutableStateRequest, opts ...grpc.CallOption) (*adminservice.DescribeMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -261,6 +321,106 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeNamespaces mocks base method.
+func (m *MockAdminServiceClient) DescribeNamespaces(ctx context.Context, in *adminservice.DescribeNamespacesRequest, opts ...grpc.CallOption) (*adminservice.DescribeNamespacesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeNamespaces", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeNamespacesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNamespaces indicates an expected call of DescribeNamespaces.
+func (mr *MockAdminServiceClientMockRecorder) DescribeNamespaces(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespaces", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeNamespaces), varargs...)
+}
+
+// DescribeOutboundDestination mocks base method.
+func (m *MockAdminServiceClient) DescribeOutboundDestination(ctx context.Context, in *adminservice.DescribeOutboundDestinationRequest, opts ...grpc.CallOption) (*adminservice.DescribeOutboundDestinationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeOutboundDestination", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeOutboundDestinationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOutboundDestination indicates an expected call of DescribeOutboundDestination.
+func (mr *MockAdminServiceClientMockRecorder) DescribeOutboundDestination(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOutboundDestination", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeOutboundDestination), varargs...)
+}
+
+// DescribeWorkflowUpdates mocks base method.
+func (m *MockAdminServiceClient) DescribeWorkflowUpdates(ctx context.Context, in *adminservice.DescribeWorkflowUpdatesRequest, opts ...grpc.CallOption) (*adminservice.DescribeWorkflowUpdatesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeWorkflowUpdates", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeWorkflowUpdatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkflowUpdates indicates an expected call of DescribeWorkflowUpdates.
+func (mr *MockAdminServiceClientMockRecorder) DescribeWorkflowUpdates(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowUpdates", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeWorkflowUpdates), varargs...)
+}
+
+// ForceResyncNamespace mocks base method.
+func (m *MockAdminServiceClient) ForceResyncNamespace(ctx context.Context, in *adminservice.ForceResyncNamespaceRequest, opts ...grpc.CallOption) (*adminservice.ForceResyncNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ForceResyncNamespace", varargs...)
+	ret0, _ := ret[0].(*adminservice.ForceResyncNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForceResyncNamespace indicates an expected call of ForceResyncNamespace.
+func (mr *MockAdminServiceClientMockRecorder) ForceResyncNamespace(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceResyncNamespace", reflect.TypeOf((*MockAdminServiceClient)(nil).ForceResyncNamespace), varargs...)
+}
+
+// GetBuildIdTaskQueueMapping mocks base method.
+func (m *MockAdminServiceClient) GetBuildIdTaskQueueMapping(ctx context.Context, in *adminservice.GetBuildIdTaskQueueMappingRequest, opts ...grpc.CallOption) (*adminservice.GetBuildIdTaskQueueMappingResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBuildIdTaskQueueMapping", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetBuildIdTaskQueueMappingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBuildIdTaskQueueMapping indicates an expected call of GetBuildIdTaskQueueMapping.
+func (mr *MockAdminServiceClientMockRecorder) GetBuildIdTaskQueueMapping(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuildIdTaskQueueMapping", reflect.TypeOf((*MockAdminServiceClient)(nil).GetBuildIdTaskQueueMapping), varargs...)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockAdminServiceClient) GetDLQMessages(ctx context.Context, in *adminservice.GetDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -561,6 +721,26 @@ func (mr *MockAdminServiceClientMockRecorder) ListHistoryTasks(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ListHistoryTasks), varargs...)
 }
 
+// ListNamespaces mocks base method.
+func (m *MockAdminServiceClient) ListNamespaces(ctx context.Context, in *adminservice.ListNamespacesRequest, opts ...grpc.CallOption) (*adminservice.ListNamespacesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNamespaces", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListNamespacesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNamespaces indicates an expected call of ListNamespaces.
+func (mr *MockAdminServiceClientMockRecorder) ListNamespaces(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaces", reflect.TypeOf((*MockAdminServiceClient)(nil).ListNamespaces), varargs...)
+}
+
 // ListQueues mocks base method.
 func (m *MockAdminServiceClient) ListQueues(ctx context.Context, in *adminservice.ListQueuesRequest, opts ...grpc.CallOption) (*adminservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -581,6 +761,26 @@ func (mr *MockAdminServiceClientMockRecorder) ListQueues(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockAdminServiceClient)(nil).ListQueues), varargs...)
 }
 
+// ListTaskQueuePollers mocks base method.
+func (m *MockAdminServiceClient) ListTaskQueuePollers(ctx context.Context, in *adminservice.ListTaskQueuePollersRequest, opts ...grpc.CallOption) (*adminservice.ListTaskQueuePollersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTaskQueuePollers", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListTaskQueuePollersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskQueuePollers indicates an expected call of ListTaskQueuePollers.
+func (mr *MockAdminServiceClientMockRecorder) ListTaskQueuePollers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueuePollers", reflect.TypeOf((*MockAdminServiceClient)(nil).ListTaskQueuePollers), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) MergeDLQMessages(ctx context.Context, in *adminservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -701,6 +901,26 @@ func (mr *MockAdminServiceClientMockRecorder) RebuildMutableState(ctx, in interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).RebuildMutableState), varargs...)
 }
 
+// RecomputeMutableStateChecksum mocks base method.
+func (m *MockAdminServiceClient) RecomputeMutableStateChecksum(ctx context.Context, in *adminservice.RecomputeMutableStateChecksumRequest, opts ...grpc.CallOption) (*adminservice.RecomputeMutableStateChecksumResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RecomputeMutableStateChecksum", varargs...)
+	ret0, _ := ret[0].(*adminservice.RecomputeMutableStateChecksumResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecomputeMutableStateChecksum indicates an expected call of RecomputeMutableStateChecksum.
+func (mr *MockAdminServiceClientMockRecorder) RecomputeMutableStateChecksum(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecomputeMutableStateChecksum", reflect.TypeOf((*MockAdminServiceClient)(nil).RecomputeMutableStateChecksum), varargs...)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockAdminServiceClient) RefreshWorkflowTasks(ctx context.Context, in *adminservice.RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*adminservice.RefreshWorkflowTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -801,6 +1021,26 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// ResetOutboundCircuitBreaker mocks base method.
+func (m *MockAdminServiceClient) ResetOutboundCircuitBreaker(ctx context.Context, in *adminservice.ResetOutboundCircuitBreakerRequest, opts ...grpc.CallOption) (*adminservice.ResetOutboundCircuitBreakerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetOutboundCircuitBreaker", varargs...)
+	ret0, _ := ret[0].(*adminservice.ResetOutboundCircuitBreakerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetOutboundCircuitBreaker indicates an expected call of ResetOutboundCircuitBreaker.
+func (mr *MockAdminServiceClientMockRecorder) ResetOutboundCircuitBreaker(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetOutboundCircuitBreaker", reflect.TypeOf((*MockAdminServiceClient)(nil).ResetOutboundCircuitBreaker), varargs...)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
 	m.ctrl.T.Helper()
@@ -821,6 +1061,26 @@ func (mr *MockAdminServiceClientMockRecorder) StreamWorkflowReplicationMessages(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).StreamWorkflowReplicationMessages), varargs...)
 }
 
+// UnloadTaskQueuePartition mocks base method.
+func (m *MockAdminServiceClient) UnloadTaskQueuePartition(ctx context.Context, in *adminservice.UnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.UnloadTaskQueuePartitionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnloadTaskQueuePartition", varargs...)
+	ret0, _ := ret[0].(*adminservice.UnloadTaskQueuePartitionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnloadTaskQueuePartition indicates an expected call of UnloadTaskQueuePartition.
+func (mr *MockAdminServiceClientMockRecorder) UnloadTaskQueuePartition(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnloadTaskQueuePartition", reflect.TypeOf((*MockAdminServiceClient)(nil).UnloadTaskQueuePartition), varargs...)
+}
+
 // MockAdminService_StreamWorkflowReplicationMessagesClient is a mock of AdminService_StreamWorkflowReplicationMessagesClient interface.
 type MockAdminService_StreamWorkflowReplicationMessagesClient struct {
 	ctrl     *gomock.Controller
@@ -1056,6 +1316,21 @@ func (mr *MockAdminServiceServerMockRecorder) CloseShard(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceServer)(nil).CloseShard), arg0, arg1)
 }
 
+// CompactQueueSlices mocks base method.
+func (m *MockAdminServiceServer) CompactQueueSlices(arg0 context.Context, arg1 *adminservice.CompactQueueSlicesRequest) (*adminservice.CompactQueueSlicesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompactQueueSlices", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CompactQueueSlicesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompactQueueSlices indicates an expected call of CompactQueueSlices.
+func (mr *MockAdminServiceServerMockRecorder) CompactQueueSlices(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactQueueSlices", reflect.TypeOf((*MockAdminServiceServer)(nil).CompactQueueSlices), arg0, arg1)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *adminservice.DeleteWorkflowExecutionRequest) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1116,6 +1391,36 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeHistoryHost(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHost", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeHistoryHost), arg0, arg1)
 }
 
+// DescribeHistoryHostHealth mocks base method.
+func (m *MockAdminServiceServer) DescribeHistoryHostHealth(arg0 context.Context, arg1 *adminservice.DescribeHistoryHostHealthRequest) (*adminservice.DescribeHistoryHostHealthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeHistoryHostHealth", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeHistoryHostHealthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeHistoryHostHealth indicates an expected call of DescribeHistoryHostHealth.
+func (mr *MockAdminServiceServerMockRecorder) DescribeHistoryHostHealth(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHostHealth", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeHistoryHostHealth), arg0, arg1)
+}
+
+// DescribeKeepAliveParameters mocks base method.
+func (m *MockAdminServiceServer) DescribeKeepAliveParameters(arg0 context.Context, arg1 *adminservice.DescribeKeepAliveParametersRequest) (*adminservice.DescribeKeepAliveParametersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeKeepAliveParameters", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeKeepAliveParametersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeKeepAliveParameters indicates an expected call of DescribeKeepAliveParameters.
+func (mr *MockAdminServiceServerMockRecorder) DescribeKeepAliveParameters(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeKeepAliveParameters", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeKeepAliveParameters), arg0, arg1)
+}
+
 // DescribeMutableState mocks base method.
 func (m *MockAdminServiceServer) DescribeMutableState(arg0 context.Context, arg1 *adminservice.DescribeMutableStateRequest) (*adminservice.DescribeMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -1131,6 +1436,81 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeNamespaces mocks base method.
+func (m *MockAdminServiceServer) DescribeNamespaces(arg0 context.Context, arg1 *adminservice.DescribeNamespacesRequest) (*adminservice.DescribeNamespacesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeNamespaces", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeNamespacesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNamespaces indicates an expected call of DescribeNamespaces.
+func (mr *MockAdminServiceServerMockRecorder) DescribeNamespaces(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespaces", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeNamespaces), arg0, arg1)
+}
+
+// DescribeOutboundDestination mocks base method.
+func (m *MockAdminServiceServer) DescribeOutboundDestination(arg0 context.Context, arg1 *adminservice.DescribeOutboundDestinationRequest) (*adminservice.DescribeOutboundDestinationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOutboundDestination", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeOutboundDestinationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOutboundDestination indicates an expected call of DescribeOutboundDestination.
+func (mr *MockAdminServiceServerMockRecorder) DescribeOutboundDestination(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOutboundDestination", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeOutboundDestination), arg0, arg1)
+}
+
+// DescribeWorkflowUpdates mocks base method.
+func (m *MockAdminServiceServer) DescribeWorkflowUpdates(arg0 context.Context, arg1 *adminservice.DescribeWorkflowUpdatesRequest) (*adminservice.DescribeWorkflowUpdatesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeWorkflowUpdates", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeWorkflowUpdatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkflowUpdates indicates an expected call of DescribeWorkflowUpdates.
+func (mr *MockAdminServiceServerMockRecorder) DescribeWorkflowUpdates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowUpdates", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeWorkflowUpdates), arg0, arg1)
+}
+
+// ForceResyncNamespace mocks base method.
+func (m *MockAdminServiceServer) ForceResyncNamespace(arg0 context.Context, arg1 *adminservice.ForceResyncNamespaceRequest) (*adminservice.ForceResyncNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceResyncNamespace", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ForceResyncNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForceResyncNamespace indicates an expected call of ForceResyncNamespace.
+func (mr *MockAdminServiceServerMockRecorder) ForceResyncNamespace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceResyncNamespace", reflect.TypeOf((*MockAdminServiceServer)(nil).ForceResyncNamespace), arg0, arg1)
+}
+
+// GetBuildIdTaskQueueMapping mocks base method.
+func (m *MockAdminServiceServer) GetBuildIdTaskQueueMapping(arg0 context.Context, arg1 *adminservice.GetBuildIdTaskQueueMappingRequest) (*adminservice.GetBuildIdTaskQueueMappingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBuildIdTaskQueueMapping", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetBuildIdTaskQueueMappingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBuildIdTaskQueueMapping indicates an expected call of GetBuildIdTaskQueueMapping.
+func (mr *MockAdminServiceServerMockRecorder) GetBuildIdTaskQueueMapping(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuildIdTaskQueueMapping", reflect.TypeOf((*MockAdminServiceServer)(nil).GetBuildIdTaskQueueMapping), arg0, arg1)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockAdminServiceServer) GetDLQMessages(arg0 context.Context, arg1 *adminservice.GetDLQMessagesRequest) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1356,6 +1736,21 @@ func (mr *MockAdminServiceServerMockRecorder) ListHistoryTasks(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ListHistoryTasks), arg0, arg1)
 }
 
+// ListNamespaces mocks base method.
+func (m *MockAdminServiceServer) ListNamespaces(arg0 context.Context, arg1 *adminservice.ListNamespacesRequest) (*adminservice.ListNamespacesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNamespaces", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListNamespacesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNamespaces indicates an expected call of ListNamespaces.
+func (mr *MockAdminServiceServerMockRecorder) ListNamespaces(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaces", reflect.TypeOf((*MockAdminServiceServer)(nil).ListNamespaces), arg0, arg1)
+}
+
 // ListQueues mocks base method.
 func (m *MockAdminServiceServer) ListQueues(arg0 context.Context, arg1 *adminservice.ListQueuesRequest) (*adminservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1371,6 +1766,21 @@ func (mr *MockAdminServiceServerMockRecorder) ListQueues(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockAdminServiceServer)(nil).ListQueues), arg0, arg1)
 }
 
+// ListTaskQueuePollers mocks base method.
+func (m *MockAdminServiceServer) ListTaskQueuePollers(arg0 context.Context, arg1 *adminservice.ListTaskQueuePollersRequest) (*adminservice.ListTaskQueuePollersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskQueuePollers", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListTaskQueuePollersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskQueuePollers indicates an expected call of ListTaskQueuePollers.
+func (mr *MockAdminServiceServerMockRecorder) ListTaskQueuePollers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueuePollers", reflect.TypeOf((*MockAdminServiceServer)(nil).ListTaskQueuePollers), arg0, arg1)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *adminservice.MergeDLQMessagesRequest) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1461,6 +1871,21 @@ func (mr *MockAdminServiceServerMockRecorder) RebuildMutableState(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).RebuildMutableState), arg0, arg1)
 }
 
+// RecomputeMutableStateChecksum mocks base method.
+func (m *MockAdminServiceServer) RecomputeMutableStateChecksum(arg0 context.Context, arg1 *adminservice.RecomputeMutableStateChecksumRequest) (*adminservice.RecomputeMutableStateChecksumResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecomputeMutableStateChecksum", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RecomputeMutableStateChecksumResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecomputeMutableStateChecksum indicates an expected call of RecomputeMutableStateChecksum.
+func (mr *MockAdminServiceServerMockRecorder) RecomputeMutableStateChecksum(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecomputeMutableStateChecksum", reflect.TypeOf((*MockAdminServiceServer)(nil).RecomputeMutableStateChecksum), arg0, arg1)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockAdminServiceServer) RefreshWorkflowTasks(arg0 context.Context, arg1 *adminservice.RefreshWorkflowTasksRequest) (*adminservice.RefreshWorkflowTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -1536,6 +1961,21 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
 
+// ResetOutboundCircuitBreaker mocks base method.
+func (m *MockAdminServiceServer) ResetOutboundCircuitBreaker(arg0 context.Context, arg1 *adminservice.ResetOutboundCircuitBreakerRequest) (*adminservice.ResetOutboundCircuitBreakerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetOutboundCircuitBreaker", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ResetOutboundCircuitBreakerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetOutboundCircuitBreaker indicates an expected call of ResetOutboundCircuitBreaker.
+func (mr *MockAdminServiceServerMockRecorder) ResetOutboundCircuitBreaker(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetOutboundCircuitBreaker", reflect.TypeOf((*MockAdminServiceServer)(nil).ResetOutboundCircuitBreaker), arg0, arg1)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) StreamWorkflowReplicationMessages(arg0 adminservice.AdminService_StreamWorkflowReplicationMessagesServer) error {
 	m.ctrl.T.Helper()
@@ -1550,6 +1990,21 @@ func (mr *MockAdminServiceServerMockRecorder) StreamWorkflowReplicationMessages(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).StreamWorkflowReplicationMessages), arg0)
 }
 
+// UnloadTaskQueuePartition mocks base method.
+func (m *MockAdminServiceServer) UnloadTaskQueuePartition(arg0 context.Context, arg1 *adminservice.UnloadTaskQueuePartitionRequest) (*adminservice.UnloadTaskQueuePartitionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnloadTaskQueuePartition", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UnloadTaskQueuePartitionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnloadTaskQueuePartition indicates an expected call of UnloadTaskQueuePartition.
+func (mr *MockAdminServiceServerMockRecorder) UnloadTaskQueuePartition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnloadTaskQueuePartition", reflect.TypeOf((*MockAdminServiceServer)(nil).UnloadTaskQueuePartition), arg0, arg1)
+}
+
 // mustEmbedUnimplementedAdminServiceServer mocks base method.
 func (m *MockAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {
 	m.ctrl.T.Helper()
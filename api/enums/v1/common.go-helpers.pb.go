@@ -105,3 +105,25 @@ func CallbackStateFromString(s string) (CallbackState, error) {
 	}
 	return CallbackState(0), fmt.Errorf("%s is not a valid CallbackState", s)
 }
+
+var (
+	HealthSignal_shorthandValue = map[string]int32{
+		"Unspecified":        0,
+		"PersistenceLatency": 1,
+		"PersistenceErrors":  2,
+		"RpcLatency":         3,
+		"RpcErrors":          4,
+		"Deadlock":           5,
+	}
+)
+
+// HealthSignalFromString parses a HealthSignal value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to HealthSignal
+func HealthSignalFromString(s string) (HealthSignal, error) {
+	if v, ok := HealthSignal_value[s]; ok {
+		return HealthSignal(v), nil
+	} else if v, ok := HealthSignal_shorthandValue[s]; ok {
+		return HealthSignal(v), nil
+	}
+	return HealthSignal(0), fmt.Errorf("%s is not a valid HealthSignal", s)
+}
@@ -296,6 +296,86 @@ func (CallbackState) EnumDescriptor() ([]byte, []int) {
 	return file_temporal_server_api_enums_v1_common_proto_rawDescGZIP(), []int{3}
 }
 
+// Signal that drives the health of a history host.
+type HealthSignal int32
+
+const (
+	HEALTH_SIGNAL_UNSPECIFIED HealthSignal = 0
+	// Average persistence latency is above the configured failure threshold.
+	HEALTH_SIGNAL_PERSISTENCE_LATENCY HealthSignal = 1
+	// Ratio of persistence requests failing with an unhealthy error is above the configured threshold.
+	HEALTH_SIGNAL_PERSISTENCE_ERRORS HealthSignal = 2
+	// Average latency of the RPCs served by the host is above the configured failure threshold.
+	HEALTH_SIGNAL_RPC_LATENCY HealthSignal = 3
+	// Ratio of the RPCs served by the host failing with an unhealthy error is above the configured threshold.
+	HEALTH_SIGNAL_RPC_ERRORS HealthSignal = 4
+	// The deadlock detector found a potential deadlock and failed the health check of the host.
+	HEALTH_SIGNAL_DEADLOCK HealthSignal = 5
+)
+
+// Enum value maps for HealthSignal.
+var (
+	HealthSignal_name = map[int32]string{
+		0: "HEALTH_SIGNAL_UNSPECIFIED",
+		1: "HEALTH_SIGNAL_PERSISTENCE_LATENCY",
+		2: "HEALTH_SIGNAL_PERSISTENCE_ERRORS",
+		3: "HEALTH_SIGNAL_RPC_LATENCY",
+		4: "HEALTH_SIGNAL_RPC_ERRORS",
+		5: "HEALTH_SIGNAL_DEADLOCK",
+	}
+	HealthSignal_value = map[string]int32{
+		"HEALTH_SIGNAL_UNSPECIFIED":         0,
+		"HEALTH_SIGNAL_PERSISTENCE_LATENCY": 1,
+		"HEALTH_SIGNAL_PERSISTENCE_ERRORS":  2,
+		"HEALTH_SIGNAL_RPC_LATENCY":         3,
+		"HEALTH_SIGNAL_RPC_ERRORS":          4,
+		"HEALTH_SIGNAL_DEADLOCK":            5,
+	}
+)
+
+func (x HealthSignal) Enum() *HealthSignal {
+	p := new(HealthSignal)
+	*p = x
+	return p
+}
+
+func (x HealthSignal) String() string {
+	switch x {
+	case HEALTH_SIGNAL_UNSPECIFIED:
+		return "Unspecified"
+	case HEALTH_SIGNAL_PERSISTENCE_LATENCY:
+		return "PersistenceLatency"
+	case HEALTH_SIGNAL_PERSISTENCE_ERRORS:
+		return "PersistenceErrors"
+	case HEALTH_SIGNAL_RPC_LATENCY:
+		return "RpcLatency"
+	case HEALTH_SIGNAL_RPC_ERRORS:
+		return "RpcErrors"
+	case HEALTH_SIGNAL_DEADLOCK:
+		return "Deadlock"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (HealthSignal) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_common_proto_enumTypes[4].Descriptor()
+}
+
+func (HealthSignal) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_common_proto_enumTypes[4]
+}
+
+func (x HealthSignal) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HealthSignal.Descriptor instead.
+func (HealthSignal) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_common_proto_rawDescGZIP(), []int{4}
+}
+
 var File_temporal_server_api_enums_v1_common_proto protoreflect.FileDescriptor
 
 var file_temporal_server_api_enums_v1_common_proto_rawDesc = []byte{
@@ -336,10 +416,23 @@ var file_temporal_server_api_enums_v1_common_proto_rawDesc = []byte{
 	0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c,
 	0x45, 0x44, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b,
 	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44,
-	0x10, 0x05, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
-	0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x10, 0x05, 0x2a, 0xd3, 0x01, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x19, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x53, 0x49,
+	0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x53, 0x49, 0x47,
+	0x4e, 0x41, 0x4c, 0x5f, 0x50, 0x45, 0x52, 0x53, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x43, 0x45, 0x5f,
+	0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x48, 0x45, 0x41,
+	0x4c, 0x54, 0x48, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x50, 0x45, 0x52, 0x53, 0x49,
+	0x53, 0x54, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x10, 0x02, 0x12,
+	0x1d, 0x0a, 0x19, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c,
+	0x5f, 0x52, 0x50, 0x43, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x03, 0x12, 0x1c,
+	0x0a, 0x18, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f,
+	0x52, 0x50, 0x43, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16,
+	0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x44, 0x45,
+	0x41, 0x44, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x05, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e, 0x74,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65,
+	0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -354,12 +447,13 @@ func file_temporal_server_api_enums_v1_common_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_enums_v1_common_proto_rawDescData
 }
 
-var file_temporal_server_api_enums_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_temporal_server_api_enums_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
 var file_temporal_server_api_enums_v1_common_proto_goTypes = []interface{}{
 	(DeadLetterQueueType)(0), // 0: temporal.server.api.enums.v1.DeadLetterQueueType
 	(ChecksumFlavor)(0),      // 1: temporal.server.api.enums.v1.ChecksumFlavor
 	(SchedulerState)(0),      // 2: temporal.server.api.enums.v1.SchedulerState
 	(CallbackState)(0),       // 3: temporal.server.api.enums.v1.CallbackState
+	(HealthSignal)(0),        // 4: temporal.server.api.enums.v1.HealthSignal
 }
 var file_temporal_server_api_enums_v1_common_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -379,7 +473,7 @@ func file_temporal_server_api_enums_v1_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_temporal_server_api_enums_v1_common_proto_rawDesc,
-			NumEnums:      4,
+			NumEnums:      5,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
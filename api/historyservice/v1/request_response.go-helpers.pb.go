@@ -2471,6 +2471,80 @@ func (this *DescribeMutableStateResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type DescribeWorkflowUpdatesRequest to the protobuf v3 wire format
+func (val *DescribeWorkflowUpdatesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeWorkflowUpdatesRequest from the protobuf v3 wire format
+func (val *DescribeWorkflowUpdatesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeWorkflowUpdatesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeWorkflowUpdatesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeWorkflowUpdatesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeWorkflowUpdatesRequest
+	switch t := that.(type) {
+	case *DescribeWorkflowUpdatesRequest:
+		that1 = t
+	case DescribeWorkflowUpdatesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeWorkflowUpdatesResponse to the protobuf v3 wire format
+func (val *DescribeWorkflowUpdatesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeWorkflowUpdatesResponse from the protobuf v3 wire format
+func (val *DescribeWorkflowUpdatesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeWorkflowUpdatesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeWorkflowUpdatesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeWorkflowUpdatesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeWorkflowUpdatesResponse
+	switch t := that.(type) {
+	case *DescribeWorkflowUpdatesResponse:
+		that1 = t
+	case DescribeWorkflowUpdatesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type DescribeHistoryHostRequest to the protobuf v3 wire format
 func (val *DescribeHistoryHostRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -2545,6 +2619,80 @@ func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type DescribeHealthRequest to the protobuf v3 wire format
+func (val *DescribeHealthRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeHealthRequest from the protobuf v3 wire format
+func (val *DescribeHealthRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeHealthRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeHealthRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeHealthRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeHealthRequest
+	switch t := that.(type) {
+	case *DescribeHealthRequest:
+		that1 = t
+	case DescribeHealthRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeHealthResponse to the protobuf v3 wire format
+func (val *DescribeHealthResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeHealthResponse from the protobuf v3 wire format
+func (val *DescribeHealthResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeHealthResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeHealthResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeHealthResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeHealthResponse
+	switch t := that.(type) {
+	case *DescribeHealthResponse:
+		that1 = t
+	case DescribeHealthResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type CloseShardRequest to the protobuf v3 wire format
 func (val *CloseShardRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -3359,6 +3507,80 @@ func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type RecomputeMutableStateChecksumRequest to the protobuf v3 wire format
+func (val *RecomputeMutableStateChecksumRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RecomputeMutableStateChecksumRequest from the protobuf v3 wire format
+func (val *RecomputeMutableStateChecksumRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RecomputeMutableStateChecksumRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RecomputeMutableStateChecksumRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RecomputeMutableStateChecksumRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RecomputeMutableStateChecksumRequest
+	switch t := that.(type) {
+	case *RecomputeMutableStateChecksumRequest:
+		that1 = t
+	case RecomputeMutableStateChecksumRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RecomputeMutableStateChecksumResponse to the protobuf v3 wire format
+func (val *RecomputeMutableStateChecksumResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RecomputeMutableStateChecksumResponse from the protobuf v3 wire format
+func (val *RecomputeMutableStateChecksumResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RecomputeMutableStateChecksumResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RecomputeMutableStateChecksumResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RecomputeMutableStateChecksumResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RecomputeMutableStateChecksumResponse
+	switch t := that.(type) {
+	case *RecomputeMutableStateChecksumResponse:
+		that1 = t
+	case RecomputeMutableStateChecksumResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type GenerateLastHistoryReplicationTasksRequest to the protobuf v3 wire format
 func (val *GenerateLastHistoryReplicationTasksRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -4875,3 +5097,299 @@ func (this *CompleteNexusOperationResponse) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type CompactQueueSlicesRequest to the protobuf v3 wire format
+func (val *CompactQueueSlicesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CompactQueueSlicesRequest from the protobuf v3 wire format
+func (val *CompactQueueSlicesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CompactQueueSlicesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CompactQueueSlicesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CompactQueueSlicesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CompactQueueSlicesRequest
+	switch t := that.(type) {
+	case *CompactQueueSlicesRequest:
+		that1 = t
+	case CompactQueueSlicesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CompactQueueSlicesResponse to the protobuf v3 wire format
+func (val *CompactQueueSlicesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CompactQueueSlicesResponse from the protobuf v3 wire format
+func (val *CompactQueueSlicesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CompactQueueSlicesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CompactQueueSlicesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CompactQueueSlicesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CompactQueueSlicesResponse
+	switch t := that.(type) {
+	case *CompactQueueSlicesResponse:
+		that1 = t
+	case CompactQueueSlicesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeOutboundDestinationRequest to the protobuf v3 wire format
+func (val *DescribeOutboundDestinationRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeOutboundDestinationRequest from the protobuf v3 wire format
+func (val *DescribeOutboundDestinationRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeOutboundDestinationRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeOutboundDestinationRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeOutboundDestinationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeOutboundDestinationRequest
+	switch t := that.(type) {
+	case *DescribeOutboundDestinationRequest:
+		that1 = t
+	case DescribeOutboundDestinationRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeOutboundDestinationResponse to the protobuf v3 wire format
+func (val *DescribeOutboundDestinationResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeOutboundDestinationResponse from the protobuf v3 wire format
+func (val *DescribeOutboundDestinationResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeOutboundDestinationResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeOutboundDestinationResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeOutboundDestinationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeOutboundDestinationResponse
+	switch t := that.(type) {
+	case *DescribeOutboundDestinationResponse:
+		that1 = t
+	case DescribeOutboundDestinationResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ResetOutboundCircuitBreakerRequest to the protobuf v3 wire format
+func (val *ResetOutboundCircuitBreakerRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResetOutboundCircuitBreakerRequest from the protobuf v3 wire format
+func (val *ResetOutboundCircuitBreakerRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResetOutboundCircuitBreakerRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResetOutboundCircuitBreakerRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResetOutboundCircuitBreakerRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResetOutboundCircuitBreakerRequest
+	switch t := that.(type) {
+	case *ResetOutboundCircuitBreakerRequest:
+		that1 = t
+	case ResetOutboundCircuitBreakerRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ResetOutboundCircuitBreakerResponse to the protobuf v3 wire format
+func (val *ResetOutboundCircuitBreakerResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResetOutboundCircuitBreakerResponse from the protobuf v3 wire format
+func (val *ResetOutboundCircuitBreakerResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResetOutboundCircuitBreakerResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResetOutboundCircuitBreakerResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResetOutboundCircuitBreakerResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResetOutboundCircuitBreakerResponse
+	switch t := that.(type) {
+	case *ResetOutboundCircuitBreakerResponse:
+		that1 = t
+	case ResetOutboundCircuitBreakerResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ForceResyncNamespaceRequest to the protobuf v3 wire format
+func (val *ForceResyncNamespaceRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ForceResyncNamespaceRequest from the protobuf v3 wire format
+func (val *ForceResyncNamespaceRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ForceResyncNamespaceRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ForceResyncNamespaceRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ForceResyncNamespaceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ForceResyncNamespaceRequest
+	switch t := that.(type) {
+	case *ForceResyncNamespaceRequest:
+		that1 = t
+	case ForceResyncNamespaceRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ForceResyncNamespaceResponse to the protobuf v3 wire format
+func (val *ForceResyncNamespaceResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ForceResyncNamespaceResponse from the protobuf v3 wire format
+func (val *ForceResyncNamespaceResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ForceResyncNamespaceResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ForceResyncNamespaceResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ForceResyncNamespaceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ForceResyncNamespaceResponse
+	switch t := that.(type) {
+	case *ForceResyncNamespaceResponse:
+		that1 = t
+	case ForceResyncNamespaceResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
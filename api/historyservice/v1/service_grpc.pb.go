@@ -74,7 +74,9 @@ const (
 	HistoryService_SyncShardStatus_FullMethodName                        = "/temporal.server.api.historyservice.v1.HistoryService/SyncShardStatus"
 	HistoryService_SyncActivity_FullMethodName                           = "/temporal.server.api.historyservice.v1.HistoryService/SyncActivity"
 	HistoryService_DescribeMutableState_FullMethodName                   = "/temporal.server.api.historyservice.v1.HistoryService/DescribeMutableState"
+	HistoryService_DescribeWorkflowUpdates_FullMethodName                = "/temporal.server.api.historyservice.v1.HistoryService/DescribeWorkflowUpdates"
 	HistoryService_DescribeHistoryHost_FullMethodName                    = "/temporal.server.api.historyservice.v1.HistoryService/DescribeHistoryHost"
+	HistoryService_DescribeHealth_FullMethodName                         = "/temporal.server.api.historyservice.v1.HistoryService/DescribeHealth"
 	HistoryService_CloseShard_FullMethodName                             = "/temporal.server.api.historyservice.v1.HistoryService/CloseShard"
 	HistoryService_GetShard_FullMethodName                               = "/temporal.server.api.historyservice.v1.HistoryService/GetShard"
 	HistoryService_RemoveTask_FullMethodName                             = "/temporal.server.api.historyservice.v1.HistoryService/RemoveTask"
@@ -86,6 +88,7 @@ const (
 	HistoryService_PurgeDLQMessages_FullMethodName                       = "/temporal.server.api.historyservice.v1.HistoryService/PurgeDLQMessages"
 	HistoryService_MergeDLQMessages_FullMethodName                       = "/temporal.server.api.historyservice.v1.HistoryService/MergeDLQMessages"
 	HistoryService_RefreshWorkflowTasks_FullMethodName                   = "/temporal.server.api.historyservice.v1.HistoryService/RefreshWorkflowTasks"
+	HistoryService_RecomputeMutableStateChecksum_FullMethodName          = "/temporal.server.api.historyservice.v1.HistoryService/RecomputeMutableStateChecksum"
 	HistoryService_GenerateLastHistoryReplicationTasks_FullMethodName    = "/temporal.server.api.historyservice.v1.HistoryService/GenerateLastHistoryReplicationTasks"
 	HistoryService_GetReplicationStatus_FullMethodName                   = "/temporal.server.api.historyservice.v1.HistoryService/GetReplicationStatus"
 	HistoryService_RebuildMutableState_FullMethodName                    = "/temporal.server.api.historyservice.v1.HistoryService/RebuildMutableState"
@@ -104,6 +107,10 @@ const (
 	HistoryService_ListQueues_FullMethodName                             = "/temporal.server.api.historyservice.v1.HistoryService/ListQueues"
 	HistoryService_AddTasks_FullMethodName                               = "/temporal.server.api.historyservice.v1.HistoryService/AddTasks"
 	HistoryService_ListTasks_FullMethodName                              = "/temporal.server.api.historyservice.v1.HistoryService/ListTasks"
+	HistoryService_CompactQueueSlices_FullMethodName                     = "/temporal.server.api.historyservice.v1.HistoryService/CompactQueueSlices"
+	HistoryService_DescribeOutboundDestination_FullMethodName            = "/temporal.server.api.historyservice.v1.HistoryService/DescribeOutboundDestination"
+	HistoryService_ResetOutboundCircuitBreaker_FullMethodName            = "/temporal.server.api.historyservice.v1.HistoryService/ResetOutboundCircuitBreaker"
+	HistoryService_ForceResyncNamespace_FullMethodName                   = "/temporal.server.api.historyservice.v1.HistoryService/ForceResyncNamespace"
 	HistoryService_CompleteNexusOperation_FullMethodName                 = "/temporal.server.api.historyservice.v1.HistoryService/CompleteNexusOperation"
 )
 
@@ -244,8 +251,13 @@ type HistoryServiceClient interface {
 	SyncActivity(ctx context.Context, in *SyncActivityRequest, opts ...grpc.CallOption) (*SyncActivityResponse, error)
 	// DescribeMutableState returns information about the internal states of workflow mutable state.
 	DescribeMutableState(ctx context.Context, in *DescribeMutableStateRequest, opts ...grpc.CallOption) (*DescribeMutableStateResponse, error)
+	// DescribeWorkflowUpdates returns the update counts of a workflow execution, as counted by its update registry
+	// against the in-flight and total update limits.
+	DescribeWorkflowUpdates(ctx context.Context, in *DescribeWorkflowUpdatesRequest, opts ...grpc.CallOption) (*DescribeWorkflowUpdatesResponse, error)
 	// DescribeHistoryHost returns information about the internal states of a history host.
 	DescribeHistoryHost(ctx context.Context, in *DescribeHistoryHostRequest, opts ...grpc.CallOption) (*DescribeHistoryHostResponse, error)
+	// DescribeHealth returns the health signals of a history host that are currently failing.
+	DescribeHealth(ctx context.Context, in *DescribeHealthRequest, opts ...grpc.CallOption) (*DescribeHealthResponse, error)
 	// CloseShard close the shard.
 	CloseShard(ctx context.Context, in *CloseShardRequest, opts ...grpc.CallOption) (*CloseShardResponse, error)
 	// GetShard gets the ShardInfo
@@ -272,6 +284,8 @@ type HistoryServiceClient interface {
 	MergeDLQMessages(ctx context.Context, in *MergeDLQMessagesRequest, opts ...grpc.CallOption) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(ctx context.Context, in *RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*RefreshWorkflowTasksResponse, error)
+	// RecomputeMutableStateChecksum recomputes the checksum of a workflow's mutable state and persists it.
+	RecomputeMutableStateChecksum(ctx context.Context, in *RecomputeMutableStateChecksumRequest, opts ...grpc.CallOption) (*RecomputeMutableStateChecksumResponse, error)
 	// GenerateLastHistoryReplicationTasks generate a replication task for last history event for requested workflow execution
 	GenerateLastHistoryReplicationTasks(ctx context.Context, in *GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*GenerateLastHistoryReplicationTasksResponse, error)
 	GetReplicationStatus(ctx context.Context, in *GetReplicationStatusRequest, opts ...grpc.CallOption) (*GetReplicationStatusResponse, error)
@@ -312,6 +326,19 @@ type HistoryServiceClient interface {
 	// in the same shard. Calls to the persistence API will be batched by workflow run.
 	AddTasks(ctx context.Context, in *AddTasksRequest, opts ...grpc.CallOption) (*AddTasksResponse, error)
 	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
+	// CompactQueueSlices forces the queue of the given task category in a shard to merge its slices immediately,
+	// instead of waiting for the slice count to exceed its critical threshold. It returns the resulting slice count.
+	CompactQueueSlices(ctx context.Context, in *CompactQueueSlicesRequest, opts ...grpc.CallOption) (*CompactQueueSlicesResponse, error)
+	// DescribeOutboundDestination returns the state and counts of the outbound queue circuit breakers of this host
+	// for the given namespace and destination.
+	DescribeOutboundDestination(ctx context.Context, in *DescribeOutboundDestinationRequest, opts ...grpc.CallOption) (*DescribeOutboundDestinationResponse, error)
+	// ResetOutboundCircuitBreaker forces the outbound queue circuit breakers of this host for the given namespace and
+	// destination back to the closed state.
+	ResetOutboundCircuitBreaker(ctx context.Context, in *ResetOutboundCircuitBreakerRequest, opts ...grpc.CallOption) (*ResetOutboundCircuitBreakerResponse, error)
+	// ForceResyncNamespace rewinds the replication streams of a shard towards the target cluster to the oldest
+	// replication task still retained by the shard, and resends the tasks of the given namespace up to the current
+	// replication read position. Streams are reset so the target cluster reconnects from the rewound position.
+	ForceResyncNamespace(ctx context.Context, in *ForceResyncNamespaceRequest, opts ...grpc.CallOption) (*ForceResyncNamespaceResponse, error)
 	// Complete an async Nexus Operation using a completion token. The completion state could be successful, failed, or
 	// canceled.
 	CompleteNexusOperation(ctx context.Context, in *CompleteNexusOperationRequest, opts ...grpc.CallOption) (*CompleteNexusOperationResponse, error)
@@ -613,6 +640,15 @@ func (c *historyServiceClient) DescribeMutableState(ctx context.Context, in *Des
 	return out, nil
 }
 
+func (c *historyServiceClient) DescribeWorkflowUpdates(ctx context.Context, in *DescribeWorkflowUpdatesRequest, opts ...grpc.CallOption) (*DescribeWorkflowUpdatesResponse, error) {
+	out := new(DescribeWorkflowUpdatesResponse)
+	err := c.cc.Invoke(ctx, HistoryService_DescribeWorkflowUpdates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) DescribeHistoryHost(ctx context.Context, in *DescribeHistoryHostRequest, opts ...grpc.CallOption) (*DescribeHistoryHostResponse, error) {
 	out := new(DescribeHistoryHostResponse)
 	err := c.cc.Invoke(ctx, HistoryService_DescribeHistoryHost_FullMethodName, in, out, opts...)
@@ -622,6 +658,15 @@ func (c *historyServiceClient) DescribeHistoryHost(ctx context.Context, in *Desc
 	return out, nil
 }
 
+func (c *historyServiceClient) DescribeHealth(ctx context.Context, in *DescribeHealthRequest, opts ...grpc.CallOption) (*DescribeHealthResponse, error) {
+	out := new(DescribeHealthResponse)
+	err := c.cc.Invoke(ctx, HistoryService_DescribeHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) CloseShard(ctx context.Context, in *CloseShardRequest, opts ...grpc.CallOption) (*CloseShardResponse, error) {
 	out := new(CloseShardResponse)
 	err := c.cc.Invoke(ctx, HistoryService_CloseShard_FullMethodName, in, out, opts...)
@@ -721,6 +766,15 @@ func (c *historyServiceClient) RefreshWorkflowTasks(ctx context.Context, in *Ref
 	return out, nil
 }
 
+func (c *historyServiceClient) RecomputeMutableStateChecksum(ctx context.Context, in *RecomputeMutableStateChecksumRequest, opts ...grpc.CallOption) (*RecomputeMutableStateChecksumResponse, error) {
+	out := new(RecomputeMutableStateChecksumResponse)
+	err := c.cc.Invoke(ctx, HistoryService_RecomputeMutableStateChecksum_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) GenerateLastHistoryReplicationTasks(ctx context.Context, in *GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*GenerateLastHistoryReplicationTasksResponse, error) {
 	out := new(GenerateLastHistoryReplicationTasksResponse)
 	err := c.cc.Invoke(ctx, HistoryService_GenerateLastHistoryReplicationTasks_FullMethodName, in, out, opts...)
@@ -905,6 +959,42 @@ func (c *historyServiceClient) ListTasks(ctx context.Context, in *ListTasksReque
 	return out, nil
 }
 
+func (c *historyServiceClient) CompactQueueSlices(ctx context.Context, in *CompactQueueSlicesRequest, opts ...grpc.CallOption) (*CompactQueueSlicesResponse, error) {
+	out := new(CompactQueueSlicesResponse)
+	err := c.cc.Invoke(ctx, HistoryService_CompactQueueSlices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) DescribeOutboundDestination(ctx context.Context, in *DescribeOutboundDestinationRequest, opts ...grpc.CallOption) (*DescribeOutboundDestinationResponse, error) {
+	out := new(DescribeOutboundDestinationResponse)
+	err := c.cc.Invoke(ctx, HistoryService_DescribeOutboundDestination_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) ResetOutboundCircuitBreaker(ctx context.Context, in *ResetOutboundCircuitBreakerRequest, opts ...grpc.CallOption) (*ResetOutboundCircuitBreakerResponse, error) {
+	out := new(ResetOutboundCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, HistoryService_ResetOutboundCircuitBreaker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) ForceResyncNamespace(ctx context.Context, in *ForceResyncNamespaceRequest, opts ...grpc.CallOption) (*ForceResyncNamespaceResponse, error) {
+	out := new(ForceResyncNamespaceResponse)
+	err := c.cc.Invoke(ctx, HistoryService_ForceResyncNamespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) CompleteNexusOperation(ctx context.Context, in *CompleteNexusOperationRequest, opts ...grpc.CallOption) (*CompleteNexusOperationResponse, error) {
 	out := new(CompleteNexusOperationResponse)
 	err := c.cc.Invoke(ctx, HistoryService_CompleteNexusOperation_FullMethodName, in, out, opts...)
@@ -1051,8 +1141,13 @@ type HistoryServiceServer interface {
 	SyncActivity(context.Context, *SyncActivityRequest) (*SyncActivityResponse, error)
 	// DescribeMutableState returns information about the internal states of workflow mutable state.
 	DescribeMutableState(context.Context, *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error)
+	// DescribeWorkflowUpdates returns the update counts of a workflow execution, as counted by its update registry
+	// against the in-flight and total update limits.
+	DescribeWorkflowUpdates(context.Context, *DescribeWorkflowUpdatesRequest) (*DescribeWorkflowUpdatesResponse, error)
 	// DescribeHistoryHost returns information about the internal states of a history host.
 	DescribeHistoryHost(context.Context, *DescribeHistoryHostRequest) (*DescribeHistoryHostResponse, error)
+	// DescribeHealth returns the health signals of a history host that are currently failing.
+	DescribeHealth(context.Context, *DescribeHealthRequest) (*DescribeHealthResponse, error)
 	// CloseShard close the shard.
 	CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error)
 	// GetShard gets the ShardInfo
@@ -1079,6 +1174,8 @@ type HistoryServiceServer interface {
 	MergeDLQMessages(context.Context, *MergeDLQMessagesRequest) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
+	// RecomputeMutableStateChecksum recomputes the checksum of a workflow's mutable state and persists it.
+	RecomputeMutableStateChecksum(context.Context, *RecomputeMutableStateChecksumRequest) (*RecomputeMutableStateChecksumResponse, error)
 	// GenerateLastHistoryReplicationTasks generate a replication task for last history event for requested workflow execution
 	GenerateLastHistoryReplicationTasks(context.Context, *GenerateLastHistoryReplicationTasksRequest) (*GenerateLastHistoryReplicationTasksResponse, error)
 	GetReplicationStatus(context.Context, *GetReplicationStatusRequest) (*GetReplicationStatusResponse, error)
@@ -1119,6 +1216,19 @@ type HistoryServiceServer interface {
 	// in the same shard. Calls to the persistence API will be batched by workflow run.
 	AddTasks(context.Context, *AddTasksRequest) (*AddTasksResponse, error)
 	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	// CompactQueueSlices forces the queue of the given task category in a shard to merge its slices immediately,
+	// instead of waiting for the slice count to exceed its critical threshold. It returns the resulting slice count.
+	CompactQueueSlices(context.Context, *CompactQueueSlicesRequest) (*CompactQueueSlicesResponse, error)
+	// DescribeOutboundDestination returns the state and counts of the outbound queue circuit breakers of this host
+	// for the given namespace and destination.
+	DescribeOutboundDestination(context.Context, *DescribeOutboundDestinationRequest) (*DescribeOutboundDestinationResponse, error)
+	// ResetOutboundCircuitBreaker forces the outbound queue circuit breakers of this host for the given namespace and
+	// destination back to the closed state.
+	ResetOutboundCircuitBreaker(context.Context, *ResetOutboundCircuitBreakerRequest) (*ResetOutboundCircuitBreakerResponse, error)
+	// ForceResyncNamespace rewinds the replication streams of a shard towards the target cluster to the oldest
+	// replication task still retained by the shard, and resends the tasks of the given namespace up to the current
+	// replication read position. Streams are reset so the target cluster reconnects from the rewound position.
+	ForceResyncNamespace(context.Context, *ForceResyncNamespaceRequest) (*ForceResyncNamespaceResponse, error)
 	// Complete an async Nexus Operation using a completion token. The completion state could be successful, failed, or
 	// canceled.
 	CompleteNexusOperation(context.Context, *CompleteNexusOperationRequest) (*CompleteNexusOperationResponse, error)
@@ -1225,9 +1335,15 @@ func (UnimplementedHistoryServiceServer) SyncActivity(context.Context, *SyncActi
 func (UnimplementedHistoryServiceServer) DescribeMutableState(context.Context, *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeMutableState not implemented")
 }
+func (UnimplementedHistoryServiceServer) DescribeWorkflowUpdates(context.Context, *DescribeWorkflowUpdatesRequest) (*DescribeWorkflowUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeWorkflowUpdates not implemented")
+}
 func (UnimplementedHistoryServiceServer) DescribeHistoryHost(context.Context, *DescribeHistoryHostRequest) (*DescribeHistoryHostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeHistoryHost not implemented")
 }
+func (UnimplementedHistoryServiceServer) DescribeHealth(context.Context, *DescribeHealthRequest) (*DescribeHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeHealth not implemented")
+}
 func (UnimplementedHistoryServiceServer) CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseShard not implemented")
 }
@@ -1261,6 +1377,9 @@ func (UnimplementedHistoryServiceServer) MergeDLQMessages(context.Context, *Merg
 func (UnimplementedHistoryServiceServer) RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshWorkflowTasks not implemented")
 }
+func (UnimplementedHistoryServiceServer) RecomputeMutableStateChecksum(context.Context, *RecomputeMutableStateChecksumRequest) (*RecomputeMutableStateChecksumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecomputeMutableStateChecksum not implemented")
+}
 func (UnimplementedHistoryServiceServer) GenerateLastHistoryReplicationTasks(context.Context, *GenerateLastHistoryReplicationTasksRequest) (*GenerateLastHistoryReplicationTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateLastHistoryReplicationTasks not implemented")
 }
@@ -1315,6 +1434,18 @@ func (UnimplementedHistoryServiceServer) AddTasks(context.Context, *AddTasksRequ
 func (UnimplementedHistoryServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
 }
+func (UnimplementedHistoryServiceServer) CompactQueueSlices(context.Context, *CompactQueueSlicesRequest) (*CompactQueueSlicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompactQueueSlices not implemented")
+}
+func (UnimplementedHistoryServiceServer) DescribeOutboundDestination(context.Context, *DescribeOutboundDestinationRequest) (*DescribeOutboundDestinationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeOutboundDestination not implemented")
+}
+func (UnimplementedHistoryServiceServer) ResetOutboundCircuitBreaker(context.Context, *ResetOutboundCircuitBreakerRequest) (*ResetOutboundCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetOutboundCircuitBreaker not implemented")
+}
+func (UnimplementedHistoryServiceServer) ForceResyncNamespace(context.Context, *ForceResyncNamespaceRequest) (*ForceResyncNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceResyncNamespace not implemented")
+}
 func (UnimplementedHistoryServiceServer) CompleteNexusOperation(context.Context, *CompleteNexusOperationRequest) (*CompleteNexusOperationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CompleteNexusOperation not implemented")
 }
@@ -1907,6 +2038,24 @@ func _HistoryService_DescribeMutableState_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_DescribeWorkflowUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeWorkflowUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DescribeWorkflowUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_DescribeWorkflowUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DescribeWorkflowUpdates(ctx, req.(*DescribeWorkflowUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_DescribeHistoryHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeHistoryHostRequest)
 	if err := dec(in); err != nil {
@@ -1925,6 +2074,24 @@ func _HistoryService_DescribeHistoryHost_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_DescribeHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DescribeHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_DescribeHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DescribeHealth(ctx, req.(*DescribeHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_CloseShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CloseShardRequest)
 	if err := dec(in); err != nil {
@@ -2123,6 +2290,24 @@ func _HistoryService_RefreshWorkflowTasks_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_RecomputeMutableStateChecksum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecomputeMutableStateChecksumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).RecomputeMutableStateChecksum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_RecomputeMutableStateChecksum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).RecomputeMutableStateChecksum(ctx, req.(*RecomputeMutableStateChecksumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_GenerateLastHistoryReplicationTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GenerateLastHistoryReplicationTasksRequest)
 	if err := dec(in); err != nil {
@@ -2455,6 +2640,78 @@ func _HistoryService_ListTasks_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_CompactQueueSlices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactQueueSlicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).CompactQueueSlices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_CompactQueueSlices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).CompactQueueSlices(ctx, req.(*CompactQueueSlicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_DescribeOutboundDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeOutboundDestinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DescribeOutboundDestination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_DescribeOutboundDestination_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DescribeOutboundDestination(ctx, req.(*DescribeOutboundDestinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_ResetOutboundCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetOutboundCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ResetOutboundCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_ResetOutboundCircuitBreaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ResetOutboundCircuitBreaker(ctx, req.(*ResetOutboundCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_ForceResyncNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceResyncNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ForceResyncNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_ForceResyncNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ForceResyncNamespace(ctx, req.(*ForceResyncNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_CompleteNexusOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CompleteNexusOperationRequest)
 	if err := dec(in); err != nil {
@@ -2608,10 +2865,18 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeMutableState",
 			Handler:    _HistoryService_DescribeMutableState_Handler,
 		},
+		{
+			MethodName: "DescribeWorkflowUpdates",
+			Handler:    _HistoryService_DescribeWorkflowUpdates_Handler,
+		},
 		{
 			MethodName: "DescribeHistoryHost",
 			Handler:    _HistoryService_DescribeHistoryHost_Handler,
 		},
+		{
+			MethodName: "DescribeHealth",
+			Handler:    _HistoryService_DescribeHealth_Handler,
+		},
 		{
 			MethodName: "CloseShard",
 			Handler:    _HistoryService_CloseShard_Handler,
@@ -2656,6 +2921,10 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshWorkflowTasks",
 			Handler:    _HistoryService_RefreshWorkflowTasks_Handler,
 		},
+		{
+			MethodName: "RecomputeMutableStateChecksum",
+			Handler:    _HistoryService_RecomputeMutableStateChecksum_Handler,
+		},
 		{
 			MethodName: "GenerateLastHistoryReplicationTasks",
 			Handler:    _HistoryService_GenerateLastHistoryReplicationTasks_Handler,
@@ -2724,6 +2993,22 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTasks",
 			Handler:    _HistoryService_ListTasks_Handler,
 		},
+		{
+			MethodName: "CompactQueueSlices",
+			Handler:    _HistoryService_CompactQueueSlices_Handler,
+		},
+		{
+			MethodName: "DescribeOutboundDestination",
+			Handler:    _HistoryService_DescribeOutboundDestination_Handler,
+		},
+		{
+			MethodName: "ResetOutboundCircuitBreaker",
+			Handler:    _HistoryService_ResetOutboundCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "ForceResyncNamespace",
+			Handler:    _HistoryService_ForceResyncNamespace_Handler,
+		},
 		{
 			MethodName: "CompleteNexusOperation",
 			Handler:    _HistoryService_CompleteNexusOperation_Handler,
@@ -101,6 +101,26 @@ func (mr *MockHistoryServiceClientMockRecorder) CloseShard(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockHistoryServiceClient)(nil).CloseShard), varargs...)
 }
 
+// CompactQueueSlices mocks base method.
+func (m *MockHistoryServiceClient) CompactQueueSlices(ctx context.Context, in *historyservice.CompactQueueSlicesRequest, opts ...grpc.CallOption) (*historyservice.CompactQueueSlicesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CompactQueueSlices", varargs...)
+	ret0, _ := ret[0].(*historyservice.CompactQueueSlicesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompactQueueSlices indicates an expected call of CompactQueueSlices.
+func (mr *MockHistoryServiceClientMockRecorder) CompactQueueSlices(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactQueueSlices", reflect.TypeOf((*MockHistoryServiceClient)(nil).CompactQueueSlices), varargs...)
+}
+
 // CompleteNexusOperation mocks base method.
 func (m *MockHistoryServiceClient) CompleteNexusOperation(ctx context.Context, in *historyservice.CompleteNexusOperationRequest, opts ...grpc.CallOption) (*historyservice.CompleteNexusOperationResponse, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +201,26 @@ func (mr *MockHistoryServiceClientMockRecorder) DeleteWorkflowVisibilityRecord(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowVisibilityRecord", reflect.TypeOf((*MockHistoryServiceClient)(nil).DeleteWorkflowVisibilityRecord), varargs...)
 }
 
+// DescribeHealth mocks base method.
+func (m *MockHistoryServiceClient) DescribeHealth(ctx context.Context, in *historyservice.DescribeHealthRequest, opts ...grpc.CallOption) (*historyservice.DescribeHealthResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeHealth", varargs...)
+	ret0, _ := ret[0].(*historyservice.DescribeHealthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeHealth indicates an expected call of DescribeHealth.
+func (mr *MockHistoryServiceClientMockRecorder) DescribeHealth(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHealth", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeHealth), varargs...)
+}
+
 // DescribeHistoryHost mocks base method.
 func (m *MockHistoryServiceClient) DescribeHistoryHost(ctx context.Context, in *historyservice.DescribeHistoryHostRequest, opts ...grpc.CallOption) (*historyservice.DescribeHistoryHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -221,6 +261,26 @@ func (mr *MockHistoryServiceClientMockRecorder) DescribeMutableState(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeOutboundDestination mocks base method.
+func (m *MockHistoryServiceClient) DescribeOutboundDestination(ctx context.Context, in *historyservice.DescribeOutboundDestinationRequest, opts ...grpc.CallOption) (*historyservice.DescribeOutboundDestinationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeOutboundDestination", varargs...)
+	ret0, _ := ret[0].(*historyservice.DescribeOutboundDestinationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOutboundDestination indicates an expected call of DescribeOutboundDestination.
+func (mr *MockHistoryServiceClientMockRecorder) DescribeOutboundDestination(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOutboundDestination", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeOutboundDestination), varargs...)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockHistoryServiceClient) DescribeWorkflowExecution(ctx context.Context, in *historyservice.DescribeWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -241,6 +301,26 @@ func (mr *MockHistoryServiceClientMockRecorder) DescribeWorkflowExecution(ctx, i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeWorkflowExecution), varargs...)
 }
 
+// DescribeWorkflowUpdates mocks base method.
+func (m *MockHistoryServiceClient) DescribeWorkflowUpdates(ctx context.Context, in *historyservice.DescribeWorkflowUpdatesRequest, opts ...grpc.CallOption) (*historyservice.DescribeWorkflowUpdatesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeWorkflowUpdates", varargs...)
+	ret0, _ := ret[0].(*historyservice.DescribeWorkflowUpdatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkflowUpdates indicates an expected call of DescribeWorkflowUpdates.
+func (mr *MockHistoryServiceClientMockRecorder) DescribeWorkflowUpdates(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowUpdates", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeWorkflowUpdates), varargs...)
+}
+
 // ExecuteMultiOperation mocks base method.
 func (m *MockHistoryServiceClient) ExecuteMultiOperation(ctx context.Context, in *historyservice.ExecuteMultiOperationRequest, opts ...grpc.CallOption) (*historyservice.ExecuteMultiOperationResponse, error) {
 	m.ctrl.T.Helper()
@@ -281,6 +361,26 @@ func (mr *MockHistoryServiceClientMockRecorder) ForceDeleteWorkflowExecution(ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceDeleteWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).ForceDeleteWorkflowExecution), varargs...)
 }
 
+// ForceResyncNamespace mocks base method.
+func (m *MockHistoryServiceClient) ForceResyncNamespace(ctx context.Context, in *historyservice.ForceResyncNamespaceRequest, opts ...grpc.CallOption) (*historyservice.ForceResyncNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ForceResyncNamespace", varargs...)
+	ret0, _ := ret[0].(*historyservice.ForceResyncNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForceResyncNamespace indicates an expected call of ForceResyncNamespace.
+func (mr *MockHistoryServiceClientMockRecorder) ForceResyncNamespace(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceResyncNamespace", reflect.TypeOf((*MockHistoryServiceClient)(nil).ForceResyncNamespace), varargs...)
+}
+
 // GenerateLastHistoryReplicationTasks mocks base method.
 func (m *MockHistoryServiceClient) GenerateLastHistoryReplicationTasks(ctx context.Context, in *historyservice.GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*historyservice.GenerateLastHistoryReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -761,6 +861,26 @@ func (mr *MockHistoryServiceClientMockRecorder) RebuildMutableState(ctx, in inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockHistoryServiceClient)(nil).RebuildMutableState), varargs...)
 }
 
+// RecomputeMutableStateChecksum mocks base method.
+func (m *MockHistoryServiceClient) RecomputeMutableStateChecksum(ctx context.Context, in *historyservice.RecomputeMutableStateChecksumRequest, opts ...grpc.CallOption) (*historyservice.RecomputeMutableStateChecksumResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RecomputeMutableStateChecksum", varargs...)
+	ret0, _ := ret[0].(*historyservice.RecomputeMutableStateChecksumResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecomputeMutableStateChecksum indicates an expected call of RecomputeMutableStateChecksum.
+func (mr *MockHistoryServiceClientMockRecorder) RecomputeMutableStateChecksum(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecomputeMutableStateChecksum", reflect.TypeOf((*MockHistoryServiceClient)(nil).RecomputeMutableStateChecksum), varargs...)
+}
+
 // RecordActivityTaskHeartbeat mocks base method.
 func (m *MockHistoryServiceClient) RecordActivityTaskHeartbeat(ctx context.Context, in *historyservice.RecordActivityTaskHeartbeatRequest, opts ...grpc.CallOption) (*historyservice.RecordActivityTaskHeartbeatResponse, error) {
 	m.ctrl.T.Helper()
@@ -961,6 +1081,26 @@ func (mr *MockHistoryServiceClientMockRecorder) RequestCancelWorkflowExecution(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).RequestCancelWorkflowExecution), varargs...)
 }
 
+// ResetOutboundCircuitBreaker mocks base method.
+func (m *MockHistoryServiceClient) ResetOutboundCircuitBreaker(ctx context.Context, in *historyservice.ResetOutboundCircuitBreakerRequest, opts ...grpc.CallOption) (*historyservice.ResetOutboundCircuitBreakerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetOutboundCircuitBreaker", varargs...)
+	ret0, _ := ret[0].(*historyservice.ResetOutboundCircuitBreakerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetOutboundCircuitBreaker indicates an expected call of ResetOutboundCircuitBreaker.
+func (mr *MockHistoryServiceClientMockRecorder) ResetOutboundCircuitBreaker(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetOutboundCircuitBreaker", reflect.TypeOf((*MockHistoryServiceClient)(nil).ResetOutboundCircuitBreaker), varargs...)
+}
+
 // ResetStickyTaskQueue mocks base method.
 func (m *MockHistoryServiceClient) ResetStickyTaskQueue(ctx context.Context, in *historyservice.ResetStickyTaskQueueRequest, opts ...grpc.CallOption) (*historyservice.ResetStickyTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -1511,6 +1651,21 @@ func (mr *MockHistoryServiceServerMockRecorder) CloseShard(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockHistoryServiceServer)(nil).CloseShard), arg0, arg1)
 }
 
+// CompactQueueSlices mocks base method.
+func (m *MockHistoryServiceServer) CompactQueueSlices(arg0 context.Context, arg1 *historyservice.CompactQueueSlicesRequest) (*historyservice.CompactQueueSlicesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompactQueueSlices", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.CompactQueueSlicesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompactQueueSlices indicates an expected call of CompactQueueSlices.
+func (mr *MockHistoryServiceServerMockRecorder) CompactQueueSlices(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactQueueSlices", reflect.TypeOf((*MockHistoryServiceServer)(nil).CompactQueueSlices), arg0, arg1)
+}
+
 // CompleteNexusOperation mocks base method.
 func (m *MockHistoryServiceServer) CompleteNexusOperation(arg0 context.Context, arg1 *historyservice.CompleteNexusOperationRequest) (*historyservice.CompleteNexusOperationResponse, error) {
 	m.ctrl.T.Helper()
@@ -1571,6 +1726,21 @@ func (mr *MockHistoryServiceServerMockRecorder) DeleteWorkflowVisibilityRecord(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowVisibilityRecord", reflect.TypeOf((*MockHistoryServiceServer)(nil).DeleteWorkflowVisibilityRecord), arg0, arg1)
 }
 
+// DescribeHealth mocks base method.
+func (m *MockHistoryServiceServer) DescribeHealth(arg0 context.Context, arg1 *historyservice.DescribeHealthRequest) (*historyservice.DescribeHealthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeHealth", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DescribeHealthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeHealth indicates an expected call of DescribeHealth.
+func (mr *MockHistoryServiceServerMockRecorder) DescribeHealth(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHealth", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeHealth), arg0, arg1)
+}
+
 // DescribeHistoryHost mocks base method.
 func (m *MockHistoryServiceServer) DescribeHistoryHost(arg0 context.Context, arg1 *historyservice.DescribeHistoryHostRequest) (*historyservice.DescribeHistoryHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -1601,6 +1771,21 @@ func (mr *MockHistoryServiceServerMockRecorder) DescribeMutableState(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeOutboundDestination mocks base method.
+func (m *MockHistoryServiceServer) DescribeOutboundDestination(arg0 context.Context, arg1 *historyservice.DescribeOutboundDestinationRequest) (*historyservice.DescribeOutboundDestinationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOutboundDestination", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DescribeOutboundDestinationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOutboundDestination indicates an expected call of DescribeOutboundDestination.
+func (mr *MockHistoryServiceServerMockRecorder) DescribeOutboundDestination(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOutboundDestination", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeOutboundDestination), arg0, arg1)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockHistoryServiceServer) DescribeWorkflowExecution(arg0 context.Context, arg1 *historyservice.DescribeWorkflowExecutionRequest) (*historyservice.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1616,6 +1801,21 @@ func (mr *MockHistoryServiceServerMockRecorder) DescribeWorkflowExecution(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeWorkflowExecution), arg0, arg1)
 }
 
+// DescribeWorkflowUpdates mocks base method.
+func (m *MockHistoryServiceServer) DescribeWorkflowUpdates(arg0 context.Context, arg1 *historyservice.DescribeWorkflowUpdatesRequest) (*historyservice.DescribeWorkflowUpdatesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeWorkflowUpdates", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DescribeWorkflowUpdatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkflowUpdates indicates an expected call of DescribeWorkflowUpdates.
+func (mr *MockHistoryServiceServerMockRecorder) DescribeWorkflowUpdates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowUpdates", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeWorkflowUpdates), arg0, arg1)
+}
+
 // ExecuteMultiOperation mocks base method.
 func (m *MockHistoryServiceServer) ExecuteMultiOperation(arg0 context.Context, arg1 *historyservice.ExecuteMultiOperationRequest) (*historyservice.ExecuteMultiOperationResponse, error) {
 	m.ctrl.T.Helper()
@@ -1646,6 +1846,21 @@ func (mr *MockHistoryServiceServerMockRecorder) ForceDeleteWorkflowExecution(arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceDeleteWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).ForceDeleteWorkflowExecution), arg0, arg1)
 }
 
+// ForceResyncNamespace mocks base method.
+func (m *MockHistoryServiceServer) ForceResyncNamespace(arg0 context.Context, arg1 *historyservice.ForceResyncNamespaceRequest) (*historyservice.ForceResyncNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceResyncNamespace", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ForceResyncNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForceResyncNamespace indicates an expected call of ForceResyncNamespace.
+func (mr *MockHistoryServiceServerMockRecorder) ForceResyncNamespace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceResyncNamespace", reflect.TypeOf((*MockHistoryServiceServer)(nil).ForceResyncNamespace), arg0, arg1)
+}
+
 // GenerateLastHistoryReplicationTasks mocks base method.
 func (m *MockHistoryServiceServer) GenerateLastHistoryReplicationTasks(arg0 context.Context, arg1 *historyservice.GenerateLastHistoryReplicationTasksRequest) (*historyservice.GenerateLastHistoryReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -2006,6 +2221,21 @@ func (mr *MockHistoryServiceServerMockRecorder) RebuildMutableState(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockHistoryServiceServer)(nil).RebuildMutableState), arg0, arg1)
 }
 
+// RecomputeMutableStateChecksum mocks base method.
+func (m *MockHistoryServiceServer) RecomputeMutableStateChecksum(arg0 context.Context, arg1 *historyservice.RecomputeMutableStateChecksumRequest) (*historyservice.RecomputeMutableStateChecksumResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecomputeMutableStateChecksum", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.RecomputeMutableStateChecksumResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecomputeMutableStateChecksum indicates an expected call of RecomputeMutableStateChecksum.
+func (mr *MockHistoryServiceServerMockRecorder) RecomputeMutableStateChecksum(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecomputeMutableStateChecksum", reflect.TypeOf((*MockHistoryServiceServer)(nil).RecomputeMutableStateChecksum), arg0, arg1)
+}
+
 // RecordActivityTaskHeartbeat mocks base method.
 func (m *MockHistoryServiceServer) RecordActivityTaskHeartbeat(arg0 context.Context, arg1 *historyservice.RecordActivityTaskHeartbeatRequest) (*historyservice.RecordActivityTaskHeartbeatResponse, error) {
 	m.ctrl.T.Helper()
@@ -2156,6 +2386,21 @@ func (mr *MockHistoryServiceServerMockRecorder) RequestCancelWorkflowExecution(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).RequestCancelWorkflowExecution), arg0, arg1)
 }
 
+// ResetOutboundCircuitBreaker mocks base method.
+func (m *MockHistoryServiceServer) ResetOutboundCircuitBreaker(arg0 context.Context, arg1 *historyservice.ResetOutboundCircuitBreakerRequest) (*historyservice.ResetOutboundCircuitBreakerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetOutboundCircuitBreaker", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ResetOutboundCircuitBreakerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetOutboundCircuitBreaker indicates an expected call of ResetOutboundCircuitBreaker.
+func (mr *MockHistoryServiceServerMockRecorder) ResetOutboundCircuitBreaker(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetOutboundCircuitBreaker", reflect.TypeOf((*MockHistoryServiceServer)(nil).ResetOutboundCircuitBreaker), arg0, arg1)
+}
+
 // ResetStickyTaskQueue mocks base method.
 func (m *MockHistoryServiceServer) ResetStickyTaskQueue(arg0 context.Context, arg1 *historyservice.ResetStickyTaskQueueRequest) (*historyservice.ResetStickyTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -695,6 +695,80 @@ func (this *DescribeTaskQueuePartitionResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type ListTaskQueuePollersRequest to the protobuf v3 wire format
+func (val *ListTaskQueuePollersRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListTaskQueuePollersRequest from the protobuf v3 wire format
+func (val *ListTaskQueuePollersRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListTaskQueuePollersRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListTaskQueuePollersRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListTaskQueuePollersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListTaskQueuePollersRequest
+	switch t := that.(type) {
+	case *ListTaskQueuePollersRequest:
+		that1 = t
+	case ListTaskQueuePollersRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListTaskQueuePollersResponse to the protobuf v3 wire format
+func (val *ListTaskQueuePollersResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListTaskQueuePollersResponse from the protobuf v3 wire format
+func (val *ListTaskQueuePollersResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListTaskQueuePollersResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListTaskQueuePollersResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListTaskQueuePollersResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListTaskQueuePollersResponse
+	switch t := that.(type) {
+	case *ListTaskQueuePollersResponse:
+		that1 = t
+	case ListTaskQueuePollersResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type ListTaskQueuePartitionsRequest to the protobuf v3 wire format
 func (val *ListTaskQueuePartitionsRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
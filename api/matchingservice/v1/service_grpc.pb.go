@@ -55,6 +55,7 @@ const (
 	MatchingService_CancelOutstandingPoll_FullMethodName                  = "/temporal.server.api.matchingservice.v1.MatchingService/CancelOutstandingPoll"
 	MatchingService_DescribeTaskQueue_FullMethodName                      = "/temporal.server.api.matchingservice.v1.MatchingService/DescribeTaskQueue"
 	MatchingService_DescribeTaskQueuePartition_FullMethodName             = "/temporal.server.api.matchingservice.v1.MatchingService/DescribeTaskQueuePartition"
+	MatchingService_ListTaskQueuePollers_FullMethodName                   = "/temporal.server.api.matchingservice.v1.MatchingService/ListTaskQueuePollers"
 	MatchingService_ListTaskQueuePartitions_FullMethodName                = "/temporal.server.api.matchingservice.v1.MatchingService/ListTaskQueuePartitions"
 	MatchingService_UpdateWorkerBuildIdCompatibility_FullMethodName       = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateWorkerBuildIdCompatibility"
 	MatchingService_GetWorkerBuildIdCompatibility_FullMethodName          = "/temporal.server.api.matchingservice.v1.MatchingService/GetWorkerBuildIdCompatibility"
@@ -113,6 +114,9 @@ type MatchingServiceClient interface {
 	DescribeTaskQueue(ctx context.Context, in *DescribeTaskQueueRequest, opts ...grpc.CallOption) (*DescribeTaskQueueResponse, error)
 	// DescribeTaskQueuePartition returns information about the target task queue partition.
 	DescribeTaskQueuePartition(ctx context.Context, in *DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*DescribeTaskQueuePartitionResponse, error)
+	// ListTaskQueuePollers returns the pollers recently seen by the target task queue partition, most recent first.
+	// It only reads the in-memory poller history and returns nothing if the partition is not loaded.
+	ListTaskQueuePollers(ctx context.Context, in *ListTaskQueuePollersRequest, opts ...grpc.CallOption) (*ListTaskQueuePollersResponse, error)
 	// ListTaskQueuePartitions returns a map of partitionKey and hostAddress for a task queue.
 	ListTaskQueuePartitions(ctx context.Context, in *ListTaskQueuePartitionsRequest, opts ...grpc.CallOption) (*ListTaskQueuePartitionsResponse, error)
 	// (-- api-linter: core::0134::response-message-name=disabled
@@ -322,6 +326,15 @@ func (c *matchingServiceClient) DescribeTaskQueuePartition(ctx context.Context,
 	return out, nil
 }
 
+func (c *matchingServiceClient) ListTaskQueuePollers(ctx context.Context, in *ListTaskQueuePollersRequest, opts ...grpc.CallOption) (*ListTaskQueuePollersResponse, error) {
+	out := new(ListTaskQueuePollersResponse)
+	err := c.cc.Invoke(ctx, MatchingService_ListTaskQueuePollers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *matchingServiceClient) ListTaskQueuePartitions(ctx context.Context, in *ListTaskQueuePartitionsRequest, opts ...grpc.CallOption) (*ListTaskQueuePartitionsResponse, error) {
 	out := new(ListTaskQueuePartitionsResponse)
 	err := c.cc.Invoke(ctx, MatchingService_ListTaskQueuePartitions_FullMethodName, in, out, opts...)
@@ -498,6 +511,9 @@ type MatchingServiceServer interface {
 	DescribeTaskQueue(context.Context, *DescribeTaskQueueRequest) (*DescribeTaskQueueResponse, error)
 	// DescribeTaskQueuePartition returns information about the target task queue partition.
 	DescribeTaskQueuePartition(context.Context, *DescribeTaskQueuePartitionRequest) (*DescribeTaskQueuePartitionResponse, error)
+	// ListTaskQueuePollers returns the pollers recently seen by the target task queue partition, most recent first.
+	// It only reads the in-memory poller history and returns nothing if the partition is not loaded.
+	ListTaskQueuePollers(context.Context, *ListTaskQueuePollersRequest) (*ListTaskQueuePollersResponse, error)
 	// ListTaskQueuePartitions returns a map of partitionKey and hostAddress for a task queue.
 	ListTaskQueuePartitions(context.Context, *ListTaskQueuePartitionsRequest) (*ListTaskQueuePartitionsResponse, error)
 	// (-- api-linter: core::0134::response-message-name=disabled
@@ -626,6 +642,9 @@ func (UnimplementedMatchingServiceServer) DescribeTaskQueue(context.Context, *De
 func (UnimplementedMatchingServiceServer) DescribeTaskQueuePartition(context.Context, *DescribeTaskQueuePartitionRequest) (*DescribeTaskQueuePartitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskQueuePartition not implemented")
 }
+func (UnimplementedMatchingServiceServer) ListTaskQueuePollers(context.Context, *ListTaskQueuePollersRequest) (*ListTaskQueuePollersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskQueuePollers not implemented")
+}
 func (UnimplementedMatchingServiceServer) ListTaskQueuePartitions(context.Context, *ListTaskQueuePartitionsRequest) (*ListTaskQueuePartitionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTaskQueuePartitions not implemented")
 }
@@ -918,6 +937,24 @@ func _MatchingService_DescribeTaskQueuePartition_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_ListTaskQueuePollers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskQueuePollersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).ListTaskQueuePollers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_ListTaskQueuePollers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).ListTaskQueuePollers(ctx, req.(*ListTaskQueuePollersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MatchingService_ListTaskQueuePartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListTaskQueuePartitionsRequest)
 	if err := dec(in); err != nil {
@@ -1247,6 +1284,10 @@ var MatchingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeTaskQueuePartition",
 			Handler:    _MatchingService_DescribeTaskQueuePartition_Handler,
 		},
+		{
+			MethodName: "ListTaskQueuePollers",
+			Handler:    _MatchingService_ListTaskQueuePollers_Handler,
+		},
 		{
 			MethodName: "ListTaskQueuePartitions",
 			Handler:    _MatchingService_ListTaskQueuePartitions_Handler,
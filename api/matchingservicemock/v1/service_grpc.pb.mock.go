@@ -380,6 +380,26 @@ func (mr *MockMatchingServiceClientMockRecorder) ListTaskQueuePartitions(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueuePartitions", reflect.TypeOf((*MockMatchingServiceClient)(nil).ListTaskQueuePartitions), varargs...)
 }
 
+// ListTaskQueuePollers mocks base method.
+func (m *MockMatchingServiceClient) ListTaskQueuePollers(ctx context.Context, in *matchingservice.ListTaskQueuePollersRequest, opts ...grpc.CallOption) (*matchingservice.ListTaskQueuePollersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTaskQueuePollers", varargs...)
+	ret0, _ := ret[0].(*matchingservice.ListTaskQueuePollersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskQueuePollers indicates an expected call of ListTaskQueuePollers.
+func (mr *MockMatchingServiceClientMockRecorder) ListTaskQueuePollers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueuePollers", reflect.TypeOf((*MockMatchingServiceClient)(nil).ListTaskQueuePollers), varargs...)
+}
+
 // PollActivityTaskQueue mocks base method.
 func (m *MockMatchingServiceClient) PollActivityTaskQueue(ctx context.Context, in *matchingservice.PollActivityTaskQueueRequest, opts ...grpc.CallOption) (*matchingservice.PollActivityTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -883,6 +903,21 @@ func (mr *MockMatchingServiceServerMockRecorder) ListTaskQueuePartitions(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueuePartitions", reflect.TypeOf((*MockMatchingServiceServer)(nil).ListTaskQueuePartitions), arg0, arg1)
 }
 
+// ListTaskQueuePollers mocks base method.
+func (m *MockMatchingServiceServer) ListTaskQueuePollers(arg0 context.Context, arg1 *matchingservice.ListTaskQueuePollersRequest) (*matchingservice.ListTaskQueuePollersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskQueuePollers", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.ListTaskQueuePollersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskQueuePollers indicates an expected call of ListTaskQueuePollers.
+func (mr *MockMatchingServiceServerMockRecorder) ListTaskQueuePollers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueuePollers", reflect.TypeOf((*MockMatchingServiceServer)(nil).ListTaskQueuePollers), arg0, arg1)
+}
+
 // PollActivityTaskQueue mocks base method.
 func (m *MockMatchingServiceServer) PollActivityTaskQueue(arg0 context.Context, arg1 *matchingservice.PollActivityTaskQueueRequest) (*matchingservice.PollActivityTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +103,43 @@ func (this *QueueReaderState) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type ReplicationNamespaceResync to the protobuf v3 wire format
+func (val *ReplicationNamespaceResync) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReplicationNamespaceResync from the protobuf v3 wire format
+func (val *ReplicationNamespaceResync) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReplicationNamespaceResync) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReplicationNamespaceResync values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReplicationNamespaceResync) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReplicationNamespaceResync
+	switch t := that.(type) {
+	case *ReplicationNamespaceResync:
+		that1 = t
+	case ReplicationNamespaceResync:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type QueueSliceScope to the protobuf v3 wire format
 func (val *QueueSliceScope) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -140,6 +140,80 @@ func (this *PhysicalTaskQueueInfo) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type RateLimitInfo to the protobuf v3 wire format
+func (val *RateLimitInfo) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RateLimitInfo from the protobuf v3 wire format
+func (val *RateLimitInfo) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RateLimitInfo) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RateLimitInfo values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RateLimitInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RateLimitInfo
+	switch t := that.(type) {
+	case *RateLimitInfo:
+		that1 = t
+	case RateLimitInfo:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PollerStats to the protobuf v3 wire format
+func (val *PollerStats) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PollerStats from the protobuf v3 wire format
+func (val *PollerStats) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PollerStats) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PollerStats values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PollerStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PollerStats
+	switch t := that.(type) {
+	case *PollerStats:
+		that1 = t
+	case PollerStats:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type TaskQueuePartition to the protobuf v3 wire format
 func (val *TaskQueuePartition) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
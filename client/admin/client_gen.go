@@ -83,6 +83,16 @@ func (c *clientImpl) CloseShard(
 	return c.client.CloseShard(ctx, request, opts...)
 }
 
+func (c *clientImpl) CompactQueueSlices(
+	ctx context.Context,
+	request *adminservice.CompactQueueSlicesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CompactQueueSlicesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CompactQueueSlices(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -123,6 +133,26 @@ func (c *clientImpl) DescribeHistoryHost(
 	return c.client.DescribeHistoryHost(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeHistoryHostHealth(
+	ctx context.Context,
+	request *adminservice.DescribeHistoryHostHealthRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeHistoryHostHealthResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeHistoryHostHealth(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeKeepAliveParameters(
+	ctx context.Context,
+	request *adminservice.DescribeKeepAliveParametersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeKeepAliveParametersResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeKeepAliveParameters(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeMutableState(
 	ctx context.Context,
 	request *adminservice.DescribeMutableStateRequest,
@@ -133,6 +163,56 @@ func (c *clientImpl) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeNamespaces(
+	ctx context.Context,
+	request *adminservice.DescribeNamespacesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespacesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeNamespaces(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeOutboundDestination(
+	ctx context.Context,
+	request *adminservice.DescribeOutboundDestinationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeOutboundDestinationResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeOutboundDestination(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeWorkflowUpdates(
+	ctx context.Context,
+	request *adminservice.DescribeWorkflowUpdatesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeWorkflowUpdatesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeWorkflowUpdates(ctx, request, opts...)
+}
+
+func (c *clientImpl) ForceResyncNamespace(
+	ctx context.Context,
+	request *adminservice.ForceResyncNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ForceResyncNamespaceResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ForceResyncNamespace(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetBuildIdTaskQueueMapping(
+	ctx context.Context,
+	request *adminservice.GetBuildIdTaskQueueMappingRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetBuildIdTaskQueueMappingResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetBuildIdTaskQueueMapping(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetDLQMessages(
 	ctx context.Context,
 	request *adminservice.GetDLQMessagesRequest,
@@ -283,6 +363,16 @@ func (c *clientImpl) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListNamespaces(
+	ctx context.Context,
+	request *adminservice.ListNamespacesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListNamespacesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListNamespaces(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListQueues(
 	ctx context.Context,
 	request *adminservice.ListQueuesRequest,
@@ -293,6 +383,16 @@ func (c *clientImpl) ListQueues(
 	return c.client.ListQueues(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListTaskQueuePollers(
+	ctx context.Context,
+	request *adminservice.ListTaskQueuePollersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListTaskQueuePollersResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListTaskQueuePollers(ctx, request, opts...)
+}
+
 func (c *clientImpl) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -353,6 +453,16 @@ func (c *clientImpl) RebuildMutableState(
 	return c.client.RebuildMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) RecomputeMutableStateChecksum(
+	ctx context.Context,
+	request *adminservice.RecomputeMutableStateChecksumRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RecomputeMutableStateChecksumResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RecomputeMutableStateChecksum(ctx, request, opts...)
+}
+
 func (c *clientImpl) RefreshWorkflowTasks(
 	ctx context.Context,
 	request *adminservice.RefreshWorkflowTasksRequest,
@@ -402,3 +512,23 @@ func (c *clientImpl) ResendReplicationTasks(
 	defer cancel()
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
+
+func (c *clientImpl) ResetOutboundCircuitBreaker(
+	ctx context.Context,
+	request *adminservice.ResetOutboundCircuitBreakerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResetOutboundCircuitBreakerResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ResetOutboundCircuitBreaker(ctx, request, opts...)
+}
+
+func (c *clientImpl) UnloadTaskQueuePartition(
+	ctx context.Context,
+	request *adminservice.UnloadTaskQueuePartitionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnloadTaskQueuePartitionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UnloadTaskQueuePartition(ctx, request, opts...)
+}
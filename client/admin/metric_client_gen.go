@@ -103,6 +103,20 @@ func (c *metricClient) CloseShard(
 	return c.client.CloseShard(ctx, request, opts...)
 }
 
+func (c *metricClient) CompactQueueSlices(
+	ctx context.Context,
+	request *adminservice.CompactQueueSlicesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.CompactQueueSlicesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientCompactQueueSlices")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CompactQueueSlices(ctx, request, opts...)
+}
+
 func (c *metricClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -159,6 +173,34 @@ func (c *metricClient) DescribeHistoryHost(
 	return c.client.DescribeHistoryHost(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeHistoryHostHealth(
+	ctx context.Context,
+	request *adminservice.DescribeHistoryHostHealthRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeHistoryHostHealthResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeHistoryHostHealth")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeHistoryHostHealth(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeKeepAliveParameters(
+	ctx context.Context,
+	request *adminservice.DescribeKeepAliveParametersRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeKeepAliveParametersResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeKeepAliveParameters")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeKeepAliveParameters(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeMutableState(
 	ctx context.Context,
 	request *adminservice.DescribeMutableStateRequest,
@@ -173,6 +215,76 @@ func (c *metricClient) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeNamespaces(
+	ctx context.Context,
+	request *adminservice.DescribeNamespacesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeNamespacesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeNamespaces")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeNamespaces(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeOutboundDestination(
+	ctx context.Context,
+	request *adminservice.DescribeOutboundDestinationRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeOutboundDestinationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeOutboundDestination")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeOutboundDestination(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeWorkflowUpdates(
+	ctx context.Context,
+	request *adminservice.DescribeWorkflowUpdatesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeWorkflowUpdatesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeWorkflowUpdates")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeWorkflowUpdates(ctx, request, opts...)
+}
+
+func (c *metricClient) ForceResyncNamespace(
+	ctx context.Context,
+	request *adminservice.ForceResyncNamespaceRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ForceResyncNamespaceResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientForceResyncNamespace")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ForceResyncNamespace(ctx, request, opts...)
+}
+
+func (c *metricClient) GetBuildIdTaskQueueMapping(
+	ctx context.Context,
+	request *adminservice.GetBuildIdTaskQueueMappingRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetBuildIdTaskQueueMappingResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetBuildIdTaskQueueMapping")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetBuildIdTaskQueueMapping(ctx, request, opts...)
+}
+
 func (c *metricClient) GetDLQMessages(
 	ctx context.Context,
 	request *adminservice.GetDLQMessagesRequest,
@@ -383,6 +495,20 @@ func (c *metricClient) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) ListNamespaces(
+	ctx context.Context,
+	request *adminservice.ListNamespacesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListNamespacesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListNamespaces")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListNamespaces(ctx, request, opts...)
+}
+
 func (c *metricClient) ListQueues(
 	ctx context.Context,
 	request *adminservice.ListQueuesRequest,
@@ -397,6 +523,20 @@ func (c *metricClient) ListQueues(
 	return c.client.ListQueues(ctx, request, opts...)
 }
 
+func (c *metricClient) ListTaskQueuePollers(
+	ctx context.Context,
+	request *adminservice.ListTaskQueuePollersRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListTaskQueuePollersResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListTaskQueuePollers")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListTaskQueuePollers(ctx, request, opts...)
+}
+
 func (c *metricClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -481,6 +621,20 @@ func (c *metricClient) RebuildMutableState(
 	return c.client.RebuildMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) RecomputeMutableStateChecksum(
+	ctx context.Context,
+	request *adminservice.RecomputeMutableStateChecksumRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RecomputeMutableStateChecksumResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientRecomputeMutableStateChecksum")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RecomputeMutableStateChecksum(ctx, request, opts...)
+}
+
 func (c *metricClient) RefreshWorkflowTasks(
 	ctx context.Context,
 	request *adminservice.RefreshWorkflowTasksRequest,
@@ -550,3 +704,31 @@ func (c *metricClient) ResendReplicationTasks(
 
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
+
+func (c *metricClient) ResetOutboundCircuitBreaker(
+	ctx context.Context,
+	request *adminservice.ResetOutboundCircuitBreakerRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ResetOutboundCircuitBreakerResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientResetOutboundCircuitBreaker")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ResetOutboundCircuitBreaker(ctx, request, opts...)
+}
+
+func (c *metricClient) UnloadTaskQueuePartition(
+	ctx context.Context,
+	request *adminservice.UnloadTaskQueuePartitionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UnloadTaskQueuePartitionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUnloadTaskQueuePartition")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UnloadTaskQueuePartition(ctx, request, opts...)
+}
@@ -110,6 +110,21 @@ func (c *retryableClient) CloseShard(
 	return resp, err
 }
 
+func (c *retryableClient) CompactQueueSlices(
+	ctx context.Context,
+	request *adminservice.CompactQueueSlicesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CompactQueueSlicesResponse, error) {
+	var resp *adminservice.CompactQueueSlicesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CompactQueueSlices(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -170,6 +185,36 @@ func (c *retryableClient) DescribeHistoryHost(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeHistoryHostHealth(
+	ctx context.Context,
+	request *adminservice.DescribeHistoryHostHealthRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeHistoryHostHealthResponse, error) {
+	var resp *adminservice.DescribeHistoryHostHealthResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeHistoryHostHealth(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeKeepAliveParameters(
+	ctx context.Context,
+	request *adminservice.DescribeKeepAliveParametersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeKeepAliveParametersResponse, error) {
+	var resp *adminservice.DescribeKeepAliveParametersResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeKeepAliveParameters(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeMutableState(
 	ctx context.Context,
 	request *adminservice.DescribeMutableStateRequest,
@@ -185,6 +230,81 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeNamespaces(
+	ctx context.Context,
+	request *adminservice.DescribeNamespacesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespacesResponse, error) {
+	var resp *adminservice.DescribeNamespacesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeNamespaces(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeOutboundDestination(
+	ctx context.Context,
+	request *adminservice.DescribeOutboundDestinationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeOutboundDestinationResponse, error) {
+	var resp *adminservice.DescribeOutboundDestinationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeOutboundDestination(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeWorkflowUpdates(
+	ctx context.Context,
+	request *adminservice.DescribeWorkflowUpdatesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeWorkflowUpdatesResponse, error) {
+	var resp *adminservice.DescribeWorkflowUpdatesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeWorkflowUpdates(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ForceResyncNamespace(
+	ctx context.Context,
+	request *adminservice.ForceResyncNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ForceResyncNamespaceResponse, error) {
+	var resp *adminservice.ForceResyncNamespaceResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ForceResyncNamespace(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetBuildIdTaskQueueMapping(
+	ctx context.Context,
+	request *adminservice.GetBuildIdTaskQueueMappingRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetBuildIdTaskQueueMappingResponse, error) {
+	var resp *adminservice.GetBuildIdTaskQueueMappingResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetBuildIdTaskQueueMapping(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetDLQMessages(
 	ctx context.Context,
 	request *adminservice.GetDLQMessagesRequest,
@@ -410,6 +530,21 @@ func (c *retryableClient) ListHistoryTasks(
 	return resp, err
 }
 
+func (c *retryableClient) ListNamespaces(
+	ctx context.Context,
+	request *adminservice.ListNamespacesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListNamespacesResponse, error) {
+	var resp *adminservice.ListNamespacesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListNamespaces(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListQueues(
 	ctx context.Context,
 	request *adminservice.ListQueuesRequest,
@@ -425,6 +560,21 @@ func (c *retryableClient) ListQueues(
 	return resp, err
 }
 
+func (c *retryableClient) ListTaskQueuePollers(
+	ctx context.Context,
+	request *adminservice.ListTaskQueuePollersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListTaskQueuePollersResponse, error) {
+	var resp *adminservice.ListTaskQueuePollersResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListTaskQueuePollers(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -515,6 +665,21 @@ func (c *retryableClient) RebuildMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) RecomputeMutableStateChecksum(
+	ctx context.Context,
+	request *adminservice.RecomputeMutableStateChecksumRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RecomputeMutableStateChecksumResponse, error) {
+	var resp *adminservice.RecomputeMutableStateChecksumResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RecomputeMutableStateChecksum(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RefreshWorkflowTasks(
 	ctx context.Context,
 	request *adminservice.RefreshWorkflowTasksRequest,
@@ -589,3 +754,33 @@ func (c *retryableClient) ResendReplicationTasks(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) ResetOutboundCircuitBreaker(
+	ctx context.Context,
+	request *adminservice.ResetOutboundCircuitBreakerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResetOutboundCircuitBreakerResponse, error) {
+	var resp *adminservice.ResetOutboundCircuitBreakerResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ResetOutboundCircuitBreaker(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UnloadTaskQueuePartition(
+	ctx context.Context,
+	request *adminservice.UnloadTaskQueuePartitionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnloadTaskQueuePartitionResponse, error) {
+	var resp *adminservice.UnloadTaskQueuePartitionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UnloadTaskQueuePartition(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
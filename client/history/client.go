@@ -131,6 +131,15 @@ func (c *clientImpl) DescribeHistoryHost(
 	return response, nil
 }
 
+func (c *clientImpl) DescribeHealth(
+	ctx context.Context,
+	request *historyservice.DescribeHealthRequest,
+	opts ...grpc.CallOption) (*historyservice.DescribeHealthResponse, error) {
+
+	clientConn := c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress()))
+	return clientConn.historyClient.DescribeHealth(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetReplicationMessages(
 	ctx context.Context,
 	request *historyservice.GetReplicationMessagesRequest,
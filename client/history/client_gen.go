@@ -74,6 +74,26 @@ func (c *clientImpl) CloseShard(
 	return response, nil
 }
 
+func (c *clientImpl) CompactQueueSlices(
+	ctx context.Context,
+	request *historyservice.CompactQueueSlicesRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.CompactQueueSlicesResponse, error) {
+	shardID := request.GetShardId()
+	var response *historyservice.CompactQueueSlicesResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.CompactQueueSlices(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) CompleteNexusOperation(
 	ctx context.Context,
 	request *historyservice.CompleteNexusOperationRequest,
@@ -154,6 +174,26 @@ func (c *clientImpl) DescribeMutableState(
 	return response, nil
 }
 
+func (c *clientImpl) DescribeOutboundDestination(
+	ctx context.Context,
+	request *historyservice.DescribeOutboundDestinationRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeOutboundDestinationResponse, error) {
+	shardID := request.GetShardId()
+	var response *historyservice.DescribeOutboundDestinationResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DescribeOutboundDestination(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.DescribeWorkflowExecutionRequest,
@@ -174,6 +214,26 @@ func (c *clientImpl) DescribeWorkflowExecution(
 	return response, nil
 }
 
+func (c *clientImpl) DescribeWorkflowUpdates(
+	ctx context.Context,
+	request *historyservice.DescribeWorkflowUpdatesRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeWorkflowUpdatesResponse, error) {
+	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	var response *historyservice.DescribeWorkflowUpdatesResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DescribeWorkflowUpdates(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) ExecuteMultiOperation(
 	ctx context.Context,
 	request *historyservice.ExecuteMultiOperationRequest,
@@ -214,6 +274,26 @@ func (c *clientImpl) ForceDeleteWorkflowExecution(
 	return response, nil
 }
 
+func (c *clientImpl) ForceResyncNamespace(
+	ctx context.Context,
+	request *historyservice.ForceResyncNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ForceResyncNamespaceResponse, error) {
+	shardID := request.GetShardId()
+	var response *historyservice.ForceResyncNamespaceResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ForceResyncNamespace(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
@@ -598,6 +678,26 @@ func (c *clientImpl) RebuildMutableState(
 	return response, nil
 }
 
+func (c *clientImpl) RecomputeMutableStateChecksum(
+	ctx context.Context,
+	request *historyservice.RecomputeMutableStateChecksumRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.RecomputeMutableStateChecksumResponse, error) {
+	shardID := c.shardIDFromWorkflowID(request.NamespaceId, request.GetRequest().GetExecution().GetWorkflowId())
+	var response *historyservice.RecomputeMutableStateChecksumResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.RecomputeMutableStateChecksum(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	request *historyservice.RecordActivityTaskHeartbeatRequest,
@@ -803,6 +903,26 @@ func (c *clientImpl) RequestCancelWorkflowExecution(
 	return response, nil
 }
 
+func (c *clientImpl) ResetOutboundCircuitBreaker(
+	ctx context.Context,
+	request *historyservice.ResetOutboundCircuitBreakerRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ResetOutboundCircuitBreakerResponse, error) {
+	shardID := request.GetShardId()
+	var response *historyservice.ResetOutboundCircuitBreakerResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ResetOutboundCircuitBreaker(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) ResetStickyTaskQueue(
 	ctx context.Context,
 	request *historyservice.ResetStickyTaskQueueRequest,
@@ -61,6 +61,20 @@ func (c *metricClient) CloseShard(
 	return c.client.CloseShard(ctx, request, opts...)
 }
 
+func (c *metricClient) CompactQueueSlices(
+	ctx context.Context,
+	request *historyservice.CompactQueueSlicesRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.CompactQueueSlicesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientCompactQueueSlices")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CompactQueueSlices(ctx, request, opts...)
+}
+
 func (c *metricClient) CompleteNexusOperation(
 	ctx context.Context,
 	request *historyservice.CompleteNexusOperationRequest,
@@ -117,6 +131,20 @@ func (c *metricClient) DeleteWorkflowVisibilityRecord(
 	return c.client.DeleteWorkflowVisibilityRecord(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeHealth(
+	ctx context.Context,
+	request *historyservice.DescribeHealthRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.DescribeHealthResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientDescribeHealth")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeHealth(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeHistoryHost(
 	ctx context.Context,
 	request *historyservice.DescribeHistoryHostRequest,
@@ -145,6 +173,20 @@ func (c *metricClient) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeOutboundDestination(
+	ctx context.Context,
+	request *historyservice.DescribeOutboundDestinationRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.DescribeOutboundDestinationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientDescribeOutboundDestination")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeOutboundDestination(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.DescribeWorkflowExecutionRequest,
@@ -159,6 +201,20 @@ func (c *metricClient) DescribeWorkflowExecution(
 	return c.client.DescribeWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeWorkflowUpdates(
+	ctx context.Context,
+	request *historyservice.DescribeWorkflowUpdatesRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.DescribeWorkflowUpdatesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientDescribeWorkflowUpdates")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeWorkflowUpdates(ctx, request, opts...)
+}
+
 func (c *metricClient) ExecuteMultiOperation(
 	ctx context.Context,
 	request *historyservice.ExecuteMultiOperationRequest,
@@ -187,6 +243,20 @@ func (c *metricClient) ForceDeleteWorkflowExecution(
 	return c.client.ForceDeleteWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *metricClient) ForceResyncNamespace(
+	ctx context.Context,
+	request *historyservice.ForceResyncNamespaceRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.ForceResyncNamespaceResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientForceResyncNamespace")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ForceResyncNamespace(ctx, request, opts...)
+}
+
 func (c *metricClient) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
@@ -523,6 +593,20 @@ func (c *metricClient) RebuildMutableState(
 	return c.client.RebuildMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) RecomputeMutableStateChecksum(
+	ctx context.Context,
+	request *historyservice.RecomputeMutableStateChecksumRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.RecomputeMutableStateChecksumResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientRecomputeMutableStateChecksum")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RecomputeMutableStateChecksum(ctx, request, opts...)
+}
+
 func (c *metricClient) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	request *historyservice.RecordActivityTaskHeartbeatRequest,
@@ -663,6 +747,20 @@ func (c *metricClient) RequestCancelWorkflowExecution(
 	return c.client.RequestCancelWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *metricClient) ResetOutboundCircuitBreaker(
+	ctx context.Context,
+	request *historyservice.ResetOutboundCircuitBreakerRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.ResetOutboundCircuitBreakerResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientResetOutboundCircuitBreaker")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ResetOutboundCircuitBreaker(ctx, request, opts...)
+}
+
 func (c *metricClient) ResetStickyTaskQueue(
 	ctx context.Context,
 	request *historyservice.ResetStickyTaskQueueRequest,
@@ -65,6 +65,21 @@ func (c *retryableClient) CloseShard(
 	return resp, err
 }
 
+func (c *retryableClient) CompactQueueSlices(
+	ctx context.Context,
+	request *historyservice.CompactQueueSlicesRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.CompactQueueSlicesResponse, error) {
+	var resp *historyservice.CompactQueueSlicesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CompactQueueSlices(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) CompleteNexusOperation(
 	ctx context.Context,
 	request *historyservice.CompleteNexusOperationRequest,
@@ -125,6 +140,21 @@ func (c *retryableClient) DeleteWorkflowVisibilityRecord(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeHealth(
+	ctx context.Context,
+	request *historyservice.DescribeHealthRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeHealthResponse, error) {
+	var resp *historyservice.DescribeHealthResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeHealth(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeHistoryHost(
 	ctx context.Context,
 	request *historyservice.DescribeHistoryHostRequest,
@@ -155,6 +185,21 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeOutboundDestination(
+	ctx context.Context,
+	request *historyservice.DescribeOutboundDestinationRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeOutboundDestinationResponse, error) {
+	var resp *historyservice.DescribeOutboundDestinationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeOutboundDestination(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.DescribeWorkflowExecutionRequest,
@@ -170,6 +215,21 @@ func (c *retryableClient) DescribeWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeWorkflowUpdates(
+	ctx context.Context,
+	request *historyservice.DescribeWorkflowUpdatesRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeWorkflowUpdatesResponse, error) {
+	var resp *historyservice.DescribeWorkflowUpdatesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeWorkflowUpdates(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ExecuteMultiOperation(
 	ctx context.Context,
 	request *historyservice.ExecuteMultiOperationRequest,
@@ -200,6 +260,21 @@ func (c *retryableClient) ForceDeleteWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) ForceResyncNamespace(
+	ctx context.Context,
+	request *historyservice.ForceResyncNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ForceResyncNamespaceResponse, error) {
+	var resp *historyservice.ForceResyncNamespaceResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ForceResyncNamespace(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
@@ -560,6 +635,21 @@ func (c *retryableClient) RebuildMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) RecomputeMutableStateChecksum(
+	ctx context.Context,
+	request *historyservice.RecomputeMutableStateChecksumRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.RecomputeMutableStateChecksumResponse, error) {
+	var resp *historyservice.RecomputeMutableStateChecksumResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RecomputeMutableStateChecksum(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	request *historyservice.RecordActivityTaskHeartbeatRequest,
@@ -710,6 +800,21 @@ func (c *retryableClient) RequestCancelWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) ResetOutboundCircuitBreaker(
+	ctx context.Context,
+	request *historyservice.ResetOutboundCircuitBreakerRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ResetOutboundCircuitBreakerResponse, error) {
+	var resp *historyservice.ResetOutboundCircuitBreakerResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ResetOutboundCircuitBreaker(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ResetStickyTaskQueue(
 	ctx context.Context,
 	request *historyservice.ResetStickyTaskQueueRequest,
@@ -275,6 +275,21 @@ func (c *retryableClient) ListTaskQueuePartitions(
 	return resp, err
 }
 
+func (c *retryableClient) ListTaskQueuePollers(
+	ctx context.Context,
+	request *matchingservice.ListTaskQueuePollersRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ListTaskQueuePollersResponse, error) {
+	var resp *matchingservice.ListTaskQueuePollersResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListTaskQueuePollers(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PollActivityTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollActivityTaskQueueRequest,
@@ -99,6 +99,7 @@ var (
 
 		// these are non-standard implementations. do not generate.
 		"client.history.DescribeHistoryHost":    true,
+		"client.history.DescribeHealth":         true,
 		"client.history.GetReplicationMessages": true,
 		"client.history.GetReplicationStatus":   true,
 		"client.history.GetDLQTasks":            true,
@@ -238,6 +239,10 @@ func makeGetMatchingClient(reqType reflect.Type) string {
 	var nsID, tq, tqt fieldWithPath
 
 	switch t.Name() {
+	case "ListTaskQueuePollersRequest":
+		// Route to the owner of the requested partition, which is not necessarily the root.
+		return `p := tqid.PartitionFromPartitionProto(request.GetTaskQueuePartition(), request.GetNamespaceId())
+	client, err := c.getClientForTaskQueuePartition(p)`
 	case "GetBuildIdTaskQueueMappingRequest":
 		// Pick a random node for this request, it's not associated with a specific task queue.
 		tq = fieldWithPath{path: "fmt.Sprintf(\"not-applicable-%d\", rand.Int())"}
@@ -31,7 +31,9 @@ import (
 
 type (
 	MovingWindowAverage interface {
-		Record(val int64)
+		// Record adds val to the window and returns true if a value still inside the window had to be
+		// dropped because the buffer was full.
+		Record(val int64) bool
 		Average() float64
 	}
 
@@ -63,10 +65,13 @@ func NewMovingWindowAvgImpl(
 	}
 }
 
-func (a *MovingWindowAvgImpl) Record(val int64) {
+func (a *MovingWindowAvgImpl) Record(val int64) bool {
 	a.Lock()
 	defer a.Unlock()
 
+	// flush values from previous windows first so that they don't take up buffer space
+	a.expireOldValuesLocked()
+
 	a.buffer[a.tailIdx] = timestampedData{timestamp: time.Now(), value: val}
 	a.tailIdx = (a.tailIdx + 1) % a.maxBufferSize
 
@@ -78,7 +83,9 @@ func (a *MovingWindowAvgImpl) Record(val int64) {
 		a.sum -= a.buffer[a.headIdx].value
 		a.count--
 		a.headIdx = (a.headIdx + 1) % a.maxBufferSize
+		return true
 	}
+	return false
 }
 
 func (a *MovingWindowAvgImpl) Average() float64 {
@@ -32,6 +32,6 @@ type (
 
 func newNoopMovingWindowAverage() *noopMovingWindowAverage { return &noopMovingWindowAverage{} }
 
-func (a *noopMovingWindowAverage) Record(_ int64) {}
+func (a *noopMovingWindowAverage) Record(_ int64) bool { return false }
 
 func (a *noopMovingWindowAverage) Average() float64 { return 0 }
@@ -56,6 +56,12 @@ type Cache interface {
 	// Size returns current size of the Cache, the size definition is implementation of SizeGetter interface
 	// for the entry size, if the entry does not implement SizeGetter interface, the size is 1
 	Size() int
+
+	// SetMaxSize changes the max size of the Cache, evicting entries until the Cache fits into the new size
+	SetMaxSize(maxSize int)
+
+	// SetTTL changes the time-to-live of the Cache entries
+	SetTTL(ttl time.Duration)
 }
 
 // Options control the behavior of the cache
@@ -69,8 +75,27 @@ type Options struct {
 
 	// TimeSource is an optional clock to use for time-skipping and testing. If this is nil, a real clock will be used.
 	TimeSource clock.TimeSource
+
+	// EvictionPolicy controls which entries are evicted first when the cache is over capacity. If this is empty,
+	// EvictionPolicyLRU will be used.
+	EvictionPolicy EvictionPolicy
+
+	// Level is the level of the cache, e.g. metrics.HostCacheLevelTagValue, that the eviction counters of caches
+	// created with NewWithMetrics are tagged with.
+	Level string
 }
 
+// EvictionPolicy selects the entries evicted when a cache runs out of capacity
+type EvictionPolicy string
+
+const (
+	// EvictionPolicyLRU evicts the least recently used entries first
+	EvictionPolicyLRU EvictionPolicy = "lru"
+	// EvictionPolicySizeWeighted evicts the largest entries first, breaking ties by least recent use. It frees
+	// capacity with the fewest evictions when a few entries dominate the cache size.
+	EvictionPolicySizeWeighted EvictionPolicy = "size-weighted"
+)
+
 // SimpleOptions provides options that can be used to configure SimpleCache
 type SimpleOptions struct {
 	// RemovedFunc is an optional function called when an element
@@ -25,6 +25,7 @@
 package cache
 
 import (
+	"container/heap"
 	"container/list"
 	"sync"
 	"time"
@@ -48,7 +49,8 @@ var (
 
 const emptyEntrySize = 0
 
-// lru is a concurrent fixed size cache that evicts elements in lru order
+// lru is a concurrent fixed size cache that evicts elements in lru order, or largest first when configured with
+// EvictionPolicySizeWeighted
 type (
 	lru struct {
 		mut            sync.Mutex
@@ -59,8 +61,15 @@ type (
 		pinnedSize     int
 		ttl            time.Duration
 		pin            bool
+		evictionPolicy EvictionPolicy
 		timeSource     clock.TimeSource
 		metricsHandler metrics.Handler
+		// evictionMetricsHandler is also tagged with the cache level
+		evictionMetricsHandler metrics.Handler
+
+		// bySize and accessSeq are only maintained for EvictionPolicySizeWeighted
+		bySize    entriesBySize
+		accessSeq uint64
 	}
 
 	iteratorImpl struct {
@@ -75,7 +84,13 @@ type (
 		value      interface{}
 		refCount   int
 		size       int
+		// accessSeq and heapIndex track the entry's position in lru.bySize
+		accessSeq uint64
+		heapIndex int
 	}
+
+	// entriesBySize is a heap of unpinned entries ordered by size, largest first, breaking ties by least recent use
+	entriesBySize []*entryImpl
 )
 
 // Close closes the iterator
@@ -113,6 +128,7 @@ func (it *iteratorImpl) prepareNext() {
 		if it.lru.isEntryExpired(entry, it.createTime) {
 			nextItem := it.nextItem.Next()
 			it.lru.deleteInternal(it.nextItem)
+			metrics.CacheTTLEvictions.With(it.lru.evictionMetricsHandler).Record(1)
 			it.nextItem = nextItem
 		} else {
 			return
@@ -175,8 +191,11 @@ func NewWithMetrics(maxSize int, opts *Options, handler metrics.Handler) Cache {
 		maxSize:        maxSize,
 		currSize:       0,
 		pin:            opts.Pin,
+		evictionPolicy: opts.EvictionPolicy,
 		timeSource:     timeSource,
 		metricsHandler: handler,
+
+		evictionMetricsHandler: handler.WithTags(metrics.CacheLevelTag(opts.Level)),
 	}
 }
 
@@ -188,12 +207,13 @@ func NewLRU(maxSize int, handler metrics.Handler) Cache {
 
 // Get retrieves the value stored under the given key
 func (c *lru) Get(key interface{}) interface{} {
-	if c.maxSize == 0 { //
-		return nil
-	}
 	c.mut.Lock()
 	defer c.mut.Unlock()
 
+	if c.maxSize == 0 {
+		return nil
+	}
+
 	element := c.byKey[key]
 	if element == nil {
 		return nil
@@ -206,11 +226,13 @@ func (c *lru) Get(key interface{}) interface{} {
 	if c.isEntryExpired(entry, c.timeSource.Now().UTC()) {
 		// Entry has expired
 		c.deleteInternal(element)
+		metrics.CacheTTLEvictions.With(c.evictionMetricsHandler).Record(1)
 		return nil
 	}
 
 	c.updateEntryRefCount(entry)
 	c.byAccess.MoveToFront(element)
+	c.updateEntryEvictionOrder(entry, true)
 	return entry.value
 }
 
@@ -274,6 +296,7 @@ func (c *lru) Release(key interface{}) {
 	newEntrySize := getSize(entry.value)
 	c.currSize = c.calculateNewCacheSize(newEntrySize, entry.Size())
 	entry.size = newEntrySize
+	c.updateEntryEvictionOrder(entry, false)
 	if c.currSize > c.maxSize {
 		c.tryEvictUntilCacheSizeUnderLimit()
 	}
@@ -291,20 +314,48 @@ func (c *lru) Size() int {
 	return c.currSize
 }
 
+// SetMaxSize changes the max size of the lru. If the lru is over the new size, entries are evicted
+// until it fits, except for pinned entries which are evicted once released.
+func (c *lru) SetMaxSize(maxSize int) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	if c.maxSize == maxSize {
+		return
+	}
+	c.maxSize = maxSize
+	metrics.CacheSize.With(c.metricsHandler).Record(float64(maxSize))
+	c.tryEvictUntilCacheSizeUnderLimit()
+}
+
+// SetTTL changes the time-to-live of the lru entries. It also applies to existing entries
+// that were added with a non-zero TTL.
+func (c *lru) SetTTL(ttl time.Duration) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	if c.ttl == ttl {
+		return
+	}
+	c.ttl = ttl
+	metrics.CacheTtl.With(c.metricsHandler).Record(ttl)
+}
+
 // Put puts a new value associated with a given key, returning the existing value (if present)
 // allowUpdate flag is used to control overwrite behavior if the value exists.
 func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool) (interface{}, error) {
+	newEntrySize := getSize(value)
+
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
 	if c.maxSize == 0 {
 		return nil, nil
 	}
-	newEntrySize := getSize(value)
 	if newEntrySize > c.maxSize {
 		return nil, ErrCacheItemTooLarge
 	}
 
-	c.mut.Lock()
-	defer c.mut.Unlock()
-
 	elt := c.byKey[key]
 	// If the entry exists, check if it has expired or update the value
 	if elt != nil {
@@ -334,11 +385,13 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 
 			c.updateEntryRefCount(existingEntry)
 			c.byAccess.MoveToFront(elt)
+			c.updateEntryEvictionOrder(existingEntry, true)
 			return existingVal, nil
 		}
 
 		// Entry has expired
 		c.deleteInternal(elt)
+		metrics.CacheTTLEvictions.With(c.evictionMetricsHandler).Record(1)
 	}
 
 	c.tryEvictUntilEnoughSpaceWithSkipEntry(newEntrySize, nil)
@@ -350,15 +403,17 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 	}
 
 	entry := &entryImpl{
-		key:   key,
-		value: value,
-		size:  newEntrySize,
+		key:       key,
+		value:     value,
+		size:      newEntrySize,
+		heapIndex: -1,
 	}
 
 	c.updateEntryTTL(entry)
 	c.updateEntryRefCount(entry)
 	element := c.byAccess.PushFront(entry)
 	c.byKey[key] = element
+	c.updateEntryEvictionOrder(entry, true)
 	c.currSize = newCacheSize
 	metrics.CacheUsage.With(c.metricsHandler).Record(float64(c.currSize))
 	return nil, nil
@@ -370,6 +425,9 @@ func (c *lru) calculateNewCacheSize(newEntrySize int, existingEntrySize int) int
 
 func (c *lru) deleteInternal(element *list.Element) {
 	entry := c.byAccess.Remove(element).(*entryImpl)
+	if entry.heapIndex >= 0 {
+		heap.Remove(&c.bySize, entry.heapIndex)
+	}
 	c.currSize -= entry.Size()
 	metrics.CacheUsage.With(c.metricsHandler).Record(float64(c.currSize))
 	metrics.CacheEntryAgeOnEviction.With(c.metricsHandler).Record(c.timeSource.Now().UTC().Sub(entry.createTime))
@@ -384,11 +442,16 @@ func (c *lru) tryEvictUntilCacheSizeUnderLimit() {
 // tryEvictUntilEnoughSpaceWithSkipEntry try to evict entries until there is enough space for the new entry without
 // evicting the existing entry. the existing entry is skipped because it is being updated.
 func (c *lru) tryEvictUntilEnoughSpaceWithSkipEntry(newEntrySize int, existingEntry *entryImpl) {
-	element := c.byAccess.Back()
 	existingEntrySize := 0
 	if existingEntry != nil {
 		existingEntrySize = existingEntry.Size()
 	}
+	if c.evictionPolicy == EvictionPolicySizeWeighted {
+		c.tryEvictLargestUntilEnoughSpaceWithSkipEntry(newEntrySize, existingEntry, existingEntrySize)
+		return
+	}
+
+	element := c.byAccess.Back()
 
 	for c.calculateNewCacheSize(newEntrySize, existingEntrySize) > c.maxSize && element != nil {
 		entry := element.Value.(*entryImpl)
@@ -400,11 +463,56 @@ func (c *lru) tryEvictUntilEnoughSpaceWithSkipEntry(newEntrySize int, existingEn
 	}
 }
 
+// tryEvictLargestUntilEnoughSpaceWithSkipEntry is the size-weighted counterpart of
+// tryEvictUntilEnoughSpaceWithSkipEntry, it evicts the largest unpinned entries first.
+func (c *lru) tryEvictLargestUntilEnoughSpaceWithSkipEntry(
+	newEntrySize int,
+	existingEntry *entryImpl,
+	existingEntrySize int,
+) {
+	var skippedEntry *entryImpl
+	for c.calculateNewCacheSize(newEntrySize, existingEntrySize) > c.maxSize && c.bySize.Len() > 0 {
+		entry := c.bySize[0]
+		if existingEntry != nil && entry.key == existingEntry.key {
+			skippedEntry = heap.Pop(&c.bySize).(*entryImpl)
+			continue
+		}
+		c.deleteInternal(c.byKey[entry.key])
+		metrics.CacheSizeEvictions.With(c.evictionMetricsHandler).Record(1)
+	}
+	if skippedEntry != nil {
+		heap.Push(&c.bySize, skippedEntry)
+	}
+}
+
+// updateEntryEvictionOrder updates the entry's position in the size-weighted eviction order after its size, ref count
+// or, if accessed is set, its recency changed. Pinned entries are not eviction candidates and are left out.
+func (c *lru) updateEntryEvictionOrder(entry *entryImpl, accessed bool) {
+	if c.evictionPolicy != EvictionPolicySizeWeighted {
+		return
+	}
+	if accessed {
+		c.accessSeq++
+		entry.accessSeq = c.accessSeq
+	}
+	switch {
+	case entry.refCount > 0:
+		if entry.heapIndex >= 0 {
+			heap.Remove(&c.bySize, entry.heapIndex)
+		}
+	case entry.heapIndex >= 0:
+		heap.Fix(&c.bySize, entry.heapIndex)
+	default:
+		heap.Push(&c.bySize, entry)
+	}
+}
+
 func (c *lru) tryEvictAndGetPreviousElement(entry *entryImpl, element *list.Element) *list.Element {
 	if entry.refCount == 0 {
 		elementPrev := element.Prev()
 		// currSize will be updated within deleteInternal
 		c.deleteInternal(element)
+		metrics.CacheSizeEvictions.With(c.evictionMetricsHandler).Record(1)
 		return elementPrev
 	}
 	// entry.refCount > 0
@@ -431,3 +539,36 @@ func (c *lru) updateEntryRefCount(entry *entryImpl) {
 		}
 	}
 }
+
+func (h entriesBySize) Len() int {
+	return len(h)
+}
+
+func (h entriesBySize) Less(i, j int) bool {
+	if h[i].size != h[j].size {
+		return h[i].size > h[j].size
+	}
+	return h[i].accessSeq < h[j].accessSeq
+}
+
+func (h entriesBySize) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].heapIndex = i
+	h[j].heapIndex = j
+}
+
+func (h *entriesBySize) Push(x any) {
+	entry := x.(*entryImpl)
+	entry.heapIndex = len(*h)
+	*h = append(*h, entry)
+}
+
+func (h *entriesBySize) Pop() any {
+	old := *h
+	n := len(old)
+	entry := old[n-1]
+	old[n-1] = nil
+	entry.heapIndex = -1
+	*h = old[:n-1]
+	return entry
+}
@@ -721,3 +721,131 @@ func TestCache_ItemSizeChangeBeforeRelease(t *testing.T) {
 	// Cache should have evicted entry1 to bring cache size under max limit.
 	assert.Equal(t, 2, cache.Size())
 }
+
+func TestCache_SizeWeightedEviction(t *testing.T) {
+	t.Parallel()
+
+	cache := New(20, &Options{EvictionPolicy: EvictionPolicySizeWeighted})
+	cache.Put("small-1", &testEntryWithCacheSize{2})
+	cache.Put("large-1", &testEntryWithCacheSize{8})
+	cache.Put("small-2", &testEntryWithCacheSize{2})
+	cache.Put("large-2", &testEntryWithCacheSize{6})
+	cache.Put("small-3", &testEntryWithCacheSize{2})
+	assert.Equal(t, 20, cache.Size())
+
+	// the largest entry is evicted even though small-1 is the least recently used
+	cache.Put("small-4", &testEntryWithCacheSize{3})
+	assert.Equal(t, 15, cache.Size())
+	assert.Nil(t, cache.Get("large-1"))
+	assert.NotNil(t, cache.Get("small-1"))
+
+	cache.Put("medium-1", &testEntryWithCacheSize{7})
+	assert.Equal(t, 16, cache.Size())
+	assert.Nil(t, cache.Get("large-2"))
+	for _, key := range []string{"small-1", "small-2", "small-3", "small-4", "medium-1"} {
+		assert.NotNil(t, cache.Get(key))
+	}
+}
+
+func TestCache_SizeWeightedEviction_TiesInLRUOrder(t *testing.T) {
+	t.Parallel()
+
+	cache := New(10, &Options{EvictionPolicy: EvictionPolicySizeWeighted})
+	cache.Put("a", &testEntryWithCacheSize{5})
+	cache.Put("b", &testEntryWithCacheSize{5})
+	assert.NotNil(t, cache.Get("a"))
+
+	cache.Put("c", &testEntryWithCacheSize{1})
+	assert.Equal(t, 6, cache.Size())
+	assert.NotNil(t, cache.Get("a"))
+	assert.Nil(t, cache.Get("b"))
+}
+
+func TestCache_SizeWeightedEviction_SkipsPinnedEntries(t *testing.T) {
+	t.Parallel()
+
+	cache := New(10, &Options{Pin: true, EvictionPolicy: EvictionPolicySizeWeighted})
+	_, err := cache.PutIfNotExist("large", &testEntryWithCacheSize{6})
+	assert.NoError(t, err)
+	_, err = cache.PutIfNotExist("small", &testEntryWithCacheSize{4})
+	assert.NoError(t, err)
+	cache.Release("small")
+
+	// the large entry is pinned, so the smaller unpinned entry is evicted instead
+	_, err = cache.PutIfNotExist("new", &testEntryWithCacheSize{3})
+	assert.NoError(t, err)
+	assert.Equal(t, 9, cache.Size())
+	assert.NotNil(t, cache.Get("large"))
+	assert.Nil(t, cache.Get("small"))
+}
+
+func TestCache_SetMaxSize(t *testing.T) {
+	t.Parallel()
+
+	cache := New(4, nil)
+	cache.Put("A", "Foo")
+	cache.Put("B", "Bar")
+	cache.Put("C", "Cid")
+	assert.Equal(t, 3, cache.Size())
+
+	// shrinking evicts the least recently used entries
+	cache.SetMaxSize(2)
+	assert.Equal(t, 2, cache.Size())
+	assert.Nil(t, cache.Get("A"))
+	assert.Equal(t, "Bar", cache.Get("B"))
+	assert.Equal(t, "Cid", cache.Get("C"))
+
+	// growing makes room for more entries
+	cache.SetMaxSize(3)
+	cache.Put("D", "Delt")
+	assert.Equal(t, 3, cache.Size())
+	assert.Equal(t, "Bar", cache.Get("B"))
+
+	// a zero size disables the cache
+	cache.SetMaxSize(0)
+	assert.Nil(t, cache.Get("B"))
+	cache.Put("E", "Epsi")
+	assert.Equal(t, 0, cache.Size())
+}
+
+func TestCache_SetTTL(t *testing.T) {
+	t.Parallel()
+
+	timeSource := clock.NewEventTimeSource()
+	cache := New(5,
+		&Options{
+			TTL:        time.Millisecond * 50,
+			TimeSource: timeSource,
+		},
+	)
+
+	cache.Put("A", t)
+	cache.SetTTL(time.Millisecond * 200)
+	timeSource.Advance(time.Millisecond * 100)
+	assert.Equal(t, t, cache.Get("A"))
+
+	cache.SetTTL(time.Millisecond * 50)
+	assert.Nil(t, cache.Get("A"))
+}
+
+func TestCache_SizeWeightedEviction_UpdateExistingEntry(t *testing.T) {
+	t.Parallel()
+
+	cache := New(10, &Options{EvictionPolicy: EvictionPolicySizeWeighted})
+	cache.Put("a", &testEntryWithCacheSize{2})
+	cache.Put("b", &testEntryWithCacheSize{3})
+	cache.Put("c", &testEntryWithCacheSize{4})
+
+	// growing an entry evicts the largest other entries, but never the updated entry itself
+	cache.Put("a", &testEntryWithCacheSize{6})
+	assert.Equal(t, 9, cache.Size())
+	assert.Nil(t, cache.Get("c"))
+	assert.NotNil(t, cache.Get("b"))
+
+	// the grown entry is now the largest and is evicted first
+	cache.Put("d", &testEntryWithCacheSize{4})
+	assert.Equal(t, 7, cache.Size())
+	assert.Nil(t, cache.Get("a"))
+	assert.NotNil(t, cache.Get("b"))
+	assert.NotNil(t, cache.Get("d"))
+}
@@ -38,6 +38,8 @@ type (
 		State() gobreaker.State
 		Counts() gobreaker.Counts
 		Allow() (done func(success bool), err error)
+		// Reset forces the circuit breaker back to the closed state and clears its counts.
+		Reset()
 	}
 
 	// TwoStepCircuitBreakerWithDynamicSettings is a wrapper of gobreaker.TwoStepCircuitBreaker
@@ -105,10 +107,14 @@ func (c *TwoStepCircuitBreakerWithDynamicSettings) Name() string {
 }
 
 func (c *TwoStepCircuitBreakerWithDynamicSettings) State() gobreaker.State {
+	c.cbLock.RLock()
+	defer c.cbLock.RUnlock()
 	return c.cb.State()
 }
 
 func (c *TwoStepCircuitBreakerWithDynamicSettings) Counts() gobreaker.Counts {
+	c.cbLock.RLock()
+	defer c.cbLock.RUnlock()
 	return c.cb.Counts()
 }
 
@@ -117,6 +123,15 @@ func (c *TwoStepCircuitBreakerWithDynamicSettings) Allow() (done func(success bo
 	return cb.Allow()
 }
 
+// Reset replaces the internal circuit breaker with a new one using the current settings. The new
+// circuit breaker starts in the closed state, and any in-flight request of the previous one is
+// not counted towards it.
+func (c *TwoStepCircuitBreakerWithDynamicSettings) Reset() {
+	c.cbLock.Lock()
+	defer c.cbLock.Unlock()
+	c.cb = c.newInternalCircuitBreaker(c.dynamicSettings)
+}
+
 // getInternalCircuitBreaker checks if the dynamic settings changed, updating
 // the cirbuit breaker if necessary, and returns the up-to-date reference to
 // the circuit breaker.
@@ -146,7 +161,14 @@ func (c *TwoStepCircuitBreakerWithDynamicSettings) getInternalCircuitBreaker() *
 		return c.cb
 	}
 	c.dynamicSettings = ds
-	c.cb = gobreaker.NewTwoStepCircuitBreaker(gobreaker.Settings{
+	c.cb = c.newInternalCircuitBreaker(ds)
+	return c.cb
+}
+
+func (c *TwoStepCircuitBreakerWithDynamicSettings) newInternalCircuitBreaker(
+	ds dynamicconfig.CircuitBreakerSettings,
+) *gobreaker.TwoStepCircuitBreaker {
+	return gobreaker.NewTwoStepCircuitBreaker(gobreaker.Settings{
 		Name:          c.name,
 		MaxRequests:   uint32(ds.MaxRequests),
 		Interval:      ds.Interval,
@@ -154,5 +176,4 @@ func (c *TwoStepCircuitBreakerWithDynamicSettings) getInternalCircuitBreaker() *
 		ReadyToTrip:   c.readyToTrip,
 		OnStateChange: c.onStateChange,
 	})
-	return c.cb
 }
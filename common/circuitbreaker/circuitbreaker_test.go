@@ -27,6 +27,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/sony/gobreaker"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
@@ -144,3 +145,33 @@ func TestGetInternalCircuitBreaker(t *testing.T) {
 	// Only one thread updates the settings at regular intervals
 	s.Equal(5, settingsCallCount)
 }
+
+func TestReset(t *testing.T) {
+	s := assert.New(t)
+
+	tscb := NewTwoStepCircuitBreakerWithDynamicSettings(
+		Settings{
+			SettingsFn: func() dynamicconfig.CircuitBreakerSettings {
+				return dynamicconfig.CircuitBreakerSettings{Timeout: time.Hour}
+			},
+			ReadyToTrip: func(counts gobreaker.Counts) bool {
+				return counts.ConsecutiveFailures >= 1
+			},
+		},
+	)
+
+	doneFn, err := tscb.Allow()
+	s.NoError(err)
+	doneFn(false)
+	s.Equal(gobreaker.StateOpen, tscb.State())
+	_, err = tscb.Allow()
+	s.ErrorIs(err, gobreaker.ErrOpenState)
+
+	tscb.Reset()
+	s.Equal(gobreaker.StateClosed, tscb.State())
+	s.Equal(gobreaker.Counts{}, tscb.Counts())
+	doneFn, err = tscb.Allow()
+	s.NoError(err)
+	doneFn(true)
+	s.Equal(uint32(1), tscb.Counts().TotalSuccesses)
+}
@@ -58,6 +58,23 @@ func (m *OnceMap[K, T]) Get(key K) T {
 	return value
 }
 
+// Range calls fn for each key and value present in the map, stopping if fn returns false.
+// fn is called on a snapshot of the map, so it may safely call back into the map.
+func (m *OnceMap[K, T]) Range(fn func(key K, value T) bool) {
+	m.mu.RLock()
+	snapshot := make(map[K]T, len(m.inner))
+	for k, v := range m.inner {
+		snapshot[k] = v
+	}
+	m.mu.RUnlock()
+
+	for k, v := range snapshot {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
 // FallibleOnceMap is a concurrent map which lazily constructs its values. Map values are initialized on-the-fly, using
 // a provided construction function only when a key is accessed for the first time.
 // If the construct function returns an error, the value is not cached.
@@ -48,3 +48,25 @@ func TestOnceMap(t *testing.T) {
 	require.Equal(t, 2, bar1)
 	require.Equal(t, 2, bar2)
 }
+
+func TestOnceMap_Range(t *testing.T) {
+	m := NewOnceMap(func(k string) int {
+		return len(k)
+	})
+	m.Get("a")
+	m.Get("bb")
+
+	values := make(map[string]int)
+	m.Range(func(k string, v int) bool {
+		values[k] = v
+		return true
+	})
+	require.Equal(t, map[string]int{"a": 1, "bb": 2}, values)
+
+	count := 0
+	m.Range(func(string, int) bool {
+		count++
+		return false
+	})
+	require.Equal(t, 1, count)
+}
@@ -252,6 +252,14 @@ type (
 		DataStores map[string]DataStore `yaml:"datastores"`
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// BufferedEventsSizeLimit is the largest allowed serialized size of the buffered events added by a transaction
+		BufferedEventsSizeLimit dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// ConflictResolveSerializationConcurrency is the number of workflows serialized concurrently by
+		// ConflictResolveWorkflowExecution, one or less means sequential
+		ConflictResolveSerializationConcurrency dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// EventBatchSerializationConcurrency is the number of event batches of a single write serialized
+		// concurrently, one or less means sequential
+		EventBatchSerializationConcurrency dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
 	}
 
 	// DataStore is the configuration for a single datastore
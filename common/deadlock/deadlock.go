@@ -43,6 +43,13 @@ import (
 )
 
 type (
+	// Detector reports the outcome of the deadlock detector of this process.
+	Detector interface {
+		// FailedHealthCheck returns true if a potential deadlock was detected and the grpc services were marked
+		// unhealthy because of it.
+		FailedHealthCheck() bool
+	}
+
 	params struct {
 		fx.In
 
@@ -69,6 +76,8 @@ type (
 		config         config
 		roots          []pingable.Pingable
 		loops          goro.Group
+
+		failedHealthCheck atomic.Bool
 	}
 
 	loopContext struct {
@@ -113,6 +122,10 @@ func (dd *deadlockDetector) Stop() error {
 	return nil
 }
 
+func (dd *deadlockDetector) FailedHealthCheck() bool {
+	return dd.failedHealthCheck.Load()
+}
+
 func (dd *deadlockDetector) detected(name string) {
 	dd.logger.Error("potential deadlock detected", tag.Name(name))
 
@@ -123,6 +136,7 @@ func (dd *deadlockDetector) detected(name string) {
 	if dd.config.FailHealthCheck() {
 		dd.logger.Info("marking grpc services unhealthy")
 		dd.healthServer.Shutdown()
+		dd.failedHealthCheck.Store(true)
 	}
 
 	if dd.config.AbortProcess() {
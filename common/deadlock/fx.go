@@ -32,6 +32,7 @@ import (
 
 var Module = fx.Options(
 	fx.Provide(NewDeadlockDetector),
+	fx.Provide(func(dd *deadlockDetector) Detector { return dd }),
 	fx.Invoke(func(lc fx.Lifecycle, dd *deadlockDetector) {
 		lc.Append(fx.Hook{
 			OnStart: func(ctx context.Context) error {
@@ -307,6 +307,23 @@ func convertMap(val any) (map[string]any, error) {
 	return nil, errors.New("value type is not map")
 }
 
+// convertStringSet converts a list of strings to a set, for settings that are only used for lookups.
+func convertStringSet(val any) (map[string]struct{}, error) {
+	if set, ok := val.(map[string]struct{}); ok {
+		// default value
+		return set, nil
+	}
+	list, err := ConvertStructure([]string(nil))(val)
+	if err != nil {
+		return nil, err
+	}
+	set := make(map[string]struct{}, len(list))
+	for _, s := range list {
+		set[s] = struct{}{}
+	}
+	return set, nil
+}
+
 // ConvertStructure can be used as a conversion function for New*TypedSettingWithConverter.
 // The value from dynamic config will be converted to T, on top of the given default.
 //
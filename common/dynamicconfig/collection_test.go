@@ -29,6 +29,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
 	enumspb "go.temporal.io/api/enums/v1"
@@ -373,6 +374,22 @@ func (s *collectionSuite) TestGetTypedSimpleList() {
 	})
 }
 
+func (s *collectionSuite) TestGetStringSet() {
+	// the default must convert without warnings
+	logger := log.NewMockLogger(gomock.NewController(s.T()))
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	get := dynamicconfig.EventsCachePinnedWorkflowIDs.Get(dynamicconfig.NewCollection(s.client, logger))
+
+	s.Run("Default", func() {
+		s.Empty(get("ns-id"))
+	})
+
+	s.Run("Basic", func() {
+		s.client[dynamicconfig.EventsCachePinnedWorkflowIDs.Key()] = []any{"wf-1", "wf-2"}
+		s.Equal(map[string]struct{}{"wf-1": {}, "wf-2": {}}, get("ns-id"))
+	})
+}
+
 func (s *collectionSuite) TestGetTypedListOfStruct() {
 	type simple struct{ A, B int }
 	def := []simple{{1, 5}, {2, 9}}
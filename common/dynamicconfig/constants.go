@@ -54,6 +54,12 @@ var (
 		1000,
 		`AdminMatchingNamespaceTaskqueueToPartitionDispatchRate is the max qps of a task queue partition for a given namespace & task queue`,
 	)
+	AdminForceResyncNamespaceRPS = NewGlobalFloatSetting(
+		"admin.forceResyncNamespaceRPS",
+		0.1,
+		`AdminForceResyncNamespaceRPS is the per frontend host rate limit of the ForceResyncNamespace admin API. Each call
+rewinds the replication streams of every history shard, so it is kept low by default.`,
+	)
 
 	// keys for system
 
@@ -77,6 +83,13 @@ var (
 		false,
 		`VisibilityEnableShadowReadMode is the config to enable shadow read from secondary visibility`,
 	)
+	VisibilityShadowReadDiffSampleRate = NewGlobalFloatSetting(
+		"system.visibilityShadowReadDiffSampleRate",
+		0.1,
+		`VisibilityShadowReadDiffSampleRate is the fraction of shadow reads, between 0 and 1, for which the primary and
+secondary visibility results are compared and divergences are reported by metrics. Only used if
+VisibilityEnableShadowReadMode is enabled.`,
+	)
 	SecondaryVisibilityWritingMode = NewGlobalStringSetting(
 		"system.secondaryVisibilityWritingMode",
 		"off",
@@ -87,6 +100,13 @@ var (
 		true,
 		`VisibilityDisableOrderByClause is the config to disable ORDERY BY clause for Elasticsearch`,
 	)
+	VisibilityOrderByAllowedFields = NewNamespaceTypedSetting(
+		"system.visibilityOrderByAllowedFields",
+		[]string(nil),
+		`VisibilityOrderByAllowedFields is the list of search attributes which can be used in ORDER BY clause for
+Elasticsearch, e.g. ["StartTime", "CloseTime"]. ORDER BY on any other field is rejected. An empty list (the
+default) falls back to VisibilityDisableOrderByClause.`,
+	)
 	VisibilityEnableManualPagination = NewNamespaceBoolSetting(
 		"system.visibilityEnableManualPagination",
 		true,
@@ -135,6 +155,19 @@ for signal / start / signal with start API if namespace is not active`,
 		primitives.DefaultTransactionSizeLimit,
 		`TransactionSizeLimit is the largest allowed transaction size to persistence`,
 	)
+	ConflictResolveSerializationConcurrency = NewGlobalIntSetting(
+		"system.conflictResolveSerializationConcurrency",
+		1,
+		`ConflictResolveSerializationConcurrency is the number of workflows (out of the reset, new and current
+workflows) whose events and mutable state are serialized concurrently when resolving a workflow conflict.
+One means sequential.`,
+	)
+	EventBatchSerializationConcurrency = NewGlobalIntSetting(
+		"system.eventBatchSerializationConcurrency",
+		1,
+		`EventBatchSerializationConcurrency is the number of history event batches of a single workflow write that
+are serialized concurrently. The output order is preserved. One means sequential.`,
+	)
 	DisallowQuery = NewNamespaceBoolSetting(
 		"system.disallowQuery",
 		false,
@@ -213,7 +246,9 @@ response to a StartWorkflowExecution request and skipping the trip through match
 	PersistenceHealthSignalBufferSize = NewGlobalIntSetting(
 		"system.persistenceHealthSignalBufferSize",
 		5000,
-		`PersistenceHealthSignalBufferSize is the maximum number of persistence signals to buffer in memory per signal key`,
+		`PersistenceHealthSignalBufferSize is the maximum number of persistence signals to buffer in memory per signal key.
+Signals from previous windows are flushed before a new signal is buffered. If the buffer is still full, the oldest
+signal in the current window is dropped and the persistence_health_signal_dropped metric is incremented.`,
 	)
 	ShardRPSWarnLimit = NewGlobalIntSetting(
 		"system.shardRPSWarnLimit",
@@ -332,6 +367,14 @@ StartChildWorkflowExecution commands will fail.`,
 		2000,
 		`NumPendingActivitiesLimitError is the maximum number of pending activities a workflow can have before
 ScheduleActivityTask will fail.`,
+	)
+	NumPendingActivitiesPerTaskQueueLimitError = NewTaskQueueIntSetting(
+		"limit.numPendingActivitiesPerTaskQueue.error",
+		0,
+		`NumPendingActivitiesPerTaskQueueLimitError is the maximum number of pending activities on the task queue a
+workflow can have before ScheduleActivityTask commands targeting the task queue will fail. It is capped by
+NumPendingActivitiesLimitError, which still applies to all pending activities of the workflow; 0 means no task queue
+specific limit.`,
 	)
 	NumPendingSignalsLimitError = NewNamespaceIntSetting(
 		"limit.numPendingSignals.error",
@@ -382,10 +425,11 @@ If exceeded, failure will be truncated before being stored in mutable state.`,
 		2*1024,
 		`MutableStateActivityFailureSizeLimitWarn is the per activity failure size warning limit for workflow mutable state`,
 	)
-	MutableStateSizeLimitError = NewGlobalIntSetting(
+	MutableStateSizeLimitError = NewNamespaceIntSetting(
 		"limit.mutableStateSize.error",
 		8*1024*1024,
-		`MutableStateSizeLimitError is the per workflow execution mutable state size limit in bytes`,
+		`MutableStateSizeLimitError is the per workflow execution mutable state size limit in bytes.
+A namespace-scoped value overrides the global default for workflows in that namespace.`,
 	)
 	MutableStateSizeLimitWarn = NewGlobalIntSetting(
 		"limit.mutableStateSize.warn",
@@ -456,7 +500,15 @@ to exceed this number will fail with a FailedPrecondition error.`,
 		"matching.wv.DeletedRuleRetentionTime",
 		14*24*time.Hour,
 		`MatchingDeletedRuleRetentionTime is the length of time that deleted Version Assignment Rules and
-Deleted Redirect Rules will be kept in the DB (with DeleteTimestamp). After this time, the tombstones are deleted at the next time update of versioning data for the task queue.`,
+Deleted Redirect Rules will be kept in the DB (with DeleteTimestamp). After this time, the tombstones are deleted at the next time update of versioning data for the task queue,
+or by the periodic compaction controlled by MatchingDeletedRuleCompactionInterval.`,
+	)
+	MatchingDeletedRuleCompactionInterval = NewNamespaceDurationSetting(
+		"matching.wv.DeletedRuleCompactionInterval",
+		time.Hour,
+		`MatchingDeletedRuleCompactionInterval is how often the user data owner of a task queue checks for Version Assignment
+Rule and Redirect Rule tombstones older than MatchingDeletedRuleRetentionTime and removes them, even if the versioning
+data of the task queue is not otherwise updated.`,
 	)
 	ReachabilityBuildIdVisibilityGracePeriod = NewNamespaceDurationSetting(
 		"matching.wv.ReachabilityBuildIdVisibilityGracePeriod",
@@ -486,6 +538,16 @@ DescribeTaskQueue API with ReportTaskQueueReachability==true, or to the GetWorke
 		10*time.Minute,
 		`ReachabilityCacheClosedWFsTTL is the TTL for the reachability closed workflows cache.`,
 	)
+	ReachabilityCacheOpenWFsMaxSize = NewGlobalIntSetting(
+		"matching.wv.reachabilityCacheOpenWFsMaxSize",
+		10000,
+		`ReachabilityCacheOpenWFsMaxSize is the max number of entries in the reachability open workflows cache.`,
+	)
+	ReachabilityCacheClosedWFsMaxSize = NewGlobalIntSetting(
+		"matching.wv.reachabilityCacheClosedWFsMaxSize",
+		10000,
+		`ReachabilityCacheClosedWFsMaxSize is the max number of entries in the reachability closed workflows cache.`,
+	)
 	ReachabilityQuerySetDurationSinceDefault = NewGlobalDurationSetting(
 		"frontend.reachabilityQuerySetDurationSinceDefault",
 		5*time.Minute,
@@ -602,6 +664,14 @@ See DynamicRateLimitingParams comments for more details.`,
 		`FrontendNamespaceReplicationInducingAPIsRPS limits the per second request rate for namespace replication inducing
 APIs (e.g. RegisterNamespace, UpdateNamespace, UpdateWorkerBuildIdCompatibility).
 This config is EXPERIMENTAL and may be changed or removed in a later release.`,
+	)
+	ReducePollWorkflowHistoryRequestPriority = NewNamespaceBoolSetting(
+		"frontend.reducePollWorkflowHistoryRequestPriority",
+		false,
+		`ReducePollWorkflowHistoryRequestPriority decides whether GetWorkflowExecutionHistory requests waiting for new
+events are rate limited with the low priority of other poll APIs. When disabled (the default) they keep the priority
+of regular GetWorkflowExecutionHistory requests. Leave it disabled for namespaces whose history long-polls are latency
+sensitive, e.g. UI polling.`,
 	)
 	FrontendMaxNamespaceRPSPerInstance = NewNamespaceIntSetting(
 		"frontend.namespaceRPS",
@@ -625,6 +695,15 @@ long-running requests to fail. The name 'frontend.namespaceCount' is kept for ba
 existing deployments even though it is a bit of a misnomer. This does not limit the number of namespaces; it is a
 per-_namespace_ limit on the _count_ of long-running requests. Requests are only throttled when the limit is
 exceeded, not when it is only reached.`,
+	)
+	FrontendMaxConcurrentLongRunningRequestsPerInstancePerAPI = NewNamespaceTypedSetting(
+		"frontend.namespaceCount.perAPI",
+		map[string]int(nil),
+		`FrontendMaxConcurrentLongRunningRequestsPerInstancePerAPI overrides the per-instance limit on concurrent
+long-running requests for individual APIs, keyed by method name, e.g. {"QueryWorkflow": 100,
+"GetWorkflowExecutionHistory": 2000}. An API with an override is limited by it instead of
+FrontendMaxConcurrentLongRunningRequestsPerInstance and FrontendGlobalMaxConcurrentLongRunningRequests. APIs without an
+override fall back to those limits.`,
 	)
 	FrontendGlobalMaxConcurrentLongRunningRequests = NewNamespaceIntSetting(
 		"frontend.globalNamespaceCount",
@@ -721,6 +800,19 @@ This config is EXPERIMENTAL and may be changed or removed in a later release.`,
 		10,
 		`FrontendMaxBadBinaries is the max number of bad binaries in namespace config`,
 	)
+	FrontendRegisterNamespaceIfNotExists = NewNamespaceBoolSetting(
+		"frontend.registerNamespaceIfNotExists",
+		false,
+		`FrontendRegisterNamespaceIfNotExists makes RegisterNamespace succeed without changes when the namespace already
+exists with the same description, owner, data, retention, archival, replication and global settings as the request.
+Registering an existing namespace with a different configuration still fails with NamespaceAlreadyExists.`,
+	)
+	FrontendMaxNamespaceFailoverHistorySize = NewGlobalIntSetting(
+		"frontend.maxNamespaceFailoverHistorySize",
+		10,
+		`FrontendMaxNamespaceFailoverHistorySize is the max number of entries kept in the failover history of a namespace.
+The oldest entries are dropped when a failover exceeds it.`,
+	)
 	FrontendMaskInternalErrorDetails = NewNamespaceBoolSetting(
 		"frontend.maskInternalErrorDetails",
 		true,
@@ -736,6 +828,12 @@ This config is EXPERIMENTAL and may be changed or removed in a later release.`,
 		100,
 		`SearchAttributesNumberOfKeysLimit is the limit of number of keys`,
 	)
+	SearchAttributesNumberOfKeysLimitWarn = NewNamespaceIntSetting(
+		"frontend.searchAttributesNumberOfKeysLimitWarn",
+		80,
+		`SearchAttributesNumberOfKeysLimitWarn is the number of keys above which a warning is logged and
+a metric is emitted. It should be set below SearchAttributesNumberOfKeysLimit. 0 disables the warning.`,
+	)
 	SearchAttributesSizeOfValueLimit = NewNamespaceIntSetting(
 		"frontend.searchAttributesSizeOfValueLimit",
 		2*1024,
@@ -823,6 +921,13 @@ of Timeout and if no activity is seen even after that the connection is closed.`
 		false,
 		`EnableNexus toggles all Nexus functionality on the server. Note that toggling this requires restarting
 server hosts for it to take effect.`,
+	)
+	EnableNexusForNamespace = NewNamespaceBoolSetting(
+		"frontend.enableNexusForNamespace",
+		true,
+		`EnableNexusForNamespace toggles the frontend Nexus APIs for a single namespace. It is evaluated on every
+request and doesn't require a restart. EnableNexus remains the master switch, this setting can only disable Nexus
+for namespaces where it is otherwise enabled.`,
 	)
 	RefreshNexusEndpointsLongPollTimeout = NewGlobalDurationSetting(
 		"system.refreshNexusEndpointsLongPollTimeout",
@@ -849,6 +954,12 @@ server hosts for it to take effect.`,
 		32,
 		`MaxCallbacksPerWorkflow is the maximum number of callbacks that can be attached to a workflow.`,
 	)
+	FrontendCallbackHeaderMaxTotalSizePerWorkflow = NewNamespaceIntSetting(
+		"frontend.callbackHeaderMaxTotalSizePerWorkflow",
+		32*8*1024,
+		`FrontendCallbackHeaderMaxTotalSizePerWorkflow is the maximum accumulated size of header keys and values across
+all callbacks attached to a workflow. Attaching a callback that would exceed it is rejected.`,
+	)
 	FrontendMaxConcurrentBatchOperationPerNamespace = NewNamespaceIntSetting(
 		"frontend.MaxConcurrentBatchOperationPerNamespace",
 		1,
@@ -879,6 +990,12 @@ feature gets more time in production.`,
 		`FrontendEnableExecuteMultiOperation enables the ExecuteMultiOperation API in the frontend.
 The API is under active development.`,
 	)
+	FrontendMaxOperationsPerMultiOperation = NewNamespaceIntSetting(
+		"frontend.maxOperationsPerMultiOperation",
+		10,
+		`FrontendMaxOperationsPerMultiOperation is the max number of operations in a single ExecuteMultiOperation request.
+Larger requests are rejected with InvalidArgument.`,
+	)
 
 	FrontendEnableUpdateWorkflowExecutionAsyncAccepted = NewNamespaceBoolSetting(
 		"frontend.enableUpdateWorkflowExecutionAsyncAccepted",
@@ -929,6 +1046,16 @@ Default value is 256.`,
 		`DeleteNamespaceConcurrentDeleteExecutionsActivities is a number of concurrent delete executions activities.
 Must be not greater than 256 and number of worker cores in the cluster.
 Default is 4.`,
+	)
+	DeleteNamespaceTotalConcurrentDeleteExecutionsActivities = NewGlobalIntSetting(
+		"frontend.deleteNamespaceTotalConcurrentDeleteExecutionsActivities",
+		0,
+		`DeleteNamespaceTotalConcurrentDeleteExecutionsActivities is a cluster-wide budget of concurrent delete executions
+activities shared by all namespaces that are being deleted at the same time. When set, each namespace deletion uses
+the budget divided by the number of running namespace deletions, but never more than
+DeleteNamespaceConcurrentDeleteExecutionsActivities and never less than 1. The share is recomputed every time the
+delete executions workflow continues as new. Total RPS is then bounded by DeleteNamespaceDeleteActivityRPS * this value.
+Default is 0, which means every namespace deletion uses DeleteNamespaceConcurrentDeleteExecutionsActivities.`,
 	)
 	DeleteNamespaceNamespaceDeleteDelay = NewGlobalDurationSetting(
 		"frontend.deleteNamespaceNamespaceDeleteDelay",
@@ -982,6 +1109,12 @@ See DynamicRateLimitingParams comments for more details.`,
 		1000,
 		`MatchingGetTasksBatchSize is the maximum batch size to fetch from the task buffer`,
 	)
+	MatchingAdaptiveBatchEnabled = NewTaskQueueBoolSetting(
+		"matching.adaptiveBatchEnabled",
+		false,
+		`MatchingAdaptiveBatchEnabled makes the task reader grow its batch size towards MatchingGetTasksBatchSize
+while reads return full batches, and shrink it towards a small floor while reads return mostly empty`,
+	)
 	MatchingLongPollExpirationInterval = NewTaskQueueDurationSetting(
 		"matching.longPollExpirationInterval",
 		time.Minute,
@@ -1026,6 +1159,8 @@ versioning semantics. Operator intervention will be required to reschedule the d
 		"matching.maxTaskQueueIdleTime",
 		5*time.Minute,
 		`MatchingMaxTaskQueueIdleTime is the time after which an idle task queue will be unloaded.
+It can be constrained by namespace and task queue, e.g. to keep bursty queues loaded between bursts
+while unloading ephemeral ones sooner.
 Note: this should be greater than matching.longPollExpirationInterval and matching.getUserDataLongPollTimeout.`,
 	)
 	MatchingOutstandingTaskAppendsThreshold = NewTaskQueueIntSetting(
@@ -1068,6 +1203,13 @@ Note: this should be greater than matching.longPollExpirationInterval and matchi
 		1,
 		`MatchingForwarderMaxOutstandingTasks is the max number of inflight addTask/queryTask from the forwarder`,
 	)
+	MatchingForwarderMaxOutstandingBacklogTasks = NewTaskQueueIntSetting(
+		"matching.forwarderMaxOutstandingBacklogTasks",
+		1,
+		`MatchingForwarderMaxOutstandingBacklogTasks is the max number of inflight backlog tasks forwarded from the
+forwarder. It is separate from MatchingForwarderMaxOutstandingTasks so that backlog drain can be parallelized
+without affecting sync match forwarding.`,
+	)
 	MatchingForwarderMaxRatePerSecond = NewTaskQueueIntSetting(
 		"matching.forwarderMaxRatePerSecond",
 		10,
@@ -1098,7 +1240,10 @@ This can help reduce effects of task queue movement.`,
 		"matching.backlogNegligibleAge",
 		24*365*10*time.Hour,
 		`MatchingBacklogNegligibleAge if the head of backlog gets older than this we stop sync match and
-forwarding to ensure more equal dispatch order among partitions.`,
+forwarding to ensure more equal dispatch order among partitions. Like other task queue settings it can be
+overridden per namespace with a namespace-only constraint, a more specific task queue constraint takes precedence
+over it. Once the backlog is non-negligible, forwarding is still allowed if the partition has not seen a poll for
+MatchingMaxWaitForPollerBeforeFwd, so latency-sensitive namespaces will usually want to lower both.`,
 	)
 	MatchingMaxWaitForPollerBeforeFwd = NewTaskQueueDurationSetting(
 		"matching.maxWaitForPollerBeforeFwd",
@@ -1199,6 +1344,40 @@ If value less or equal to 0, will fall back to HistoryPersistenceMaxQPS`,
 Fields: Enabled, RefreshInterval, LatencyThreshold, ErrorThreshold, RateBackoffStepSize, RateIncreaseStepSize, RateMultiMin, RateMultiMax.
 See DynamicRateLimitingParams comments for more details.`,
 	)
+	HealthPersistenceLatencyFailure = NewGlobalFloatSetting(
+		"history.healthPersistenceLatencyFailure",
+		500,
+		`HealthPersistenceLatencyFailure is the average persistence latency in milliseconds above which the
+persistence latency health signal of a history host is failing`,
+	)
+	HealthPersistenceErrorRatio = NewGlobalFloatSetting(
+		"history.healthPersistenceErrorRatio",
+		0.9,
+		`HealthPersistenceErrorRatio is the ratio of persistence requests failing with an unhealthy error above which
+the persistence error health signal of a history host is failing`,
+	)
+	HealthRPCLatencyFailure = NewGlobalFloatSetting(
+		"history.healthRPCLatencyFailure",
+		500,
+		`HealthRPCLatencyFailure is the average latency in milliseconds of the RPCs served by a history host above which
+its RPC latency health signal is failing. Long poll RPCs are not taken into account.`,
+	)
+	HealthRPCErrorRatio = NewGlobalFloatSetting(
+		"history.healthRPCErrorRatio",
+		0.9,
+		`HealthRPCErrorRatio is the ratio of the RPCs served by a history host failing with an unhealthy error above
+which its RPC error health signal is failing`,
+	)
+	HealthRPCSignalWindowSize = NewGlobalDurationSetting(
+		"history.healthRPCSignalWindowSize",
+		10*time.Second,
+		`HealthRPCSignalWindowSize is the time window for aggregating the RPC health signals of a history host`,
+	)
+	HealthRPCSignalBufferSize = NewGlobalIntSetting(
+		"history.healthRPCSignalBufferSize",
+		5000,
+		`HealthRPCSignalBufferSize is the maximum number of RPC health signals of a history host to buffer in memory`,
+	)
 	HistoryLongPollExpirationInterval = NewNamespaceDurationSetting(
 		"history.longPollExpirationInterval",
 		time.Second*20,
@@ -1284,6 +1463,13 @@ before joining membership after starting.`,
 		8*1024*1024,
 		`XDCCacheMaxSizeBytes is max size of events cache in bytes`,
 	)
+	XDCCacheEvictionPolicy = NewGlobalStringSetting(
+		"history.xdcCacheEvictionPolicy",
+		"lru",
+		`XDCCacheEvictionPolicy is the eviction policy of the XDC cache once it reaches XDCCacheMaxSizeBytes. Valid values
+are "lru", which evicts the least recently used entries first, and "size-weighted", which evicts the largest entries
+first and suits workloads with a few huge entries. Unknown values fall back to "lru". Changes require a restart.`,
+	)
 	EventsCacheMaxSizeBytes = NewGlobalIntSetting(
 		"history.eventsCacheMaxSizeBytes",
 		512*1024,
@@ -1294,6 +1480,27 @@ before joining membership after starting.`,
 		512*512*1024,
 		`EventsHostLevelCacheMaxSizeBytes is max size of the host level events cache in bytes`,
 	)
+	EventsCachePinnedMaxSizeBytes = NewGlobalIntSetting(
+		"history.eventsCachePinnedMaxSizeBytes",
+		0,
+		`EventsCachePinnedMaxSizeBytes is max size in bytes of the events of pinned workflows that the shard level events
+cache keeps out of LRU eviction. It is in addition to EventsCacheMaxSizeBytes. 0 disables pinning.`,
+	)
+	EventsCachePinnedWorkflowIDs = NewNamespaceIDTypedSettingWithConverter(
+		"history.eventsCachePinnedWorkflowIDs",
+		convertStringSet,
+		map[string]struct{}(nil),
+		`EventsCachePinnedWorkflowIDs is the list of workflow IDs of a namespace whose events are kept out of LRU eviction
+by the events cache, within EventsCachePinnedMaxSizeBytes or EventsHostLevelCachePinnedMaxSizeBytes. The oldest pinned
+events are released first once the budget is used up. Removing a workflow ID hands its pinned events back to the LRU
+cache, and the pinned events of a workflow run are dropped when the run is deleted.`,
+	)
+	EventsHostLevelCachePinnedMaxSizeBytes = NewGlobalIntSetting(
+		"history.eventsHostLevelCachePinnedMaxSizeBytes",
+		0,
+		`EventsHostLevelCachePinnedMaxSizeBytes is max size in bytes of the events of pinned workflows that the host level
+events cache keeps out of LRU eviction. It is in addition to EventsHostLevelCacheMaxSizeBytes. 0 disables pinning.`,
+	)
 	EventsCacheTTL = NewGlobalDurationSetting(
 		"history.eventsCacheTTL",
 		time.Hour,
@@ -1339,7 +1546,12 @@ to this require a restart to take effect.`,
 	ShardIOConcurrency = NewGlobalIntSetting(
 		"history.shardIOConcurrency",
 		1,
-		`ShardIOConcurrency controls the concurrency of persistence operations in shard context`,
+		`ShardIOConcurrency controls the concurrency of persistence operations in shard context.
+Only operations that may conflict with each other are bounded by this limit: shard info updates,
+ownership assertions, adding tasks, and creating, updating, conflict-resolving, setting and deleting
+workflow executions. Reads are never bounded by it. Values greater than 1 are only supported by SQL
+persistence; Cassandra relies on serialized LWTs and is always forced to 1. Requests that have to wait
+for a slot are counted by the semaphore_queued metric.`,
 	)
 	ShardIOTimeout = NewGlobalDurationSetting(
 		"history.shardIOTimeout",
@@ -1362,12 +1574,31 @@ before calling remote for missing events`,
 		15*time.Minute,
 		`StandbyTaskMissingEventsDiscardDelay is the amount of time standby cluster's will wait (if events are missing)
 before discarding the task`,
+	)
+	StandbyTaskMissingEventsResendDelayOverride = NewNamespaceDurationSetting(
+		"history.standbyTaskMissingEventsResendDelayOverride",
+		0,
+		`StandbyTaskMissingEventsResendDelayOverride overrides StandbyTaskMissingEventsResendDelay for all standby
+tasks of a namespace, e.g. one with known slow replication. Zero means the task type default is used`,
+	)
+	StandbyTaskMissingEventsDiscardDelayOverride = NewNamespaceDurationSetting(
+		"history.standbyTaskMissingEventsDiscardDelayOverride",
+		0,
+		`StandbyTaskMissingEventsDiscardDelayOverride overrides StandbyTaskMissingEventsDiscardDelay for all standby
+tasks of a namespace. Zero means the task type default is used`,
 	)
 	QueuePendingTaskCriticalCount = NewGlobalIntSetting(
 		"history.queuePendingTaskCriticalCount",
 		9000,
 		`QueuePendingTaskCriticalCount is the max number of pending task in one queue
 before triggering queue slice splitting and unloading`,
+	)
+	QueuePendingTaskPerNamespaceCriticalCount = NewNamespaceIntSetting(
+		"history.queuePendingTaskPerNamespaceCriticalCount",
+		0,
+		`QueuePendingTaskPerNamespaceCriticalCount is the max number of pending task from a single namespace
+in one queue before unloading tasks from that namespace only. This allows a single namespace's backlog
+to be slowed down without penalizing other namespaces on the same shard. 0 means no per-namespace limit.`,
 	)
 	QueueReaderStuckCriticalAttempts = NewGlobalIntSetting(
 		"history.queueReaderStuckCriticalAttempts",
@@ -1391,6 +1622,25 @@ for the entire queue but only trigger a queue action to unload tasks. Ideally th
 limit should not be hit and task unloading should happen once critical count is exceeded. But
 since queue action is async, we need this hard limit.`,
 	)
+	ProcessorStartupJitter = NewGlobalDurationSetting(
+		"history.processorStartupJitter",
+		0,
+		`ProcessorStartupJitter is the max random delay applied once before a history queue processor
+starts loading tasks, so that processors on a restarted host don't all poll persistence at the same time.
+0 means no delay.`,
+	)
+	ListTasksMaxScheduledRangeWidth = NewGlobalDurationSetting(
+		"history.listTasksMaxScheduledRangeWidth",
+		0,
+		`ListTasksMaxScheduledRangeWidth is the max fire time width of a scheduled task range requested through the
+admin ListHistoryTasks API. A missing min fire time is measured from the start of the queue. Zero means unlimited.`,
+	)
+	ListTasksMaxImmediateRangeWidth = NewGlobalIntSetting(
+		"history.listTasksMaxImmediateRangeWidth",
+		0,
+		`ListTasksMaxImmediateRangeWidth is the max number of task IDs in an immediate task range requested through the
+admin ListHistoryTasks API. A missing min task ID is treated as 0. Zero means unlimited.`,
+	)
 
 	TaskSchedulerEnableRateLimiter = NewGlobalBoolSetting(
 		"history.taskSchedulerEnableRateLimiter",
@@ -1498,10 +1748,11 @@ If value less or equal to 0, will fall back to HistoryPersistenceNamespaceMaxQPS
 		2,
 		`TimerQueueMaxReaderCount is the max number of readers in one multi-cursor timer queue`,
 	)
-	RetentionTimerJitterDuration = NewGlobalDurationSetting(
+	RetentionTimerJitterDuration = NewNamespaceDurationSetting(
 		"history.retentionTimerJitterDuration",
 		30*time.Minute,
-		`RetentionTimerJitterDuration is a time duration jitter to distribute timer from T0 to T0 + jitter duration`,
+		`RetentionTimerJitterDuration is a time duration jitter to distribute timer from T0 to T0 + jitter duration.
+It can be overridden per namespace, e.g. lowered for namespaces with short retention so cleanup happens promptly.`,
 	)
 
 	MemoryTimerProcessorSchedulerWorkerCount = NewGlobalIntSetting(
@@ -1570,6 +1821,12 @@ If value less or equal to 0, will fall back to HistoryPersistenceNamespaceMaxQPS
 		true,
 		`TransferProcessorEnsureCloseBeforeDelete means we ensure the execution is closed before we delete it`,
 	)
+	DeleteManagerEnsureCloseBeforeDelete = NewGlobalBoolSetting(
+		"history.deleteManagerEnsureCloseBeforeDelete",
+		false,
+		`DeleteManagerEnsureCloseBeforeDelete makes history reject, with FailedPrecondition, deleting a workflow execution
+that is still running in a namespace active in the current cluster. Passive clusters and admin force-delete are not affected.`,
+	)
 	TransferQueueMaxReaderCount = NewGlobalIntSetting(
 		"history.transferQueueMaxReaderCount",
 		2,
@@ -1636,6 +1893,20 @@ If value less or equal to 0, will fall back to HistoryPersistenceNamespaceMaxQPS
 		100.0,
 		`OutboundQueueHostSchedulerMaxTaskRPS is the host scheduler max task RPS`,
 	)
+	OutboundQueueHostSchedulerMaxConcurrency = NewGlobalIntSetting(
+		"history.outboundQueue.hostScheduler.maxConcurrency",
+		0,
+		`OutboundQueueHostSchedulerMaxConcurrency is the max number of outbound tasks the host scheduler executes
+concurrently across all destinations. Destination priorities only take effect once this limit is reached.
+0 means unlimited. Changes take effect on service restart.`,
+	)
+	OutboundQueueHostSchedulerPriority = NewDestinationIntSetting(
+		"history.outboundQueue.hostScheduler.priority",
+		0,
+		`OutboundQueueHostSchedulerPriority is the priority of a destination in the host scheduler. When the host
+scheduler concurrency limit is reached, tasks of high priority (0) destinations are executed ahead of tasks of low
+priority (1) destinations.`,
+	)
 	OutboundQueueCircuitBreakerSettings = NewDestinationTypedSetting(
 		"history.outboundQueue.circuitBreakerSettings",
 		CircuitBreakerSettings{},
@@ -1793,6 +2064,13 @@ archivalQueueProcessor`,
 		2000,
 		`WorkflowExecutionMaxTotalUpdates is the max number of updates that any given workflow execution can receive.`,
 	)
+	WorkflowExecutionMaxTotalUpdatesSuggestContinueAsNewThreshold = NewNamespaceFloatSetting(
+		"history.maxTotalUpdates.suggestContinueAsNewThreshold",
+		0.9,
+		`WorkflowExecutionMaxTotalUpdatesSuggestContinueAsNewThreshold is the fraction of WorkflowExecutionMaxTotalUpdates
+at which continue-as-new is suggested (in workflow task started event). For example, 0.9 suggests continue-as-new once
+a workflow execution has received 90% of its allowed total updates. A value of 0 disables the suggestion.`,
+	)
 
 	ReplicatorTaskBatchSize = NewGlobalIntSetting(
 		"history.replicatorTaskBatchSize",
@@ -1823,7 +2101,18 @@ archivalQueueProcessor`,
 		"history.maximumBufferedEventsSizeInBytes",
 		2*1024*1024,
 		`MaximumBufferedEventsSizeInBytes is the maximum permissible size of all buffered events for any given mutable
-state. The total size is determined by the sum of the size, in bytes, of each HistoryEvent proto.`,
+state. The total size is the serialized size, in bytes, of the buffered events as they are persisted, i.e. the
+size of each HistoryEvent proto plus its encoding overhead. Persistence additionally rejects a transaction whose
+serialized new buffered events exceed this size.`,
+	)
+	BufferedEventsOverflowBehavior = NewNamespaceStringSetting(
+		"history.bufferedEventsOverflowBehavior",
+		"force-workflow-task",
+		`BufferedEventsOverflowBehavior controls what happens when a transaction leaves more buffered events than
+MaximumBufferedEventsBatch or MaximumBufferedEventsSizeInBytes allow while a workflow task is in flight. Valid values
+are "force-workflow-task", which fails the in-flight workflow task and schedules a new one so the buffer gets flushed,
+and "reject", which fails the request that would overflow the buffer with a busy workflow error and leaves the
+in-flight workflow task running. Unknown values fall back to "force-workflow-task".`,
 	)
 	MaximumSignalsPerExecution = NewNamespaceIntSetting(
 		"history.maximumSignalsPerExecution",
@@ -1839,7 +2128,7 @@ state. The total size is determined by the sum of the size, in bytes, of each Hi
 		"history.shardFirstUpdateInterval",
 		10*time.Second,
 		`ShardFirstUpdateInterval is the time interval after which the first shard info update will happen.
-		It should be smaller than ShardUpdateMinInterval`,
+		It should be smaller than ShardUpdateMinInterval, larger values are clamped to ShardUpdateMinInterval`,
 	)
 	ShardUpdateMinTasksCompleted = NewGlobalIntSetting(
 		"history.shardUpdateMinTasksCompleted",
@@ -1847,6 +2136,14 @@ state. The total size is determined by the sum of the size, in bytes, of each Hi
 		`ShardUpdateMinTasksCompleted is the minimum number of tasks which must be completed (across all queues) before the shard info can be updated.
 Note that once history.shardUpdateMinInterval amount of time has passed we'll update the shard info regardless of the number of tasks completed.
 When the this config is zero or lower we will only update shard info at most once every history.shardUpdateMinInterval.`,
+	)
+	ShardUpdateMinTasksCompletedPerQueue = NewGlobalTypedSetting(
+		"history.shardUpdateMinTasksCompletedPerQueue",
+		map[string]int(nil),
+		`ShardUpdateMinTasksCompletedPerQueue overrides history.shardUpdateMinTasksCompleted for individual queue types,
+keyed by queue category name (e.g. "transfer", "timer", "visibility"). Tasks completed by a queue with an override
+only count towards that queue's own threshold, all other queues share history.shardUpdateMinTasksCompleted.
+A threshold of zero or lower disables task counting for that queue.`,
 	)
 	ShardSyncMinInterval = NewGlobalDurationSetting(
 		"history.shardSyncMinInterval",
@@ -1858,6 +2155,11 @@ When the this config is zero or lower we will only update shard info at most onc
 		false,
 		`EmitShardLagLog whether emit the shard lag log`,
 	)
+	EmitShardLagMetrics = NewGlobalBoolSetting(
+		"history.emitShardLagMetrics",
+		false,
+		`EmitShardLagMetrics whether emit the per shard queue lag gauges when shard info is persisted`,
+	)
 	DefaultEventEncoding = NewNamespaceStringSetting(
 		"history.defaultEventEncoding",
 		enumspb.ENCODING_TYPE_PROTO3.String(),
@@ -1911,6 +2213,12 @@ the number of children greater than or equal to this threshold`,
 		10,
 		`WorkflowTaskCriticalAttempts is the number of attempts for a workflow task that's regarded as critical`,
 	)
+	WorkflowTaskTimeoutLogRate = NewNamespaceFloatSetting(
+		"history.workflowTaskTimeoutLogRate",
+		0.0,
+		`WorkflowTaskTimeoutLogRate defines the sampling rate for logs when a workflow task times out. Since these log lines
+can be noisy, we want to be able to turn on and sample selectively for each affected namespace.`,
+	)
 	WorkflowTaskRetryMaxInterval = NewGlobalDurationSetting(
 		"history.workflowTaskRetryMaxInterval",
 		time.Minute*10,
@@ -1921,6 +2229,19 @@ the number of children greater than or equal to this threshold`,
 		primitives.DefaultWorkflowTaskTimeout,
 		`DefaultWorkflowTaskTimeout for a workflow task`,
 	)
+	MaxWorkflowTaskTimeout = NewNamespaceDurationSetting(
+		"history.maxWorkflowTaskTimeout",
+		0,
+		`MaxWorkflowTaskTimeout is the ceiling on the workflow task timeout a workflow may request in a namespace.
+Requests above it are clamped or rejected depending on RejectWorkflowTaskTimeoutAboveMax. 0 means no ceiling
+beyond the built-in maximum.`,
+	)
+	RejectWorkflowTaskTimeoutAboveMax = NewNamespaceBoolSetting(
+		"history.rejectWorkflowTaskTimeoutAboveMax",
+		false,
+		`RejectWorkflowTaskTimeoutAboveMax controls whether a workflow task timeout above MaxWorkflowTaskTimeout
+is rejected with an InvalidArgument error instead of being clamped to the ceiling`,
+	)
 	SkipReapplicationByNamespaceID = NewNamespaceIDBoolSetting(
 		"history.SkipReapplicationByNamespaceID",
 		false,
@@ -1952,10 +2273,11 @@ the number of children greater than or equal to this threshold`,
 		`MutableStateChecksumInvalidateBefore is the epoch timestamp before which all checksums are to be discarded`,
 	)
 
-	ReplicationTaskApplyTimeout = NewGlobalDurationSetting(
+	ReplicationTaskApplyTimeout = NewNamespaceDurationSetting(
 		"history.ReplicationTaskApplyTimeout",
 		20*time.Second,
-		`ReplicationTaskApplyTimeout is the context timeout for replication task apply`,
+		`ReplicationTaskApplyTimeout is the context timeout for replication task apply.
+A namespace-scoped value overrides the global default for replication tasks of that namespace.`,
 	)
 	ReplicationTaskFetcherParallelism = NewGlobalIntSetting(
 		"history.ReplicationTaskFetcherParallelism",
@@ -2064,7 +2386,15 @@ the dlq (or will drop them if not enabled)`,
 		"history.TaskDLQErrorPattern",
 		"",
 		`HistoryTaskDLQErrorPattern specifies a regular expression. If a task processing error matches with this regex,
-that task will be sent to DLQ.`,
+that task will be sent to DLQ. A pattern prefixed with "glob:" is instead matched against the whole error message as a
+glob, where '*' matches any characters including '/', e.g. "glob:*serialization error*".`,
+	)
+	HistoryTaskTypeDLQErrorPattern = NewTaskTypeStringSetting(
+		"history.TaskTypeDLQErrorPattern",
+		"",
+		`HistoryTaskTypeDLQErrorPattern is HistoryTaskDLQErrorPattern with a history task type constraint, e.g. to only
+send visibility tasks failing with a serialization error to DLQ. When set for a task type, it takes precedence over
+HistoryTaskDLQErrorPattern, which is used for task types that have no pattern set.`,
 	)
 
 	ReplicationStreamSyncStatusDuration = NewGlobalDurationSetting(
@@ -2123,15 +2453,25 @@ that task will be sent to DLQ.`,
 		100,
 		`Maximum number of low priority replication tasks that can be sent per second per shard`,
 	)
+	ReplicationTaskLowPriorityPolicy = NewGlobalTypedSetting(
+		"history.ReplicationTaskLowPriorityPolicy",
+		ReplicationTaskPriorityPolicy{},
+		`ReplicationTaskLowPriorityPolicy classifies additional replication tasks as low priority when
+history.EnableReplicationTaskTieredProcessing is on, so backfill and catch-up traffic does not delay live events.
+Fields: MinTaskAge, TaskTypes. See ReplicationTaskPriorityPolicy comments for more details.
+Stream senders read the policy when the stream connects. While it is set, both priorities resume from the lower of
+their ack levels on reconnect, since a policy change can move tasks between priorities.`,
+	)
 	ReplicationReceiverMaxOutstandingTaskCount = NewGlobalIntSetting(
 		"history.ReplicationReceiverMaxOutstandingTaskCount",
 		50,
 		`Maximum number of outstanding tasks allowed for a single shard in the stream receiver`,
 	)
-	ReplicationResendMaxBatchCount = NewGlobalIntSetting(
+	ReplicationResendMaxBatchCount = NewNamespaceIntSetting(
 		"history.ReplicationResendMaxBatchCount",
 		10,
-		`Maximum number of resend events batch for a single replication request`,
+		`Maximum number of resend events batch for a single replication request. Can be overridden per namespace
+to keep the resend of a large namespace from starving the replication of other namespaces.`,
 	)
 
 	// keys for worker
@@ -2183,6 +2523,13 @@ See DynamicRateLimitingParams comments for more details.`,
 		16*1024*1024,
 		`WorkerESProcessorBulkSize is max total size of bulk in bytes for esProcessor`,
 	)
+	WorkerESProcessorAdaptiveBulkSize = NewGlobalBoolSetting(
+		"worker.ESProcessorAdaptiveBulkSize",
+		false,
+		`WorkerESProcessorAdaptiveBulkSize enables flushing the bulk of esProcessor before it reaches
+WorkerESProcessorBulkSize bytes, leaving room for a document of the average size of the recently added ones.
+This avoids oversized bulk requests when documents are large.`,
+	)
 	WorkerESProcessorFlushInterval = NewGlobalDurationSetting(
 		"worker.ESProcessorFlushInterval",
 		1*time.Second,
@@ -2254,11 +2601,27 @@ Should be at least WorkerESProcessorFlushInterval+<time to process request>.`,
 		true,
 		`TaskQueueScannerEnabled indicates if task queue scanner should be started as part of worker.Scanner`,
 	)
+	TaskQueueScannerPerHostQPS = NewGlobalIntSetting(
+		"worker.taskQueueScannerPerHostQPS",
+		100,
+		`TaskQueueScannerPerHostQPS is the maximum rate of persistence calls per host from taskqueue.Scavenger`,
+	)
+	TaskQueueScannerWorkerCount = NewGlobalIntSetting(
+		"worker.taskQueueScannerWorkerCount",
+		32,
+		`TaskQueueScannerWorkerCount is the number of task queues processed concurrently by taskqueue.Scavenger`,
+	)
 	BuildIdScavengerEnabled = NewGlobalBoolSetting(
 		"worker.buildIdScavengerEnabled",
 		false,
 		`BuildIdScavengerEnabled indicates if the build id scavenger should be started as part of worker.Scanner`,
 	)
+	BuildIdScavengerDryRun = NewGlobalBoolSetting(
+		"worker.buildIdScavengerDryRun",
+		false,
+		`BuildIdScavengerDryRun makes the build id scavenger log and count the build ids it would remove without
+removing them from versioning data. Reachability checks still respect BuildIdScavengerVisibilityRPS.`,
+	)
 	HistoryScannerEnabled = NewGlobalBoolSetting(
 		"worker.historyScannerEnabled",
 		true,
@@ -2274,11 +2637,12 @@ Should be at least WorkerESProcessorFlushInterval+<time to process request>.`,
 		60*24*time.Hour,
 		`HistoryScannerDataMinAge indicates the history scanner cleanup minimum age.`,
 	)
-	HistoryScannerVerifyRetention = NewGlobalBoolSetting(
+	HistoryScannerVerifyRetention = NewNamespaceBoolSetting(
 		"worker.historyScannerVerifyRetention",
 		true,
-		`HistoryScannerVerifyRetention indicates the history scanner verify data retention.
-If the service configures with archival feature enabled, update worker.historyScannerVerifyRetention to be double of the data retention.`,
+		`HistoryScannerVerifyRetention indicates the history scanner verify data retention for workflows in the namespace.
+Closed workflows are deleted once they are older than the namespace retention, or twice the namespace retention if
+history archival is enabled for the namespace, so that archival has time to complete first.`,
 	)
 	EnableBatcherGlobal = NewGlobalBoolSetting(
 		"worker.enableBatcher",
@@ -2320,6 +2684,13 @@ If the service configures with archival feature enabled, update worker.historySc
 		4,
 		`WorkerParentCloseMaxConcurrentWorkflowTaskPollers indicates worker parent close worker max concurrent workflow pollers`,
 	)
+	WorkerParentCloseProcessorConcurrency = NewNamespaceIntSetting(
+		"worker.ParentCloseProcessorConcurrency",
+		1,
+		`WorkerParentCloseProcessorConcurrency is the number of child executions in a namespace that one parent close
+policy activity terminates or cancels in parallel. The number of such activities running at once is still bounded
+by WorkerParentCloseMaxConcurrentActivityExecutionSize.`,
+	)
 	WorkerPerNamespaceWorkerCount = NewNamespaceIntSetting(
 		"worker.perNamespaceWorkerCount",
 		1,
@@ -2381,4 +2752,11 @@ WorkerActivitiesPerSecond, MaxConcurrentActivityTaskPollers.
 		1*time.Second,
 		`WorkflowIdReuseMinimalInterval is used for timing how soon users can create new workflow with the same workflow ID.`,
 	)
+	WorkflowIdReuseMinimalIntervalExemptSystemCallers = NewNamespaceBoolSetting(
+		"system.workflowIdReuseMinimalIntervalExemptSystemCallers",
+		false,
+		`WorkflowIdReuseMinimalIntervalExemptSystemCallers lets workflow starts received by the internal frontend (i.e. from
+server components such as schedules) bypass WorkflowIdReuseMinimalInterval. Starts from clients are always throttled.
+Only has an effect when the internal frontend is enabled.`,
+	)
 )
@@ -114,6 +114,17 @@ var DefaultDynamicRateLimitingParams = DynamicRateLimitingParams{
 	RateMultiMax:         1.0,
 }
 
+// ReplicationTaskPriorityPolicy selects replication tasks sent on the low priority tier of the tiered replication
+// stack, in addition to the tasks created with a low priority.
+type ReplicationTaskPriorityPolicy struct {
+	// MinTaskAge: tasks already older than this when the replication stream connects are low priority.
+	// Zero disables age based classification.
+	MinTaskAge time.Duration
+	// TaskTypes: replication task types that are always low priority, e.g. "ReplicationSyncActivity" or
+	// "TASK_TYPE_REPLICATION_SYNC_ACTIVITY". Unknown task types are ignored.
+	TaskTypes []string
+}
+
 type CircuitBreakerSettings struct {
 	// MaxRequests: Maximum number of requests allowed to pass through when
 	// it is in half-open state (default 1).
@@ -25,6 +25,8 @@
 package failure
 
 import (
+	"reflect"
+
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	failurepb "go.temporal.io/api/failure/v1"
@@ -47,6 +49,22 @@ func NewServerFailure(message string, nonRetryable bool) *failurepb.Failure {
 	return f
 }
 
+// NewFailureFromError returns a failure describing err, with the Go type name of err, e.g. NamespaceNotFound
+// for a *serviceerror.NamespaceNotFound, as the failure type, so that callers can tell errors apart.
+func NewFailureFromError(err error) *failurepb.Failure {
+	errType := reflect.TypeOf(err)
+	if errType.Kind() == reflect.Pointer {
+		errType = errType.Elem()
+	}
+	return &failurepb.Failure{
+		Message: err.Error(),
+		Source:  failureSourceServer,
+		FailureInfo: &failurepb.Failure_ApplicationFailureInfo{ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{
+			Type: errType.Name(),
+		}},
+	}
+}
+
 func NewResetWorkflowFailure(message string, lastHeartbeatDetails *commonpb.Payloads) *failurepb.Failure {
 	f := &failurepb.Failure{
 		Message: message,
@@ -25,10 +25,12 @@
 package failure
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	failurepb "go.temporal.io/api/failure/v1"
+	"go.temporal.io/api/serviceerror"
 )
 
 func TestTruncate(t *testing.T) {
@@ -109,3 +111,14 @@ func TestTruncateDepth(t *testing.T) {
 	assert.LessOrEqual(t, trunc.Size(), 30)
 	assert.Nil(t, trunc.Cause.Cause)
 }
+
+func TestNewFailureFromError(t *testing.T) {
+	f := NewFailureFromError(serviceerror.NewNamespaceNotFound("test-namespace"))
+	assert.Equal(t, "Namespace test-namespace is not found.", f.GetMessage())
+	assert.Equal(t, "Server", f.GetSource())
+	assert.Equal(t, "NamespaceNotFound", f.GetApplicationFailureInfo().GetType())
+
+	f = NewFailureFromError(errors.New("plain error"))
+	assert.Equal(t, "plain error", f.GetMessage())
+	assert.Equal(t, "errorString", f.GetApplicationFailureInfo().GetType())
+}
@@ -60,6 +60,11 @@ func Error(err error) ZapTag {
 	return NewErrorTag(err)
 }
 
+// ErrorCorrelationID returns tag for ErrorCorrelationID
+func ErrorCorrelationID(correlationID string) ZapTag {
+	return NewStringTag("error-correlation-id", correlationID)
+}
+
 // ServiceErrorType returns tag for ServiceErrorType
 func ServiceErrorType(err error) ZapTag {
 	return NewStringTag("service-error-type", util.ErrorType(err))
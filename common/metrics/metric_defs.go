@@ -30,6 +30,7 @@ const (
 	OperationTagName            = "operation"
 	ServiceRoleTagName          = "service_role"
 	CacheTypeTagName            = "cache_type"
+	CacheLevelTagName           = "cache_level"
 	FailureTagName              = "failure"
 	TaskCategoryTagName         = "task_category"
 	TaskTypeTagName             = "task_type"
@@ -62,6 +63,9 @@ const (
 	MutableStateCacheTypeTagValue = "mutablestate"
 	EventsCacheTypeTagValue       = "events"
 
+	HostCacheLevelTagValue  = "host"
+	ShardCacheLevelTagValue = "shard"
+
 	InvalidHistoryURITagValue    = "invalid_history_uri"
 	InvalidVisibilityURITagValue = "invalid_visibility_uri"
 )
@@ -123,6 +127,8 @@ const (
 	PersistenceNamespaceReplicationQueueScope = "NamespaceReplicationQueue"
 	// PersistenceEnqueueMessageScope tracks Enqueue calls made by service to persistence layer
 	PersistenceEnqueueMessageScope = "EnqueueMessage"
+	// PersistenceEnqueueMessagesScope tracks batched Enqueue calls made by service to persistence layer
+	PersistenceEnqueueMessagesScope = "EnqueueMessages"
 	// PersistenceEnqueueMessageToDLQScope tracks Enqueue DLQ calls made by service to persistence layer
 	PersistenceEnqueueMessageToDLQScope = "EnqueueMessageToDLQ"
 	// PersistenceReadQueueMessagesScope tracks ReadMessages calls made by service to persistence layer
@@ -169,6 +175,8 @@ const (
 	PersistenceCreateWorkflowExecutionScope = "CreateWorkflowExecution"
 	// PersistenceGetWorkflowExecutionScope tracks GetWorkflowExecution calls made by service to persistence layer
 	PersistenceGetWorkflowExecutionScope = "GetWorkflowExecution"
+	// PersistenceGetRawWorkflowExecutionScope tracks GetRawWorkflowExecution calls made by service to persistence layer
+	PersistenceGetRawWorkflowExecutionScope = "GetRawWorkflowExecution"
 	// PersistenceSetWorkflowExecutionScope tracks SetWorkflowExecution calls made by service to persistence layer
 	PersistenceSetWorkflowExecutionScope = "SetWorkflowExecution"
 	// PersistenceUpdateWorkflowExecutionScope tracks UpdateWorkflowExecution calls made by service to persistence layer
@@ -185,6 +193,8 @@ const (
 	PersistenceListConcreteExecutionsScope = "ListConcreteExecutions"
 	// PersistenceAddTasksScope tracks AddTasks calls made by service to persistence layer
 	PersistenceAddTasksScope = "AddTasks"
+	// PersistenceAddTasksBatchScope tracks AddTasksBatch calls made by service to persistence layer
+	PersistenceAddTasksBatchScope = "AddTasksBatch"
 	// PersistenceGetTransferTasksScope tracks GetTransferTasks calls made by service to persistence layer
 	PersistenceGetTransferTasksScope = "GetTransferTasks"
 	// PersistenceCompleteTransferTaskScope tracks CompleteTransferTasks calls made by service to persistence layer
@@ -223,6 +233,8 @@ const (
 	PersistenceDeleteReplicationTaskFromDLQScope = "DeleteReplicationTaskFromDLQ"
 	// PersistenceRangeDeleteReplicationTaskFromDLQScope tracks PersistenceRangeDeleteReplicationTaskFromDLQScope calls made by service to persistence layer
 	PersistenceRangeDeleteReplicationTaskFromDLQScope = "RangeDeleteReplicationTaskFromDLQ"
+	// PersistenceMergeReplicationTasksFromDLQScope tracks PersistenceMergeReplicationTasksFromDLQScope calls made by service to persistence layer
+	PersistenceMergeReplicationTasksFromDLQScope = "MergeReplicationTasksFromDLQ"
 	// PersistenceGetTimerTasksScope tracks GetTimerTasks calls made by service to persistence layer
 	PersistenceGetTimerTasksScope = "GetTimerTasks"
 	// PersistenceCompleteTimerTaskScope tracks CompleteTimerTasks calls made by service to persistence layer
@@ -619,6 +631,7 @@ var (
 	SemaphoreRequests                        = NewCounterDef("semaphore_requests")
 	SemaphoreFailures                        = NewCounterDef("semaphore_failures")
 	SemaphoreLatency                         = NewTimerDef("semaphore_latency")
+	SemaphoreQueued                          = NewCounterDef("semaphore_queued")
 	ClientRequests                           = NewCounterDef(
 		"client_requests",
 		WithDescription("The number of requests sent by the client to an individual service, keyed by `service_role` and `operation`."),
@@ -639,6 +652,10 @@ var (
 		"wf_too_many_pending_child_workflows",
 		WithDescription("The number of Workflow Tasks failed because they would cause the limit on the number of pending child workflows to be exceeded. See https://t.mp/limits for more information."),
 	)
+	SearchAttributesNumberOfKeysWarn = NewCounterDef(
+		"search_attributes_number_of_keys_warn",
+		WithDescription("The number of times the number of search attribute keys set on a workflow exceeded the warning limit."),
+	)
 	TooManyPendingActivities = NewCounterDef(
 		"wf_too_many_pending_activities",
 		WithDescription("The number of Workflow Tasks failed because they would cause the limit on the number of pending activities to be exceeded. See https://t.mp/limits for more information."),
@@ -707,6 +724,8 @@ var (
 	CacheTtl                                     = NewTimerDef("cache_ttl")
 	CacheEntryAgeOnGet                           = NewTimerDef("cache_entry_age_on_get")
 	CacheEntryAgeOnEviction                      = NewTimerDef("cache_entry_age_on_eviction")
+	CacheTTLEvictions                            = NewCounterDef("cache_ttl_evictions")
+	CacheSizeEvictions                           = NewCounterDef("cache_size_evictions")
 	HistoryEventNotificationQueueingLatency      = NewTimerDef("history_event_notification_queueing_latency")
 	HistoryEventNotificationFanoutLatency        = NewTimerDef("history_event_notification_fanout_latency")
 	HistoryEventNotificationInFlightMessageGauge = NewGaugeDef("history_event_notification_inflight_message_gauge")
@@ -729,6 +748,14 @@ var (
 		"shardinfo_scheduled_queue_lag",
 		WithDescription("A histogram across history shards for the difference between the earliest scheduled time of pending history tasks and current time."),
 	)
+	ShardImmediateQueueLag = NewGaugeDef(
+		"shard_immediate_queue_lag",
+		WithDescription("Per shard difference between the smallest taskID of pending history tasks and the last generated history task ID."),
+	)
+	ShardScheduledQueueLag = NewGaugeDef(
+		"shard_scheduled_queue_lag_seconds",
+		WithDescription("Per shard difference in seconds between the earliest scheduled time of pending history tasks and the scheduled queue high watermark."),
+	)
 	SyncShardFromRemoteCounter = NewCounterDef("syncshard_remote_count")
 	SyncShardFromRemoteFailure = NewCounterDef("syncshard_remote_failed")
 	TaskRequests               = NewCounterDef(
@@ -785,6 +812,14 @@ var (
 		"task_errors_workflow_busy",
 		WithDescription("The number of history task processing errors caused by failing to acquire workflow lock within the configured timeout (history.cacheNonUserContextLockTimeout)."),
 	)
+	TaskStandbyMissingEventsResend = NewCounterDef(
+		"task_standby_missing_events_resend",
+		WithDescription("The number of times a standby task with missing events was past its resend delay (history.standbyTaskMissingEventsResendDelay) and fetched history from the remote cluster."),
+	)
+	TaskStandbyMissingEventsDiscard = NewCounterDef(
+		"task_standby_missing_events_discard",
+		WithDescription("The number of standby tasks with missing events discarded after their discard delay (history.standbyTaskMissingEventsDiscardDelay)."),
+	)
 	TaskNotActiveCounter         = NewCounterDef("task_errors_not_active_counter")
 	TaskNamespaceHandoverCounter = NewCounterDef("task_errors_namespace_handover")
 	TaskInternalErrorCounter     = NewCounterDef("task_errors_internal")
@@ -805,6 +840,7 @@ var (
 	QueueReaderCountHistogram                            = NewDimensionlessHistogramDef("queue_reader_count")
 	QueueSliceCountHistogram                             = NewDimensionlessHistogramDef("queue_slice_count")
 	QueueActionCounter                                   = NewCounterDef("queue_actions")
+	QueueNamespacePendingTaskLimitExceeded               = NewCounterDef("queue_namespace_pending_task_limit_exceeded")
 	ActivityE2ELatency                                   = NewTimerDef("activity_end_to_end_latency")
 	AckLevelUpdateCounter                                = NewCounterDef("ack_level_update")
 	AckLevelUpdateFailedCounter                          = NewCounterDef("ack_level_update_failed")
@@ -874,6 +910,10 @@ var (
 		"persisted_mutable_state_size",
 		WithDescription("Size of the persisted Workflow Execution's state in DB, emitted each time a workflow execution is updated."),
 	)
+	MutableStateSizeOverrideCounter = NewCounterDef(
+		"mutable_state_size_override",
+		WithDescription("The number of times a Workflow Execution's state exceeded the global size limit but stayed under its namespace override."),
+	)
 	ExecutionInfoSize                     = NewBytesHistogramDef("execution_info_size")
 	ExecutionStateSize                    = NewBytesHistogramDef("execution_state_size")
 	ActivityInfoSize                      = NewBytesHistogramDef("activity_info_size")
@@ -917,6 +957,10 @@ var (
 	ReplicationTasksSkipped               = NewCounterDef("replication_tasks_skipped")
 	ReplicationTasksApplied               = NewCounterDef("replication_tasks_applied")
 	ReplicationTasksFailed                = NewCounterDef("replication_tasks_failed")
+	ReplicationTaskApplyNearTimeout       = NewCounterDef(
+		"replication_task_apply_near_timeout",
+		WithDescription("The number of replication tasks which took more than half of the namespace's apply timeout to apply."),
+	)
 	// ReplicationTasksLag is a heuristic for how far behind the remote DC is for a given cluster. It measures the
 	// difference between task IDs so its unit should be "tasks".
 	ReplicationTasksLag = NewDimensionlessHistogramDef("replication_tasks_lag")
@@ -929,6 +973,7 @@ var (
 	ReplicationDLQAckLevelGauge                    = NewGaugeDef("replication_dlq_ack_level")
 	ReplicationNonEmptyDLQCount                    = NewCounterDef("replication_dlq_non_empty")
 	ReplicationOutlierNamespace                    = NewCounterDef("replication_outlier_namespace")
+	ReplicationResendBatches                       = NewCounterDef("replication_resend_batches")
 	EventReapplySkippedCount                       = NewCounterDef("event_reapply_skipped_count")
 	DirectQueryDispatchLatency                     = NewTimerDef("direct_query_dispatch_latency")
 	DirectQueryDispatchStickyLatency               = NewTimerDef("direct_query_dispatch_sticky_latency")
@@ -951,6 +996,9 @@ var (
 	MutableStateChecksumInvalidated                = NewCounterDef("mutable_state_checksum_invalidated")
 	ClosedWorkflowBufferEventCount                 = NewCounterDef("closed_workflow_buffer_event_counter")
 	OutOfOrderBufferedEventsCounter                = NewCounterDef("out_of_order_buffered_events")
+	BufferedEventsLimitForcedWorkflowTask          = NewCounterDef("buffered_events_limit_forced_workflow_task")
+	BufferedEventsLimitRejected                    = NewCounterDef("buffered_events_limit_rejected")
+	UpdateCountSuggestContinueAsNew                = NewCounterDef("update_count_suggest_continue_as_new")
 	ShardLingerSuccess                             = NewTimerDef("shard_linger_success")
 	ShardLingerTimeouts                            = NewCounterDef("shard_linger_timeouts")
 	DynamicRateLimiterMultiplier                   = NewGaugeDef("dynamic_rate_limit_multiplier")
@@ -1016,6 +1064,8 @@ var (
 
 	// Versioning and Reachability
 	ReachabilityExitPointCounter = NewCounterDef("reachability_exit_point_count")
+	ReachabilityCacheHitCounter  = NewCounterDef("reachability_cache_hit")
+	ReachabilityCacheMissCounter = NewCounterDef("reachability_cache_miss")
 
 	// Worker
 	ExecutorTasksDoneCount                          = NewCounterDef("executor_done")
@@ -1048,6 +1098,7 @@ var (
 	ScavengerValidationRequestsCount                = NewCounterDef("scavenger_validation_requests")
 	ScavengerValidationFailuresCount                = NewCounterDef("scavenger_validation_failures")
 	ScavengerValidationSkipsCount                   = NewCounterDef("scavenger_validation_skips")
+	BuildIdScavengerDryRunRemovableBuildIds         = NewCounterDef("build_id_scavenger_dry_run_removable_build_ids")
 	AddSearchAttributesFailuresCount                = NewCounterDef("add_search_attributes_failures")
 	DeleteNamespaceSuccessCount                     = NewCounterDef("delete_namespace_success")
 	RenameNamespaceSuccessCount                     = NewCounterDef("rename_namespace_success")
@@ -1154,6 +1205,10 @@ var (
 		WithDescription("Persistence latency, keyed by `operation`"),
 	)
 	PersistenceShardRPS                    = NewDimensionlessHistogramDef("persistence_shard_rps")
+	PersistenceHealthSignalDropped         = NewCounterDef("persistence_health_signal_dropped")
+	PersistenceTrimHistoryNodeAttempts     = NewCounterDef("persistence_trim_history_node_attempts")
+	PersistenceTrimHistoryNodeSuccesses    = NewCounterDef("persistence_trim_history_node_successes")
+	PersistenceTrimHistoryNodeFailures     = NewCounterDef("persistence_trim_history_node_failures")
 	PersistenceErrResourceExhaustedCounter = NewCounterDef("persistence_errors_resource_exhausted")
 	VisibilityPersistenceRequests          = NewCounterDef("visibility_persistence_requests")
 	VisibilityPersistenceErrorWithType     = NewCounterDef("visibility_persistence_error_with_type")
@@ -1164,6 +1219,14 @@ var (
 	CassandraSessionRefreshFailures        = NewCounterDef("cassandra_session_refresh_failures")
 	PersistenceSessionRefreshFailures      = NewCounterDef("persistence_session_refresh_failures")
 	PersistenceSessionRefreshAttempts      = NewCounterDef("persistence_session_refresh_attempts")
+	VisibilityShadowReadCompared           = NewCounterDef(
+		"visibility_shadow_read_compared",
+		WithDescription("The number of sampled visibility shadow reads whose result was compared against primary visibility."),
+	)
+	VisibilityShadowReadDiverged = NewCounterDef(
+		"visibility_shadow_read_diverged",
+		WithDescription("The number of sampled visibility shadow reads whose result differs from primary visibility."),
+	)
 
 	// Common service base metrics
 	RestartCount         = NewCounterDef("restarts")
@@ -47,11 +47,13 @@ const (
 
 	instance       = "instance"
 	namespace      = "namespace"
+	namespaceID    = "namespace_id"
 	namespaceState = "namespace_state"
 	sourceCluster  = "source_cluster"
 	targetCluster  = "target_cluster"
 	fromCluster    = "from_cluster"
 	toCluster      = "to_cluster"
+	shardID        = "shard_id"
 	taskQueue      = "taskqueue"
 	workflowType   = "workflowType"
 	activityType   = "activityType"
@@ -113,6 +115,18 @@ func NamespaceTag(value string) Tag {
 	}
 }
 
+// NamespaceIDTag returns a new namespace ID tag. It is meant for layers that only know the namespace ID,
+// such as persistence. If a blank namespace ID is provided then this converts that to an unknown namespace ID.
+func NamespaceIDTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return &tagImpl{
+		key:   namespaceID,
+		value: value,
+	}
+}
+
 var namespaceUnknownTag = &tagImpl{key: namespace, value: unknownValue}
 
 // NamespaceUnknownTag returns a new namespace:unknown tag-value
@@ -159,6 +173,18 @@ func TargetClusterTag(value string) Tag {
 	return &tagImpl{key: targetCluster, value: value}
 }
 
+// ShardIDTag returns a new shard ID tag. Make sure that the number of shards tagged is of limited cardinality.
+func ShardIDTag(value string) Tag {
+	return &tagImpl{key: shardID, value: value}
+}
+
+var shardIDExcludedTag = &tagImpl{key: shardID, value: tagExcludedValue}
+
+// ShardIDExcludedTag returns a shard ID tag for shards that are not tagged individually.
+func ShardIDExcludedTag() Tag {
+	return shardIDExcludedTag
+}
+
 // FromClusterIDTag returns a new from cluster tag.
 func FromClusterIDTag(value int32) Tag {
 	return &tagImpl{key: fromCluster, value: strconv.FormatInt(int64(value), 10)}
@@ -338,6 +364,13 @@ func CacheTypeTag(value string) Tag {
 	return &tagImpl{key: CacheTypeTagName, value: value}
 }
 
+func CacheLevelTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return &tagImpl{key: CacheLevelTagName, value: value}
+}
+
 func PriorityTag(value locks.Priority) Tag {
 	return &tagImpl{key: PriorityTagName, value: strconv.Itoa(int(value))}
 }
@@ -96,6 +96,38 @@ func (q *QueueStore) EnqueueMessage(
 	return err
 }
 
+func (q *QueueStore) EnqueueMessages(
+	ctx context.Context,
+	blobs []*commonpb.DataBlob,
+) error {
+	if len(blobs) == 0 {
+		return nil
+	}
+	lastMessageID, err := q.getLastMessageID(ctx, q.queueType)
+	if err != nil {
+		return err
+	}
+
+	// all messages share the queue type partition, so the conditional batch is applied atomically
+	batch := q.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	for i, blob := range blobs {
+		batch.Query(templateEnqueueMessageQuery, q.queueType, lastMessageID+int64(i)+1, blob.Data, blob.EncodingType.String())
+	}
+	previous := make(map[string]interface{})
+	applied, iter, err := q.session.MapExecuteBatchCAS(batch, previous)
+	if err != nil {
+		return gocql.ConvertError("EnqueueMessages", err)
+	}
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	if !applied {
+		return &persistence.ConditionFailedError{Msg: fmt.Sprintf("message ID %v exists in queue", previous["message_id"])}
+	}
+	return nil
+}
+
 func (q *QueueStore) EnqueueMessageToDLQ(
 	ctx context.Context,
 	blob *commonpb.DataBlob,
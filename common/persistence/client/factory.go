@@ -193,7 +193,19 @@ func (f *factoryImpl) NewExecutionManager() (persistence.ExecutionManager, error
 		return nil, err
 	}
 
-	result := persistence.NewExecutionManager(store, f.serializer, f.eventBlobCache, f.logger, f.config.TransactionSizeLimit)
+	result := persistence.NewExecutionManager(
+		store,
+		f.serializer,
+		f.eventBlobCache,
+		f.logger,
+		f.config.TransactionSizeLimit,
+		persistence.ExecutionManagerOptions{
+			ConflictResolveSerializationConcurrency: f.config.ConflictResolveSerializationConcurrency,
+			EventBatchSerializationConcurrency:      f.config.EventBatchSerializationConcurrency,
+			BufferedEventsSizeLimit:                 f.config.BufferedEventsSizeLimit,
+			MetricsHandler:                          f.metricsHandler,
+		},
+	)
 	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
 		result = persistence.NewExecutionPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.logger)
 	}
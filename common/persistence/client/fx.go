@@ -29,6 +29,7 @@ import (
 
 	"go.uber.org/fx"
 
+	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -104,6 +105,7 @@ func EventBlobCacheProvider(
 	return persistence.NewEventsBlobCache(
 		dynamicconfig.XDCCacheMaxSizeBytes.Get(dc)(),
 		20*time.Second,
+		cache.EvictionPolicy(dynamicconfig.XDCCacheEvictionPolicy.Get(dc)()),
 	)
 }
 
@@ -163,6 +163,10 @@ type (
 	// TransactionSizeLimitError is returned when the transaction size is too large
 	TransactionSizeLimitError struct {
 		Msg string
+		// Size is the serialized size of the rejected transaction in bytes.
+		Size int
+		// Limit is the transaction size limit in bytes at the time of the rejection.
+		Limit int
 	}
 
 	// TaskQueueKey is the struct used to identity TaskQueues
@@ -206,6 +210,27 @@ type (
 		WorkflowID  string
 
 		Tasks map[tasks.Category][]tasks.Task
+
+		// IdempotencyToken is optional. None of the stores persist or dedupe on it, so the history shard context
+		// checks it against the tokens it remembers in memory before writing. The check is not part of the write
+		// transaction and is best effort; see addTasksDeduper for its limits.
+		IdempotencyToken string
+	}
+
+	// AddHistoryTasksBatchRequest is used to write new tasks of multiple workflows in a single shard transaction
+	AddHistoryTasksBatchRequest struct {
+		ShardID int32
+		RangeID int64
+
+		WorkflowTasks []WorkflowHistoryTasks
+	}
+
+	// WorkflowHistoryTasks is the set of new tasks of a single workflow
+	WorkflowHistoryTasks struct {
+		NamespaceID string
+		WorkflowID  string
+
+		Tasks map[tasks.Category][]tasks.Task
 	}
 
 	// CreateWorkflowExecutionRequest is used to write a new workflow execution
@@ -302,6 +327,13 @@ type (
 		MutableStateStats MutableStateStatistics
 	}
 
+	// GetRawWorkflowExecutionResponse is the response to GetRawWorkflowExecution.
+	// State holds the blobs exactly as they are stored, the caller is responsible for deserializing them.
+	GetRawWorkflowExecutionResponse struct {
+		State           *InternalWorkflowMutableState
+		DBRecordVersion int64
+	}
+
 	// SetWorkflowExecutionRequest is used to overwrite the info of a workflow execution
 	SetWorkflowExecutionRequest struct {
 		ShardID int32
@@ -312,6 +344,7 @@ type (
 
 	// SetWorkflowExecutionResponse is the response to SetWorkflowExecutionRequest
 	SetWorkflowExecutionResponse struct {
+		SetMutableStateStats MutableStateStatistics
 	}
 
 	// ListConcreteExecutionsRequest is request to ListConcreteExecutions
@@ -319,6 +352,11 @@ type (
 		ShardID   int32
 		PageSize  int
 		PageToken []byte
+		// States and Statuses optionally restrict the response to executions in one of the given states and
+		// statuses. Empty means no restriction. Filtering is applied after the page is read, so a page may
+		// contain fewer than PageSize executions even when more pages remain.
+		States   []enumsspb.WorkflowExecutionState
+		Statuses []enumspb.WorkflowExecutionStatus
 	}
 
 	// ListConcreteExecutionsResponse is response to ListConcreteExecutions
@@ -416,6 +454,10 @@ type (
 		ExclusiveMaxTaskKey tasks.Key
 		BatchSize           int
 		NextPageToken       []byte
+		// TaskType, if specified, only returns tasks of the given type within the category.
+		// None of the stores persist the task type outside the task blob, so the filter is applied
+		// when deserializing tasks and a page may contain fewer than BatchSize tasks.
+		TaskType enumsspb.TaskType
 	}
 
 	// GetHistoryTasksResponse is the response for GetHistoryTasks
@@ -464,6 +506,29 @@ type (
 		SourceClusterName string
 	}
 
+	// MergeReplicationTasksFromDLQRequest is used to move replication tasks from dlq back to the replication queue
+	MergeReplicationTasksFromDLQRequest struct {
+		GetReplicationTasksFromDLQRequest
+
+		// RangeID is the shard range ID used when re-enqueueing tasks to the replication queue
+		RangeID int64
+		// GenerateTaskID returns the task ID a task is re-enqueued under. A dlq task keeps the ID it was first
+		// created with, which is below the replication queue ack level, so re-enqueueing it under that ID would
+		// leave it unread. Required.
+		GenerateTaskID func() (int64, error)
+		// TaskFilter is invoked for each task read from dlq before it is re-enqueued. The filter may
+		// modify the task, returning false skips the task and leaves it in the dlq. Optional.
+		TaskFilter func(task tasks.Task) bool
+	}
+
+	// MergeReplicationTasksFromDLQResponse is the response for MergeReplicationTasksFromDLQ
+	MergeReplicationTasksFromDLQResponse struct {
+		MergedTaskCount  int
+		SkippedTaskCount int
+		// LastMergedTaskID is the ID of the last task removed from dlq, or 0 if no task was merged
+		LastMergedTaskID int64
+	}
+
 	// DeleteReplicationTaskFromDLQRequest is used to delete replication task from DLQ
 	DeleteReplicationTaskFromDLQRequest struct {
 		CompleteHistoryTaskRequest
@@ -671,11 +736,16 @@ type (
 		Name string
 	}
 
-	// ListNamespacesRequest is used to list namespaces
+	// ListNamespacesRequest is used to list namespaces. When namespaces are filtered out, a page can hold fewer than
+	// PageSize namespaces even though more remain, callers should page until NextPageToken is empty.
 	ListNamespacesRequest struct {
 		PageSize       int
 		NextPageToken  []byte
 		IncludeDeleted bool
+		// DataKey, if set, only includes namespaces whose data contains the key.
+		DataKey string
+		// DataValue, if set together with DataKey, only includes namespaces whose data value for DataKey matches.
+		DataValue string
 	}
 
 	// ListNamespacesResponse is the response for GetNamespace
@@ -1080,6 +1150,8 @@ type (
 		DeleteCurrentWorkflowExecution(ctx context.Context, request *DeleteCurrentWorkflowExecutionRequest) error
 		GetCurrentExecution(ctx context.Context, request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error)
 		GetWorkflowExecution(ctx context.Context, request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error)
+		// GetRawWorkflowExecution returns the mutable state blobs as stored, without deserializing them.
+		GetRawWorkflowExecution(ctx context.Context, request *GetWorkflowExecutionRequest) (*GetRawWorkflowExecutionResponse, error)
 		SetWorkflowExecution(ctx context.Context, request *SetWorkflowExecutionRequest) (*SetWorkflowExecutionResponse, error)
 
 		// Scan operations
@@ -1089,6 +1161,7 @@ type (
 		// Tasks related APIs
 
 		AddHistoryTasks(ctx context.Context, request *AddHistoryTasksRequest) error
+		AddHistoryTasksBatch(ctx context.Context, request *AddHistoryTasksBatchRequest) error
 		GetHistoryTasks(ctx context.Context, request *GetHistoryTasksRequest) (*GetHistoryTasksResponse, error)
 		CompleteHistoryTask(ctx context.Context, request *CompleteHistoryTaskRequest) error
 		RangeCompleteHistoryTasks(ctx context.Context, request *RangeCompleteHistoryTasksRequest) error
@@ -1098,6 +1171,10 @@ type (
 		DeleteReplicationTaskFromDLQ(ctx context.Context, request *DeleteReplicationTaskFromDLQRequest) error
 		RangeDeleteReplicationTaskFromDLQ(ctx context.Context, request *RangeDeleteReplicationTaskFromDLQRequest) error
 		IsReplicationDLQEmpty(ctx context.Context, request *GetReplicationTasksFromDLQRequest) (bool, error)
+		// MergeReplicationTasksFromDLQ re-enqueues replication tasks within the given dlq range back to the
+		// replication queue. Each task is removed from dlq once re-enqueued, so retrying after a partial
+		// failure resumes after the last merged task.
+		MergeReplicationTasksFromDLQ(ctx context.Context, request *MergeReplicationTasksFromDLQRequest) (*MergeReplicationTasksFromDLQResponse, error)
 
 		// The below are history V2 APIs
 		// V2 regards history events growing as a tree, decoupled from workflow concepts
@@ -1243,6 +1320,8 @@ type (
 		// SourceShardID of the task in its original cluster. Note that tasks may move between clusters, so this shard
 		// id may not be the same as the shard id of the task in the current cluster.
 		SourceShardID int
+		// FailureReason is the error that caused the task to be moved to a DLQ, if any.
+		FailureReason string
 	}
 
 	EnqueueTaskResponse struct {
@@ -1258,6 +1337,8 @@ type (
 	HistoryTask struct {
 		MessageMetadata MessageMetadata
 		Task            tasks.Task
+		SourceShardID   int
+		FailureReason   string
 	}
 
 	ReadTasksResponse struct {
@@ -1337,6 +1418,14 @@ func (e *TimeoutError) Error() string {
 	return e.Msg
 }
 
+func NewTransactionSizeLimitError(size int, limit int) *TransactionSizeLimitError {
+	return &TransactionSizeLimitError{
+		Msg:   fmt.Sprintf("transaction size of %v bytes exceeds limit of %v bytes", size, limit),
+		Size:  size,
+		Limit: limit,
+	}
+}
+
 func (e *TransactionSizeLimitError) Error() string {
 	return e.Msg
 }
@@ -200,6 +200,20 @@ func (mr *MockExecutionManagerMockRecorder) AddHistoryTasks(ctx, request interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHistoryTasks", reflect.TypeOf((*MockExecutionManager)(nil).AddHistoryTasks), ctx, request)
 }
 
+// AddHistoryTasksBatch mocks base method.
+func (m *MockExecutionManager) AddHistoryTasksBatch(ctx context.Context, request *AddHistoryTasksBatchRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddHistoryTasksBatch", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddHistoryTasksBatch indicates an expected call of AddHistoryTasksBatch.
+func (mr *MockExecutionManagerMockRecorder) AddHistoryTasksBatch(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHistoryTasksBatch", reflect.TypeOf((*MockExecutionManager)(nil).AddHistoryTasksBatch), ctx, request)
+}
+
 // AppendHistoryNodes mocks base method.
 func (m *MockExecutionManager) AppendHistoryNodes(ctx context.Context, request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
 	m.ctrl.T.Helper()
@@ -445,6 +459,21 @@ func (mr *MockExecutionManagerMockRecorder) GetReplicationTasksFromDLQ(ctx, requ
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationTasksFromDLQ", reflect.TypeOf((*MockExecutionManager)(nil).GetReplicationTasksFromDLQ), ctx, request)
 }
 
+// GetRawWorkflowExecution mocks base method.
+func (m *MockExecutionManager) GetRawWorkflowExecution(ctx context.Context, request *GetWorkflowExecutionRequest) (*GetRawWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRawWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*GetRawWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRawWorkflowExecution indicates an expected call of GetRawWorkflowExecution.
+func (mr *MockExecutionManagerMockRecorder) GetRawWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRawWorkflowExecution", reflect.TypeOf((*MockExecutionManager)(nil).GetRawWorkflowExecution), ctx, request)
+}
+
 // GetWorkflowExecution mocks base method.
 func (m *MockExecutionManager) GetWorkflowExecution(ctx context.Context, request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -490,6 +519,21 @@ func (mr *MockExecutionManagerMockRecorder) ListConcreteExecutions(ctx, request
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConcreteExecutions", reflect.TypeOf((*MockExecutionManager)(nil).ListConcreteExecutions), ctx, request)
 }
 
+// MergeReplicationTasksFromDLQ mocks base method.
+func (m *MockExecutionManager) MergeReplicationTasksFromDLQ(ctx context.Context, request *MergeReplicationTasksFromDLQRequest) (*MergeReplicationTasksFromDLQResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeReplicationTasksFromDLQ", ctx, request)
+	ret0, _ := ret[0].(*MergeReplicationTasksFromDLQResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeReplicationTasksFromDLQ indicates an expected call of MergeReplicationTasksFromDLQ.
+func (mr *MockExecutionManagerMockRecorder) MergeReplicationTasksFromDLQ(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeReplicationTasksFromDLQ", reflect.TypeOf((*MockExecutionManager)(nil).MergeReplicationTasksFromDLQ), ctx, request)
+}
+
 // PutReplicationTaskToDLQ mocks base method.
 func (m *MockExecutionManager) PutReplicationTaskToDLQ(ctx context.Context, request *PutReplicationTaskToDLQRequest) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,114 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DLQErrorGlobPatternPrefix marks DLQ error patterns that are globs instead of regular expressions.
+const DLQErrorGlobPatternPrefix = "glob:"
+
+// MatchDLQErrorPattern matches the error message against the pattern, which is a regular expression unless it
+// starts with DLQErrorGlobPatternPrefix. Glob patterns must match the whole message and support '*' (any sequence of
+// characters, including '/'), '?' (any single character), character classes such as [a-z] or [^0-9], and '\' to
+// escape the next character.
+// This is used both to decide which history tasks are sent to the DLQ and to filter the tasks in it by the failure
+// reason they were stored with.
+func MatchDLQErrorPattern(pattern string, errMessage string) (bool, error) {
+	if glob, ok := strings.CutPrefix(pattern, DLQErrorGlobPatternPrefix); ok {
+		expr, err := globToRegexp(glob)
+		if err != nil {
+			return false, err
+		}
+		pattern = expr
+	}
+	return regexp.MatchString(pattern, errMessage)
+}
+
+// globToRegexp converts a glob to an anchored regular expression. Unlike path.Match, '*' also matches '/', since
+// error messages are not paths.
+func globToRegexp(glob string) (string, error) {
+	var sb strings.Builder
+	sb.WriteString(`^(?s:`)
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; c {
+		case '*':
+			sb.WriteString(`.*`)
+		case '?':
+			sb.WriteString(`.`)
+		case '\\':
+			i++
+			if i == len(glob) {
+				return "", fmt.Errorf("invalid glob %q: trailing escape", glob)
+			}
+			sb.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		case '[':
+			end, class, err := globClassToRegexp(glob, i+1)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(class)
+			i = end
+		default:
+			sb.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+	sb.WriteString(`)$`)
+	return sb.String(), nil
+}
+
+// globClassToRegexp converts the character class starting at glob[start], right after '[', and returns the index of
+// its closing ']' together with the equivalent regular expression class.
+func globClassToRegexp(glob string, start int) (int, string, error) {
+	var sb strings.Builder
+	sb.WriteString(`[`)
+	i := start
+	if i < len(glob) && glob[i] == '^' {
+		sb.WriteString(`^`)
+		i++
+	}
+	for first := true; i < len(glob); i, first = i+1, false {
+		c := glob[i]
+		switch {
+		case c == ']' && !first:
+			sb.WriteString(`]`)
+			return i, sb.String(), nil
+		case c == '\\':
+			i++
+			if i == len(glob) {
+				return 0, "", fmt.Errorf("invalid glob %q: trailing escape", glob)
+			}
+			sb.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		case c == '-':
+			sb.WriteByte(c)
+		default:
+			sb.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+	return 0, "", fmt.Errorf("invalid glob %q: unterminated character class", glob)
+}
@@ -0,0 +1,110 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchDLQErrorPattern(t *testing.T) {
+	testCases := []struct {
+		name       string
+		pattern    string
+		errMessage string
+		match      bool
+	}{
+		{
+			name:       "regexp matches substring",
+			pattern:    "test [a-z]ubstring",
+			errMessage: "some test substring error",
+			match:      true,
+		},
+		{
+			name:       "glob must match whole message",
+			pattern:    "glob:test substring",
+			errMessage: "some test substring error",
+			match:      false,
+		},
+		{
+			name:       "glob star and class",
+			pattern:    "glob:*test [a-z]ubstring",
+			errMessage: "some test substring",
+			match:      true,
+		},
+		{
+			name:       "glob star matches slash",
+			pattern:    "glob:*deadline exceeded*",
+			errMessage: "rpc error: code = DeadlineExceeded desc = /temporal.server.api.historyservice.v1/RecordActivityTaskStarted: deadline exceeded",
+			match:      true,
+		},
+		{
+			name:       "glob question mark matches slash",
+			pattern:    "glob:a?b",
+			errMessage: "a/b",
+			match:      true,
+		},
+		{
+			name:       "glob negated class",
+			pattern:    "glob:code [^0-9]",
+			errMessage: "code 7",
+			match:      false,
+		},
+		{
+			name:       "glob regexp metacharacters are literal",
+			pattern:    "glob:failed (1.0)+",
+			errMessage: "failed (1.0)+",
+			match:      true,
+		},
+		{
+			name:       "glob escaped star is literal",
+			pattern:    `glob:a\*`,
+			errMessage: "abc",
+			match:      false,
+		},
+		{
+			name:       "glob matches multiline message",
+			pattern:    "glob:first*last",
+			errMessage: "first\nlast",
+			match:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			match, err := MatchDLQErrorPattern(tc.pattern, tc.errMessage)
+			require.NoError(t, err)
+			require.Equal(t, tc.match, match)
+		})
+	}
+}
+
+func TestMatchDLQErrorPattern_InvalidGlob(t *testing.T) {
+	for _, pattern := range []string{"glob:[a-z", `glob:abc\`} {
+		_, err := MatchDLQErrorPattern(pattern, "")
+		require.Error(t, err, pattern)
+	}
+}
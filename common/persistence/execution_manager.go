@@ -27,13 +27,18 @@ package persistence
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sync"
+	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.uber.org/multierr"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
@@ -41,6 +46,7 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/service/history/tasks"
@@ -55,6 +61,25 @@ type (
 		logger                log.Logger
 		pagingTokenSerializer *jsonHistoryTokenSerializer
 		transactionSizeLimit  dynamicconfig.IntPropertyFn
+		// Optional, workflows are serialized sequentially if not set.
+		conflictResolveSerializationConcurrency dynamicconfig.IntPropertyFn
+		// Optional, event batches are serialized sequentially if not set.
+		eventBatchSerializationConcurrency dynamicconfig.IntPropertyFn
+		// Optional, the serialized size of new buffered events is unchecked if not set.
+		bufferedEventsSizeLimit dynamicconfig.IntPropertyFn
+		metricsHandler          metrics.Handler
+	}
+
+	// ExecutionManagerOptions are the optional parameters of NewExecutionManager.
+	ExecutionManagerOptions struct {
+		// Workflows of a ConflictResolveWorkflowExecution are serialized sequentially if not set.
+		ConflictResolveSerializationConcurrency dynamicconfig.IntPropertyFn
+		// Event batches of a transaction are serialized sequentially if not set.
+		EventBatchSerializationConcurrency dynamicconfig.IntPropertyFn
+		// The serialized size of the new buffered events of a mutation is unchecked if not set.
+		BufferedEventsSizeLimit dynamicconfig.IntPropertyFn
+		// Metrics are not emitted if not set.
+		MetricsHandler metrics.Handler
 	}
 )
 
@@ -67,7 +92,12 @@ func NewExecutionManager(
 	eventBlobCache XDCCache,
 	logger log.Logger,
 	transactionSizeLimit dynamicconfig.IntPropertyFn,
+	options ExecutionManagerOptions,
 ) ExecutionManager {
+	metricsHandler := options.MetricsHandler
+	if metricsHandler == nil {
+		metricsHandler = metrics.NoopMetricsHandler
+	}
 	return &executionManagerImpl{
 		serializer:            serializer,
 		eventBlobCache:        eventBlobCache,
@@ -75,6 +105,11 @@ func NewExecutionManager(
 		logger:                logger,
 		pagingTokenSerializer: newJSONHistoryTokenSerializer(),
 		transactionSizeLimit:  transactionSizeLimit,
+
+		conflictResolveSerializationConcurrency: options.ConflictResolveSerializationConcurrency,
+		eventBatchSerializationConcurrency:      options.EventBatchSerializationConcurrency,
+		bufferedEventsSizeLimit:                 options.BufferedEventsSizeLimit,
+		metricsHandler:                          metricsHandler,
 	}
 }
 
@@ -259,76 +294,85 @@ func (m *executionManagerImpl) ConflictResolveWorkflowExecution(
 	newSnapshot := request.NewWorkflowSnapshot
 	currentMutation := request.CurrentWorkflowMutation
 
-	resetWorkflowXDCKVs, resetWorkflowEvents, resetWorkflowHistoryDiff, err := m.serializeWorkflowEventBatches(
-		ctx,
-		request.ShardID,
-		request.ResetWorkflowSnapshot.ExecutionInfo,
-		request.ResetWorkflowEvents,
-	)
-	if err != nil {
+	if err := ValidateConflictResolveWorkflowModeState(
+		request.Mode,
+		resetSnapshot,
+		newSnapshot,
+		currentMutation,
+	); err != nil {
 		return nil, err
 	}
-	resetSnapshot.ExecutionInfo.ExecutionStats.HistorySize += int64(resetWorkflowHistoryDiff.SizeDiff)
+
+	// The reset, new and current workflows are independent of each other and can be serialized concurrently.
+	var resetWorkflowXDCKVs map[XDCCacheKey]XDCCacheValue
+	var resetWorkflowEvents []*InternalAppendHistoryNodesRequest
+	var resetWorkflowHistoryDiff *HistoryStatistics
+	var serializedResetWorkflowSnapshot *InternalWorkflowSnapshot
+	serializeFns := []func() error{
+		func() error {
+			var err error
+			resetWorkflowXDCKVs, resetWorkflowEvents, resetWorkflowHistoryDiff, err = m.serializeWorkflowEventBatches(
+				ctx,
+				request.ShardID,
+				resetSnapshot.ExecutionInfo,
+				request.ResetWorkflowEvents,
+			)
+			if err != nil {
+				return err
+			}
+			resetSnapshot.ExecutionInfo.ExecutionStats.HistorySize += int64(resetWorkflowHistoryDiff.SizeDiff)
+			serializedResetWorkflowSnapshot, err = m.SerializeWorkflowSnapshot(&resetSnapshot)
+			return err
+		},
+	}
 
 	var newWorkflowXDCKVs map[XDCCacheKey]XDCCacheValue
 	var newWorkflowEvents []*InternalAppendHistoryNodesRequest
 	var newWorkflowHistoryDiff *HistoryStatistics
+	var serializedNewWorkflowMutation *InternalWorkflowSnapshot
 	if newSnapshot != nil {
-		newWorkflowXDCKVs, newWorkflowEvents, newWorkflowHistoryDiff, err = m.serializeWorkflowEventBatches(
-			ctx,
-			request.ShardID,
-			request.NewWorkflowSnapshot.ExecutionInfo,
-			request.NewWorkflowEvents,
-		)
-		if err != nil {
-			return nil, err
-		}
-		newSnapshot.ExecutionInfo.ExecutionStats.HistorySize += int64(newWorkflowHistoryDiff.SizeDiff)
+		serializeFns = append(serializeFns, func() error {
+			var err error
+			newWorkflowXDCKVs, newWorkflowEvents, newWorkflowHistoryDiff, err = m.serializeWorkflowEventBatches(
+				ctx,
+				request.ShardID,
+				newSnapshot.ExecutionInfo,
+				request.NewWorkflowEvents,
+			)
+			if err != nil {
+				return err
+			}
+			newSnapshot.ExecutionInfo.ExecutionStats.HistorySize += int64(newWorkflowHistoryDiff.SizeDiff)
+			serializedNewWorkflowMutation, err = m.SerializeWorkflowSnapshot(newSnapshot)
+			return err
+		})
 	}
 
 	var currentWorkflowXDCKVs map[XDCCacheKey]XDCCacheValue
 	var currentWorkflowEvents []*InternalAppendHistoryNodesRequest
 	var currentWorkflowHistoryDiff *HistoryStatistics
+	var serializedCurrentWorkflowMutation *InternalWorkflowMutation
 	if currentMutation != nil {
-		currentWorkflowXDCKVs, currentWorkflowEvents, currentWorkflowHistoryDiff, err = m.serializeWorkflowEventBatches(
-			ctx,
-			request.ShardID,
-			request.CurrentWorkflowMutation.ExecutionInfo,
-			request.CurrentWorkflowEvents,
-		)
-		if err != nil {
-			return nil, err
-		}
-		currentMutation.ExecutionInfo.ExecutionStats.HistorySize += int64(currentWorkflowHistoryDiff.SizeDiff)
-	}
-
-	if err := ValidateConflictResolveWorkflowModeState(
-		request.Mode,
-		resetSnapshot,
-		newSnapshot,
-		currentMutation,
-	); err != nil {
-		return nil, err
+		serializeFns = append(serializeFns, func() error {
+			var err error
+			currentWorkflowXDCKVs, currentWorkflowEvents, currentWorkflowHistoryDiff, err = m.serializeWorkflowEventBatches(
+				ctx,
+				request.ShardID,
+				currentMutation.ExecutionInfo,
+				request.CurrentWorkflowEvents,
+			)
+			if err != nil {
+				return err
+			}
+			currentMutation.ExecutionInfo.ExecutionStats.HistorySize += int64(currentWorkflowHistoryDiff.SizeDiff)
+			serializedCurrentWorkflowMutation, err = m.SerializeWorkflowMutation(currentMutation)
+			return err
+		})
 	}
 
-	serializedResetWorkflowSnapshot, err := m.SerializeWorkflowSnapshot(&resetSnapshot)
-	if err != nil {
+	if err := runWithConcurrency(m.logger, m.conflictResolveConcurrency(), serializeFns...); err != nil {
 		return nil, err
 	}
-	var serializedCurrentWorkflowMutation *InternalWorkflowMutation
-	if currentMutation != nil {
-		serializedCurrentWorkflowMutation, err = m.SerializeWorkflowMutation(currentMutation)
-		if err != nil {
-			return nil, err
-		}
-	}
-	var serializedNewWorkflowMutation *InternalWorkflowSnapshot
-	if newSnapshot != nil {
-		serializedNewWorkflowMutation, err = m.SerializeWorkflowSnapshot(newSnapshot)
-		if err != nil {
-			return nil, err
-		}
-	}
 
 	newRequest := &InternalConflictResolveWorkflowExecutionRequest{
 		ShardID: request.ShardID,
@@ -346,7 +390,7 @@ func (m *executionManagerImpl) ConflictResolveWorkflowExecution(
 		CurrentWorkflowEventsNewEvents: currentWorkflowEvents,
 	}
 
-	err = m.persistence.ConflictResolveWorkflowExecution(ctx, newRequest)
+	err := m.persistence.ConflictResolveWorkflowExecution(ctx, newRequest)
 	switch err.(type) {
 	case nil:
 		m.addXDCCacheKV(resetWorkflowXDCKVs)
@@ -400,7 +444,7 @@ func (m *executionManagerImpl) GetWorkflowExecution(
 		// try to utilize resp as much as possible, for RebuildMutableState API
 		return nil, respErr
 	}
-	state, err := m.toWorkflowMutableState(response.State)
+	state, err := m.toWorkflowMutableState(response.State, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -418,10 +462,33 @@ func (m *executionManagerImpl) GetWorkflowExecution(
 	return newResponse, respErr
 }
 
+// GetRawWorkflowExecution returns the mutable state blobs without deserializing them, which lets tooling
+// read state written by a different server version.
+func (m *executionManagerImpl) GetRawWorkflowExecution(
+	ctx context.Context,
+	request *GetWorkflowExecutionRequest,
+) (*GetRawWorkflowExecutionResponse, error) {
+	response, err := m.persistence.GetWorkflowExecution(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &GetRawWorkflowExecutionResponse{
+		State:           response.State,
+		DBRecordVersion: response.DBRecordVersion,
+	}, nil
+}
+
 func (m *executionManagerImpl) SetWorkflowExecution(
 	ctx context.Context,
 	request *SetWorkflowExecutionRequest,
 ) (*SetWorkflowExecutionResponse, error) {
+	if err := ValidateUpdateWorkflowStateStatus(
+		request.SetWorkflowSnapshot.ExecutionState.State,
+		request.SetWorkflowSnapshot.ExecutionState.Status,
+	); err != nil {
+		return nil, err
+	}
+
 	serializedWorkflowSnapshot, err := m.SerializeWorkflowSnapshot(&request.SetWorkflowSnapshot)
 	if err != nil {
 		return nil, err
@@ -438,7 +505,13 @@ func (m *executionManagerImpl) SetWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
-	return &SetWorkflowExecutionResponse{}, nil
+	return &SetWorkflowExecutionResponse{
+		// no events are appended when setting a workflow execution
+		SetMutableStateStats: *statusOfInternalWorkflowSnapshot(
+			serializedWorkflowSnapshot,
+			&HistoryStatistics{},
+		),
+	}, nil
 }
 
 func (m *executionManagerImpl) serializeWorkflowEventBatches(
@@ -452,13 +525,28 @@ func (m *executionManagerImpl) serializeWorkflowEventBatches(
 		return nil, nil, &historyStatistics, nil
 	}
 
-	xdcKVs := make(map[XDCCacheKey]XDCCacheValue, len(eventBatches))
-	workflowNewEvents := make([]*InternalAppendHistoryNodesRequest, 0, len(eventBatches))
-	for _, workflowEvents := range eventBatches {
-		newEvents, err := m.serializeWorkflowEvents(shardID, workflowEvents)
-		if err != nil {
-			return nil, nil, nil, err
+	// Batches are independent, so they are serialized concurrently into their own slot, while the
+	// XDC cache KVs and statistics below are accumulated sequentially in batch order.
+	workflowNewEvents := make([]*InternalAppendHistoryNodesRequest, len(eventBatches))
+	serializeFns := make([]func() error, len(eventBatches))
+	for i, workflowEvents := range eventBatches {
+		serializeFns[i] = func() error {
+			newEvents, err := m.serializeWorkflowEvents(shardID, workflowEvents)
+			if err != nil {
+				return err
+			}
+			newEvents.ShardID = shardID
+			workflowNewEvents[i] = newEvents
+			return nil
 		}
+	}
+	if err := runWithConcurrency(m.logger, m.eventBatchConcurrency(), serializeFns...); err != nil {
+		return nil, nil, nil, err
+	}
+
+	xdcKVs := make(map[XDCCacheKey]XDCCacheValue, len(eventBatches))
+	for i, workflowEvents := range eventBatches {
+		newEvents := workflowNewEvents[i]
 		versionHistoryItems, _, baseWorkflowInfo, err := GetXDCCacheValue(
 			executionInfo,
 			workflowEvents.Events[0].EventId,
@@ -477,8 +565,6 @@ func (m *executionManagerImpl) serializeWorkflowEventBatches(
 			versionHistoryItems,
 			[]*commonpb.DataBlob{newEvents.Node.Events},
 		)
-		newEvents.ShardID = shardID
-		workflowNewEvents = append(workflowNewEvents, newEvents)
 		historyStatistics.SizeDiff += len(newEvents.Node.Events.Data)
 		historyStatistics.CountDiff += len(workflowEvents.Events)
 	}
@@ -640,6 +726,9 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 		if err != nil {
 			return nil, err
 		}
+		if err := m.checkBufferedEventsSize(result.NewBufferedEvents); err != nil {
+			return nil, err
+		}
 	}
 
 	result.LastWriteVersion, err = getCurrentBranchLastWriteVersion(input.ExecutionInfo.VersionHistories)
@@ -650,10 +739,42 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 	if err != nil {
 		return nil, err
 	}
+	if err := m.checkTransactionSize(statusOfInternalWorkflowMutation(result, nil).TotalSize); err != nil {
+		return nil, err
+	}
 
 	return result, nil
 }
 
+// checkTransactionSize enforces the transaction size limit on the serialized mutable state of a snapshot or mutation.
+func (m *executionManagerImpl) checkTransactionSize(size int) error {
+	if m.transactionSizeLimit == nil {
+		return nil
+	}
+	if sizeLimit := m.transactionSizeLimit(); size > sizeLimit {
+		return NewTransactionSizeLimitError(size, sizeLimit)
+	}
+	return nil
+}
+
+// checkBufferedEventsSize enforces the buffered events size limit on the serialized blob. The history service
+// enforces the same limit on its estimate of the persisted size, so this only fails if the estimate falls short.
+func (m *executionManagerImpl) checkBufferedEventsSize(blob *commonpb.DataBlob) error {
+	if m.bufferedEventsSizeLimit == nil {
+		return nil
+	}
+	size := len(blob.GetData())
+	sizeLimit := m.bufferedEventsSizeLimit()
+	if size > sizeLimit {
+		return &TransactionSizeLimitError{
+			Msg:   fmt.Sprintf("buffered events size of %v bytes exceeds limit of %v bytes", size, sizeLimit),
+			Size:  size,
+			Limit: sizeLimit,
+		}
+	}
+	return nil
+}
+
 func (m *executionManagerImpl) SerializeWorkflowSnapshot( // unexport
 	input *WorkflowSnapshot,
 ) (*InternalWorkflowSnapshot, error) {
@@ -741,6 +862,9 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot( // unexport
 	if err != nil {
 		return nil, err
 	}
+	if err := m.checkTransactionSize(statusOfInternalWorkflowSnapshot(result, nil).TotalSize); err != nil {
+		return nil, err
+	}
 
 	return result, nil
 }
@@ -786,47 +910,100 @@ func (m *executionManagerImpl) ListConcreteExecutions(
 		return nil, err
 	}
 	newResponse := &ListConcreteExecutionsResponse{
-		States:    make([]*persistencespb.WorkflowMutableState, len(response.States)),
+		States:    make([]*persistencespb.WorkflowMutableState, 0, len(response.States)),
 		PageToken: response.NextPageToken,
 	}
-	for i, s := range response.States {
-		state, err := m.toWorkflowMutableState(s)
+	for _, s := range response.States {
+		var executionState *persistencespb.WorkflowExecutionState
+		if len(request.States) > 0 || len(request.Statuses) > 0 {
+			// only the execution state is deserialized for executions that are filtered out
+			executionState, err = m.serializer.WorkflowExecutionStateFromBlob(s.ExecutionState)
+			if err != nil {
+				return nil, err
+			}
+			if !matchesListConcreteExecutionsFilter(request, executionState) {
+				continue
+			}
+		}
+		state, err := m.toWorkflowMutableState(s, executionState)
 		if err != nil {
 			return nil, err
 		}
-		newResponse.States[i] = state
+		newResponse.States = append(newResponse.States, state)
 	}
 	return newResponse, nil
 }
 
+func matchesListConcreteExecutionsFilter(
+	request *ListConcreteExecutionsRequest,
+	executionState *persistencespb.WorkflowExecutionState,
+) bool {
+	if len(request.States) > 0 && !slices.Contains(request.States, executionState.GetState()) {
+		return false
+	}
+	if len(request.Statuses) > 0 && !slices.Contains(request.Statuses, executionState.GetStatus()) {
+		return false
+	}
+	return true
+}
+
 func (m *executionManagerImpl) AddHistoryTasks(
 	ctx context.Context,
 	input *AddHistoryTasksRequest,
 ) error {
-	tasks, err := serializeTasks(m.serializer, input.Tasks)
-	if err != nil {
-		return err
+	return m.AddHistoryTasksBatch(ctx, &AddHistoryTasksBatchRequest{
+		ShardID: input.ShardID,
+		RangeID: input.RangeID,
+
+		WorkflowTasks: []WorkflowHistoryTasks{{
+			NamespaceID: input.NamespaceID,
+			WorkflowID:  input.WorkflowID,
+			Tasks:       input.Tasks,
+		}},
+	})
+}
+
+func (m *executionManagerImpl) AddHistoryTasksBatch(
+	ctx context.Context,
+	input *AddHistoryTasksBatchRequest,
+) error {
+	// all tasks are serialized upfront so that a single failure aborts the whole batch
+	// and they can be written within one shard transaction
+	internalTasks := make(map[tasks.Category][]InternalHistoryTask)
+	for _, workflowTasks := range input.WorkflowTasks {
+		serializedTasks, err := serializeTasks(m.serializer, workflowTasks.Tasks)
+		if err != nil {
+			return err
+		}
+		for category, categoryTasks := range serializedTasks {
+			internalTasks[category] = append(internalTasks[category], categoryTasks...)
+		}
 	}
 
-	return m.persistence.AddHistoryTasks(ctx, &InternalAddHistoryTasksRequest{
+	request := &InternalAddHistoryTasksRequest{
 		ShardID: input.ShardID,
 		RangeID: input.RangeID,
 
-		NamespaceID: input.NamespaceID,
-		WorkflowID:  input.WorkflowID,
-
-		Tasks: tasks,
-	})
+		Tasks: internalTasks,
+	}
+	if len(input.WorkflowTasks) == 1 {
+		request.NamespaceID = input.WorkflowTasks[0].NamespaceID
+		request.WorkflowID = input.WorkflowTasks[0].WorkflowID
+	}
+	return m.persistence.AddHistoryTasks(ctx, request)
 }
 
 func (m *executionManagerImpl) GetHistoryTasks(
 	ctx context.Context,
 	request *GetHistoryTasksRequest,
 ) (*GetHistoryTasksResponse, error) {
-	if err := validateTaskRange(
+	// Queue readers and range completion legitimately span wide ranges, so the width is not capped here.
+	if err := ValidateTaskRange(
 		request.TaskCategory.Type(),
 		request.InclusiveMinTaskKey,
 		request.ExclusiveMaxTaskKey,
+		0,
+		0,
 	); err != nil {
 		return nil, err
 	}
@@ -838,6 +1015,15 @@ func (m *executionManagerImpl) GetHistoryTasks(
 
 	historyTasks := make([]tasks.Task, 0, len(resp.Tasks))
 	for _, internalTask := range resp.Tasks {
+		if request.TaskType != enumsspb.TASK_TYPE_UNSPECIFIED {
+			taskType, err := m.serializer.DeserializeTaskType(request.TaskCategory, internalTask.Blob)
+			if err != nil {
+				return nil, err
+			}
+			if taskType != request.TaskType {
+				continue
+			}
+		}
 		task, err := m.serializer.DeserializeTask(request.TaskCategory, internalTask.Blob)
 		if err != nil {
 			return nil, err
@@ -868,10 +1054,13 @@ func (m *executionManagerImpl) RangeCompleteHistoryTasks(
 	ctx context.Context,
 	request *RangeCompleteHistoryTasksRequest,
 ) error {
-	if err := validateTaskRange(
+	// Queue readers and range completion legitimately span wide ranges, so the width is not capped here.
+	if err := ValidateTaskRange(
 		request.TaskCategory.Type(),
 		request.InclusiveMinTaskKey,
 		request.ExclusiveMaxTaskKey,
+		0,
+		0,
 	); err != nil {
 		return err
 	}
@@ -899,6 +1088,15 @@ func (m *executionManagerImpl) GetReplicationTasksFromDLQ(
 	dlqTasks := make([]tasks.Task, 0, len(resp.Tasks))
 	for i := range resp.Tasks {
 		internalTask := resp.Tasks[i]
+		if request.TaskType != enumsspb.TASK_TYPE_UNSPECIFIED {
+			taskType, err := m.serializer.DeserializeTaskType(category, internalTask.Blob)
+			if err != nil {
+				return nil, err
+			}
+			if taskType != request.TaskType {
+				continue
+			}
+		}
 		task, err := m.serializer.DeserializeTask(category, internalTask.Blob)
 		if err != nil {
 			return nil, err
@@ -939,6 +1137,68 @@ func (m *executionManagerImpl) IsReplicationDLQEmpty(
 	return m.persistence.IsReplicationDLQEmpty(ctx, request)
 }
 
+func (m *executionManagerImpl) MergeReplicationTasksFromDLQ(
+	ctx context.Context,
+	request *MergeReplicationTasksFromDLQRequest,
+) (*MergeReplicationTasksFromDLQResponse, error) {
+	if request.GenerateTaskID == nil {
+		return nil, serviceerror.NewInternal("MergeReplicationTasksFromDLQ requires GenerateTaskID")
+	}
+
+	getRequest := request.GetReplicationTasksFromDLQRequest
+	response := &MergeReplicationTasksFromDLQResponse{}
+	for {
+		resp, err := m.GetReplicationTasksFromDLQ(ctx, &getRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, task := range resp.Tasks {
+			taskKey := task.GetKey()
+			if request.TaskFilter != nil && !request.TaskFilter(task) {
+				response.SkippedTaskCount++
+				continue
+			}
+
+			taskID, err := request.GenerateTaskID()
+			if err != nil {
+				return nil, err
+			}
+			task.SetTaskID(taskID)
+			if err := m.AddHistoryTasks(ctx, &AddHistoryTasksRequest{
+				ShardID:     request.ShardID,
+				RangeID:     request.RangeID,
+				NamespaceID: task.GetNamespaceID(),
+				WorkflowID:  task.GetWorkflowID(),
+				Tasks: map[tasks.Category][]tasks.Task{
+					tasks.CategoryReplication: {task},
+				},
+			}); err != nil {
+				return nil, err
+			}
+
+			// the dlq entry is keyed by its original task key, which the filter may have changed on the task
+			if err := m.persistence.DeleteReplicationTaskFromDLQ(ctx, &DeleteReplicationTaskFromDLQRequest{
+				CompleteHistoryTaskRequest: CompleteHistoryTaskRequest{
+					ShardID:      request.ShardID,
+					TaskCategory: tasks.CategoryReplication,
+					TaskKey:      taskKey,
+				},
+				SourceClusterName: request.SourceClusterName,
+			}); err != nil {
+				return nil, err
+			}
+			response.MergedTaskCount++
+			response.LastMergedTaskID = taskKey.TaskID
+		}
+
+		if len(resp.NextPageToken) == 0 {
+			return response, nil
+		}
+		getRequest.NextPageToken = resp.NextPageToken
+	}
+}
+
 func (m *executionManagerImpl) Close() {
 	m.persistence.Close()
 }
@@ -950,6 +1210,9 @@ func (m *executionManagerImpl) trimHistoryNode(
 	workflowID string,
 	runID string,
 ) {
+	metricsHandler := m.metricsHandler.WithTags(metrics.NamespaceIDTag(namespaceID))
+	metrics.PersistenceTrimHistoryNodeAttempts.With(metricsHandler).Record(1)
+
 	response, err := m.GetWorkflowExecution(ctx, &GetWorkflowExecutionRequest{
 		ShardID:     shardID,
 		NamespaceID: namespaceID,
@@ -963,12 +1226,14 @@ func (m *executionManagerImpl) trimHistoryNode(
 			tag.WorkflowRunID(runID),
 			tag.Error(err),
 		)
+		metrics.PersistenceTrimHistoryNodeFailures.With(metricsHandler).Record(1)
 		return // best effort trim
 	}
 
 	executionInfo := response.State.ExecutionInfo
 	branchToken, err := getCurrentBranchToken(executionInfo.VersionHistories)
 	if err != nil {
+		metrics.PersistenceTrimHistoryNodeFailures.With(metricsHandler).Record(1)
 		return
 	}
 	mutableStateLastNodeID := executionInfo.LastFirstEventId
@@ -986,11 +1251,18 @@ func (m *executionManagerImpl) trimHistoryNode(
 			tag.WorkflowRunID(runID),
 			tag.Error(err),
 		)
+		metrics.PersistenceTrimHistoryNodeFailures.With(metricsHandler).Record(1)
 		return
 	}
+	metrics.PersistenceTrimHistoryNodeSuccesses.With(metricsHandler).Record(1)
 }
 
-func (m *executionManagerImpl) toWorkflowMutableState(internState *InternalWorkflowMutableState) (*persistencespb.WorkflowMutableState, error) {
+// toWorkflowMutableState deserializes the mutable state. executionState is the already deserialized execution state,
+// if the caller has it.
+func (m *executionManagerImpl) toWorkflowMutableState(
+	internState *InternalWorkflowMutableState,
+	executionState *persistencespb.WorkflowExecutionState,
+) (*persistencespb.WorkflowMutableState, error) {
 	state := &persistencespb.WorkflowMutableState{
 		ActivityInfos:       make(map[int64]*persistencespb.ActivityInfo),
 		TimerInfos:          make(map[string]*persistencespb.TimerInfo),
@@ -1045,9 +1317,12 @@ func (m *executionManagerImpl) toWorkflowMutableState(internState *InternalWorkf
 		// TODO: check if we need this?
 		state.ExecutionInfo.AutoResetPoints = &workflowpb.ResetPoints{}
 	}
-	state.ExecutionState, err = m.serializer.WorkflowExecutionStateFromBlob(internState.ExecutionState)
-	if err != nil {
-		return nil, err
+	state.ExecutionState = executionState
+	if state.ExecutionState == nil {
+		state.ExecutionState, err = m.serializer.WorkflowExecutionStateFromBlob(internState.ExecutionState)
+		if err != nil {
+			return nil, err
+		}
 	}
 	state.BufferedEvents, err = m.DeserializeBufferedEvents(internState.BufferedEvents)
 	if err != nil {
@@ -1117,10 +1392,62 @@ func serializeTasks(
 	return outputTasks, nil
 }
 
-func validateTaskRange(
+func (m *executionManagerImpl) conflictResolveConcurrency() int {
+	if m.conflictResolveSerializationConcurrency == nil {
+		return 1
+	}
+	return m.conflictResolveSerializationConcurrency()
+}
+
+func (m *executionManagerImpl) eventBatchConcurrency() int {
+	if m.eventBatchSerializationConcurrency == nil {
+		return 1
+	}
+	return m.eventBatchSerializationConcurrency()
+}
+
+// runWithConcurrency runs fns with at most concurrency of them in flight. Errors are combined in the order of fns.
+// A concurrency lower than 2 runs fns sequentially and stops at the first error.
+// A panic in a concurrently run fn is logged and returned as its error.
+func runWithConcurrency(logger log.Logger, concurrency int, fns ...func() error) error {
+	if concurrency < 2 || len(fns) < 2 {
+		for _, fn := range fns {
+			if err := fn(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	errs := make([]error, len(fns))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, fn := range fns {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			defer log.CapturePanic(logger, &errs[i])
+
+			errs[i] = fn()
+		}()
+	}
+	wg.Wait()
+	return multierr.Combine(errs...)
+}
+
+// ValidateTaskRange returns an InvalidArgument error if the task range is not valid for the task category, or if it is
+// wider than maxScheduledWidth or maxImmediateWidth. A zero max width means unlimited. An unset min key is the start of
+// the queue, so the width of such a range is measured from there.
+func ValidateTaskRange(
 	taskCategoryType tasks.CategoryType,
 	minTaskKey tasks.Key,
 	maxTaskKey tasks.Key,
+	maxScheduledWidth time.Duration,
+	maxImmediateWidth int64,
 ) error {
 	minTaskIDSpecified := minTaskKey.TaskID != 0
 	minFireTimeSpecified := !minTaskKey.FireTime.IsZero() && !minTaskKey.FireTime.Equal(tasks.DefaultFireTime)
@@ -1135,6 +1462,9 @@ func validateTaskRange(
 		if minFireTimeSpecified || maxFireTimeSpecified {
 			return serviceerror.NewInvalidArgument("invalid task range, fireTime must be empty for immediate task category")
 		}
+		if width := maxTaskKey.TaskID - minTaskKey.TaskID; maxImmediateWidth > 0 && width > maxImmediateWidth {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("invalid task range, width %v exceeds the limit of %v task IDs", width, maxImmediateWidth))
+		}
 	case tasks.CategoryTypeScheduled:
 		if !maxFireTimeSpecified {
 			return serviceerror.NewInvalidArgument("invalid task range, max fire time must be specified for scheduled task category")
@@ -1142,6 +1472,13 @@ func validateTaskRange(
 		if minTaskIDSpecified || maxTaskIDSpecified {
 			return serviceerror.NewInvalidArgument("invalid task range, taskID must be empty for scheduled task category")
 		}
+		minFireTime := tasks.DefaultFireTime
+		if minFireTimeSpecified {
+			minFireTime = minTaskKey.FireTime
+		}
+		if width := maxTaskKey.FireTime.Sub(minFireTime); maxScheduledWidth > 0 && width > maxScheduledWidth {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("invalid task range, width %v exceeds the limit of %v", width, maxScheduledWidth))
+		}
 	default:
 		return serviceerror.NewInvalidArgument(fmt.Sprintf("invalid task category type: %v", taskCategoryType))
 	}
@@ -0,0 +1,1191 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/protobuf/proto"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type conflictResolveCaptureStore struct {
+	ExecutionStore
+	request *InternalConflictResolveWorkflowExecutionRequest
+}
+
+func (s *conflictResolveCaptureStore) GetHistoryBranchUtil() HistoryBranchUtil {
+	return &HistoryBranchUtilImpl{}
+}
+
+func (s *conflictResolveCaptureStore) ConflictResolveWorkflowExecution(
+	_ context.Context,
+	request *InternalConflictResolveWorkflowExecutionRequest,
+) error {
+	s.request = request
+	return nil
+}
+
+type setWorkflowCaptureStore struct {
+	ExecutionStore
+	request *InternalSetWorkflowExecutionRequest
+}
+
+func (s *setWorkflowCaptureStore) SetWorkflowExecution(
+	_ context.Context,
+	request *InternalSetWorkflowExecutionRequest,
+) error {
+	s.request = request
+	return nil
+}
+
+type replicationDLQStore struct {
+	ExecutionStore
+	dlq        []InternalHistoryTask
+	added      []InternalHistoryTask
+	failAddAt  int
+	addAttempt int
+}
+
+func (s *replicationDLQStore) GetReplicationTasksFromDLQ(
+	_ context.Context,
+	request *GetReplicationTasksFromDLQRequest,
+) (*InternalGetReplicationTasksFromDLQResponse, error) {
+	// serve one task per page to exercise pagination, the page token is the ID of the last task served
+	lastTaskID := int64(0)
+	if len(request.NextPageToken) != 0 {
+		lastTaskID = int64(request.NextPageToken[0])
+	}
+	var resp InternalGetReplicationTasksFromDLQResponse
+	for i, task := range s.dlq {
+		if task.Key.TaskID <= lastTaskID {
+			continue
+		}
+		resp.Tasks = []InternalHistoryTask{task}
+		if i+1 < len(s.dlq) {
+			resp.NextPageToken = []byte{byte(task.Key.TaskID)}
+		}
+		break
+	}
+	return &resp, nil
+}
+
+func (s *replicationDLQStore) AddHistoryTasks(
+	_ context.Context,
+	request *InternalAddHistoryTasksRequest,
+) error {
+	s.addAttempt++
+	if s.addAttempt == s.failAddAt {
+		return errors.New("add history tasks failed")
+	}
+	s.added = append(s.added, request.Tasks[tasks.CategoryReplication]...)
+	return nil
+}
+
+func (s *replicationDLQStore) DeleteReplicationTaskFromDLQ(
+	_ context.Context,
+	request *DeleteReplicationTaskFromDLQRequest,
+) error {
+	for i, task := range s.dlq {
+		if task.Key == request.TaskKey {
+			s.dlq = append(s.dlq[:i], s.dlq[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+type addHistoryTasksCaptureStore struct {
+	ExecutionStore
+	requests []*InternalAddHistoryTasksRequest
+}
+
+func (s *addHistoryTasksCaptureStore) AddHistoryTasks(
+	_ context.Context,
+	request *InternalAddHistoryTasksRequest,
+) error {
+	s.requests = append(s.requests, request)
+	return nil
+}
+
+type trimHistoryStore struct {
+	ExecutionStore
+	executionInfo  *persistencespb.WorkflowExecutionInfo
+	executionState *persistencespb.WorkflowExecutionState
+}
+
+func (s *trimHistoryStore) GetWorkflowExecution(
+	_ context.Context,
+	_ *GetWorkflowExecutionRequest,
+) (*InternalGetWorkflowExecutionResponse, error) {
+	if s.executionInfo == nil {
+		return nil, serviceerror.NewNotFound("workflow execution not found")
+	}
+	serializer := serialization.NewSerializer()
+	infoBlob, err := serializer.WorkflowExecutionInfoToBlob(s.executionInfo, enumspb.ENCODING_TYPE_PROTO3)
+	if err != nil {
+		return nil, err
+	}
+	stateBlob, err := serializer.WorkflowExecutionStateToBlob(s.executionState, enumspb.ENCODING_TYPE_PROTO3)
+	if err != nil {
+		return nil, err
+	}
+	return &InternalGetWorkflowExecutionResponse{
+		State: &InternalWorkflowMutableState{ExecutionInfo: infoBlob, ExecutionState: stateBlob},
+	}, nil
+}
+
+func (s *trimHistoryStore) GetHistoryBranchUtil() HistoryBranchUtil {
+	return &HistoryBranchUtilImpl{}
+}
+
+func (s *trimHistoryStore) ReadHistoryBranch(
+	_ context.Context,
+	_ *InternalReadHistoryBranchRequest,
+) (*InternalReadHistoryBranchResponse, error) {
+	return &InternalReadHistoryBranchResponse{}, nil
+}
+
+type listConcreteExecutionsStore struct {
+	ExecutionStore
+	states []*InternalWorkflowMutableState
+}
+
+func (s *listConcreteExecutionsStore) ListConcreteExecutions(
+	_ context.Context,
+	_ *ListConcreteExecutionsRequest,
+) (*InternalListConcreteExecutionsResponse, error) {
+	return &InternalListConcreteExecutionsResponse{States: s.states, NextPageToken: []byte("next")}, nil
+}
+
+type rawWorkflowStore struct {
+	ExecutionStore
+	response *InternalGetWorkflowExecutionResponse
+}
+
+func (s *rawWorkflowStore) GetWorkflowExecution(
+	_ context.Context,
+	_ *GetWorkflowExecutionRequest,
+) (*InternalGetWorkflowExecutionResponse, error) {
+	return s.response, nil
+}
+
+type historyTasksStore struct {
+	ExecutionStore
+	tasks []InternalHistoryTask
+}
+
+func (s *historyTasksStore) GetHistoryTasks(
+	_ context.Context,
+	_ *GetHistoryTasksRequest,
+) (*InternalGetHistoryTasksResponse, error) {
+	return &InternalGetHistoryTasksResponse{Tasks: s.tasks}, nil
+}
+
+func TestRunWithConcurrency(t *testing.T) {
+	err1 := errors.New("error 1")
+	err2 := errors.New("error 2")
+
+	var calls int
+	err := runWithConcurrency(log.NewNoopLogger(), 1, func() error { calls++; return err1 }, func() error { calls++; return err2 })
+	require.Equal(t, err1, err)
+	require.Equal(t, 1, calls, "sequential run stops at the first error")
+
+	err = runWithConcurrency(log.NewNoopLogger(), 3, func() error { return nil }, func() error { return err2 }, func() error { return err1 })
+	require.ErrorIs(t, err, err1)
+	require.ErrorIs(t, err, err2)
+	require.Equal(t, "error 2; error 1", err.Error())
+
+	err = runWithConcurrency(log.NewNoopLogger(), 3, func() error { return nil }, func() error { return err2 })
+	require.Equal(t, err2, err)
+
+	err = runWithConcurrency(log.NewNoopLogger(), 3, func() error { return nil }, func() error { panic("serialization panic") })
+	var internalErr *serviceerror.Internal
+	require.ErrorAs(t, err, &internalErr)
+	require.Contains(t, err.Error(), "serialization panic")
+}
+
+func TestConflictResolveWorkflowExecution_ConcurrentSerializationMatchesSequential(t *testing.T) {
+	sequentialRequest, sequentialResponse := conflictResolveWithConcurrency(t, 1, 10)
+	concurrentRequest, concurrentResponse := conflictResolveWithConcurrency(t, 3, 10)
+
+	require.Equal(t, sequentialRequest, concurrentRequest)
+	require.Equal(t, sequentialResponse, concurrentResponse)
+	require.NotZero(t, concurrentResponse.ResetMutableStateStats.HistoryStatistics.SizeDiff)
+	require.NotZero(t, concurrentResponse.NewMutableStateStats.HistoryStatistics.SizeDiff)
+	require.NotZero(t, concurrentResponse.CurrentMutableStateStats.HistoryStatistics.SizeDiff)
+}
+
+func TestSetWorkflowExecution_ReturnsMutableStateStats(t *testing.T) {
+	store := &setWorkflowCaptureStore{}
+	manager := NewExecutionManager(
+		store,
+		serialization.NewSerializer(),
+		nil,
+		log.NewNoopLogger(),
+		dynamicconfig.GetIntPropertyFn(64*1024*1024),
+		ExecutionManagerOptions{
+			ConflictResolveSerializationConcurrency: dynamicconfig.GetIntPropertyFn(1),
+		},
+	)
+
+	executionInfo, executionState, _ := newConflictResolveTestWorkflow(t, "set-run", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, 1)
+	executionInfo.ActivityCount = 1
+	resp, err := manager.SetWorkflowExecution(context.Background(), &SetWorkflowExecutionRequest{
+		ShardID: 1,
+		RangeID: 1,
+		SetWorkflowSnapshot: WorkflowSnapshot{
+			ExecutionInfo:  executionInfo,
+			ExecutionState: executionState,
+			ActivityInfos: map[int64]*persistencespb.ActivityInfo{
+				5: {ScheduledEventId: 5, ActivityId: "activity-id"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	expected := statusOfInternalWorkflowSnapshot(&store.request.SetWorkflowSnapshot, &HistoryStatistics{})
+	require.Equal(t, *expected, resp.SetMutableStateStats)
+	require.NotZero(t, resp.SetMutableStateStats.TotalSize)
+	require.Equal(t, 1, resp.SetMutableStateStats.ActivityInfoCount)
+	require.Equal(t, int64(1), resp.SetMutableStateStats.TotalActivityCount)
+	require.Zero(t, resp.SetMutableStateStats.HistoryStatistics.SizeDiff)
+}
+
+func TestListConcreteExecutions_FiltersByStateAndStatus(t *testing.T) {
+	serializer := serialization.NewSerializer()
+	newState := func(runID string, state enumsspb.WorkflowExecutionState, status enumspb.WorkflowExecutionStatus) *InternalWorkflowMutableState {
+		infoBlob, err := serializer.WorkflowExecutionInfoToBlob(&persistencespb.WorkflowExecutionInfo{WorkflowId: runID}, enumspb.ENCODING_TYPE_PROTO3)
+		require.NoError(t, err)
+		stateBlob, err := serializer.WorkflowExecutionStateToBlob(&persistencespb.WorkflowExecutionState{
+			RunId:  runID,
+			State:  state,
+			Status: status,
+		}, enumspb.ENCODING_TYPE_PROTO3)
+		require.NoError(t, err)
+		return &InternalWorkflowMutableState{ExecutionInfo: infoBlob, ExecutionState: stateBlob}
+	}
+	// Filtered out executions must not have their execution info deserialized.
+	corrupted := newState("corrupted", enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	corrupted.ExecutionInfo = NewDataBlob([]byte("corrupted"), enumspb.ENCODING_TYPE_PROTO3.String())
+	store := &listConcreteExecutionsStore{states: []*InternalWorkflowMutableState{
+		newState("running", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
+		newState("completed", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED),
+		newState("terminated", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED),
+		corrupted,
+	}}
+	manager := NewExecutionManager(
+		store,
+		serializer,
+		nil,
+		log.NewNoopLogger(),
+		dynamicconfig.GetIntPropertyFn(64*1024*1024),
+		ExecutionManagerOptions{
+			ConflictResolveSerializationConcurrency: dynamicconfig.GetIntPropertyFn(1),
+		},
+	)
+
+	testCases := []struct {
+		name     string
+		states   []enumsspb.WorkflowExecutionState
+		statuses []enumspb.WorkflowExecutionStatus
+		runIDs   []string
+	}{
+		{
+			name:   "running only",
+			states: []enumsspb.WorkflowExecutionState{enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING},
+			runIDs: []string{"running"},
+		},
+		{
+			name:   "completed state",
+			states: []enumsspb.WorkflowExecutionState{enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED},
+			runIDs: []string{"completed", "terminated"},
+		},
+		{
+			name:     "completed state and terminated status",
+			states:   []enumsspb.WorkflowExecutionState{enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED},
+			statuses: []enumspb.WorkflowExecutionStatus{enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED},
+			runIDs:   []string{"terminated"},
+		},
+		{
+			name:   "no match",
+			states: []enumsspb.WorkflowExecutionState{enumsspb.WORKFLOW_EXECUTION_STATE_CREATED},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := manager.ListConcreteExecutions(context.Background(), &ListConcreteExecutionsRequest{
+				ShardID:  1,
+				PageSize: 10,
+				States:   tc.states,
+				Statuses: tc.statuses,
+			})
+			require.NoError(t, err)
+			require.Equal(t, []byte("next"), resp.PageToken)
+			var runIDs []string
+			for _, state := range resp.States {
+				runIDs = append(runIDs, state.ExecutionState.GetRunId())
+			}
+			require.Equal(t, tc.runIDs, runIDs)
+		})
+	}
+}
+
+func TestSetWorkflowExecution_ValidatesStateStatus(t *testing.T) {
+	testCases := []struct {
+		name   string
+		state  enumsspb.WorkflowExecutionState
+		status enumspb.WorkflowExecutionStatus
+		valid  bool
+	}{
+		{
+			name:   "running",
+			state:  enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+			status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			valid:  true,
+		},
+		{
+			name:   "completed",
+			state:  enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+			status: enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED,
+			valid:  true,
+		},
+		{
+			name:   "completed state with running status",
+			state:  enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+			status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			valid:  false,
+		},
+		{
+			name:   "running state with closed status",
+			state:  enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+			status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			valid:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &setWorkflowCaptureStore{}
+			manager := NewExecutionManager(
+				store,
+				serialization.NewSerializer(),
+				nil,
+				log.NewNoopLogger(),
+				dynamicconfig.GetIntPropertyFn(64*1024*1024),
+				ExecutionManagerOptions{
+					ConflictResolveSerializationConcurrency: dynamicconfig.GetIntPropertyFn(1),
+				},
+			)
+
+			executionInfo, executionState, _ := newConflictResolveTestWorkflow(t, "set-run", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, 1)
+			executionState.State = tc.state
+			executionState.Status = tc.status
+			_, err := manager.SetWorkflowExecution(context.Background(), &SetWorkflowExecutionRequest{
+				ShardID: 1,
+				RangeID: 1,
+				SetWorkflowSnapshot: WorkflowSnapshot{
+					ExecutionInfo:  executionInfo,
+					ExecutionState: executionState,
+				},
+			})
+
+			if tc.valid {
+				require.NoError(t, err)
+				require.NotNil(t, store.request)
+				return
+			}
+			var internalErr *serviceerror.Internal
+			require.ErrorAs(t, err, &internalErr)
+			require.Nil(t, store.request)
+		})
+	}
+}
+
+func TestTrimHistoryNode_Metrics(t *testing.T) {
+	executionInfo, executionState, _ := newConflictResolveTestWorkflow(t, "run-id", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, 1)
+	executionInfo.LastFirstEventId = 1
+
+	testCases := []struct {
+		name           string
+		store          *trimHistoryStore
+		expectedMetric string
+	}{
+		{
+			name:           "trimmed",
+			store:          &trimHistoryStore{executionInfo: executionInfo, executionState: executionState},
+			expectedMetric: metrics.PersistenceTrimHistoryNodeSuccesses.Name(),
+		},
+		{
+			name:           "mutable state not found",
+			store:          &trimHistoryStore{},
+			expectedMetric: metrics.PersistenceTrimHistoryNodeFailures.Name(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metricsHandler := metricstest.NewCaptureHandler()
+			capture := metricsHandler.StartCapture()
+			defer metricsHandler.StopCapture(capture)
+
+			manager := NewExecutionManager(
+				tc.store,
+				serialization.NewSerializer(),
+				nil,
+				log.NewNoopLogger(),
+				nil,
+				ExecutionManagerOptions{
+					MetricsHandler: metricsHandler,
+				},
+			)
+			manager.(*executionManagerImpl).trimHistoryNode(context.Background(), 1, "namespace-id", "workflow-id", "run-id")
+
+			snapshot := capture.Snapshot()
+			attempts := snapshot[metrics.PersistenceTrimHistoryNodeAttempts.Name()]
+			require.Len(t, attempts, 1)
+			require.Equal(t, "namespace-id", attempts[0].Tags["namespace_id"])
+			outcomes := snapshot[tc.expectedMetric]
+			require.Len(t, outcomes, 1)
+			require.Equal(t, int64(1), outcomes[0].Value)
+			require.Equal(t, "namespace-id", outcomes[0].Tags["namespace_id"])
+			for _, name := range []string{
+				metrics.PersistenceTrimHistoryNodeSuccesses.Name(),
+				metrics.PersistenceTrimHistoryNodeFailures.Name(),
+			} {
+				if name != tc.expectedMetric {
+					require.Empty(t, snapshot[name])
+				}
+			}
+		})
+	}
+}
+
+func TestGetRawWorkflowExecution_ReturnsBlobsVerbatim(t *testing.T) {
+	// the execution info blob is not a valid proto, so deserializing it would fail
+	state := &InternalWorkflowMutableState{
+		ExecutionInfo:  &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("not a proto")},
+		ExecutionState: &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("execution state")},
+		ActivityInfos: map[int64]*commonpb.DataBlob{
+			5: {EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("activity info")},
+		},
+		NextEventID:     10,
+		DBRecordVersion: 3,
+	}
+	store := &rawWorkflowStore{
+		response: &InternalGetWorkflowExecutionResponse{State: state, DBRecordVersion: 3},
+	}
+	manager := NewExecutionManager(
+		store,
+		serialization.NewSerializer(),
+		nil,
+		log.NewNoopLogger(),
+		nil,
+		ExecutionManagerOptions{},
+	)
+	request := &GetWorkflowExecutionRequest{
+		ShardID:     1,
+		NamespaceID: "namespace-id",
+		WorkflowID:  "workflow-id",
+		RunID:       "run-id",
+	}
+
+	resp, err := manager.GetRawWorkflowExecution(context.Background(), request)
+	require.NoError(t, err)
+	require.Same(t, state, resp.State)
+	require.Equal(t, []byte("not a proto"), resp.State.ExecutionInfo.Data)
+	require.Equal(t, []byte("activity info"), resp.State.ActivityInfos[5].Data)
+	require.Equal(t, int64(3), resp.DBRecordVersion)
+
+	_, err = manager.GetWorkflowExecution(context.Background(), request)
+	require.Error(t, err)
+}
+
+func TestConflictResolveWorkflowExecution_TransactionSizeLimitExceeded(t *testing.T) {
+	store := &conflictResolveCaptureStore{}
+	manager := NewExecutionManager(
+		store,
+		serialization.NewSerializer(),
+		nil,
+		log.NewNoopLogger(),
+		dynamicconfig.GetIntPropertyFn(1),
+		ExecutionManagerOptions{
+			ConflictResolveSerializationConcurrency: dynamicconfig.GetIntPropertyFn(1),
+		},
+	)
+
+	resetInfo, resetState, resetEvents := newConflictResolveTestWorkflow(t, "reset-run", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, 1)
+	_, err := manager.ConflictResolveWorkflowExecution(context.Background(), &ConflictResolveWorkflowExecutionRequest{
+		ShardID: 1,
+		RangeID: 1,
+		Mode:    ConflictResolveWorkflowModeUpdateCurrent,
+
+		ResetWorkflowSnapshot: WorkflowSnapshot{ExecutionInfo: resetInfo, ExecutionState: resetState},
+		ResetWorkflowEvents:   resetEvents,
+	})
+
+	var sizeErr *TransactionSizeLimitError
+	require.ErrorAs(t, err, &sizeErr)
+	require.Equal(t, 1, sizeErr.Limit)
+	require.Greater(t, sizeErr.Size, sizeErr.Limit)
+	require.Nil(t, store.request)
+}
+
+func TestSerializeWorkflowSnapshot_TransactionSizeLimitExceeded(t *testing.T) {
+	store := &setWorkflowCaptureStore{}
+	manager := NewExecutionManager(
+		store,
+		serialization.NewSerializer(),
+		nil,
+		log.NewNoopLogger(),
+		dynamicconfig.GetIntPropertyFn(10),
+		ExecutionManagerOptions{},
+	)
+
+	executionInfo, executionState, _ := newConflictResolveTestWorkflow(t, "set-run", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, 1)
+	_, err := manager.SetWorkflowExecution(context.Background(), &SetWorkflowExecutionRequest{
+		ShardID:             1,
+		RangeID:             1,
+		SetWorkflowSnapshot: WorkflowSnapshot{ExecutionInfo: executionInfo, ExecutionState: executionState},
+	})
+
+	var sizeErr *TransactionSizeLimitError
+	require.ErrorAs(t, err, &sizeErr)
+	require.Equal(t, 10, sizeErr.Limit)
+	require.Greater(t, sizeErr.Size, sizeErr.Limit)
+	require.Nil(t, store.request)
+}
+
+func TestSerializeWorkflowMutation_TransactionSizeLimitExceeded(t *testing.T) {
+	executionInfo, executionState, _ := newConflictResolveTestWorkflow(t, "update-run", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, 1)
+	mutation := &WorkflowMutation{
+		ExecutionInfo:  executionInfo,
+		ExecutionState: executionState,
+		UpsertActivityInfos: map[int64]*persistencespb.ActivityInfo{
+			5: {ScheduledEventId: 5, ActivityId: "activity-id"},
+		},
+	}
+
+	for _, tc := range []struct {
+		name      string
+		sizeLimit int
+		expectErr bool
+	}{
+		{name: "within limit", sizeLimit: 64 * 1024 * 1024},
+		{name: "exceeds limit", sizeLimit: 10, expectErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			manager := NewExecutionManager(
+				nil,
+				serialization.NewSerializer(),
+				nil,
+				log.NewNoopLogger(),
+				dynamicconfig.GetIntPropertyFn(tc.sizeLimit),
+				ExecutionManagerOptions{},
+			).(*executionManagerImpl)
+
+			result, err := manager.SerializeWorkflowMutation(mutation)
+			if !tc.expectErr {
+				require.NoError(t, err)
+				require.Len(t, result.UpsertActivityInfos, 1)
+				return
+			}
+
+			var sizeErr *TransactionSizeLimitError
+			require.ErrorAs(t, err, &sizeErr)
+			require.Equal(t, tc.sizeLimit, sizeErr.Limit)
+			require.Greater(t, sizeErr.Size, sizeErr.Limit)
+		})
+	}
+}
+
+func TestSerializeWorkflowMutation_BufferedEventsSizeLimit(t *testing.T) {
+	executionInfo, executionState, workflowEvents := newConflictResolveTestWorkflow(t, "buffered-run", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, 10)
+	bufferedEvents := workflowEvents[0].Events
+
+	// The limit fits the events by their proto size, but not the framing added by serialization.
+	protoSize := 0
+	for _, event := range bufferedEvents {
+		protoSize += proto.Size(event)
+	}
+
+	for _, tc := range []struct {
+		name      string
+		sizeLimit dynamicconfig.IntPropertyFn
+		expectErr bool
+	}{
+		{name: "unchecked", sizeLimit: nil},
+		{name: "within limit", sizeLimit: dynamicconfig.GetIntPropertyFn(2 * protoSize)},
+		{name: "exceeds limit", sizeLimit: dynamicconfig.GetIntPropertyFn(protoSize), expectErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			manager := NewExecutionManager(
+				nil,
+				serialization.NewSerializer(),
+				nil,
+				log.NewNoopLogger(),
+				dynamicconfig.GetIntPropertyFn(64*1024*1024),
+				ExecutionManagerOptions{
+					BufferedEventsSizeLimit: tc.sizeLimit,
+				},
+			).(*executionManagerImpl)
+
+			result, err := manager.SerializeWorkflowMutation(&WorkflowMutation{
+				ExecutionInfo:     executionInfo,
+				ExecutionState:    executionState,
+				NewBufferedEvents: bufferedEvents,
+			})
+			if !tc.expectErr {
+				require.NoError(t, err)
+				require.NotNil(t, result.NewBufferedEvents)
+				return
+			}
+
+			var sizeErr *TransactionSizeLimitError
+			require.ErrorAs(t, err, &sizeErr)
+			require.Equal(t, protoSize, sizeErr.Limit)
+			require.Greater(t, sizeErr.Size, protoSize)
+		})
+	}
+}
+
+func TestAddHistoryTasksBatch_SingleStoreWrite(t *testing.T) {
+	store := &addHistoryTasksCaptureStore{}
+	manager := NewExecutionManager(
+		store,
+		serialization.NewSerializer(),
+		nil,
+		log.NewNoopLogger(),
+		nil,
+		ExecutionManagerOptions{},
+	)
+
+	err := manager.AddHistoryTasksBatch(context.Background(), &AddHistoryTasksBatchRequest{
+		ShardID: 1,
+		RangeID: 2,
+		WorkflowTasks: []WorkflowHistoryTasks{
+			newAddHistoryTasksTestGroup("workflow-1", &tasks.ActivityTask{TaskID: 10}),
+			newAddHistoryTasksTestGroup("workflow-2", &tasks.ActivityTask{TaskID: 11}),
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, store.requests, 1)
+	request := store.requests[0]
+	require.Equal(t, int32(1), request.ShardID)
+	require.Equal(t, int64(2), request.RangeID)
+	require.Empty(t, request.WorkflowID)
+	require.Len(t, request.Tasks[tasks.CategoryTransfer], 2)
+	require.Equal(t, tasks.NewImmediateKey(10), request.Tasks[tasks.CategoryTransfer][0].Key)
+	require.Equal(t, tasks.NewImmediateKey(11), request.Tasks[tasks.CategoryTransfer][1].Key)
+}
+
+func TestAddHistoryTasksBatch_SerializationFailureAbortsBatch(t *testing.T) {
+	store := &addHistoryTasksCaptureStore{}
+	manager := NewExecutionManager(
+		store,
+		serialization.NewSerializer(),
+		nil,
+		log.NewNoopLogger(),
+		nil,
+		ExecutionManagerOptions{},
+	)
+
+	// the fake task has no transfer task serialization
+	err := manager.AddHistoryTasksBatch(context.Background(), &AddHistoryTasksBatchRequest{
+		ShardID: 1,
+		RangeID: 2,
+		WorkflowTasks: []WorkflowHistoryTasks{
+			newAddHistoryTasksTestGroup("workflow-1", &tasks.ActivityTask{TaskID: 10}),
+			newAddHistoryTasksTestGroup("workflow-2", tasks.NewFakeTask(
+				definition.NewWorkflowKey("namespace-id", "workflow-2", "run-id"),
+				tasks.CategoryTransfer,
+				time.Time{},
+			)),
+		},
+	})
+	require.Error(t, err)
+	require.Empty(t, store.requests)
+}
+
+func TestAddHistoryTasks_DelegatesToBatch(t *testing.T) {
+	store := &addHistoryTasksCaptureStore{}
+	manager := NewExecutionManager(
+		store,
+		serialization.NewSerializer(),
+		nil,
+		log.NewNoopLogger(),
+		nil,
+		ExecutionManagerOptions{},
+	)
+
+	err := manager.AddHistoryTasks(context.Background(), &AddHistoryTasksRequest{
+		ShardID:     1,
+		RangeID:     2,
+		NamespaceID: "namespace-id",
+		WorkflowID:  "workflow-1",
+		Tasks: map[tasks.Category][]tasks.Task{
+			tasks.CategoryTransfer: {&tasks.ActivityTask{TaskID: 10}},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, store.requests, 1)
+	require.Equal(t, "namespace-id", store.requests[0].NamespaceID)
+	require.Equal(t, "workflow-1", store.requests[0].WorkflowID)
+	require.Len(t, store.requests[0].Tasks[tasks.CategoryTransfer], 1)
+}
+
+func TestGetHistoryTasks_TaskTypeFilter(t *testing.T) {
+	serializer := serialization.NewSerializer()
+	workflowKey := definition.NewWorkflowKey("namespace-id", "workflow-id", "run-id")
+	store := &historyTasksStore{}
+	for taskID, task := range []tasks.Task{
+		&tasks.WorkflowTask{WorkflowKey: workflowKey, TaskID: 1},
+		&tasks.CloseExecutionTask{WorkflowKey: workflowKey, TaskID: 2},
+		&tasks.ActivityTask{WorkflowKey: workflowKey, TaskID: 3},
+		&tasks.CloseExecutionTask{WorkflowKey: workflowKey, TaskID: 4},
+	} {
+		blob, err := serializer.SerializeTask(task)
+		require.NoError(t, err)
+		store.tasks = append(store.tasks, InternalHistoryTask{Key: tasks.NewImmediateKey(int64(taskID + 1)), Blob: blob})
+	}
+	manager := NewExecutionManager(
+		store,
+		serializer,
+		nil,
+		log.NewNoopLogger(),
+		dynamicconfig.GetIntPropertyFn(64*1024*1024),
+		ExecutionManagerOptions{
+			ConflictResolveSerializationConcurrency: dynamicconfig.GetIntPropertyFn(1),
+		},
+	)
+
+	request := &GetHistoryTasksRequest{
+		ShardID:             1,
+		TaskCategory:        tasks.CategoryTransfer,
+		InclusiveMinTaskKey: tasks.NewImmediateKey(1),
+		ExclusiveMaxTaskKey: tasks.NewImmediateKey(5),
+		BatchSize:           10,
+	}
+	resp, err := manager.GetHistoryTasks(context.Background(), request)
+	require.NoError(t, err)
+	require.Len(t, resp.Tasks, 4)
+
+	// A task of another type is filtered out on its stored type, without deserializing it.
+	undeserializableTask, err := proto.Marshal(&persistencespb.TransferTaskInfo{TaskType: enumsspb.TASK_TYPE_USER_TIMER})
+	require.NoError(t, err)
+	store.tasks = append(store.tasks, InternalHistoryTask{
+		Key:  tasks.NewImmediateKey(5),
+		Blob: &commonpb.DataBlob{Data: undeserializableTask, EncodingType: enumspb.ENCODING_TYPE_PROTO3},
+	})
+	request.ExclusiveMaxTaskKey = tasks.NewImmediateKey(6)
+	_, err = manager.GetHistoryTasks(context.Background(), request)
+	require.Error(t, err)
+
+	request.TaskType = enumsspb.TASK_TYPE_TRANSFER_CLOSE_EXECUTION
+	resp, err = manager.GetHistoryTasks(context.Background(), request)
+	require.NoError(t, err)
+	require.Len(t, resp.Tasks, 2)
+	for _, task := range resp.Tasks {
+		require.Equal(t, enumsspb.TASK_TYPE_TRANSFER_CLOSE_EXECUTION, task.GetType())
+	}
+	require.Equal(t, int64(2), resp.Tasks[0].GetTaskID())
+	require.Equal(t, int64(4), resp.Tasks[1].GetTaskID())
+}
+
+func TestValidateTaskRange_WidthImmediate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		minTaskKey  tasks.Key
+		maxTaskKey  tasks.Key
+		maxWidth    int64
+		expectError bool
+	}{
+		{
+			name:       "unlimited width",
+			minTaskKey: tasks.NewImmediateKey(1),
+			maxTaskKey: tasks.NewImmediateKey(1_000_000),
+		},
+		{
+			name:       "width within limit",
+			minTaskKey: tasks.NewImmediateKey(100),
+			maxTaskKey: tasks.NewImmediateKey(200),
+			maxWidth:   100,
+		},
+		{
+			name:        "width exceeds limit",
+			minTaskKey:  tasks.NewImmediateKey(100),
+			maxTaskKey:  tasks.NewImmediateKey(201),
+			maxWidth:    100,
+			expectError: true,
+		},
+		{
+			name:       "unset min task ID within limit",
+			minTaskKey: tasks.MinimumKey,
+			maxTaskKey: tasks.NewImmediateKey(100),
+			maxWidth:   100,
+		},
+		{
+			name:        "unset min task ID exceeds limit",
+			minTaskKey:  tasks.MinimumKey,
+			maxTaskKey:  tasks.NewImmediateKey(1_000_000),
+			maxWidth:    100,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateTaskRange(tasks.CategoryTypeImmediate, tc.minTaskKey, tc.maxTaskKey, time.Second, tc.maxWidth)
+			if tc.expectError {
+				var invalidArgument *serviceerror.InvalidArgument
+				require.ErrorAs(t, err, &invalidArgument)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateTaskRange_WidthScheduled(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		name        string
+		minTaskKey  tasks.Key
+		maxTaskKey  tasks.Key
+		maxWidth    time.Duration
+		expectError bool
+	}{
+		{
+			name:       "unlimited width",
+			minTaskKey: tasks.NewKey(now, 0),
+			maxTaskKey: tasks.NewKey(now.Add(24*365*time.Hour), 0),
+		},
+		{
+			name:       "width within limit",
+			minTaskKey: tasks.NewKey(now, 0),
+			maxTaskKey: tasks.NewKey(now.Add(time.Hour), 0),
+			maxWidth:   time.Hour,
+		},
+		{
+			name:        "width exceeds limit",
+			minTaskKey:  tasks.NewKey(now, 0),
+			maxTaskKey:  tasks.NewKey(now.Add(time.Hour+time.Second), 0),
+			maxWidth:    time.Hour,
+			expectError: true,
+		},
+		{
+			name:        "unset min fire time",
+			minTaskKey:  tasks.MinimumKey,
+			maxTaskKey:  tasks.NewKey(now, 0),
+			maxWidth:    time.Hour,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateTaskRange(tasks.CategoryTypeScheduled, tc.minTaskKey, tc.maxTaskKey, tc.maxWidth, 1)
+			if tc.expectError {
+				var invalidArgument *serviceerror.InvalidArgument
+				require.ErrorAs(t, err, &invalidArgument)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMergeReplicationTasksFromDLQ_ResumesAfterPartialFailure(t *testing.T) {
+	serializer := serialization.NewSerializer()
+	store := &replicationDLQStore{failAddAt: 2}
+	for taskID := int64(1); taskID <= 4; taskID++ {
+		blob, err := serializer.SerializeTask(&tasks.HistoryReplicationTask{
+			WorkflowKey:  definition.NewWorkflowKey("namespace-id", "workflow-id", "run-id"),
+			TaskID:       taskID,
+			FirstEventID: taskID,
+			NextEventID:  taskID + 1,
+		})
+		require.NoError(t, err)
+		store.dlq = append(store.dlq, InternalHistoryTask{Key: tasks.NewImmediateKey(taskID), Blob: blob})
+	}
+	manager := NewExecutionManager(
+		store,
+		serializer,
+		nil,
+		log.NewNoopLogger(),
+		dynamicconfig.GetIntPropertyFn(64*1024*1024),
+		ExecutionManagerOptions{
+			ConflictResolveSerializationConcurrency: dynamicconfig.GetIntPropertyFn(1),
+		},
+	)
+	nextTaskID := int64(100)
+
+	request := &MergeReplicationTasksFromDLQRequest{
+		GetReplicationTasksFromDLQRequest: GetReplicationTasksFromDLQRequest{
+			GetHistoryTasksRequest: GetHistoryTasksRequest{
+				ShardID:             1,
+				TaskCategory:        tasks.CategoryReplication,
+				InclusiveMinTaskKey: tasks.NewImmediateKey(1),
+				ExclusiveMaxTaskKey: tasks.NewImmediateKey(5),
+				BatchSize:           1,
+			},
+			SourceClusterName: "source-cluster",
+		},
+		RangeID: 1,
+		GenerateTaskID: func() (int64, error) {
+			nextTaskID++
+			return nextTaskID, nil
+		},
+		TaskFilter: func(task tasks.Task) bool {
+			return task.GetTaskID() != 2
+		},
+	}
+
+	// task 1 is merged, task 2 is skipped and merging task 3 fails
+	_, err := manager.MergeReplicationTasksFromDLQ(context.Background(), request)
+	require.Error(t, err)
+	require.Len(t, store.added, 1)
+	require.Len(t, store.dlq, 3)
+
+	resp, err := manager.MergeReplicationTasksFromDLQ(context.Background(), request)
+	require.NoError(t, err)
+	require.Equal(t, 2, resp.MergedTaskCount)
+	require.Equal(t, 1, resp.SkippedTaskCount)
+	require.Equal(t, int64(4), resp.LastMergedTaskID)
+
+	mergedTaskIDs := make([]int64, 0, len(store.added))
+	for _, task := range store.added {
+		mergedTaskIDs = append(mergedTaskIDs, task.Key.TaskID)
+	}
+	// tasks are re-enqueued under new IDs above the ones they had in the dlq
+	require.Equal(t, []int64{101, 103, 104}, mergedTaskIDs)
+	require.Equal(t, []InternalHistoryTask{{Key: tasks.NewImmediateKey(2), Blob: store.dlq[0].Blob}}, store.dlq)
+}
+
+func BenchmarkConflictResolveWorkflowExecution(b *testing.B) {
+	for _, concurrency := range []int{1, 3} {
+		b.Run(fmt.Sprintf("concurrency=%d", concurrency), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = conflictResolveWithConcurrency(b, concurrency, 1000)
+			}
+		})
+	}
+}
+
+func TestSerializeWorkflowEventBatches_ConcurrentSerializationMatchesSequential(t *testing.T) {
+	executionInfo, eventBatches := newEventBatchesTestWorkflow(t, 20, 5)
+
+	sequentialKVs, sequentialEvents, sequentialStats := serializeEventBatchesWithConcurrency(t, 1, executionInfo, eventBatches)
+	concurrentKVs, concurrentEvents, concurrentStats := serializeEventBatchesWithConcurrency(t, 4, executionInfo, eventBatches)
+
+	require.Equal(t, sequentialStats, concurrentStats)
+	require.Equal(t, 20*5, concurrentStats.CountDiff)
+	require.Len(t, concurrentEvents, len(eventBatches))
+	for i := range sequentialEvents {
+		require.Equal(t, int32(1), concurrentEvents[i].ShardID)
+		require.Equal(t, sequentialEvents[i].Node.TransactionID, concurrentEvents[i].Node.TransactionID)
+		require.True(t, proto.Equal(sequentialEvents[i].Node.Events, concurrentEvents[i].Node.Events))
+	}
+	require.Len(t, concurrentKVs, len(sequentialKVs))
+	for key, value := range sequentialKVs {
+		require.Contains(t, concurrentKVs, key)
+		require.Equal(t, value.EventBlobs, concurrentKVs[key].EventBlobs)
+	}
+}
+
+func BenchmarkSerializeWorkflowEventBatches(b *testing.B) {
+	executionInfo, eventBatches := newEventBatchesTestWorkflow(b, 100, 50)
+	for _, concurrency := range []int{1, 4, 8} {
+		b.Run(fmt.Sprintf("concurrency=%d", concurrency), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _, _ = serializeEventBatchesWithConcurrency(b, concurrency, executionInfo, eventBatches)
+			}
+		})
+	}
+}
+
+func serializeEventBatchesWithConcurrency(
+	tb testing.TB,
+	concurrency int,
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+	eventBatches []*WorkflowEvents,
+) (map[XDCCacheKey]XDCCacheValue, []*InternalAppendHistoryNodesRequest, *HistoryStatistics) {
+	manager := NewExecutionManager(
+		&conflictResolveCaptureStore{},
+		serialization.NewSerializer(),
+		nil,
+		log.NewNoopLogger(),
+		dynamicconfig.GetIntPropertyFn(64*1024*1024),
+		ExecutionManagerOptions{
+			EventBatchSerializationConcurrency: dynamicconfig.GetIntPropertyFn(concurrency),
+		},
+	).(*executionManagerImpl)
+
+	xdcKVs, newEvents, stats, err := manager.serializeWorkflowEventBatches(context.Background(), 1, executionInfo, eventBatches)
+	require.NoError(tb, err)
+	return xdcKVs, newEvents, stats
+}
+
+// newEventBatchesTestWorkflow returns a workflow whose history is split into numBatches consecutive event batches.
+func newEventBatchesTestWorkflow(
+	tb testing.TB,
+	numBatches int,
+	eventsPerBatch int,
+) (*persistencespb.WorkflowExecutionInfo, []*WorkflowEvents) {
+	executionInfo, _, workflowEvents := newConflictResolveTestWorkflow(tb, "batches-run", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, numBatches*eventsPerBatch)
+	events := workflowEvents[0].Events
+	eventBatches := make([]*WorkflowEvents, numBatches)
+	for i := range eventBatches {
+		batch := *workflowEvents[0]
+		batch.Events = events[i*eventsPerBatch : (i+1)*eventsPerBatch]
+		batch.PrevTxnID = int64(i)
+		batch.TxnID = int64(i + 1)
+		eventBatches[i] = &batch
+	}
+	return executionInfo, eventBatches
+}
+
+func conflictResolveWithConcurrency(
+	tb testing.TB,
+	concurrency int,
+	eventsPerWorkflow int,
+) (*InternalConflictResolveWorkflowExecutionRequest, *ConflictResolveWorkflowExecutionResponse) {
+	store := &conflictResolveCaptureStore{}
+	manager := NewExecutionManager(
+		store,
+		serialization.NewSerializer(),
+		nil,
+		log.NewNoopLogger(),
+		dynamicconfig.GetIntPropertyFn(64*1024*1024),
+		ExecutionManagerOptions{
+			ConflictResolveSerializationConcurrency: dynamicconfig.GetIntPropertyFn(concurrency),
+		},
+	)
+
+	resetInfo, resetState, resetEvents := newConflictResolveTestWorkflow(tb, "reset-run", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, eventsPerWorkflow)
+	newInfo, newState, newEvents := newConflictResolveTestWorkflow(tb, "new-run", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, eventsPerWorkflow)
+	currentInfo, currentState, currentEvents := newConflictResolveTestWorkflow(tb, "current-run", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, eventsPerWorkflow)
+	resp, err := manager.ConflictResolveWorkflowExecution(context.Background(), &ConflictResolveWorkflowExecutionRequest{
+		ShardID: 1,
+		RangeID: 1,
+		Mode:    ConflictResolveWorkflowModeUpdateCurrent,
+
+		ResetWorkflowSnapshot: WorkflowSnapshot{ExecutionInfo: resetInfo, ExecutionState: resetState},
+		ResetWorkflowEvents:   resetEvents,
+
+		NewWorkflowSnapshot: &WorkflowSnapshot{ExecutionInfo: newInfo, ExecutionState: newState},
+		NewWorkflowEvents:   newEvents,
+
+		CurrentWorkflowMutation: &WorkflowMutation{ExecutionInfo: currentInfo, ExecutionState: currentState},
+		CurrentWorkflowEvents:   currentEvents,
+	})
+	require.NoError(tb, err)
+	return store.request, resp
+}
+
+func newAddHistoryTasksTestGroup(workflowID string, task tasks.Task) WorkflowHistoryTasks {
+	return WorkflowHistoryTasks{
+		NamespaceID: "namespace-id",
+		WorkflowID:  workflowID,
+		Tasks: map[tasks.Category][]tasks.Task{
+			task.GetCategory(): {task},
+		},
+	}
+}
+
+func newConflictResolveTestWorkflow(
+	tb testing.TB,
+	runID string,
+	state enumsspb.WorkflowExecutionState,
+	numEvents int,
+) (*persistencespb.WorkflowExecutionInfo, *persistencespb.WorkflowExecutionState, []*WorkflowEvents) {
+	branchID := runID
+	branchToken, err := (&HistoryBranchUtilImpl{}).NewHistoryBranch("namespace-id", "workflow-id", runID, runID, &branchID, nil, 0, 0, 0)
+	require.NoError(tb, err)
+
+	// Events don't start at the first event ID, otherwise the appended history nodes would carry a
+	// wall clock dependent garbage cleanup info.
+	events := make([]*historypb.HistoryEvent, numEvents)
+	for i := range events {
+		events[i] = &historypb.HistoryEvent{
+			EventId:   int64(i + 2),
+			Version:   1,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+				WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+					SignalName: fmt.Sprintf("signal-%d", i),
+				},
+			},
+		}
+	}
+
+	executionInfo := &persistencespb.WorkflowExecutionInfo{
+		NamespaceId:    "namespace-id",
+		WorkflowId:     "workflow-id",
+		ExecutionStats: &persistencespb.ExecutionStats{},
+		VersionHistories: versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(
+			branchToken,
+			[]*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(int64(numEvents+1), 1)},
+		)),
+	}
+	executionState := &persistencespb.WorkflowExecutionState{
+		RunId:  runID,
+		State:  state,
+		Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+	}
+	if state == enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+		executionState.Status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	}
+	return executionInfo, executionState, []*WorkflowEvents{{
+		NamespaceID: "namespace-id",
+		WorkflowID:  "workflow-id",
+		RunID:       runID,
+		BranchToken: branchToken,
+		Events:      events,
+		TxnID:       1,
+	}}
+}
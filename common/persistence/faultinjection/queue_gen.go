@@ -90,6 +90,15 @@ func (c *faultInjectionQueue) EnqueueMessageToDLQ(
 	})
 }
 
+func (c *faultInjectionQueue) EnqueueMessages(
+	ctx context.Context,
+	p1 []*common.DataBlob,
+) error {
+	return inject0(c.generator.generate("EnqueueMessages"), func() error {
+		return c.baseStore.EnqueueMessages(ctx, p1)
+	})
+}
+
 func (c *faultInjectionQueue) GetAckLevels(
 	ctx context.Context,
 ) (*persistence.InternalQueueMetadata, error) {
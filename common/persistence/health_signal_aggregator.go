@@ -120,12 +120,17 @@ func (s *HealthSignalAggregatorImpl) Stop() {
 
 func (s *HealthSignalAggregatorImpl) Record(callerSegment int32, namespace string, latency time.Duration, err error) {
 	if s.aggregationEnabled {
-		s.latencyAverage.Record(latency.Milliseconds())
+		latencyDropped := s.latencyAverage.Record(latency.Milliseconds())
 
+		var errorDropped bool
 		if isUnhealthyError(err) {
-			s.errorRatio.Record(1)
+			errorDropped = s.errorRatio.Record(1)
 		} else {
-			s.errorRatio.Record(0)
+			errorDropped = s.errorRatio.Record(0)
+		}
+
+		if latencyDropped || errorDropped {
+			metrics.PersistenceHealthSignalDropped.With(s.metricsHandler).Record(1)
 		}
 	}
 
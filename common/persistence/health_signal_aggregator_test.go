@@ -0,0 +1,94 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+)
+
+func newTestHealthSignalAggregator(
+	windowSize time.Duration,
+	maxBufferSize int,
+	metricsHandler metrics.Handler,
+) *HealthSignalAggregatorImpl {
+	return NewHealthSignalAggregatorImpl(
+		true,
+		windowSize,
+		maxBufferSize,
+		metricsHandler,
+		dynamicconfig.GetIntPropertyFn(0),
+		dynamicconfig.GetFloatPropertyFn(0),
+		log.NewNoopLogger(),
+	)
+}
+
+func TestHealthSignalAggregator_BufferOverflowRecordsDroppedSignals(t *testing.T) {
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	defer metricsHandler.StopCapture(capture)
+
+	// a buffer of size 3 holds 2 signals, so 3 of the 5 recorded signals are dropped
+	aggregator := newTestHealthSignalAggregator(time.Hour, 3, metricsHandler)
+	for i := 0; i < 5; i++ {
+		aggregator.Record(CallerSegmentMissing, "", time.Duration(i)*time.Millisecond, nil)
+	}
+
+	recordings := capture.Snapshot()[metrics.PersistenceHealthSignalDropped.Name()]
+	assert.Len(t, recordings, 3)
+	for _, recording := range recordings {
+		assert.Equal(t, int64(1), recording.Value)
+	}
+	// only the latest signals remain in the window
+	assert.Equal(t, float64(3.5), aggregator.AverageLatency())
+}
+
+func TestHealthSignalAggregator_WindowRolloverFlushesBuffer(t *testing.T) {
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	defer metricsHandler.StopCapture(capture)
+
+	windowSize := 50 * time.Millisecond
+	aggregator := newTestHealthSignalAggregator(windowSize, 3, metricsHandler)
+	aggregator.Record(CallerSegmentMissing, "", 10*time.Millisecond, nil)
+	aggregator.Record(CallerSegmentMissing, "", 10*time.Millisecond, nil)
+
+	time.Sleep(2 * windowSize)
+
+	// signals from the previous window are flushed, so the new ones fit without dropping anything
+	aggregator.Record(CallerSegmentMissing, "", 20*time.Millisecond, &TimeoutError{})
+	aggregator.Record(CallerSegmentMissing, "", 20*time.Millisecond, &TimeoutError{})
+
+	assert.Empty(t, capture.Snapshot()[metrics.PersistenceHealthSignalDropped.Name()])
+	assert.Equal(t, float64(20), aggregator.AverageLatency())
+	assert.Equal(t, float64(1), aggregator.ErrorRatio())
+}
@@ -387,9 +387,7 @@ func (m *executionManagerImpl) serializeAppendHistoryNodesRequest(
 	size := len(blob.Data)
 	sizeLimit := m.transactionSizeLimit()
 	if size > sizeLimit {
-		return nil, &TransactionSizeLimitError{
-			Msg: fmt.Sprintf("transaction size of %v bytes exceeds limit of %v bytes", size, sizeLimit),
-		}
+		return nil, NewTransactionSizeLimitError(size, sizeLimit)
 	}
 
 	req := &InternalAppendHistoryNodesRequest{
@@ -455,9 +453,7 @@ func (m *executionManagerImpl) serializeAppendRawHistoryNodesRequest(
 	size := len(request.History.Data)
 	sizeLimit := m.transactionSizeLimit()
 	if size > sizeLimit {
-		return nil, &TransactionSizeLimitError{
-			Msg: fmt.Sprintf("transaction size of %v bytes exceeds limit of %v bytes", size, sizeLimit),
-		}
+		return nil, NewTransactionSizeLimitError(size, sizeLimit)
 	}
 
 	req := &InternalAppendHistoryNodesRequest{
@@ -90,8 +90,9 @@ func (m *HistoryTaskQueueManagerImpl) EnqueueTask(
 
 	taskCategory := request.Task.GetCategory()
 	task := persistencespb.HistoryTask{
-		ShardId: int32(request.SourceShardID),
-		Blob:    blob,
+		ShardId:       int32(request.SourceShardID),
+		Blob:          blob,
+		FailureReason: request.FailureReason,
 	}
 	taskBytes, _ := task.Marshal()
 	blob = &commonpb.DataBlob{
@@ -184,6 +185,8 @@ func (m *HistoryTaskQueueManagerImpl) ReadTasks(ctx context.Context, request *Re
 		resTasks[i] = HistoryTask{
 			MessageMetadata: rawTask.MessageMetadata,
 			Task:            task,
+			SourceShardID:   int(rawTask.Payload.ShardId),
+			FailureReason:   rawTask.Payload.FailureReason,
 		}
 	}
 
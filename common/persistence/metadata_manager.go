@@ -39,6 +39,10 @@ import (
 	"go.temporal.io/server/common/primitives"
 )
 
+// listNamespacesMaxPageReads caps the store pages ListNamespaces reads to replace filtered out namespaces, so that a
+// selective filter does not scan the whole namespaces table in a single call.
+const listNamespacesMaxPageReads = 10
+
 type (
 
 	// metadataManagerImpl implements MetadataManager based on MetadataStore and Serializer
@@ -200,7 +204,7 @@ func (m *metadataManagerImpl) ListNamespaces(
 	nextPageToken := request.NextPageToken
 	pageSize := request.PageSize
 
-	for {
+	for pageReads := 1; ; pageReads++ {
 		resp, err := m.persistence.ListNamespaces(ctx, &InternalListNamespacesRequest{
 			PageSize:      pageSize,
 			NextPageToken: nextPageToken,
@@ -208,16 +212,23 @@ func (m *metadataManagerImpl) ListNamespaces(
 		if err != nil {
 			return nil, err
 		}
-		deletedNamespacesCount := 0
+		filteredNamespacesCount := 0
 		for _, d := range resp.Namespaces {
 			ret, err := m.ConvertInternalGetResponse(d)
 			if err != nil {
 				return nil, err
 			}
 			if ret.Namespace.Info.State == enumspb.NAMESPACE_STATE_DELETED && !request.IncludeDeleted {
-				deletedNamespacesCount++
+				filteredNamespacesCount++
 				continue
 			}
+			if request.DataKey != "" {
+				value, ok := ret.Namespace.Info.GetData()[request.DataKey]
+				if !ok || (request.DataValue != "" && value != request.DataValue) {
+					filteredNamespacesCount++
+					continue
+				}
+			}
 			namespaces = append(namespaces, ret)
 		}
 		nextPageToken = resp.NextPageToken
@@ -225,11 +236,15 @@ func (m *metadataManagerImpl) ListNamespaces(
 			// Page wasn't full, no more namespaces in DB.
 			break
 		}
-		if deletedNamespacesCount == 0 {
+		if filteredNamespacesCount == 0 {
+			break
+		}
+		if pageReads >= listNamespacesMaxPageReads {
+			// Return a short page, the caller continues from nextPageToken.
 			break
 		}
-		// Page was full but few namespaces weren't added. Read number of deleted namespaces for DB again.
-		pageSize = deletedNamespacesCount
+		// Page was full but few namespaces weren't added. Read number of filtered namespaces for DB again.
+		pageSize = filteredNamespacesCount
 	}
 
 	return &ListNamespacesResponse{
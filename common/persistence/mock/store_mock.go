@@ -1370,6 +1370,20 @@ func (mr *MockQueueMockRecorder) EnqueueMessageToDLQ(ctx, blob interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueMessageToDLQ", reflect.TypeOf((*MockQueue)(nil).EnqueueMessageToDLQ), ctx, blob)
 }
 
+// EnqueueMessages mocks base method.
+func (m *MockQueue) EnqueueMessages(ctx context.Context, blobs []*common.DataBlob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueMessages", ctx, blobs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueMessages indicates an expected call of EnqueueMessages.
+func (mr *MockQueueMockRecorder) EnqueueMessages(ctx, blobs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueMessages", reflect.TypeOf((*MockQueue)(nil).EnqueueMessages), ctx, blobs)
+}
+
 // GetAckLevels mocks base method.
 func (m *MockQueue) GetAckLevels(ctx context.Context) (*persistence.InternalQueueMetadata, error) {
 	m.ctrl.T.Helper()
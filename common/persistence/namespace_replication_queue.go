@@ -50,6 +50,11 @@ import (
 const (
 	purgeInterval                    = 5 * time.Minute
 	localNamespaceReplicationCluster = "namespaceReplication"
+
+	// publishBatchMaxMessages and publishBatchMaxBytes cap the size of each queue write made by PublishBatch.
+	// Cassandra rejects batches larger than batch_size_fail_threshold_in_kb, which is 50KB by default.
+	publishBatchMaxMessages = 100
+	publishBatchMaxBytes    = 32 * 1024
 )
 
 var _ NamespaceReplicationQueue = (*namespaceReplicationQueueImpl)(nil)
@@ -105,6 +110,10 @@ type (
 	NamespaceReplicationQueue interface {
 		Closeable
 		Publish(ctx context.Context, task *replicationspb.ReplicationTask) error
+		// PublishBatch enqueues the tasks in order with as few queue writes as possible, for bulk updates. Each write
+		// holds at most publishBatchMaxMessages tasks and publishBatchMaxBytes of encoded tasks, so a large batch is
+		// not written atomically and a failure can leave its first writes in the queue.
+		PublishBatch(ctx context.Context, tasks []*replicationspb.ReplicationTask) error
 		GetReplicationMessages(
 			ctx context.Context,
 			lastMessageID int64,
@@ -160,6 +169,41 @@ func (q *namespaceReplicationQueueImpl) Publish(ctx context.Context, task *repli
 	return q.queue.EnqueueMessage(ctx, blob)
 }
 
+func (q *namespaceReplicationQueueImpl) PublishBatch(ctx context.Context, tasks []*replicationspb.ReplicationTask) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+	blobs := make([]*commonpb.DataBlob, 0, len(tasks))
+	for _, task := range tasks {
+		blob, err := q.serializer.ReplicationTaskToBlob(task, enumspb.ENCODING_TYPE_PROTO3)
+		if err != nil {
+			return fmt.Errorf("failed to encode message: %v", err)
+		}
+		blobs = append(blobs, blob)
+	}
+
+	for len(blobs) > 0 {
+		size := 1
+		batchBytes := len(blobs[0].Data)
+		for size < len(blobs) && size < publishBatchMaxMessages && batchBytes+len(blobs[size].Data) <= publishBatchMaxBytes {
+			batchBytes += len(blobs[size].Data)
+			size++
+		}
+		var err error
+		if size == 1 {
+			// a single message, possibly larger than publishBatchMaxBytes, doesn't need a batch write
+			err = q.queue.EnqueueMessage(ctx, blobs[0])
+		} else {
+			err = q.queue.EnqueueMessages(ctx, blobs[:size])
+		}
+		if err != nil {
+			return err
+		}
+		blobs = blobs[size:]
+	}
+	return nil
+}
+
 func (q *namespaceReplicationQueueImpl) PublishToDLQ(ctx context.Context, task *replicationspb.ReplicationTask) error {
 	blob, err := q.serializer.ReplicationTaskToBlob(task, enumspb.ENCODING_TYPE_PROTO3)
 	if err != nil {
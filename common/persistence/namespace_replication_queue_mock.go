@@ -161,6 +161,20 @@ func (mr *MockNamespaceReplicationQueueMockRecorder) Publish(ctx, task interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockNamespaceReplicationQueue)(nil).Publish), ctx, task)
 }
 
+// PublishBatch mocks base method.
+func (m *MockNamespaceReplicationQueue) PublishBatch(ctx context.Context, tasks []*repication.ReplicationTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishBatch", ctx, tasks)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishBatch indicates an expected call of PublishBatch.
+func (mr *MockNamespaceReplicationQueueMockRecorder) PublishBatch(ctx, tasks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishBatch", reflect.TypeOf((*MockNamespaceReplicationQueue)(nil).PublishBatch), ctx, tasks)
+}
+
 // PublishToDLQ mocks base method.
 func (m *MockNamespaceReplicationQueue) PublishToDLQ(ctx context.Context, task *repication.ReplicationTask) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,125 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type enqueueRecordingQueue struct {
+	Queue
+	singleWrites int
+	batchWrites  [][]*commonpb.DataBlob
+}
+
+func (q *enqueueRecordingQueue) Init(_ context.Context, _ *commonpb.DataBlob) error {
+	return nil
+}
+
+func (q *enqueueRecordingQueue) EnqueueMessage(_ context.Context, _ *commonpb.DataBlob) error {
+	q.singleWrites++
+	return nil
+}
+
+func (q *enqueueRecordingQueue) EnqueueMessages(_ context.Context, blobs []*commonpb.DataBlob) error {
+	q.batchWrites = append(q.batchWrites, blobs)
+	return nil
+}
+
+func TestNamespaceReplicationQueue_PublishBatchUsesSingleWrite(t *testing.T) {
+	store := &enqueueRecordingQueue{}
+	serializer := serialization.NewSerializer()
+	queue, err := NewNamespaceReplicationQueue(store, serializer, "active", metrics.NoopMetricsHandler, log.NewNoopLogger())
+	require.NoError(t, err)
+
+	tasks := []*replicationspb.ReplicationTask{
+		{SourceTaskId: 1, TaskType: enumsspb.REPLICATION_TASK_TYPE_NAMESPACE_TASK},
+		{SourceTaskId: 2, TaskType: enumsspb.REPLICATION_TASK_TYPE_NAMESPACE_TASK},
+		{SourceTaskId: 3, TaskType: enumsspb.REPLICATION_TASK_TYPE_NAMESPACE_TASK},
+	}
+	require.NoError(t, queue.PublishBatch(context.Background(), tasks))
+
+	require.Zero(t, store.singleWrites)
+	require.Len(t, store.batchWrites, 1)
+	require.Len(t, store.batchWrites[0], len(tasks))
+	for i, blob := range store.batchWrites[0] {
+		require.Equal(t, enumspb.ENCODING_TYPE_PROTO3, blob.EncodingType)
+		task, err := serializer.ReplicationTaskFromBlob(blob)
+		require.NoError(t, err)
+		require.Equal(t, tasks[i].SourceTaskId, task.SourceTaskId)
+	}
+
+	// an empty batch doesn't touch the store
+	require.NoError(t, queue.PublishBatch(context.Background(), nil))
+	require.Len(t, store.batchWrites, 1)
+}
+
+func TestNamespaceReplicationQueue_PublishBatchCapsWriteSize(t *testing.T) {
+	store := &enqueueRecordingQueue{}
+	serializer := serialization.NewSerializer()
+	queue, err := NewNamespaceReplicationQueue(store, serializer, "active", metrics.NoopMetricsHandler, log.NewNoopLogger())
+	require.NoError(t, err)
+
+	tasks := make([]*replicationspb.ReplicationTask, 2*publishBatchMaxMessages+50)
+	for i := range tasks {
+		tasks[i] = &replicationspb.ReplicationTask{SourceTaskId: int64(i), TaskType: enumsspb.REPLICATION_TASK_TYPE_NAMESPACE_TASK}
+	}
+	require.NoError(t, queue.PublishBatch(context.Background(), tasks))
+	require.Zero(t, store.singleWrites)
+	require.Len(t, store.batchWrites, 3)
+	require.Len(t, store.batchWrites[0], publishBatchMaxMessages)
+	require.Len(t, store.batchWrites[1], publishBatchMaxMessages)
+	require.Len(t, store.batchWrites[2], 50)
+
+	// two of these tasks fit in a write, three don't
+	store = &enqueueRecordingQueue{}
+	queue, err = NewNamespaceReplicationQueue(store, serializer, "active", metrics.NoopMetricsHandler, log.NewNoopLogger())
+	require.NoError(t, err)
+	largeTask := &replicationspb.ReplicationTask{
+		TaskType: enumsspb.REPLICATION_TASK_TYPE_TASK_QUEUE_USER_DATA,
+		Attributes: &replicationspb.ReplicationTask_TaskQueueUserDataAttributes{
+			TaskQueueUserDataAttributes: &replicationspb.TaskQueueUserDataAttributes{
+				TaskQueueName: strings.Repeat("a", publishBatchMaxBytes/3+1),
+			},
+		},
+	}
+	require.NoError(t, queue.PublishBatch(context.Background(), []*replicationspb.ReplicationTask{
+		largeTask, largeTask, largeTask, largeTask, largeTask,
+	}))
+	require.Len(t, store.batchWrites, 2)
+	require.Len(t, store.batchWrites[0], 2)
+	require.Len(t, store.batchWrites[1], 2)
+	require.Equal(t, 1, store.singleWrites)
+}
@@ -1450,6 +1450,94 @@ func (m *MetadataPersistenceSuiteV2) TestListNamespaces_DeletedNamespace() {
 	}
 }
 
+func (m *MetadataPersistenceSuiteV2) TestListNamespaces_DataFilter() {
+	data := []map[string]string{
+		{"tenant": "a"},
+		{"tenant": "b"},
+		nil,
+		{"tenant": "a", "other": "value"},
+		{"other": "value"},
+	}
+	for i, d := range data {
+		_, err := m.CreateNamespace(
+			&persistencespb.NamespaceInfo{
+				Id:    uuid.New(),
+				Name:  fmt.Sprintf("list-namespace-data-filter-test-name-%v", i),
+				State: enumspb.NAMESPACE_STATE_REGISTERED,
+				Data:  d,
+			},
+			&persistencespb.NamespaceConfig{},
+			&persistencespb.NamespaceReplicationConfig{},
+			false,
+			0,
+			0,
+		)
+		m.NoError(err)
+	}
+
+	listAll := func(dataKey string, dataValue string) []string {
+		var token []byte
+		var names []string
+		for {
+			resp, err := m.MetadataManager.ListNamespaces(m.ctx, &p.ListNamespacesRequest{
+				PageSize:      1,
+				NextPageToken: token,
+				DataKey:       dataKey,
+				DataValue:     dataValue,
+			})
+			m.NoError(err)
+			for _, ns := range resp.Namespaces {
+				names = append(names, ns.Namespace.Info.Name)
+			}
+			token = resp.NextPageToken
+			if len(token) == 0 {
+				break
+			}
+		}
+		return names
+	}
+
+	m.ElementsMatch([]string{
+		"list-namespace-data-filter-test-name-0",
+		"list-namespace-data-filter-test-name-3",
+	}, listAll("tenant", "a"))
+	m.ElementsMatch([]string{
+		"list-namespace-data-filter-test-name-0",
+		"list-namespace-data-filter-test-name-1",
+		"list-namespace-data-filter-test-name-3",
+	}, listAll("tenant", ""))
+	m.Empty(listAll("tenant", "c"))
+	m.Len(listAll("", ""), len(data))
+}
+
+func (m *MetadataPersistenceSuiteV2) TestListNamespaces_DataFilter_CapsPageReads() {
+	for i := 0; i < 15; i++ {
+		_, err := m.CreateNamespace(
+			&persistencespb.NamespaceInfo{
+				Id:    uuid.New(),
+				Name:  fmt.Sprintf("list-namespace-data-filter-cap-test-name-%v", i),
+				State: enumspb.NAMESPACE_STATE_REGISTERED,
+			},
+			&persistencespb.NamespaceConfig{},
+			&persistencespb.NamespaceReplicationConfig{},
+			false,
+			0,
+			0,
+		)
+		m.NoError(err)
+	}
+
+	// No namespace matches, so every page read is filtered out. The call returns a short page instead of reading
+	// the rest of the table.
+	resp, err := m.MetadataManager.ListNamespaces(m.ctx, &p.ListNamespacesRequest{
+		PageSize: 1,
+		DataKey:  "list-namespace-data-filter-cap-test-missing-key",
+	})
+	m.NoError(err)
+	m.Empty(resp.Namespaces)
+	m.NotEmpty(resp.NextPageToken)
+}
+
 // CreateNamespace helper method
 func (m *MetadataPersistenceSuiteV2) CreateNamespace(info *persistencespb.NamespaceInfo, config *persistencespb.NamespaceConfig,
 	replicationConfig *persistencespb.NamespaceReplicationConfig, isGlobalnamespace bool, configVersion int64, failoverVersion int64) (*p.CreateNamespaceResponse, error) {
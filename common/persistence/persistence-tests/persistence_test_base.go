@@ -318,6 +318,22 @@ func (s *TestBase) Publish(ctx context.Context, task *replicationspb.Replication
 		})
 }
 
+// PublishBatch is a utility method to add a batch of messages to the queue
+func (s *TestBase) PublishBatch(ctx context.Context, tasks []*replicationspb.ReplicationTask) error {
+	retryPolicy := backoff.NewExponentialRetryPolicy(100 * time.Millisecond).
+		WithBackoffCoefficient(1.5).
+		WithMaximumAttempts(20)
+
+	return backoff.ThrottleRetry(
+		func() error {
+			return s.NamespaceReplicationQueue.PublishBatch(ctx, tasks)
+		},
+		retryPolicy,
+		func(e error) bool {
+			return common.IsPersistenceTransientError(e) || isMessageIDConflictError(e)
+		})
+}
+
 func isMessageIDConflictError(err error) bool {
 	_, ok := err.(*persistence.ConditionFailedError)
 	return ok
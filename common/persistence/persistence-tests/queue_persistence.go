@@ -115,6 +115,59 @@ func (s *QueuePersistenceSuite) TestNamespaceReplicationQueue() {
 	s.Equal(int64(numMessages-1), lastRetrievedMessageID)
 }
 
+// TestNamespaceReplicationQueue_PublishBatch tests that each batch is enqueued with consecutive message IDs while
+// other batches are enqueued concurrently
+func (s *QueuePersistenceSuite) TestNamespaceReplicationQueue_PublishBatch() {
+	numBatches := 5
+	batchSize := 10
+
+	// other tests share the queue, so only look at the messages after the current last one
+	lastMessageID := int64(persistence.EmptyQueueMessageID)
+	for {
+		result, lastRetrievedMessageID, err := s.GetReplicationMessages(s.ctx, lastMessageID, 100)
+		s.NoError(err)
+		if len(result) == 0 {
+			break
+		}
+		lastMessageID = lastRetrievedMessageID
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(numBatches)
+	for i := 0; i < numBatches; i++ {
+		go func(batchNum int) {
+			defer wg.Done()
+			batch := make([]*replicationspb.ReplicationTask, 0, batchSize)
+			for j := 0; j < batchSize; j++ {
+				batch = append(batch, &replicationspb.ReplicationTask{
+					TaskType: enumsspb.REPLICATION_TASK_TYPE_NAMESPACE_TASK,
+					Attributes: &replicationspb.ReplicationTask_NamespaceTaskAttributes{
+						NamespaceTaskAttributes: &replicationspb.NamespaceTaskAttributes{
+							Id: fmt.Sprintf("batch-%v-message-%v", batchNum, j),
+						},
+					},
+				})
+			}
+			s.NoError(s.PublishBatch(s.ctx, batch), "Enqueue batch %d failed", batchNum)
+		}(i)
+	}
+	wg.Wait()
+
+	result, lastRetrievedMessageID, err := s.GetReplicationMessages(s.ctx, lastMessageID, numBatches*batchSize)
+	s.NoError(err)
+	s.Len(result, numBatches*batchSize)
+	s.Equal(lastMessageID+int64(numBatches*batchSize), lastRetrievedMessageID)
+	for i := 0; i < len(result); i += batchSize {
+		first := result[i].GetNamespaceTaskAttributes().GetId()
+		var batchNum int
+		_, err := fmt.Sscanf(first, "batch-%d-message-0", &batchNum)
+		s.NoError(err, "batch doesn't start at message %d: %s", i, first)
+		for j := 0; j < batchSize; j++ {
+			s.Equal(fmt.Sprintf("batch-%v-message-%v", batchNum, j), result[i+j].GetNamespaceTaskAttributes().GetId())
+		}
+	}
+}
+
 // TestQueueMetadataOperations tests queue metadata operations
 func (s *QueuePersistenceSuite) TestQueueMetadataOperations() {
 	clusterAckLevels, err := s.GetAckLevels(s.ctx)
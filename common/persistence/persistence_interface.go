@@ -192,6 +192,8 @@ type (
 		Closeable
 		Init(ctx context.Context, blob *commonpb.DataBlob) error
 		EnqueueMessage(ctx context.Context, blob *commonpb.DataBlob) error
+		// EnqueueMessages appends the blobs to the queue in a single store write, with consecutive message IDs.
+		EnqueueMessages(ctx context.Context, blobs []*commonpb.DataBlob) error
 		ReadMessages(ctx context.Context, lastMessageID int64, maxCount int) ([]*QueueMessage, error)
 		DeleteMessagesBefore(ctx context.Context, messageID int64) error
 		UpdateAckLevel(ctx context.Context, metadata *InternalQueueMetadata) error
@@ -428,6 +430,7 @@ type (
 		ShardID int32
 		RangeID int64
 
+		// NamespaceID and WorkflowID are empty when the tasks belong to more than one workflow
 		NamespaceID string
 		WorkflowID  string
 
@@ -263,6 +263,19 @@ func (p *executionPersistenceClient) GetWorkflowExecution(
 	return p.persistence.GetWorkflowExecution(ctx, request)
 }
 
+func (p *executionPersistenceClient) GetRawWorkflowExecution(
+	ctx context.Context,
+	request *GetWorkflowExecutionRequest,
+) (_ *GetRawWorkflowExecutionResponse, retErr error) {
+	caller := headers.GetCallerInfo(ctx).CallerName
+	startTime := time.Now().UTC()
+	defer func() {
+		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(metrics.PersistenceGetRawWorkflowExecutionScope, caller, time.Since(startTime), retErr)
+	}()
+	return p.persistence.GetRawWorkflowExecution(ctx, request)
+}
+
 func (p *executionPersistenceClient) SetWorkflowExecution(
 	ctx context.Context,
 	request *SetWorkflowExecutionRequest,
@@ -367,6 +380,19 @@ func (p *executionPersistenceClient) AddHistoryTasks(
 	return p.persistence.AddHistoryTasks(ctx, request)
 }
 
+func (p *executionPersistenceClient) AddHistoryTasksBatch(
+	ctx context.Context,
+	request *AddHistoryTasksBatchRequest,
+) (retErr error) {
+	caller := headers.GetCallerInfo(ctx).CallerName
+	startTime := time.Now().UTC()
+	defer func() {
+		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(metrics.PersistenceAddTasksBatchScope, caller, time.Since(startTime), retErr)
+	}()
+	return p.persistence.AddHistoryTasksBatch(ctx, request)
+}
+
 func (p *executionPersistenceClient) GetHistoryTasks(
 	ctx context.Context,
 	request *GetHistoryTasksRequest,
@@ -525,6 +551,19 @@ func (p *executionPersistenceClient) IsReplicationDLQEmpty(
 	return p.persistence.IsReplicationDLQEmpty(ctx, request)
 }
 
+func (p *executionPersistenceClient) MergeReplicationTasksFromDLQ(
+	ctx context.Context,
+	request *MergeReplicationTasksFromDLQRequest,
+) (_ *MergeReplicationTasksFromDLQResponse, retErr error) {
+	caller := headers.GetCallerInfo(ctx).CallerName
+	startTime := time.Now().UTC()
+	defer func() {
+		p.healthSignals.Record(request.ShardID, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(metrics.PersistenceMergeReplicationTasksFromDLQScope, caller, time.Since(startTime), retErr)
+	}()
+	return p.persistence.MergeReplicationTasksFromDLQ(ctx, request)
+}
+
 func (p *executionPersistenceClient) Close() {
 	p.persistence.Close()
 }
@@ -963,6 +1002,19 @@ func (p *queuePersistenceClient) EnqueueMessage(
 	return p.persistence.EnqueueMessage(ctx, blob)
 }
 
+func (p *queuePersistenceClient) EnqueueMessages(
+	ctx context.Context,
+	blobs []*commonpb.DataBlob,
+) (retErr error) {
+	caller := headers.GetCallerInfo(ctx).CallerName
+	startTime := time.Now().UTC()
+	defer func() {
+		p.healthSignals.Record(CallerSegmentMissing, caller, time.Since(startTime), retErr)
+		p.recordRequestMetrics(metrics.PersistenceEnqueueMessagesScope, caller, time.Since(startTime), retErr)
+	}()
+	return p.persistence.EnqueueMessages(ctx, blobs)
+}
+
 func (p *queuePersistenceClient) ReadMessages(
 	ctx context.Context,
 	lastMessageID int64,
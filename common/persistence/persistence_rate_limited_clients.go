@@ -261,6 +261,18 @@ func (p *executionRateLimitedPersistenceClient) GetWorkflowExecution(
 	return response, err
 }
 
+func (p *executionRateLimitedPersistenceClient) GetRawWorkflowExecution(
+	ctx context.Context,
+	request *GetWorkflowExecutionRequest,
+) (*GetRawWorkflowExecutionResponse, error) {
+	if err := allow(ctx, "GetRawWorkflowExecution", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetRawWorkflowExecution(ctx, request)
+	return response, err
+}
+
 func (p *executionRateLimitedPersistenceClient) SetWorkflowExecution(
 	ctx context.Context,
 	request *SetWorkflowExecutionRequest,
@@ -354,6 +366,17 @@ func (p *executionRateLimitedPersistenceClient) AddHistoryTasks(
 	return p.persistence.AddHistoryTasks(ctx, request)
 }
 
+func (p *executionRateLimitedPersistenceClient) AddHistoryTasksBatch(
+	ctx context.Context,
+	request *AddHistoryTasksBatchRequest,
+) error {
+	if err := allow(ctx, "AddHistoryTasksBatch", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter); err != nil {
+		return err
+	}
+
+	return p.persistence.AddHistoryTasksBatch(ctx, request)
+}
+
 func (p *executionRateLimitedPersistenceClient) GetHistoryTasks(
 	ctx context.Context,
 	request *GetHistoryTasksRequest,
@@ -461,6 +484,17 @@ func (p *executionRateLimitedPersistenceClient) IsReplicationDLQEmpty(
 	return p.persistence.IsReplicationDLQEmpty(ctx, request)
 }
 
+func (p *executionRateLimitedPersistenceClient) MergeReplicationTasksFromDLQ(
+	ctx context.Context,
+	request *MergeReplicationTasksFromDLQRequest,
+) (*MergeReplicationTasksFromDLQResponse, error) {
+	if err := allow(ctx, "MergeReplicationTasksFromDLQ", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter); err != nil {
+		return nil, err
+	}
+
+	return p.persistence.MergeReplicationTasksFromDLQ(ctx, request)
+}
+
 func (p *executionRateLimitedPersistenceClient) Close() {
 	p.persistence.Close()
 }
@@ -837,6 +871,17 @@ func (p *queueRateLimitedPersistenceClient) EnqueueMessage(
 	return p.persistence.EnqueueMessage(ctx, blob)
 }
 
+func (p *queueRateLimitedPersistenceClient) EnqueueMessages(
+	ctx context.Context,
+	blobs []*commonpb.DataBlob,
+) error {
+	if err := allow(ctx, "EnqueueMessages", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter); err != nil {
+		return err
+	}
+
+	return p.persistence.EnqueueMessages(ctx, blobs)
+}
+
 func (p *queueRateLimitedPersistenceClient) ReadMessages(
 	ctx context.Context,
 	lastMessageID int64,
@@ -259,6 +259,21 @@ func (p *executionRetryablePersistenceClient) GetWorkflowExecution(
 	return response, err
 }
 
+func (p *executionRetryablePersistenceClient) GetRawWorkflowExecution(
+	ctx context.Context,
+	request *GetWorkflowExecutionRequest,
+) (*GetRawWorkflowExecutionResponse, error) {
+	var response *GetRawWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.GetRawWorkflowExecution(ctx, request)
+		return err
+	}
+
+	err := backoff.ThrottleRetryContext(ctx, op, p.policy, p.isRetryable)
+	return response, err
+}
+
 func (p *executionRetryablePersistenceClient) SetWorkflowExecution(
 	ctx context.Context,
 	request *SetWorkflowExecutionRequest,
@@ -367,6 +382,17 @@ func (p *executionRetryablePersistenceClient) AddHistoryTasks(
 	return backoff.ThrottleRetryContext(ctx, op, p.policy, p.isRetryable)
 }
 
+func (p *executionRetryablePersistenceClient) AddHistoryTasksBatch(
+	ctx context.Context,
+	request *AddHistoryTasksBatchRequest,
+) error {
+	op := func(ctx context.Context) error {
+		return p.persistence.AddHistoryTasksBatch(ctx, request)
+	}
+
+	return backoff.ThrottleRetryContext(ctx, op, p.policy, p.isRetryable)
+}
+
 func (p *executionRetryablePersistenceClient) GetHistoryTasks(
 	ctx context.Context,
 	request *GetHistoryTasksRequest,
@@ -467,6 +493,21 @@ func (p *executionRetryablePersistenceClient) IsReplicationDLQEmpty(
 	return isEmpty, err
 }
 
+func (p *executionRetryablePersistenceClient) MergeReplicationTasksFromDLQ(
+	ctx context.Context,
+	request *MergeReplicationTasksFromDLQRequest,
+) (*MergeReplicationTasksFromDLQResponse, error) {
+	var response *MergeReplicationTasksFromDLQResponse
+	op := func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.MergeReplicationTasksFromDLQ(ctx, request)
+		return err
+	}
+
+	err := backoff.ThrottleRetryContext(ctx, op, p.policy, p.isRetryable)
+	return response, err
+}
+
 // AppendHistoryNodes add a node to history node table
 func (p *executionRetryablePersistenceClient) AppendHistoryNodes(
 	ctx context.Context,
@@ -1075,6 +1116,17 @@ func (p *queueRetryablePersistenceClient) EnqueueMessage(
 	return backoff.ThrottleRetryContext(ctx, op, p.policy, p.isRetryable)
 }
 
+func (p *queueRetryablePersistenceClient) EnqueueMessages(
+	ctx context.Context,
+	blobs []*commonpb.DataBlob,
+) error {
+	op := func(ctx context.Context) error {
+		return p.persistence.EnqueueMessages(ctx, blobs)
+	}
+
+	return backoff.ThrottleRetryContext(ctx, op, p.policy, p.isRetryable)
+}
+
 func (p *queueRetryablePersistenceClient) ReadMessages(
 	ctx context.Context,
 	lastMessageID int64,
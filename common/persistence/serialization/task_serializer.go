@@ -31,6 +31,7 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
@@ -93,6 +94,59 @@ func (s *TaskSerializer) DeserializeTask(
 	}
 }
 
+// DeserializeTaskType returns the type of the serialized task without deserializing the rest of it, so tasks can be
+// filtered by type cheaply.
+func (s *TaskSerializer) DeserializeTaskType(
+	category tasks.Category,
+	blob *commonpb.DataBlob,
+) (enumsspb.TaskType, error) {
+	var taskTypeField protowire.Number
+	switch category.ID() {
+	case tasks.CategoryIDTransfer,
+		tasks.CategoryIDTimer,
+		tasks.CategoryIDVisibility,
+		tasks.CategoryIDReplication,
+		tasks.CategoryIDOutbound:
+		taskTypeField = 4
+	case tasks.CategoryIDArchival:
+		taskTypeField = 5
+	default:
+		return enumsspb.TASK_TYPE_UNSPECIFIED, serviceerror.NewInternal(fmt.Sprintf("Unknown task category: %v", category))
+	}
+	if blob.EncodingType != enumspb.ENCODING_TYPE_PROTO3 {
+		return enumsspb.TASK_TYPE_UNSPECIFIED, NewUnknownEncodingTypeError(blob.EncodingType.String(), enumspb.ENCODING_TYPE_PROTO3)
+	}
+	return decodeTaskTypeField(blob.Data, taskTypeField)
+}
+
+// decodeTaskTypeField scans the proto3 encoded task info for its task_type field. The last occurrence wins, as it
+// does for a full unmarshal, and a missing field is TASK_TYPE_UNSPECIFIED.
+func decodeTaskTypeField(data []byte, field protowire.Number) (enumsspb.TaskType, error) {
+	taskType := enumsspb.TASK_TYPE_UNSPECIFIED
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return enumsspb.TASK_TYPE_UNSPECIFIED, NewDeserializationError(enumspb.ENCODING_TYPE_PROTO3, protowire.ParseError(n))
+		}
+		data = data[n:]
+		if num == field && typ == protowire.VarintType {
+			v, n := protowire.ConsumeVarint(data)
+			if n < 0 {
+				return enumsspb.TASK_TYPE_UNSPECIFIED, NewDeserializationError(enumspb.ENCODING_TYPE_PROTO3, protowire.ParseError(n))
+			}
+			taskType = enumsspb.TaskType(v)
+			data = data[n:]
+			continue
+		}
+		n = protowire.ConsumeFieldValue(num, typ, data)
+		if n < 0 {
+			return enumsspb.TASK_TYPE_UNSPECIFIED, NewDeserializationError(enumspb.ENCODING_TYPE_PROTO3, protowire.ParseError(n))
+		}
+		data = data[n:]
+	}
+	return taskType, nil
+}
+
 func (s *TaskSerializer) serializeTransferTask(
 	task tasks.Task,
 ) (*commonpb.DataBlob, error) {
@@ -438,6 +438,9 @@ func (s *taskSerializerSuite) TestStateMachineOutboundTask() {
 	deserializedTaskIface, err := s.taskSerializer.DeserializeTask(task.GetCategory(), blob)
 	deserializedTask := deserializedTaskIface.(*tasks.StateMachineOutboundTask)
 	s.NoError(err)
+	taskType, err := s.taskSerializer.DeserializeTaskType(task.GetCategory(), blob)
+	s.NoError(err)
+	s.Equal(task.GetType(), taskType)
 
 	protorequire.ProtoEqual(s.T(), task.Info, deserializedTask.Info)
 	task.Info = nil
@@ -473,4 +476,7 @@ func (s *taskSerializerSuite) assertEqualTasks(
 	deserializedTask, err := s.taskSerializer.DeserializeTask(task.GetCategory(), blob)
 	s.NoError(err)
 	s.Equal(task, deserializedTask)
+	taskType, err := s.taskSerializer.DeserializeTaskType(task.GetCategory(), blob)
+	s.NoError(err)
+	s.Equal(task.GetType(), taskType)
 }
@@ -95,6 +95,35 @@ func (q *sqlQueue) EnqueueMessage(
 	return nil
 }
 
+func (q *sqlQueue) EnqueueMessages(
+	ctx context.Context,
+	blobs []*commonpb.DataBlob,
+) error {
+	if len(blobs) == 0 {
+		return nil
+	}
+	err := q.txExecute(ctx, "EnqueueMessages", func(tx sqlplugin.Tx) error {
+		lastMessageID, err := tx.GetLastEnqueuedMessageIDForUpdate(ctx, q.queueType)
+		switch err {
+		case nil:
+		case sql.ErrNoRows:
+			lastMessageID = persistence.EmptyQueueMessageID
+		default:
+			return fmt.Errorf("failed to get last enqueued message id: %v", err)
+		}
+		rows := make([]sqlplugin.QueueMessageRow, 0, len(blobs))
+		for i, blob := range blobs {
+			rows = append(rows, newQueueRow(q.queueType, lastMessageID+int64(i)+1, blob))
+		}
+		_, err = tx.InsertIntoMessages(ctx, rows)
+		return err
+	})
+	if err != nil {
+		return serviceerror.NewUnavailable(err.Error())
+	}
+	return nil
+}
+
 func (q *sqlQueue) ReadMessages(
 	ctx context.Context,
 	lastMessageID int64,
@@ -94,6 +94,9 @@ func NewExecutionMutableStateSuite(
 			nil,
 			logger,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			p.ExecutionManagerOptions{
+				ConflictResolveSerializationConcurrency: dynamicconfig.GetIntPropertyFn(3),
+			},
 		),
 		historyBranchUtil: historyBranchUtil,
 		Logger:            logger,
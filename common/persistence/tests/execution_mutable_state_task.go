@@ -39,6 +39,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/definition"
@@ -107,6 +108,9 @@ func NewExecutionMutableStateTaskSuite(
 			nil,
 			logger,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			p.ExecutionManagerOptions{
+				ConflictResolveSerializationConcurrency: dynamicconfig.GetIntPropertyFn(1),
+			},
 		),
 		Logger: logger,
 	}
@@ -715,6 +719,23 @@ func (s *testSerializer) SerializeTask(
 	return s.Serializer.SerializeTask(task)
 }
 
+func (s *testSerializer) DeserializeTaskType(
+	category tasks.Category,
+	blob *commonpb.DataBlob,
+) (enumsspb.TaskType, error) {
+	categoryID := category.ID()
+	if categoryID != fakeImmediateTaskCategory.ID() &&
+		categoryID != fakeScheduledTaskCategory.ID() {
+		return s.Serializer.DeserializeTaskType(category, blob)
+	}
+
+	taskInfo := &persistencespb.TransferTaskInfo{}
+	if err := proto.Unmarshal(blob.Data, taskInfo); err != nil {
+		return enumsspb.TASK_TYPE_UNSPECIFIED, serialization.NewDeserializationError(enumspb.ENCODING_TYPE_PROTO3, err)
+	}
+	return taskInfo.TaskType, nil
+}
+
 func (s *testSerializer) DeserializeTask(
 	category tasks.Category,
 	blob *commonpb.DataBlob,
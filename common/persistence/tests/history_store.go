@@ -92,6 +92,9 @@ func NewHistoryEventsSuite(
 			nil,
 			logger,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			p.ExecutionManagerOptions{
+				ConflictResolveSerializationConcurrency: dynamicconfig.GetIntPropertyFn(1),
+			},
 		),
 		serializer: eventSerializer,
 		logger:     logger,
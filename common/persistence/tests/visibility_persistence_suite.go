@@ -92,8 +92,10 @@ func (s *VisibilityPersistenceSuite) SetupSuite() {
 		dynamicconfig.GetFloatPropertyFn(0.2),
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 		dynamicconfig.GetBoolPropertyFn(false),
+		dynamicconfig.GetFloatPropertyFn(0),
 		dynamicconfig.GetStringPropertyFn(visibility.SecondaryVisibilityWritingModeOff),
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		dynamicconfig.GetTypedPropertyFnFilteredByNamespace([]string(nil)),
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
 		metrics.NoopMetricsHandler,
 		s.Logger,
@@ -61,8 +61,10 @@ func NewManager(
 	operatorRPSRatio dynamicconfig.FloatPropertyFn,
 	enableReadFromSecondaryVisibility dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	visibilityEnableShadowReadMode dynamicconfig.BoolPropertyFn,
+	visibilityShadowReadDiffSampleRate dynamicconfig.FloatPropertyFn,
 	secondaryVisibilityWritingMode dynamicconfig.StringPropertyFn,
 	visibilityDisableOrderByClause dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	visibilityOrderByAllowedFields dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]string],
 	visibilityEnableManualPagination dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 
 	metricsHandler metrics.Handler,
@@ -79,6 +81,7 @@ func NewManager(
 		maxWriteQPS,
 		operatorRPSRatio,
 		visibilityDisableOrderByClause,
+		visibilityOrderByAllowedFields,
 		visibilityEnableManualPagination,
 		metricsHandler,
 		logger,
@@ -102,6 +105,7 @@ func NewManager(
 		maxWriteQPS,
 		operatorRPSRatio,
 		visibilityDisableOrderByClause,
+		visibilityOrderByAllowedFields,
 		visibilityEnableManualPagination,
 		metricsHandler,
 		logger,
@@ -122,6 +126,8 @@ func NewManager(
 			secondaryVisibilityManager,
 			managerSelector,
 			visibilityEnableShadowReadMode,
+			visibilityShadowReadDiffSampleRate,
+			metricsHandler,
 		), nil
 	}
 
@@ -178,6 +184,7 @@ func newVisibilityManagerFromDataStoreConfig(
 	maxWriteQPS dynamicconfig.IntPropertyFn,
 	operatorRPSRatio dynamicconfig.FloatPropertyFn,
 	visibilityDisableOrderByClause dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	visibilityOrderByAllowedFields dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]string],
 	visibilityEnableManualPagination dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 
 	metricsHandler metrics.Handler,
@@ -191,6 +198,7 @@ func newVisibilityManagerFromDataStoreConfig(
 		searchAttributesProvider,
 		searchAttributesMapperProvider,
 		visibilityDisableOrderByClause,
+		visibilityOrderByAllowedFields,
 		visibilityEnableManualPagination,
 		metricsHandler,
 		logger,
@@ -221,6 +229,7 @@ func newVisibilityStoreFromDataStoreConfig(
 	searchAttributesProvider searchattribute.Provider,
 	searchAttributesMapperProvider searchattribute.MapperProvider,
 	visibilityDisableOrderByClause dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	visibilityOrderByAllowedFields dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]string],
 	visibilityEnableManualPagination dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 
 	metricsHandler metrics.Handler,
@@ -246,6 +255,7 @@ func newVisibilityStoreFromDataStoreConfig(
 			searchAttributesProvider,
 			searchAttributesMapperProvider,
 			visibilityDisableOrderByClause,
+			visibilityOrderByAllowedFields,
 			visibilityEnableManualPagination,
 			metricsHandler,
 			logger,
@@ -42,7 +42,10 @@ const (
 type (
 	BulkProcessor interface {
 		Stop() error
-		Add(request *BulkableRequest)
+		// Add adds the request to the bulk processor and returns the size of its serialized form in bytes.
+		Add(request *BulkableRequest) int
+		// Flush commits all requests added so far, without waiting for any of the configured thresholds.
+		Flush() error
 	}
 
 	// BulkProcessorParameters holds all required and optional parameters for executing bulk service
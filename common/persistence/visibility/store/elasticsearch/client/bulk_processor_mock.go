@@ -58,9 +58,11 @@ func (m *MockBulkProcessor) EXPECT() *MockBulkProcessorMockRecorder {
 }
 
 // Add mocks base method.
-func (m *MockBulkProcessor) Add(request *BulkableRequest) {
+func (m *MockBulkProcessor) Add(request *BulkableRequest) int {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Add", request)
+	ret := m.ctrl.Call(m, "Add", request)
+	ret0, _ := ret[0].(int)
+	return ret0
 }
 
 // Add indicates an expected call of Add.
@@ -69,6 +71,20 @@ func (mr *MockBulkProcessorMockRecorder) Add(request interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockBulkProcessor)(nil).Add), request)
 }
 
+// Flush mocks base method.
+func (m *MockBulkProcessor) Flush() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Flush")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Flush indicates an expected call of Flush.
+func (mr *MockBulkProcessorMockRecorder) Flush() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Flush", reflect.TypeOf((*MockBulkProcessor)(nil).Flush))
+}
+
 // Stop mocks base method.
 func (m *MockBulkProcessor) Stop() error {
 	m.ctrl.T.Helper()
@@ -69,22 +69,38 @@ func (p *bulkProcessorImpl) Stop() error {
 	}
 }
 
-func (p *bulkProcessorImpl) Add(request *BulkableRequest) {
+func (p *bulkProcessorImpl) Flush() error {
+	return p.esBulkProcessor.Flush()
+}
+
+func (p *bulkProcessorImpl) Add(request *BulkableRequest) int {
+	var bulkableRequest elastic.BulkableRequest
 	switch request.RequestType {
 	case BulkableRequestTypeIndex:
-		bulkIndexRequest := elastic.NewBulkIndexRequest().
+		bulkableRequest = elastic.NewBulkIndexRequest().
 			Index(request.Index).
 			Id(request.ID).
 			VersionType(versionTypeExternal).
 			Version(request.Version).
 			Doc(request.Doc)
-		p.esBulkProcessor.Add(bulkIndexRequest)
 	case BulkableRequestTypeDelete:
-		bulkDeleteRequest := elastic.NewBulkDeleteRequest().
+		bulkableRequest = elastic.NewBulkDeleteRequest().
 			Index(request.Index).
 			Id(request.ID).
 			VersionType(versionTypeExternal).
 			Version(request.Version)
-		p.esBulkProcessor.Add(bulkDeleteRequest)
+	default:
+		return 0
+	}
+
+	// Source is serialized once and cached by the request, so the bulk processor reuses it
+	// when estimating the bulk size and building the bulk body.
+	sizeInBytes := 0
+	if lines, err := bulkableRequest.Source(); err == nil {
+		for _, line := range lines {
+			sizeInBytes += len(line) + 1 // +1 for the \n
+		}
 	}
+	p.esBulkProcessor.Add(bulkableRequest)
+	return sizeInBytes
 }
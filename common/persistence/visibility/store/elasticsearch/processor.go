@@ -71,6 +71,9 @@ type (
 		metricsHandler          metrics.Handler
 		indexerConcurrency      uint32
 		shutdownLock            sync.RWMutex
+		adaptiveBulkSize        dynamicconfig.BoolPropertyFn
+		bulkSizeEstimator       *bulkSizeEstimator
+		flushing                atomic.Bool
 	}
 
 	// ProcessorConfig contains all configs for processor
@@ -83,6 +86,9 @@ type (
 		ESProcessorFlushInterval dynamicconfig.DurationPropertyFn
 
 		ESProcessorAckTimeout dynamicconfig.DurationPropertyFn
+
+		// Flush before ESProcessorBulkSize is reached, leaving room for a document of the recent average size.
+		ESProcessorAdaptiveBulkSize dynamicconfig.BoolPropertyFn
 	}
 
 	ackFuture struct { // value of processorImpl.mapToAckFuture
@@ -91,12 +97,23 @@ type (
 		addedAt   atomic.Value // of time.Time // Time when request was added to bulk processor (used to report metrics).
 		startedAt time.Time    // Time when request was sent to Elasticsearch by bulk processor (used to report metrics).
 	}
+
+	// bulkSizeEstimator keeps track of the bytes added to the bulk processor since its last commit and of
+	// the moving average of the recently added document sizes.
+	bulkSizeEstimator struct {
+		sync.Mutex
+		pendingBytes int
+		avgDocSize   float64
+	}
 )
 
 var _ Processor = (*processorImpl)(nil)
 
 const (
 	visibilityProcessorName = "visibility-processor"
+
+	// Weight of the last added document in the moving average of document sizes.
+	docSizeAvgWeight = 0.2
 )
 
 var (
@@ -117,6 +134,8 @@ func NewProcessor(
 		logger:             log.With(logger, tag.ComponentIndexerESProcessor),
 		metricsHandler:     metricsHandler.WithTags(metrics.OperationTag(metrics.ElasticsearchBulkProcessor)),
 		indexerConcurrency: uint32(cfg.IndexerConcurrency()),
+		adaptiveBulkSize:   cfg.ESProcessorAdaptiveBulkSize,
+		bulkSizeEstimator:  &bulkSizeEstimator{},
 		bulkProcessorParameters: &client.BulkProcessorParameters{
 			Name:          visibilityProcessorName,
 			NumOfWorkers:  cfg.ESProcessorNumOfWorkers(),
@@ -202,14 +221,40 @@ func (p *processorImpl) Add(request *client.BulkableRequest, visibilityTaskKey s
 		return nil
 	})
 	if !isDup {
-		p.bulkProcessor.Add(request)
+		requestSize := p.bulkProcessor.Add(request)
 		newFuture.recordAdd(p.metricsHandler)
+		if p.adaptiveBulkSize() &&
+			p.bulkSizeEstimator.add(requestSize, p.bulkProcessorParameters.BulkSize) {
+			p.flushAsync()
+		}
 	}
 	return newFuture.future
 }
 
+// flushAsync commits the pending requests of the bulk processor without blocking the caller.
+// It is a no-op if a previous flush is still in progress.
+func (p *processorImpl) flushAsync() {
+	if !p.flushing.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		defer p.flushing.Store(false)
+
+		// Flushing a stopped bulk processor panics, hold the shutdown lock to prevent it.
+		p.shutdownLock.RLock()
+		defer p.shutdownLock.RUnlock()
+		if atomic.LoadInt32(&p.status) == common.DaemonStatusStopped {
+			return
+		}
+		if err := p.bulkProcessor.Flush(); err != nil {
+			p.logger.Warn("Unable to flush Elasticsearch bulk processor.", tag.Error(err))
+		}
+	}()
+}
+
 // bulkBeforeAction is triggered before bulk processor commit
 func (p *processorImpl) bulkBeforeAction(_ int64, requests []elastic.BulkableRequest) {
+	p.bulkSizeEstimator.reset()
 	metrics.ElasticsearchBulkProcessorRequests.With(p.metricsHandler).Record(int64(len(requests)))
 	p.metricsHandler.Histogram(metrics.ElasticsearchBulkProcessorBulkSize.Name(), metrics.ElasticsearchBulkProcessorBulkSize.Unit()).
 		Record(int64(len(requests)))
@@ -450,3 +495,27 @@ func (a *ackFuture) done(ack bool, metricsHandler metrics.Handler) {
 		metrics.ElasticsearchBulkProcessorCommitLatency.With(metricsHandler).Record(doneAt.Sub(a.startedAt))
 	}
 }
+
+// add records a document of the given size and returns true if the bulk should be flushed. When documents are
+// large, the bulk is flushed before reaching bulkSize bytes, so that adding another document of the recent
+// average size doesn't make the bulk request oversized.
+func (e *bulkSizeEstimator) add(docSize int, bulkSize int) bool {
+	e.Lock()
+	defer e.Unlock()
+
+	if e.avgDocSize == 0 {
+		e.avgDocSize = float64(docSize)
+	} else {
+		e.avgDocSize += docSizeAvgWeight * (float64(docSize) - e.avgDocSize)
+	}
+	e.pendingBytes += docSize
+
+	avgDocSize := int(e.avgDocSize)
+	return e.pendingBytes >= max(bulkSize-avgDocSize, avgDocSize)
+}
+
+func (e *bulkSizeEstimator) reset() {
+	e.Lock()
+	defer e.Unlock()
+	e.pendingBytes = 0
+}
@@ -78,6 +78,8 @@ func (s *processorSuite) SetupTest() {
 		ESProcessorBulkActions:   dynamicconfig.GetIntPropertyFn(10),
 		ESProcessorBulkSize:      dynamicconfig.GetIntPropertyFn(2 << 20),
 		ESProcessorFlushInterval: dynamicconfig.GetDurationPropertyFn(1 * time.Minute),
+
+		ESProcessorAdaptiveBulkSize: dynamicconfig.GetBoolPropertyFn(false),
 	}
 
 	s.mockMetricHandler = metrics.NewMockHandler(s.controller)
@@ -104,6 +106,8 @@ func (s *processorSuite) TestNewESProcessorAndStartStop() {
 		ESProcessorBulkActions:   dynamicconfig.GetIntPropertyFn(10),
 		ESProcessorBulkSize:      dynamicconfig.GetIntPropertyFn(2 << 20),
 		ESProcessorFlushInterval: dynamicconfig.GetDurationPropertyFn(1 * time.Minute),
+
+		ESProcessorAdaptiveBulkSize: dynamicconfig.GetBoolPropertyFn(false),
 	}
 
 	p := NewProcessor(config, s.mockESClient, s.esProcessor.logger, s.mockMetricHandler)
@@ -158,6 +162,38 @@ func (s *processorSuite) TestAdd() {
 	}
 }
 
+func (s *processorSuite) TestAdd_AdaptiveBulkSize() {
+	s.esProcessor.adaptiveBulkSize = dynamicconfig.GetBoolPropertyFn(true)
+	s.esProcessor.bulkProcessorParameters.BulkSize = 1000
+
+	s.mockMetricHandler.EXPECT().Timer(metrics.ElasticsearchBulkProcessorWaitAddLatency.Name()).Return(metrics.NoopTimerMetricFunc).AnyTimes()
+
+	// Small documents don't trigger a flush until the bulk size is almost reached.
+	s.mockBulkProcessor.EXPECT().Add(gomock.Any()).Return(20).Times(10)
+	for i := 0; i < 10; i++ {
+		s.esProcessor.Add(&client.BulkableRequest{}, fmt.Sprintf("small-key-%d", i))
+	}
+	s.False(s.esProcessor.flushing.Load())
+	s.esProcessor.bulkSizeEstimator = &bulkSizeEstimator{}
+
+	// Large documents trigger a flush before the bulk size is reached, leaving room for another large document.
+	flushed := make(chan struct{})
+	s.mockBulkProcessor.EXPECT().Flush().DoAndReturn(func() error {
+		close(flushed)
+		return nil
+	}).Times(1)
+	s.mockBulkProcessor.EXPECT().Add(gomock.Any()).Return(400).Times(2)
+	s.esProcessor.Add(&client.BulkableRequest{}, "large-key-1")
+	s.False(s.esProcessor.flushing.Load())
+	s.esProcessor.Add(&client.BulkableRequest{}, "large-key-2")
+
+	select {
+	case <-flushed:
+	case <-time.After(5 * time.Second):
+		s.Fail("bulk processor should be flushed")
+	}
+}
+
 func (s *processorSuite) TestAdd_ConcurrentAdd() {
 	request := &client.BulkableRequest{}
 	docsCount := 1000
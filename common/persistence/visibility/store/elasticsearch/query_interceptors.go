@@ -25,6 +25,7 @@
 package elasticsearch
 
 import (
+	"slices"
 	"strconv"
 	"time"
 
@@ -41,6 +42,7 @@ type (
 		index                          string
 		searchAttributesTypeMap        searchattribute.NameTypeMap
 		searchAttributesMapperProvider searchattribute.MapperProvider
+		orderByAllowedFields           []string
 		seenNamespaceDivision          bool
 	}
 
@@ -56,12 +58,14 @@ func newNameInterceptor(
 	index string,
 	saTypeMap searchattribute.NameTypeMap,
 	searchAttributesMapperProvider searchattribute.MapperProvider,
+	orderByAllowedFields []string,
 ) *nameInterceptor {
 	return &nameInterceptor{
 		namespace:                      namespaceName,
 		index:                          index,
 		searchAttributesTypeMap:        saTypeMap,
 		searchAttributesMapperProvider: searchAttributesMapperProvider,
+		orderByAllowedFields:           orderByAllowedFields,
 	}
 }
 
@@ -103,6 +107,9 @@ func (ni *nameInterceptor) Name(name string, usage query.FieldNameUsage) (string
 			ni.seenNamespaceDivision = true
 		}
 	case query.FieldNameSorter:
+		if len(ni.orderByAllowedFields) > 0 && !slices.Contains(ni.orderByAllowedFields, name) {
+			return "", query.NewConverterError("unable to sort by field %s, it is not allowed in 'order by' clause", name)
+		}
 		if fieldType == enumspb.INDEXED_VALUE_TYPE_TEXT {
 			return "", query.NewConverterError(
 				"unable to sort by field of %s type, use field of type %s",
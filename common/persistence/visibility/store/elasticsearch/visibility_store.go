@@ -72,6 +72,7 @@ type (
 		processor                      Processor
 		processorAckTimeout            dynamicconfig.DurationPropertyFn
 		disableOrderByClause           dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		orderByAllowedFields           dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]string]
 		enableManualPagination         dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		metricsHandler                 metrics.Handler
 	}
@@ -137,6 +138,7 @@ func NewVisibilityStore(
 	searchAttributesProvider searchattribute.Provider,
 	searchAttributesMapperProvider searchattribute.MapperProvider,
 	disableOrderByClause dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	orderByAllowedFields dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]string],
 	enableManualPagination dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
@@ -171,6 +173,7 @@ func NewVisibilityStore(
 		processor:                      processor,
 		processorAckTimeout:            processorAckTimeout,
 		disableOrderByClause:           disableOrderByClause,
+		orderByAllowedFields:           orderByAllowedFields,
 		enableManualPagination:         enableManualPagination,
 		metricsHandler:                 metricsHandler.WithTags(metrics.OperationTag(metrics.ElasticsearchVisibility)),
 	}, nil
@@ -654,8 +657,11 @@ func (s *visibilityStore) buildSearchParametersV2(
 	// ORDER BY clause can be slow if there is a large number of documents and
 	// using a field that was not indexed by ES. Since slow queries can block
 	// writes for unreasonably long, this option forbids the usage of ORDER BY
-	// clause to prevent slow down issues.
-	if s.disableOrderByClause(request.Namespace.String()) && len(queryParams.Sorter) > 0 {
+	// clause to prevent slow down issues. If an allow-list of ORDER BY fields is configured, it takes precedence
+	// and is enforced when converting the query.
+	if len(s.getOrderByAllowedFields(request.Namespace)) == 0 &&
+		s.disableOrderByClause(request.Namespace.String()) &&
+		len(queryParams.Sorter) > 0 {
 		return nil, serviceerror.NewInvalidArgument("ORDER BY clause is not supported")
 	}
 
@@ -752,7 +758,13 @@ func (s *visibilityStore) convertQuery(
 	if err != nil {
 		return nil, serviceerror.NewUnavailable(fmt.Sprintf("Unable to read search attribute types: %v", err))
 	}
-	nameInterceptor := newNameInterceptor(namespace, s.index, saTypeMap, s.searchAttributesMapperProvider)
+	nameInterceptor := newNameInterceptor(
+		namespace,
+		s.index,
+		saTypeMap,
+		s.searchAttributesMapperProvider,
+		s.getOrderByAllowedFields(namespace),
+	)
 	queryConverter := NewQueryConverter(
 		nameInterceptor,
 		NewValuesInterceptor(namespace, saTypeMap, s.searchAttributesMapperProvider),
@@ -785,6 +797,13 @@ func (s *visibilityStore) convertQuery(
 	return queryParams, nil
 }
 
+func (s *visibilityStore) getOrderByAllowedFields(namespace namespace.Name) []string {
+	if s.orderByAllowedFields == nil {
+		return nil
+	}
+	return s.orderByAllowedFields(namespace.String())
+}
+
 func (s *visibilityStore) getScanFieldSorter(fieldSorts []elastic.Sorter) ([]elastic.Sorter, error) {
 	// custom order is not supported by Scan API
 	if len(fieldSorts) > 0 {
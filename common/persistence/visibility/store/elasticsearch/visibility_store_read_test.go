@@ -426,6 +426,49 @@ func (s *ESVisibilitySuite) TestBuildSearchParametersV2DisableOrderByClause() {
 	request.Query = ""
 }
 
+func (s *ESVisibilitySuite) TestBuildSearchParametersV2OrderByAllowedFields() {
+	request := &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		PageSize:    testPageSize,
+	}
+
+	// allow-list takes precedence over disabled ORDER BY clause
+	s.visibilityStore.disableOrderByClause = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	s.visibilityStore.orderByAllowedFields = dynamicconfig.GetTypedPropertyFnFilteredByNamespace([]string{searchattribute.WorkflowID})
+
+	// test allowed field
+	request.Query = `ORDER BY WorkflowId`
+	s.mockMetricsHandler.EXPECT().WithTags(metrics.NamespaceTag(request.Namespace.String())).Return(s.mockMetricsHandler)
+	s.mockMetricsHandler.EXPECT().Counter(metrics.ElasticsearchCustomOrderByClauseCount.Name()).Return(metrics.NoopCounterMetricFunc)
+	p, err := s.visibilityStore.buildSearchParametersV2(request, s.visibilityStore.getListFieldSorter)
+	s.NoError(err)
+	s.Equal([]elastic.Sorter{
+		elastic.NewFieldSort(searchattribute.WorkflowID).Asc(),
+		elastic.NewFieldSort(searchattribute.RunID).Desc(),
+	}, p.Sorter)
+	request.Query = ""
+
+	// test field not in allow-list
+	request.Query = `ORDER BY StartTime`
+	p, err = s.visibilityStore.buildSearchParametersV2(request, s.visibilityStore.getListFieldSorter)
+	s.Nil(p)
+	s.Error(err)
+	var invalidArgumentErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgumentErr)
+	s.ErrorContains(err, "unable to sort by field StartTime")
+	request.Query = ""
+
+	// empty allow-list falls back to disabled ORDER BY clause
+	s.visibilityStore.orderByAllowedFields = dynamicconfig.GetTypedPropertyFnFilteredByNamespace([]string(nil))
+	request.Query = `ORDER BY WorkflowId`
+	p, err = s.visibilityStore.buildSearchParametersV2(request, s.visibilityStore.getListFieldSorter)
+	s.Nil(p)
+	s.ErrorAs(err, &invalidArgumentErr)
+	s.EqualError(err, "ORDER BY clause is not supported")
+	request.Query = ""
+}
+
 func (s *ESVisibilitySuite) queryToJSON(q elastic.Query) string {
 	m, err := q.Source()
 	s.NoError(err)
@@ -26,18 +26,28 @@ package visibility
 
 import (
 	"context"
+	"math/rand"
+	"time"
+
+	workflowpb "go.temporal.io/api/workflow/v1"
 
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 )
 
+// shadowReadDiffTimeout bounds a sampled shadow read, which runs detached from the request context.
+const shadowReadDiffTimeout = 10 * time.Second
+
 type (
 	VisibilityManagerDual struct {
 		visibilityManager          manager.VisibilityManager
 		secondaryVisibilityManager manager.VisibilityManager
 		managerSelector            managerSelector
 		enableShadowReadMode       dynamicconfig.BoolPropertyFn
+		shadowReadDiffSampleRate   dynamicconfig.FloatPropertyFn
+		metricsHandler             metrics.Handler
 	}
 )
 
@@ -50,12 +60,16 @@ func NewVisibilityManagerDual(
 	secondaryVisibilityManager manager.VisibilityManager,
 	managerSelector managerSelector,
 	enableShadowReadMode dynamicconfig.BoolPropertyFn,
+	shadowReadDiffSampleRate dynamicconfig.FloatPropertyFn,
+	metricsHandler metrics.Handler,
 ) *VisibilityManagerDual {
 	return &VisibilityManagerDual{
 		visibilityManager:          visibilityManager,
 		secondaryVisibilityManager: secondaryVisibilityManager,
 		managerSelector:            managerSelector,
 		enableShadowReadMode:       enableShadowReadMode,
+		shadowReadDiffSampleRate:   shadowReadDiffSampleRate,
+		metricsHandler:             metricsHandler,
 	}
 }
 
@@ -186,13 +200,35 @@ func (v *VisibilityManagerDual) ListWorkflowExecutions(
 		if err != nil {
 			return nil, err
 		}
-		//nolint:errcheck // ignore error since it's shadow request
-		go ms[1].ListWorkflowExecutions(ctx, request)
+		if !v.sampleShadowReadDiff() {
+			//nolint:errcheck // ignore error since it's shadow request
+			go ms[1].ListWorkflowExecutions(ctx, request)
+			return ms[0].ListWorkflowExecutions(ctx, request)
+		}
+		shadowCh := make(chan *manager.ListWorkflowExecutionsResponse, 1)
+		go func() {
+			shadowCtx, cancel := newShadowReadDiffContext(ctx)
+			defer cancel()
+			shadowRes, err := ms[1].ListWorkflowExecutions(shadowCtx, request)
+			if err != nil {
+				shadowRes = nil
+			}
+			shadowCh <- shadowRes
+		}()
 		res, err := ms[0].ListWorkflowExecutions(ctx, request)
 		if err != nil {
 			return nil, err
 		}
-		return res, err
+		go func() {
+			if shadowRes := <-shadowCh; shadowRes != nil {
+				v.recordShadowReadDiff(
+					metrics.VisibilityPersistenceListWorkflowExecutionsScope,
+					request.Namespace,
+					!sameWorkflowExecutions(res.Executions, shadowRes.Executions),
+				)
+			}
+		}()
+		return res, nil
 	}
 	return v.managerSelector.readManager(request.Namespace).ListWorkflowExecutions(ctx, request)
 }
@@ -206,13 +242,35 @@ func (v *VisibilityManagerDual) ScanWorkflowExecutions(
 		if err != nil {
 			return nil, err
 		}
-		//nolint:errcheck // ignore error since it's shadow request
-		go ms[1].ScanWorkflowExecutions(ctx, request)
+		if !v.sampleShadowReadDiff() {
+			//nolint:errcheck // ignore error since it's shadow request
+			go ms[1].ScanWorkflowExecutions(ctx, request)
+			return ms[0].ScanWorkflowExecutions(ctx, request)
+		}
+		shadowCh := make(chan *manager.ListWorkflowExecutionsResponse, 1)
+		go func() {
+			shadowCtx, cancel := newShadowReadDiffContext(ctx)
+			defer cancel()
+			shadowRes, err := ms[1].ScanWorkflowExecutions(shadowCtx, request)
+			if err != nil {
+				shadowRes = nil
+			}
+			shadowCh <- shadowRes
+		}()
 		res, err := ms[0].ScanWorkflowExecutions(ctx, request)
 		if err != nil {
 			return nil, err
 		}
-		return res, err
+		go func() {
+			if shadowRes := <-shadowCh; shadowRes != nil {
+				v.recordShadowReadDiff(
+					metrics.VisibilityPersistenceScanWorkflowExecutionsScope,
+					request.Namespace,
+					!sameWorkflowExecutions(res.Executions, shadowRes.Executions),
+				)
+			}
+		}()
+		return res, nil
 	}
 	return v.managerSelector.readManager(request.Namespace).ScanWorkflowExecutions(ctx, request)
 }
@@ -226,13 +284,35 @@ func (v *VisibilityManagerDual) CountWorkflowExecutions(
 		if err != nil {
 			return nil, err
 		}
-		//nolint:errcheck // ignore error since it's shadow request
-		go ms[1].CountWorkflowExecutions(ctx, request)
+		if !v.sampleShadowReadDiff() {
+			//nolint:errcheck // ignore error since it's shadow request
+			go ms[1].CountWorkflowExecutions(ctx, request)
+			return ms[0].CountWorkflowExecutions(ctx, request)
+		}
+		shadowCh := make(chan *manager.CountWorkflowExecutionsResponse, 1)
+		go func() {
+			shadowCtx, cancel := newShadowReadDiffContext(ctx)
+			defer cancel()
+			shadowRes, err := ms[1].CountWorkflowExecutions(shadowCtx, request)
+			if err != nil {
+				shadowRes = nil
+			}
+			shadowCh <- shadowRes
+		}()
 		res, err := ms[0].CountWorkflowExecutions(ctx, request)
 		if err != nil {
 			return nil, err
 		}
-		return res, err
+		go func() {
+			if shadowRes := <-shadowCh; shadowRes != nil {
+				v.recordShadowReadDiff(
+					metrics.VisibilityPersistenceCountWorkflowExecutionsScope,
+					request.Namespace,
+					res.Count != shadowRes.Count,
+				)
+			}
+		}()
+		return res, nil
 	}
 	return v.managerSelector.readManager(request.Namespace).CountWorkflowExecutions(ctx, request)
 }
@@ -256,3 +336,57 @@ func (v *VisibilityManagerDual) GetWorkflowExecution(
 	}
 	return v.managerSelector.readManager(request.Namespace).GetWorkflowExecution(ctx, request)
 }
+
+// newShadowReadDiffContext returns the context of a sampled shadow read. It keeps the values of the request context but
+// not its cancellation, so the comparison is not cut short when the request completes, and has its own timeout.
+func newShadowReadDiffContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), shadowReadDiffTimeout)
+}
+
+func (v *VisibilityManagerDual) sampleShadowReadDiff() bool {
+	return rand.Float64() < v.shadowReadDiffSampleRate()
+}
+
+// recordShadowReadDiff reports the result of comparing the primary visibility read with its shadow read
+// on secondary visibility. The comparison is observational only, the primary result is always returned.
+func (v *VisibilityManagerDual) recordShadowReadDiff(
+	operation string,
+	nsName namespace.Name,
+	diverged bool,
+) {
+	handler := v.metricsHandler.WithTags(metrics.OperationTag(operation), metrics.NamespaceTag(nsName.String()))
+	metrics.VisibilityShadowReadCompared.With(handler).Record(1)
+	if diverged {
+		metrics.VisibilityShadowReadDiverged.With(handler).Record(1)
+	}
+}
+
+// sameWorkflowExecutions returns true if both pages contain the same workflow executions, ignoring order.
+func sameWorkflowExecutions(
+	primary []*workflowpb.WorkflowExecutionInfo,
+	secondary []*workflowpb.WorkflowExecutionInfo,
+) bool {
+	if len(primary) != len(secondary) {
+		return false
+	}
+	type executionKey struct {
+		workflowID string
+		runID      string
+	}
+	executions := make(map[executionKey]struct{}, len(primary))
+	for _, execution := range primary {
+		executions[executionKey{
+			workflowID: execution.GetExecution().GetWorkflowId(),
+			runID:      execution.GetExecution().GetRunId(),
+		}] = struct{}{}
+	}
+	for _, execution := range secondary {
+		if _, ok := executions[executionKey{
+			workflowID: execution.GetExecution().GetWorkflowId(),
+			runID:      execution.GetExecution().GetRunId(),
+		}]; !ok {
+			return false
+		}
+	}
+	return true
+}
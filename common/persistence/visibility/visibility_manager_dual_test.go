@@ -0,0 +1,181 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+)
+
+func TestVisibilityManagerDual_ShadowReadDiff(t *testing.T) {
+	controller := gomock.NewController(t)
+	primary := manager.NewMockVisibilityManager(controller)
+	secondary := manager.NewMockVisibilityManager(controller)
+	selector := NewMockmanagerSelector(controller)
+	selector.EXPECT().readManagers(gomock.Any()).Return([]manager.VisibilityManager{primary, secondary}, nil).AnyTimes()
+
+	capture := metricstest.NewCaptureHandler()
+	recording := capture.StartCapture()
+	defer capture.StopCapture(recording)
+
+	dual := NewVisibilityManagerDual(
+		primary,
+		secondary,
+		selector,
+		dynamicconfig.GetBoolPropertyFn(true),
+		dynamicconfig.GetFloatPropertyFn(1),
+		capture,
+	)
+
+	nsName := namespace.Name("test-namespace")
+	request := &manager.ListWorkflowExecutionsRequestV2{Namespace: nsName}
+	primaryRes := &manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{Execution: &commonpb.WorkflowExecution{WorkflowId: "wf-1", RunId: "run-1"}},
+			{Execution: &commonpb.WorkflowExecution{WorkflowId: "wf-2", RunId: "run-2"}},
+		},
+	}
+	sameRes := &manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{primaryRes.Executions[1], primaryRes.Executions[0]},
+	}
+	divergedRes := &manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			primaryRes.Executions[0],
+			{Execution: &commonpb.WorkflowExecution{WorkflowId: "wf-3", RunId: "run-3"}},
+		},
+	}
+
+	primary.EXPECT().ListWorkflowExecutions(gomock.Any(), request).Return(primaryRes, nil).Times(2)
+	secondary.EXPECT().ListWorkflowExecutions(gomock.Any(), request).Return(sameRes, nil)
+	res, err := dual.ListWorkflowExecutions(context.Background(), request)
+	require.NoError(t, err)
+	require.Equal(t, primaryRes, res)
+	require.Eventually(t, func() bool {
+		return len(recording.Snapshot()[metrics.VisibilityShadowReadCompared.Name()]) == 1
+	}, time.Second, 10*time.Millisecond)
+	require.Empty(t, recording.Snapshot()[metrics.VisibilityShadowReadDiverged.Name()])
+
+	// primary result stays authoritative when secondary diverges
+	secondary.EXPECT().ListWorkflowExecutions(gomock.Any(), request).Return(divergedRes, nil)
+	res, err = dual.ListWorkflowExecutions(context.Background(), request)
+	require.NoError(t, err)
+	require.Equal(t, primaryRes, res)
+	require.Eventually(t, func() bool {
+		return len(recording.Snapshot()[metrics.VisibilityShadowReadDiverged.Name()]) == 1
+	}, time.Second, 10*time.Millisecond)
+	diverged := recording.Snapshot()[metrics.VisibilityShadowReadDiverged.Name()][0]
+	require.Equal(t, nsName.String(), diverged.Tags[metrics.NamespaceTag("").Key()])
+	require.Equal(t, metrics.VisibilityPersistenceListWorkflowExecutionsScope, diverged.Tags[metrics.OperationTag("").Key()])
+}
+
+func TestVisibilityManagerDual_ShadowReadDiff_Count(t *testing.T) {
+	controller := gomock.NewController(t)
+	primary := manager.NewMockVisibilityManager(controller)
+	secondary := manager.NewMockVisibilityManager(controller)
+	selector := NewMockmanagerSelector(controller)
+	selector.EXPECT().readManagers(gomock.Any()).Return([]manager.VisibilityManager{primary, secondary}, nil).AnyTimes()
+
+	capture := metricstest.NewCaptureHandler()
+	recording := capture.StartCapture()
+	defer capture.StopCapture(recording)
+
+	dual := NewVisibilityManagerDual(
+		primary,
+		secondary,
+		selector,
+		dynamicconfig.GetBoolPropertyFn(true),
+		dynamicconfig.GetFloatPropertyFn(1),
+		capture,
+	)
+
+	request := &manager.CountWorkflowExecutionsRequest{Namespace: namespace.Name("test-namespace")}
+	primary.EXPECT().CountWorkflowExecutions(gomock.Any(), request).Return(&manager.CountWorkflowExecutionsResponse{Count: 10}, nil)
+	secondary.EXPECT().CountWorkflowExecutions(gomock.Any(), request).Return(&manager.CountWorkflowExecutionsResponse{Count: 9}, nil)
+	res, err := dual.CountWorkflowExecutions(context.Background(), request)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), res.Count)
+	require.Eventually(t, func() bool {
+		return len(recording.Snapshot()[metrics.VisibilityShadowReadDiverged.Name()]) == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestVisibilityManagerDual_ShadowReadDiff_DetachedFromRequestContext(t *testing.T) {
+	controller := gomock.NewController(t)
+	primary := manager.NewMockVisibilityManager(controller)
+	secondary := manager.NewMockVisibilityManager(controller)
+	selector := NewMockmanagerSelector(controller)
+	selector.EXPECT().readManagers(gomock.Any()).Return([]manager.VisibilityManager{primary, secondary}, nil).AnyTimes()
+
+	capture := metricstest.NewCaptureHandler()
+	recording := capture.StartCapture()
+	defer capture.StopCapture(recording)
+
+	dual := NewVisibilityManagerDual(
+		primary,
+		secondary,
+		selector,
+		dynamicconfig.GetBoolPropertyFn(true),
+		dynamicconfig.GetFloatPropertyFn(1),
+		capture,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	requestDone := make(chan struct{})
+	request := &manager.CountWorkflowExecutionsRequest{Namespace: namespace.Name("test-namespace")}
+	primary.EXPECT().CountWorkflowExecutions(gomock.Any(), request).Return(&manager.CountWorkflowExecutionsResponse{Count: 10}, nil)
+	secondary.EXPECT().CountWorkflowExecutions(gomock.Any(), request).DoAndReturn(
+		func(shadowCtx context.Context, _ *manager.CountWorkflowExecutionsRequest) (*manager.CountWorkflowExecutionsResponse, error) {
+			// the shadow read outlives the request
+			<-requestDone
+			if err := shadowCtx.Err(); err != nil {
+				return nil, err
+			}
+			deadline, ok := shadowCtx.Deadline()
+			assert.True(t, ok)
+			assert.WithinDuration(t, time.Now().Add(shadowReadDiffTimeout), deadline, shadowReadDiffTimeout)
+			return &manager.CountWorkflowExecutionsResponse{Count: 10}, nil
+		})
+
+	res, err := dual.CountWorkflowExecutions(ctx, request)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), res.Count)
+	cancel()
+	close(requestDone)
+
+	require.Eventually(t, func() bool {
+		return len(recording.Snapshot()[metrics.VisibilityShadowReadCompared.Name()]) == 1
+	}, time.Second, 10*time.Millisecond)
+}
@@ -107,13 +107,15 @@ func (v XDCCacheValue) CacheSize() int {
 func NewEventsBlobCache(
 	maxBytes int,
 	ttl time.Duration,
+	evictionPolicy cache.EvictionPolicy,
 ) *XDCCacheImpl {
 	return &XDCCacheImpl{
 		cache: cache.New(
 			max(xdcMinCacheSize, maxBytes),
 			&cache.Options{
-				TTL: ttl,
-				Pin: false,
+				TTL:            ttl,
+				Pin:            false,
+				EvictionPolicy: evictionPolicy,
 			},
 		),
 	}
@@ -140,6 +140,11 @@ func (l ClockedRateLimiter) WaitN(ctx context.Context, token int) error {
 	}
 }
 
+// Tokens returns the number of tokens currently available in the bucket
+func (l ClockedRateLimiter) Tokens() float64 {
+	return l.rateLimiter.TokensAt(l.timeSource.Now())
+}
+
 func (l ClockedRateLimiter) SetLimitAt(t time.Time, newLimit rate.Limit) {
 	l.rateLimiter.SetLimitAt(t, newLimit)
 }
@@ -88,6 +88,18 @@ func TestClockedRateLimiter_AllowN_NotOk(t *testing.T) {
 	assert.False(t, rl.AllowN(ts.Now(), 11))
 }
 
+func TestClockedRateLimiter_Tokens(t *testing.T) {
+	t.Parallel()
+
+	ts := clock.NewEventTimeSource()
+	rl := quotas.NewClockedRateLimiter(rate.NewLimiter(1, 2), ts)
+	assert.Equal(t, 2.0, rl.Tokens())
+	assert.True(t, rl.AllowN(ts.Now(), 2))
+	assert.Equal(t, 0.0, rl.Tokens())
+	ts.Advance(500 * time.Millisecond)
+	assert.InDelta(t, 0.5, rl.Tokens(), 0.001)
+}
+
 func TestClockedRateLimiter_Wait_NoBurst(t *testing.T) {
 	t.Parallel()
 
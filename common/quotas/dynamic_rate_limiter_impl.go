@@ -147,6 +147,11 @@ func (d *DynamicRateLimiterImpl) Burst() int {
 	return d.rateLimiter.Burst()
 }
 
+// Tokens returns the number of tokens currently available in this rate limiter
+func (d *DynamicRateLimiterImpl) Tokens() float64 {
+	return d.rateLimiter.Tokens()
+}
+
 func (d *DynamicRateLimiterImpl) Refresh() {
 	d.rateLimiter.SetRateBurst(d.rateBurstFn.Rate(), d.rateBurstFn.Burst())
 }
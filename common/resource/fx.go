@@ -341,6 +341,9 @@ func MatchingClientProvider(matchingRawClient MatchingRawClient) MatchingClient
 
 func PersistenceConfigProvider(persistenceConfig config.Persistence, dc *dynamicconfig.Collection) *config.Persistence {
 	persistenceConfig.TransactionSizeLimit = dynamicconfig.TransactionSizeLimit.Get(dc)
+	persistenceConfig.BufferedEventsSizeLimit = dynamicconfig.MaximumBufferedEventsSizeInBytes.Get(dc)
+	persistenceConfig.ConflictResolveSerializationConcurrency = dynamicconfig.ConflictResolveSerializationConcurrency.Get(dc)
+	persistenceConfig.EventBatchSerializationConcurrency = dynamicconfig.EventBatchSerializationConcurrency.Get(dc)
 	return &persistenceConfig
 }
 
@@ -32,6 +32,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/api"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/quotas/calculator"
 	"google.golang.org/grpc"
@@ -48,6 +49,9 @@ type (
 		namespaceRegistry namespace.Registry
 		logger            log.Logger
 		quotaCalculator   calculator.NamespaceCalculator
+		// perAPIQuota returns per-instance limits keyed by method name (the part of the method name after the final
+		// slash) which take precedence over quotaCalculator. Optional, every method uses quotaCalculator if not set.
+		perAPIQuota func(ns string) map[string]int
 		// tokens is a map of method name to the number of tokens that should be consumed for that method. If there is
 		// no entry for a method, then no tokens will be consumed, so the method will not be limited.
 		tokens map[string]int
@@ -73,6 +77,7 @@ func NewConcurrentRequestLimitInterceptor(
 	logger log.Logger,
 	perInstanceQuota func(ns string) int,
 	globalQuota func(ns string) int,
+	perAPIQuota func(ns string) map[string]int,
 	tokens map[string]int,
 ) *ConcurrentRequestLimitInterceptor {
 	return &ConcurrentRequestLimitInterceptor{
@@ -86,6 +91,7 @@ func NewConcurrentRequestLimitInterceptor(
 			},
 			log.With(logger, tag.ComponentLongPollHandler, tag.ScopeNamespace),
 		),
+		perAPIQuota:       perAPIQuota,
 		tokens:            tokens,
 		activeTokensCount: make(map[string]*int32),
 	}
@@ -135,12 +141,25 @@ func (ni *ConcurrentRequestLimitInterceptor) Allow(
 
 	// frontend.namespaceCount is applied per poller type temporarily to prevent
 	// one poller type to take all token waiting in the long poll.
-	if float64(count) > ni.quotaCalculator.GetQuota(namespaceName.String()) {
+	if float64(count) > ni.getQuota(namespaceName, methodName) {
 		return cleanup, ErrNamespaceCountLimitServerBusy
 	}
 	return cleanup, nil
 }
 
+// getQuota returns the per-API override for the method if there is one, and the namespace quota otherwise.
+func (ni *ConcurrentRequestLimitInterceptor) getQuota(
+	namespaceName namespace.Name,
+	methodName string,
+) float64 {
+	if ni.perAPIQuota != nil {
+		if quota, ok := ni.perAPIQuota(namespaceName.String())[api.MethodName(methodName)]; ok {
+			return float64(quota)
+		}
+	}
+	return ni.quotaCalculator.GetQuota(namespaceName.String())
+}
+
 func (ni *ConcurrentRequestLimitInterceptor) counter(
 	namespace namespace.Name,
 	methodName string,
@@ -54,6 +54,8 @@ type nsCountLimitTestCase struct {
 	perInstanceLimit int
 	// globalLimit is the limit on the number of pending requests across all instances.
 	globalLimit int
+	// perAPILimit is a map of method slugs to per-instance limits which override perInstanceLimit and globalLimit.
+	perAPILimit map[string]int
 	// methodName is the fully-qualified name of the gRPC method being intercepted.
 	methodName string
 	// tokens is a map of method slugs (e.g. just the part of the method name after the final slash) to the number of
@@ -157,6 +159,58 @@ func TestNamespaceCountLimitInterceptor_Intercept(t *testing.T) {
 			},
 			expectRateLimit: false,
 		},
+		{
+			name:               "per-API limit below namespace limit exceeded",
+			request:            nil,
+			numBlockedRequests: 2,
+			perInstanceLimit:   4,
+			globalLimit:        0,
+			perAPILimit: map[string]int{
+				"QueryWorkflow":               1,
+				"GetWorkflowExecutionHistory": 4,
+			},
+			memberCounter: quotastest.NewFakeMemberCounter(2),
+			methodName:    "/temporal.api.workflowservice.v1.WorkflowService/QueryWorkflow",
+			tokens: map[string]int{
+				"/temporal.api.workflowservice.v1.WorkflowService/QueryWorkflow":               1,
+				"/temporal.api.workflowservice.v1.WorkflowService/GetWorkflowExecutionHistory": 1,
+			},
+			expectRateLimit: true,
+		},
+		{
+			name:               "per-API limit above namespace limit not exceeded",
+			request:            &workflowservice.GetWorkflowExecutionHistoryRequest{WaitNewEvent: true},
+			numBlockedRequests: 4,
+			perInstanceLimit:   2,
+			globalLimit:        2,
+			perAPILimit: map[string]int{
+				"QueryWorkflow":               1,
+				"GetWorkflowExecutionHistory": 4,
+			},
+			memberCounter: quotastest.NewFakeMemberCounter(2),
+			methodName:    "/temporal.api.workflowservice.v1.WorkflowService/GetWorkflowExecutionHistory",
+			tokens: map[string]int{
+				"/temporal.api.workflowservice.v1.WorkflowService/QueryWorkflow":               1,
+				"/temporal.api.workflowservice.v1.WorkflowService/GetWorkflowExecutionHistory": 1,
+			},
+			expectRateLimit: false,
+		},
+		{
+			name:               "per-API limit falls back to namespace limit",
+			request:            nil,
+			numBlockedRequests: 3,
+			perInstanceLimit:   2,
+			globalLimit:        0,
+			perAPILimit: map[string]int{
+				"QueryWorkflow": 4,
+			},
+			memberCounter: quotastest.NewFakeMemberCounter(2),
+			methodName:    "/temporal.api.workflowservice.v1.WorkflowService/PollWorkflowTaskQueue",
+			tokens: map[string]int{
+				"/temporal.api.workflowservice.v1.WorkflowService/PollWorkflowTaskQueue": 1,
+			},
+			expectRateLimit: true,
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
@@ -230,6 +284,7 @@ func (tc *nsCountLimitTestCase) createInterceptor(ctrl *gomock.Controller) *Conc
 		log.NewNoopLogger(),
 		dynamicconfig.GetIntPropertyFnFilteredByNamespace(tc.perInstanceLimit),
 		dynamicconfig.GetIntPropertyFnFilteredByNamespace(tc.globalLimit),
+		dynamicconfig.GetTypedPropertyFnFilteredByNamespace(tc.perAPILimit),
 		tc.tokens,
 	)
 
@@ -0,0 +1,117 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/aggregate"
+	"go.temporal.io/server/common/api"
+)
+
+type (
+	// HealthCheckInterceptor keeps a moving window average of the latency and the unhealthy error ratio of the RPCs
+	// served by a host, which are used as RPC health signals of the host.
+	HealthCheckInterceptor struct {
+		latencyAverage aggregate.MovingWindowAverage
+		errorRatio     aggregate.MovingWindowAverage
+		excludedAPIs   map[string]struct{}
+	}
+)
+
+var _ grpc.UnaryServerInterceptor = (*HealthCheckInterceptor)(nil).Intercept
+
+// NewHealthCheckInterceptor returns a new HealthCheckInterceptor. RPCs of the excludedAPIs, such as long polls, are
+// not recorded.
+func NewHealthCheckInterceptor(
+	windowSize time.Duration,
+	maxBufferSize int,
+	excludedAPIs map[string]struct{},
+) *HealthCheckInterceptor {
+	return &HealthCheckInterceptor{
+		latencyAverage: aggregate.NewMovingWindowAvgImpl(windowSize, maxBufferSize),
+		errorRatio:     aggregate.NewMovingWindowAvgImpl(windowSize, maxBufferSize),
+		excludedAPIs:   excludedAPIs,
+	}
+}
+
+func (i *HealthCheckInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if _, ok := i.excludedAPIs[api.MethodName(info.FullMethod)]; ok {
+		return handler(ctx, req)
+	}
+
+	startTime := time.Now()
+	resp, err := handler(ctx, req)
+	i.Record(time.Since(startTime), err)
+	return resp, err
+}
+
+// Record adds the latency and the outcome of a single RPC to the health signals.
+func (i *HealthCheckInterceptor) Record(latency time.Duration, err error) {
+	i.latencyAverage.Record(latency.Milliseconds())
+	if isUnhealthyRPCError(err) {
+		i.errorRatio.Record(1)
+	} else {
+		i.errorRatio.Record(0)
+	}
+}
+
+// AverageLatency returns the average RPC latency in milliseconds.
+func (i *HealthCheckInterceptor) AverageLatency() float64 {
+	return i.latencyAverage.Average()
+}
+
+// ErrorRatio returns the ratio of RPCs that failed with an unhealthy error.
+func (i *HealthCheckInterceptor) ErrorRatio() float64 {
+	return i.errorRatio.Average()
+}
+
+func isUnhealthyRPCError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if common.IsContextDeadlineExceededErr(err) || common.IsContextCanceledErr(err) {
+		return true
+	}
+
+	switch err.(type) {
+	case *serviceerror.Unavailable,
+		*serviceerror.DeadlineExceeded,
+		*serviceerror.Internal:
+		return true
+	default:
+		return false
+	}
+}
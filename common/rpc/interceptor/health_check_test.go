@@ -0,0 +1,65 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/api"
+)
+
+func TestHealthCheckInterceptor(t *testing.T) {
+	i := NewHealthCheckInterceptor(time.Minute, 100, map[string]struct{}{"PollMutableState": {}})
+
+	call := func(method string, err error) {
+		_, _ = i.Intercept(
+			context.Background(),
+			nil,
+			&grpc.UnaryServerInfo{FullMethod: api.HistoryServicePrefix + method},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, err
+			},
+		)
+	}
+
+	call("RecordActivityTaskStarted", nil)
+	call("RecordActivityTaskStarted", serviceerror.NewNotFound("not found"))
+	call("RecordActivityTaskStarted", serviceerror.NewUnavailable("unavailable"))
+	call("RecordActivityTaskStarted", context.DeadlineExceeded)
+	require.Equal(t, 0.5, i.ErrorRatio())
+
+	// excluded APIs are not recorded
+	call("PollMutableState", serviceerror.NewUnavailable("unavailable"))
+	require.Equal(t, 0.5, i.ErrorRatio())
+
+	i.Record(time.Second, nil)
+	require.Greater(t, i.AverageLatency(), float64(0))
+}
@@ -36,6 +36,8 @@ import (
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/namespace"
 )
 
@@ -44,16 +46,19 @@ var errorFrontendMasked = "something went wrong, please retry"
 type MaskInternalErrorDetailsInterceptor struct {
 	maskInternalError dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	namespaceRegistry namespace.Registry
+	logger            log.Logger
 }
 
 func NewMaskInternalErrorDetailsInterceptor(
 	maskErrorSetting dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	namespaceRegistry namespace.Registry,
+	logger log.Logger,
 ) *MaskInternalErrorDetailsInterceptor {
 
 	return &MaskInternalErrorDetailsInterceptor{
 		maskInternalError: maskErrorSetting,
 		namespaceRegistry: namespaceRegistry,
+		logger:            logger,
 	}
 }
 
@@ -67,7 +72,16 @@ func (i *MaskInternalErrorDetailsInterceptor) Intercept(
 	resp, err := handler(ctx, req)
 
 	if err != nil && i.shouldMaskErrors(req) {
-		err = maskUnknownOrInternalErrors(err)
+		maskedErr, correlationID := maskUnknownOrInternalErrors(err)
+		if correlationID != "" {
+			// the correlation id is part of the masked message, log it with the original error so the
+			// error a caller sees can be traced back to it
+			i.logger.Warn("Masked internal error details",
+				tag.ErrorCorrelationID(correlationID),
+				tag.Error(err),
+			)
+		}
+		err = maskedErr
 	}
 	return resp, err
 }
@@ -80,15 +94,17 @@ func (i *MaskInternalErrorDetailsInterceptor) shouldMaskErrors(req any) bool {
 	return i.maskInternalError(ns.String())
 }
 
-func maskUnknownOrInternalErrors(err error) error {
+// maskUnknownOrInternalErrors returns the masked error along with the correlation id included in its message, the
+// correlation id is empty if the error is not masked.
+func maskUnknownOrInternalErrors(err error) (error, string) {
 	st := serviceerror.ToStatus(err)
 
 	if st.Code() != codes.Unknown && st.Code() != codes.Internal {
-		return err
+		return err, ""
 	}
 
 	// convert internal and unknown errors into neutral error with hash code of the original error
 	errorHash := common.ErrorHash(err)
 	maskedErrorMessage := fmt.Sprintf("%s (%s)", errorFrontendMasked, errorHash)
-	return status.New(st.Code(), maskedErrorMessage).Err()
+	return status.New(st.Code(), maskedErrorMessage).Err(), errorHash
 }
@@ -25,7 +25,9 @@
 package interceptor
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -34,10 +36,13 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/namespace"
 
 	"go.temporal.io/api/serviceerror"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -56,13 +61,15 @@ func TestMaskUnknownOrInternalErrors(t *testing.T) {
 
 func testMaskUnknownOrInternalErrors(t *testing.T, st *status.Status, expectRelpace bool) {
 	err := serviceerror.FromStatus(st)
-	errorMessage := maskUnknownOrInternalErrors(err)
+	errorMessage, correlationID := maskUnknownOrInternalErrors(err)
 	if expectRelpace {
 		errorHash := common.ErrorHash(err)
 		expectedMessage := fmt.Sprintf("rpc error: code = %s desc = %s (%s)", st.Message(), errorFrontendMasked, errorHash)
 
 		assert.Equal(t, expectedMessage, errorMessage.Error())
+		assert.Equal(t, errorHash, correlationID)
 	} else {
+		assert.Empty(t, correlationID)
 		if err == nil {
 			assert.Equal(t, errorMessage, nil)
 		} else {
@@ -75,7 +82,7 @@ func TestMaskInternalErrorDetailsInterceptor(t *testing.T) {
 
 	mockRegistry := namespace.NewMockRegistry(gomock.NewController(t))
 	dc := dynamicconfig.NewNoopCollection()
-	errorMask := NewMaskInternalErrorDetailsInterceptor(dynamicconfig.FrontendMaskInternalErrorDetails.Get(dc), mockRegistry)
+	errorMask := NewMaskInternalErrorDetailsInterceptor(dynamicconfig.FrontendMaskInternalErrorDetails.Get(dc), mockRegistry, log.NewNoopLogger())
 
 	test_namespace := "test-namespace"
 	req := &workflowservice.StartWorkflowExecutionRequest{Namespace: test_namespace}
@@ -95,3 +102,47 @@ func TestMaskInternalErrorDetailsInterceptor(t *testing.T) {
 	var ei interface{}
 	assert.False(t, errorMask.shouldMaskErrors(ei))
 }
+
+func TestMaskInternalErrorDetailsInterceptor_LogsCorrelationID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRegistry := namespace.NewMockRegistry(ctrl)
+	mockLogger := log.NewMockLogger(ctrl)
+	errorMask := NewMaskInternalErrorDetailsInterceptor(
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
+		mockRegistry,
+		mockLogger,
+	)
+
+	testNamespace := "test-namespace"
+	req := &workflowservice.StartWorkflowExecutionRequest{Namespace: testNamespace}
+	mockRegistry.EXPECT().GetNamespace(namespace.Name(testNamespace)).Return(&namespace.Namespace{}, nil).AnyTimes()
+
+	internalErr := serviceerror.NewInternal("database connection refused: 10.0.0.1:5432")
+	var loggedCorrelationID string
+	var loggedErr any
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any()).Do(func(_ string, tags ...tag.Tag) {
+		for _, tg := range tags {
+			switch tg.Key() {
+			case tag.ErrorCorrelationID("").Key():
+				loggedCorrelationID = tg.Value().(string)
+			case tag.Error(internalErr).Key():
+				loggedErr = tg.Value()
+			}
+		}
+	})
+
+	_, err := errorMask.Intercept(context.Background(), req, &grpc.UnaryServerInfo{}, func(context.Context, any) (any, error) {
+		return nil, internalErr
+	})
+	assert.Error(t, err)
+	assert.NotContains(t, err.Error(), "database connection refused")
+	assert.NotEmpty(t, loggedCorrelationID)
+	assert.True(t, strings.HasSuffix(err.Error(), fmt.Sprintf("(%s)", loggedCorrelationID)))
+	assert.Equal(t, internalErr.Error(), loggedErr)
+
+	// errors which are not masked are not logged
+	_, err = errorMask.Intercept(context.Background(), req, &grpc.UnaryServerInfo{}, func(context.Context, any) (any, error) {
+		return nil, serviceerror.NewInvalidArgument("invalid")
+	})
+	assert.Equal(t, serviceerror.NewInvalidArgument("invalid"), err)
+}
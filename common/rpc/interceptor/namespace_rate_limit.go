@@ -80,7 +80,7 @@ func (ni *NamespaceRateLimitInterceptor) Intercept(
 ) (interface{}, error) {
 	if ns := MustGetNamespaceName(ni.namespaceRegistry, req); ns != namespace.EmptyName {
 		md, _ := metadata.FromIncomingContext(ctx)
-		if err := ni.Allow(ns, info.FullMethod, headers.GRPCHeaderGetter{Metadata: md}); err != nil {
+		if err := ni.Allow(ns, rateLimitAPIName(info.FullMethod, req), headers.GRPCHeaderGetter{Metadata: md}); err != nil {
 			return nil, err
 		}
 	}
@@ -80,6 +80,8 @@ var (
 		"AddTasks":                  {},
 		"ListQueues":                {},
 		"ListTasks":                 {},
+		"CompactQueueSlices":        {},
+		"DescribeHealth":            {},
 		// NamespaceId is in the completion token for this request.
 		"CompleteNexusOperation": {},
 	}
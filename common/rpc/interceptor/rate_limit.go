@@ -30,15 +30,22 @@ import (
 
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/namespace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
+	"go.temporal.io/server/common/api"
 	"go.temporal.io/server/common/quotas"
 )
 
 const (
 	RateLimitDefaultToken = 1
+
+	// PollWorkflowHistoryAPIName is the API name used to rate limit GetWorkflowExecutionHistory requests waiting for
+	// new events, so they can be prioritized separately from regular history reads.
+	PollWorkflowHistoryAPIName = api.WorkflowServicePrefix + "PollWorkflowExecutionHistory"
 )
 
 var (
@@ -74,8 +81,12 @@ func (i *RateLimitInterceptor) Intercept(
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler,
 ) (interface{}, error) {
+	var namespaceName namespace.Name
+	if request, ok := req.(NamespaceNameGetter); ok {
+		namespaceName = namespace.Name(request.GetNamespace())
+	}
 	md, _ := metadata.FromIncomingContext(ctx)
-	if err := i.Allow(info.FullMethod, headers.GRPCHeaderGetter{Metadata: md}); err != nil {
+	if err := i.Allow(namespaceName, rateLimitAPIName(info.FullMethod, req), headers.GRPCHeaderGetter{Metadata: md}); err != nil {
 		return nil, err
 	}
 
@@ -83,6 +94,7 @@ func (i *RateLimitInterceptor) Intercept(
 }
 
 func (i *RateLimitInterceptor) Allow(
+	namespaceName namespace.Name,
 	methodName string,
 	headerGetter headers.HeaderGetter,
 ) error {
@@ -99,7 +111,7 @@ func (i *RateLimitInterceptor) Allow(
 	if !i.rateLimiter.Allow(time.Now().UTC(), quotas.NewRequest(
 		methodName,
 		token,
-		"", // this interceptor layer does not throttle based on caller name
+		namespaceName.String(), // caller name is only used to prioritize requests, not to throttle them
 		headerGetter.Get(headers.CallerTypeHeaderName),
 		0,  // this interceptor layer does not throttle based on caller segment
 		"", // this interceptor layer does not throttle based on call initiation
@@ -108,3 +120,11 @@ func (i *RateLimitInterceptor) Allow(
 	}
 	return nil
 }
+
+// rateLimitAPIName returns the API name the rate limiters classify the request by.
+func rateLimitAPIName(methodName string, req any) string {
+	if request, ok := req.(*workflowservice.GetWorkflowExecutionHistoryRequest); ok && request.GetWaitNewEvent() {
+		return PollWorkflowHistoryAPIName
+	}
+	return methodName
+}
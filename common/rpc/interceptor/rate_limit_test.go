@@ -27,12 +27,15 @@ package interceptor
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/workflowservice/v1"
 	"google.golang.org/grpc"
 
+	"go.temporal.io/server/common/api"
 	"go.temporal.io/server/common/quotas"
 )
 
@@ -103,3 +106,42 @@ func (s *rateLimitInterceptorSuite) TestInterceptWithNonZeroTokenConfig() {
 	s.NoError(err)
 	s.True(handlerCalled)
 }
+
+func (s *rateLimitInterceptorSuite) TestInterceptClassifiesHistoryLongPoll() {
+	methodName := api.WorkflowServicePrefix + "GetWorkflowExecutionHistory"
+	interceptor := NewRateLimitInterceptor(s.mockRateLimiter, nil)
+	var apiNames []string
+	s.mockRateLimiter.EXPECT().Allow(gomock.Any(), gomock.Any()).DoAndReturn(func(_ time.Time, req quotas.Request) bool {
+		apiNames = append(apiNames, req.API)
+		return true
+	}).Times(2)
+
+	handler := func(ctx context.Context, req any) (any, error) {
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: methodName}
+	_, err := interceptor.Intercept(context.Background(), &workflowservice.GetWorkflowExecutionHistoryRequest{}, info, handler)
+	s.NoError(err)
+	_, err = interceptor.Intercept(context.Background(), &workflowservice.GetWorkflowExecutionHistoryRequest{WaitNewEvent: true}, info, handler)
+	s.NoError(err)
+	s.Equal([]string{methodName, PollWorkflowHistoryAPIName}, apiNames)
+}
+
+func (s *rateLimitInterceptorSuite) TestInterceptPassesNamespaceAsCaller() {
+	interceptor := NewRateLimitInterceptor(s.mockRateLimiter, nil)
+	var callers []string
+	s.mockRateLimiter.EXPECT().Allow(gomock.Any(), gomock.Any()).DoAndReturn(func(_ time.Time, req quotas.Request) bool {
+		callers = append(callers, req.Caller)
+		return true
+	}).Times(2)
+
+	handler := func(ctx context.Context, req any) (any, error) {
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: api.WorkflowServicePrefix + "GetWorkflowExecutionHistory"}
+	_, err := interceptor.Intercept(context.Background(), &workflowservice.GetWorkflowExecutionHistoryRequest{Namespace: "test-namespace"}, info, handler)
+	s.NoError(err)
+	_, err = interceptor.Intercept(context.Background(), nil, info, handler)
+	s.NoError(err)
+	s.Equal([]string{"test-namespace", ""}, callers)
+}
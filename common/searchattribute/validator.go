@@ -32,6 +32,9 @@ import (
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -40,12 +43,13 @@ import (
 type (
 	// Validator is used to validate search attributes
 	Validator struct {
-		searchAttributesProvider          Provider
-		searchAttributesMapperProvider    MapperProvider
-		searchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithNamespaceFilter
-		searchAttributesSizeOfValueLimit  dynamicconfig.IntPropertyFnWithNamespaceFilter
-		searchAttributesTotalSizeLimit    dynamicconfig.IntPropertyFnWithNamespaceFilter
-		visibilityManager                 manager.VisibilityManager
+		searchAttributesProvider              Provider
+		searchAttributesMapperProvider        MapperProvider
+		searchAttributesNumberOfKeysLimit     dynamicconfig.IntPropertyFnWithNamespaceFilter
+		searchAttributesNumberOfKeysLimitWarn dynamicconfig.IntPropertyFnWithNamespaceFilter
+		searchAttributesSizeOfValueLimit      dynamicconfig.IntPropertyFnWithNamespaceFilter
+		searchAttributesTotalSizeLimit        dynamicconfig.IntPropertyFnWithNamespaceFilter
+		visibilityManager                     manager.VisibilityManager
 
 		// allowList allows list of values when it's not keyword list type.
 		allowList dynamicconfig.BoolPropertyFnWithNamespaceFilter
@@ -53,6 +57,9 @@ type (
 		// suppressErrorSetSystemSearchAttribute suppresses errors when the user
 		// attempts to set values in system search attributes.
 		suppressErrorSetSystemSearchAttribute dynamicconfig.BoolPropertyFnWithNamespaceFilter
+
+		metricsHandler metrics.Handler
+		logger         log.Logger
 	}
 )
 
@@ -61,21 +68,27 @@ func NewValidator(
 	searchAttributesProvider Provider,
 	searchAttributesMapperProvider MapperProvider,
 	searchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithNamespaceFilter,
+	searchAttributesNumberOfKeysLimitWarn dynamicconfig.IntPropertyFnWithNamespaceFilter,
 	searchAttributesSizeOfValueLimit dynamicconfig.IntPropertyFnWithNamespaceFilter,
 	searchAttributesTotalSizeLimit dynamicconfig.IntPropertyFnWithNamespaceFilter,
 	visibilityManager manager.VisibilityManager,
 	allowList dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	suppressErrorSetSystemSearchAttribute dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
 ) *Validator {
 	return &Validator{
 		searchAttributesProvider:              searchAttributesProvider,
 		searchAttributesMapperProvider:        searchAttributesMapperProvider,
 		searchAttributesNumberOfKeysLimit:     searchAttributesNumberOfKeysLimit,
+		searchAttributesNumberOfKeysLimitWarn: searchAttributesNumberOfKeysLimitWarn,
 		searchAttributesSizeOfValueLimit:      searchAttributesSizeOfValueLimit,
 		searchAttributesTotalSizeLimit:        searchAttributesTotalSizeLimit,
 		visibilityManager:                     visibilityManager,
 		allowList:                             allowList,
 		suppressErrorSetSystemSearchAttribute: suppressErrorSetSystemSearchAttribute,
+		metricsHandler:                        metricsHandler,
+		logger:                                logger,
 	}
 }
 
@@ -96,6 +109,14 @@ func (v *Validator) Validate(searchAttributes *commonpb.SearchAttributes, namesp
 			),
 		)
 	}
+	if warnLimit := v.searchAttributesNumberOfKeysLimitWarn(namespace); warnLimit > 0 && lengthOfFields > warnLimit {
+		v.logger.Warn("Number of search attributes exceeds the warning limit.",
+			tag.WorkflowNamespace(namespace),
+			tag.NewInt("search-attributes-count", lengthOfFields),
+			tag.NewInt("search-attributes-count-warn-limit", warnLimit),
+		)
+		metrics.SearchAttributesNumberOfKeysWarn.With(v.metricsHandler).Record(1, metrics.NamespaceTag(namespace))
+	}
 
 	saTypeMap, err := v.searchAttributesProvider.GetSearchAttributes(
 		v.visibilityManager.GetIndexName(),
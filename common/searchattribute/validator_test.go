@@ -32,6 +32,9 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 )
@@ -68,11 +71,14 @@ func (s *searchAttributesValidatorSuite) TestSearchAttributesValidate() {
 		NewTestProvider(),
 		NewTestMapperProvider(nil),
 		dynamicconfig.GetIntPropertyFnFilteredByNamespace(numOfKeysLimit),
+		dynamicconfig.GetIntPropertyFnFilteredByNamespace(0),
 		dynamicconfig.GetIntPropertyFnFilteredByNamespace(sizeOfValueLimit),
 		dynamicconfig.GetIntPropertyFnFilteredByNamespace(sizeOfTotalLimit),
 		s.mockVisibilityManager,
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
 	)
 
 	namespace := "namespace"
@@ -137,6 +143,48 @@ func (s *searchAttributesValidatorSuite) TestSearchAttributesValidate() {
 	s.Equal("StartTime attribute can't be set in SearchAttributes", err.Error())
 }
 
+func (s *searchAttributesValidatorSuite) TestSearchAttributesValidate_NumberOfKeysWarn() {
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	defer metricsHandler.StopCapture(capture)
+
+	saValidator := NewValidator(
+		NewTestProvider(),
+		NewTestMapperProvider(nil),
+		dynamicconfig.GetIntPropertyFnFilteredByNamespace(3),
+		dynamicconfig.GetIntPropertyFnFilteredByNamespace(1),
+		dynamicconfig.GetIntPropertyFnFilteredByNamespace(5),
+		dynamicconfig.GetIntPropertyFnFilteredByNamespace(20),
+		s.mockVisibilityManager,
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		metricsHandler,
+		log.NewNoopLogger(),
+	)
+
+	namespace := "namespace"
+	intPayload, err := payload.Encode(1)
+	s.NoError(err)
+
+	attr := &commonpb.SearchAttributes{
+		IndexedFields: map[string]*commonpb.Payload{
+			"CustomIntField": intPayload,
+		},
+	}
+	s.NoError(saValidator.Validate(attr, namespace))
+	s.Empty(capture.Snapshot()[metrics.SearchAttributesNumberOfKeysWarn.Name()])
+
+	attr.IndexedFields = map[string]*commonpb.Payload{
+		"CustomIntField":     intPayload,
+		"CustomKeywordField": payload.EncodeString("keyword"),
+	}
+	s.NoError(saValidator.Validate(attr, namespace))
+	recordings := capture.Snapshot()[metrics.SearchAttributesNumberOfKeysWarn.Name()]
+	s.Len(recordings, 1)
+	s.Equal(int64(1), recordings[0].Value)
+	s.Equal(namespace, recordings[0].Tags["namespace"])
+}
+
 func (s *searchAttributesValidatorSuite) TestSearchAttributesValidate_SuppressError() {
 	numOfKeysLimit := 2
 	sizeOfValueLimit := 5
@@ -146,11 +194,14 @@ func (s *searchAttributesValidatorSuite) TestSearchAttributesValidate_SuppressEr
 		NewTestProvider(),
 		NewTestMapperProvider(nil),
 		dynamicconfig.GetIntPropertyFnFilteredByNamespace(numOfKeysLimit),
+		dynamicconfig.GetIntPropertyFnFilteredByNamespace(0),
 		dynamicconfig.GetIntPropertyFnFilteredByNamespace(sizeOfValueLimit),
 		dynamicconfig.GetIntPropertyFnFilteredByNamespace(sizeOfTotalLimit),
 		s.mockVisibilityManager,
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
 	)
 
 	namespace := "namespace"
@@ -173,11 +224,14 @@ func (s *searchAttributesValidatorSuite) TestSearchAttributesValidate_Mapper() {
 		NewTestProvider(),
 		NewTestMapperProvider(&TestMapper{}),
 		dynamicconfig.GetIntPropertyFnFilteredByNamespace(numOfKeysLimit),
+		dynamicconfig.GetIntPropertyFnFilteredByNamespace(0),
 		dynamicconfig.GetIntPropertyFnFilteredByNamespace(sizeOfValueLimit),
 		dynamicconfig.GetIntPropertyFnFilteredByNamespace(sizeOfTotalLimit),
 		s.mockVisibilityManager,
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
 	)
 
 	namespace := "test-namespace"
@@ -237,11 +291,14 @@ func (s *searchAttributesValidatorSuite) TestSearchAttributesValidateSize() {
 		NewTestProvider(),
 		NewTestMapperProvider(nil),
 		dynamicconfig.GetIntPropertyFnFilteredByNamespace(numOfKeysLimit),
+		dynamicconfig.GetIntPropertyFnFilteredByNamespace(0),
 		dynamicconfig.GetIntPropertyFnFilteredByNamespace(sizeOfValueLimit),
 		dynamicconfig.GetIntPropertyFnFilteredByNamespace(sizeOfTotalLimit),
 		s.mockVisibilityManager,
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
 	)
 
 	namespace := "namespace"
@@ -277,11 +334,14 @@ func (s *searchAttributesValidatorSuite) TestSearchAttributesValidateSize_Mapper
 		NewTestProvider(),
 		NewTestMapperProvider(&TestMapper{}),
 		dynamicconfig.GetIntPropertyFnFilteredByNamespace(numOfKeysLimit),
+		dynamicconfig.GetIntPropertyFnFilteredByNamespace(0),
 		dynamicconfig.GetIntPropertyFnFilteredByNamespace(sizeOfValueLimit),
 		dynamicconfig.GetIntPropertyFnFilteredByNamespace(sizeOfTotalLimit),
 		s.mockVisibilityManager,
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
 	)
 
 	namespace := "test-namespace"
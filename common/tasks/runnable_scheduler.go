@@ -26,6 +26,7 @@ import (
 	"context"
 	"time"
 
+	"go.temporal.io/server/common/locks"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/quotas"
 )
@@ -72,9 +73,18 @@ func NewRateLimitedTaskRunnableFromTask(
 	task Task,
 	limiter quotas.RateLimiter,
 	metricsHandler metrics.Handler,
+) RateLimitedTaskRunnable {
+	return NewRateLimitedTaskRunnable(RunnableTask{task}, limiter, metricsHandler)
+}
+
+// NewRateLimitedTaskRunnable creates a [RateLimitedTaskRunnable] from a [Runnable] and a [rate.Limiter].
+func NewRateLimitedTaskRunnable(
+	runnable Runnable,
+	limiter quotas.RateLimiter,
+	metricsHandler metrics.Handler,
 ) RateLimitedTaskRunnable {
 	return RateLimitedTaskRunnable{
-		Runnable: RunnableTask{task},
+		Runnable: runnable,
 		Limiter:  limiter,
 
 		metricsHandler: metricsHandler,
@@ -92,3 +102,21 @@ func (r RateLimitedTaskRunnable) Run(ctx context.Context) {
 	metrics.RateLimitedTaskRunnableWaitTime.With(r.metricsHandler).Record(time.Since(t0))
 	r.Runnable.Run(ctx)
 }
+
+// PrioritySemaphoreRunnable wraps a [Runnable] with a semaphore shared between runnables. When the semaphore is
+// contended, runnables with a higher priority acquire it first.
+type PrioritySemaphoreRunnable struct {
+	Runnable
+	Semaphore locks.PrioritySemaphore
+	Priority  locks.Priority
+}
+
+// Run the embedded [Runnable] while holding the semaphore.
+func (r PrioritySemaphoreRunnable) Run(ctx context.Context) {
+	if err := r.Semaphore.Acquire(ctx, r.Priority, 1); err != nil {
+		r.Abort()
+		return
+	}
+	defer r.Semaphore.Release(1)
+	r.Runnable.Run(ctx)
+}
@@ -689,6 +689,29 @@ func OverrideWorkflowTaskTimeout(
 	return min(taskStartToCloseTimeout, workflowRunTimeout)
 }
 
+// EnforceMaxWorkflowTaskTimeout applies the namespace's workflow task timeout ceiling to taskStartToCloseTimeout,
+// the timeout after defaults are applied. A timeout above the ceiling is clamped to it, unless it was requested
+// explicitly (requestedTimeout is set) and rejection is enabled for the namespace.
+// A ceiling of zero disables the check.
+func EnforceMaxWorkflowTaskTimeout(
+	namespace string,
+	requestedTimeout time.Duration,
+	taskStartToCloseTimeout time.Duration,
+	getMaxTimeoutFunc func(namespace string) time.Duration,
+	getRejectAboveMaxFunc func(namespace string) bool,
+) (time.Duration, error) {
+
+	maxTimeout := getMaxTimeoutFunc(namespace)
+	if maxTimeout <= 0 || taskStartToCloseTimeout <= maxTimeout {
+		return taskStartToCloseTimeout, nil
+	}
+
+	if requestedTimeout != 0 && getRejectAboveMaxFunc(namespace) {
+		return 0, serviceerror.NewInvalidArgument(fmt.Sprintf("WorkflowTaskTimeout %v exceeds namespace limit %v.", taskStartToCloseTimeout, maxTimeout))
+	}
+	return maxTimeout, nil
+}
+
 // CloneProto is a generic typed version of proto.Clone from proto.
 func CloneProto[T proto.Message](v T) T {
 	return proto.Clone(v).(T)
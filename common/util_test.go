@@ -140,6 +140,34 @@ func TestOverrideWorkflowTaskTimeout_Finite(t *testing.T) {
 	require.Equal(t, MaxWorkflowTaskStartToCloseTimeout, OverrideWorkflowTaskTimeout("random domain", taskTimeout, runTimeout, defaultTimeoutFn))
 }
 
+func TestEnforceMaxWorkflowTaskTimeout(t *testing.T) {
+	maxTimeoutFn := dynamicconfig.GetDurationPropertyFnFilteredByNamespace(time.Duration(30))
+	clampFn := dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false)
+	rejectFn := dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+
+	timeout, err := EnforceMaxWorkflowTaskTimeout("random domain", time.Duration(20), time.Duration(20), maxTimeoutFn, rejectFn)
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(20), timeout)
+
+	timeout, err = EnforceMaxWorkflowTaskTimeout("random domain", time.Duration(40), time.Duration(40), maxTimeoutFn, clampFn)
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(30), timeout)
+
+	_, err = EnforceMaxWorkflowTaskTimeout("random domain", time.Duration(40), time.Duration(40), maxTimeoutFn, rejectFn)
+	var invalidArgErr *serviceerror.InvalidArgument
+	require.ErrorAs(t, err, &invalidArgErr)
+
+	// a defaulted timeout is clamped even if rejection is enabled
+	timeout, err = EnforceMaxWorkflowTaskTimeout("random domain", 0, time.Duration(40), maxTimeoutFn, rejectFn)
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(30), timeout)
+
+	noMaxTimeoutFn := dynamicconfig.GetDurationPropertyFnFilteredByNamespace(0)
+	timeout, err = EnforceMaxWorkflowTaskTimeout("random domain", time.Duration(40), time.Duration(40), noMaxTimeoutFn, rejectFn)
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(40), timeout)
+}
+
 func TestMapShardID_ByNamespaceWorkflow_4And16(t *testing.T) {
 	namespaceID := uuid.New()
 	workflowID := uuid.New()
@@ -42,6 +42,7 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/rpc"
@@ -87,6 +88,7 @@ type (
 		serializer           serialization.Serializer
 		rereplicationTimeout dynamicconfig.DurationPropertyFnWithNamespaceIDFilter
 		logger               log.Logger
+		metricsHandler       metrics.Handler
 		config               *configs.Config
 	}
 
@@ -108,6 +110,7 @@ func NewNDCHistoryResender(
 	serializer serialization.Serializer,
 	rereplicationTimeout dynamicconfig.DurationPropertyFnWithNamespaceIDFilter,
 	logger log.Logger,
+	metricsHandler metrics.Handler,
 	config *configs.Config,
 ) *NDCHistoryResenderImpl {
 
@@ -118,6 +121,7 @@ func NewNDCHistoryResender(
 		serializer:           serializer,
 		rereplicationTimeout: rereplicationTimeout,
 		logger:               logger,
+		metricsHandler:       metricsHandler,
 		config:               config,
 	}
 }
@@ -158,11 +162,13 @@ func (n *NDCHistoryResenderImpl) SendSingleWorkflowHistory(
 		endEventVersion,
 	))
 
+	// Unknown namespaces fall back to the global batch count.
+	namespaceName, _ := n.namespaceRegistry.GetNamespaceName(namespaceID)
 	getMaxBatchCount := func() int {
 		if n.config == nil {
 			return 1
 		}
-		return n.config.ReplicationResendMaxBatchCount()
+		return n.config.ReplicationResendMaxBatchCount(namespaceName.String())
 	}
 	var eventsBatch [][]*historypb.HistoryEvent
 	var versionHistory []*historyspb.VersionHistoryItem
@@ -184,6 +190,7 @@ func (n *NDCHistoryResenderImpl) SendSingleWorkflowHistory(
 				tag.Error(err))
 			return err
 		}
+		metrics.ReplicationResendBatches.With(n.metricsHandler).Record(1, metrics.NamespaceTag(namespaceName.String()))
 		eventsBatch = nil
 		versionHistory = nil
 		return nil
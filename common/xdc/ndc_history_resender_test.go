@@ -36,7 +36,6 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
-	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/tests"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -50,6 +49,8 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -70,9 +71,10 @@ type (
 		namespaceID namespace.ID
 		namespace   namespace.Name
 
-		serializer serialization.Serializer
-		logger     log.Logger
-		config     *configs.Config
+		serializer     serialization.Serializer
+		logger         log.Logger
+		metricsHandler *metricstest.CaptureHandler
+		config         *configs.Config
 	}
 )
 
@@ -101,6 +103,7 @@ func (s *nDCHistoryResenderSuite) SetupTest() {
 	s.mockClientBean.EXPECT().GetRemoteAdminClient(gomock.Any()).Return(s.mockAdminClient, nil).AnyTimes()
 
 	s.logger = log.NewTestLogger()
+	s.metricsHandler = metricstest.NewCaptureHandler()
 	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(true).AnyTimes()
 
 	s.namespaceID = namespace.ID(uuid.New())
@@ -120,6 +123,7 @@ func (s *nDCHistoryResenderSuite) SetupTest() {
 	)
 	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.namespaceID).Return(namespaceEntry, nil).AnyTimes()
 	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespaceName(s.namespaceID).Return(s.namespace, nil).AnyTimes()
 	s.serializer = serialization.NewSerializer()
 }
 
@@ -219,6 +223,7 @@ func (s *nDCHistoryResenderSuite) TestSendSingleWorkflowHistory() {
 		serialization.NewSerializer(),
 		nil,
 		s.logger,
+		s.metricsHandler,
 		s.config,
 	)
 
@@ -243,7 +248,14 @@ func (s *nDCHistoryResenderSuite) TestSendSingleWorkflowHistory_Batching() {
 	startEventID := int64(123)
 	startEventVersion := int64(100)
 	pageSize := defaultPageSize
-	s.config.ReplicationResendMaxBatchCount = dynamicconfig.GetIntPropertyFn(2)
+	s.config.ReplicationResendMaxBatchCount = func(namespace string) int {
+		if namespace == s.namespace.String() {
+			return 2
+		}
+		return 100
+	}
+	capture := s.metricsHandler.StartCapture()
+	defer s.metricsHandler.StopCapture(capture)
 
 	eventBatch0 := []*historypb.HistoryEvent{
 		{EventId: 1, Version: 123},
@@ -327,6 +339,7 @@ func (s *nDCHistoryResenderSuite) TestSendSingleWorkflowHistory_Batching() {
 		serialization.NewSerializer(),
 		nil,
 		s.logger,
+		s.metricsHandler,
 		s.config,
 	)
 
@@ -344,6 +357,12 @@ func (s *nDCHistoryResenderSuite) TestSendSingleWorkflowHistory_Batching() {
 	s.Nil(err)
 	s.Equal(3, functionCallTimes)
 	s.Equal(5, len(calledEvents))
+	recordings := capture.Snapshot()[metrics.ReplicationResendBatches.Name()]
+	s.Len(recordings, 3)
+	for _, recording := range recordings {
+		s.Equal(int64(1), recording.Value)
+		s.Equal(s.namespace.String(), recording.Tags[metrics.NamespaceTag("").Key()])
+	}
 	eventId := int64(1)
 	for _, events := range calledEvents {
 		for _, event := range events {
@@ -402,6 +421,7 @@ func (s *nDCHistoryResenderSuite) TestGetHistory() {
 		serialization.NewSerializer(),
 		nil,
 		s.logger,
+		s.metricsHandler,
 		s.config,
 	)
 	out, err := rereplicator.getHistory(
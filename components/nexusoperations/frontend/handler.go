@@ -452,7 +452,7 @@ func (c *requestContext) interceptRequest(ctx context.Context, request *nexus.Co
 		return commonnexus.ConvertGRPCError(err, true)
 	}
 
-	if err := c.RateLimitInterceptor.Allow(apiName, request.HTTPRequest.Header); err != nil {
+	if err := c.RateLimitInterceptor.Allow(c.namespace.Name(), apiName, request.HTTPRequest.Header); err != nil {
 		c.outcomeTag = metrics.NexusOutcomeTag("global_rate_limited")
 		return commonnexus.ConvertGRPCError(err, true)
 	}
@@ -30,7 +30,9 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"slices"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -48,6 +50,7 @@ import (
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.temporal.io/server/api/adminservice/v1"
@@ -56,18 +59,23 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	serverClient "go.temporal.io/server/client"
 	"go.temporal.io/server/client/admin"
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/client/history"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/channel"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -80,8 +88,10 @@ import (
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/tqid"
 	"go.temporal.io/server/common/utf8validator"
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/common/xdc"
@@ -94,6 +104,7 @@ const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
 	listClustersPageSize                    = 100
+	forceResyncNamespaceConcurrency         = 32
 )
 
 type (
@@ -118,6 +129,7 @@ type (
 		clientFactory              serverClient.Factory
 		clientBean                 serverClient.Bean
 		historyClient              historyservice.HistoryServiceClient
+		matchingClient             matchingservice.MatchingServiceClient
 		sdkClientFactory           sdk.ClientFactory
 		membershipMonitor          membership.Monitor
 		hostInfoProvider           membership.HostInfoProvider
@@ -127,6 +139,9 @@ type (
 		saManager                  searchattribute.Manager
 		clusterMetadata            cluster.Metadata
 		healthServer               *health.Server
+		namespaceHandler           *namespaceHandler
+
+		forceResyncNamespaceRateLimiter quotas.RateLimiter
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -148,6 +163,7 @@ type (
 		ClientFactory                       serverClient.Factory
 		ClientBean                          serverClient.Bean
 		HistoryClient                       historyservice.HistoryServiceClient
+		MatchingClient                      matchingservice.MatchingServiceClient
 		sdkClientFactory                    sdk.ClientFactory
 		MembershipMonitor                   membership.Monitor
 		HostInfoProvider                    membership.HostInfoProvider
@@ -159,11 +175,19 @@ type (
 		HealthServer                        *health.Server
 		EventSerializer                     serialization.Serializer
 		TimeSource                          clock.TimeSource
+		ArchivalMetadata                    archiver.ArchivalMetadata
+		ArchiverProvider                    provider.ArchiverProvider
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
 		CategoryRegistry tasks.TaskCategoryRegistry
 	}
+
+	// historyHostShard is a history host and one of the shards it owns, used to route a request to the host
+	historyHostShard struct {
+		address string
+		shardID int32
+	}
 )
 
 var (
@@ -203,6 +227,7 @@ func NewAdminHandler(
 		clientFactory:              args.ClientFactory,
 		clientBean:                 args.ClientBean,
 		historyClient:              args.HistoryClient,
+		matchingClient:             args.MatchingClient,
 		sdkClientFactory:           args.sdkClientFactory,
 		membershipMonitor:          args.MembershipMonitor,
 		hostInfoProvider:           args.HostInfoProvider,
@@ -213,6 +238,23 @@ func NewAdminHandler(
 		clusterMetadata:            args.ClusterMetadata,
 		healthServer:               args.HealthServer,
 		taskCategoryRegistry:       args.CategoryRegistry,
+		namespaceHandler: newNamespaceHandler(
+			args.Config.MaxBadBinaries,
+			args.Config.MaxNamespaceFailoverHistorySize,
+			args.Config.RegisterNamespaceIfNotExists,
+			args.Logger,
+			args.PersistenceMetadataManager,
+			args.ClusterMetadata,
+			namespace.NewNamespaceReplicator(args.ReplicatorNamespaceReplicationQueue, args.Logger),
+			args.ArchivalMetadata,
+			args.ArchiverProvider,
+			args.Config.EnableSchedules,
+			args.TimeSource,
+		),
+
+		forceResyncNamespaceRateLimiter: quotas.NewDefaultIncomingRateLimiter(
+			func() float64 { return args.Config.AdminForceResyncNamespaceRPS() },
+		),
 	}
 }
 
@@ -751,6 +793,38 @@ func (adh *AdminHandler) DescribeMutableState(ctx context.Context, request *admi
 	}, nil
 }
 
+// DescribeWorkflowUpdates returns the update counts of a workflow execution
+func (adh *AdminHandler) DescribeWorkflowUpdates(ctx context.Context, request *adminservice.DescribeWorkflowUpdatesRequest) (_ *adminservice.DescribeWorkflowUpdatesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	historyResponse, err := adh.historyClient.DescribeWorkflowUpdates(ctx, &historyservice.DescribeWorkflowUpdatesRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeWorkflowUpdatesResponse{
+		InFlightUpdates:    historyResponse.GetInFlightUpdates(),
+		TotalUpdates:       historyResponse.GetTotalUpdates(),
+		MaxInFlightUpdates: historyResponse.GetMaxInFlightUpdates(),
+		MaxTotalUpdates:    historyResponse.GetMaxTotalUpdates(),
+	}, nil
+}
+
 // RemoveTask returns information about the internal states of a history host
 func (adh *AdminHandler) RemoveTask(ctx context.Context, request *adminservice.RemoveTaskRequest) (_ *adminservice.RemoveTaskResponse, retError error) {
 	defer log.CapturePanic(adh.logger, &retError)
@@ -791,6 +865,409 @@ func (adh *AdminHandler) CloseShard(ctx context.Context, request *adminservice.C
 	return &adminservice.CloseShardResponse{}, err
 }
 
+// CompactQueueSlices forces the queue of a task category in a shard to merge its slices
+func (adh *AdminHandler) CompactQueueSlices(
+	ctx context.Context,
+	request *adminservice.CompactQueueSlicesRequest,
+) (_ *adminservice.CompactQueueSlicesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	resp, err := adh.historyClient.CompactQueueSlices(ctx, &historyservice.CompactQueueSlicesRequest{
+		ShardId:  request.GetShardId(),
+		Category: request.GetCategory(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.CompactQueueSlicesResponse{SliceCount: resp.GetSliceCount()}, nil
+}
+
+// DescribeOutboundDestination returns the outbound queue circuit breakers of a namespace and destination. Circuit
+// breakers are kept per history host, so every history host is described unless the request selects a shard.
+func (adh *AdminHandler) DescribeOutboundDestination(
+	ctx context.Context,
+	request *adminservice.DescribeOutboundDestinationRequest,
+) (_ *adminservice.DescribeOutboundDestinationResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	hosts, err := adh.historyHostShards(request.GetShardId())
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*adminservice.OutboundDestinationHostInfo, len(hosts))
+	for i, host := range hosts {
+		results[i] = &adminservice.OutboundDestinationHostInfo{Address: host.address, ShardId: host.shardID}
+	}
+	adh.forEachHistoryHost(hosts, func(i int, host historyHostShard) error {
+		resp, err := adh.historyClient.DescribeOutboundDestination(ctx, &historyservice.DescribeOutboundDestinationRequest{
+			NamespaceId: namespaceID.String(),
+			Destination: request.GetDestination(),
+			ShardId:     host.shardID,
+		})
+		if err != nil {
+			return err
+		}
+		results[i].CircuitBreakers = resp.GetCircuitBreakers()
+		return nil
+	}, func(i int, err error) {
+		results[i].Failure = failure.NewFailureFromError(err)
+	})
+	return &adminservice.DescribeOutboundDestinationResponse{Hosts: results}, nil
+}
+
+// ResetOutboundCircuitBreaker closes the outbound queue circuit breakers of a namespace and destination on every
+// history host, or only on the host owning the shard selected by the request
+func (adh *AdminHandler) ResetOutboundCircuitBreaker(
+	ctx context.Context,
+	request *adminservice.ResetOutboundCircuitBreakerRequest,
+) (_ *adminservice.ResetOutboundCircuitBreakerResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	hosts, err := adh.historyHostShards(request.GetShardId())
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*adminservice.ResetOutboundCircuitBreakerHostResult, len(hosts))
+	for i, host := range hosts {
+		results[i] = &adminservice.ResetOutboundCircuitBreakerHostResult{Address: host.address, ShardId: host.shardID}
+	}
+	adh.forEachHistoryHost(hosts, func(i int, host historyHostShard) error {
+		_, err := adh.historyClient.ResetOutboundCircuitBreaker(ctx, &historyservice.ResetOutboundCircuitBreakerRequest{
+			NamespaceId: namespaceID.String(),
+			Destination: request.GetDestination(),
+			ShardId:     host.shardID,
+		})
+		return err
+	}, func(i int, err error) {
+		results[i].Failure = failure.NewFailureFromError(err)
+	})
+	return &adminservice.ResetOutboundCircuitBreakerResponse{Hosts: results}, nil
+}
+
+// historyHostShards returns one shard owned by each history host, so that a request routed by shard reaches every
+// host once. If shardID is set, only the host owning it is returned. Shards moving between hosts while the request
+// is sent can make it reach a host twice or miss one.
+func (adh *AdminHandler) historyHostShards(shardID int32) ([]historyHostShard, error) {
+	if shardID < 0 || shardID > adh.numberOfHistoryShards {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid shard ID: %v", shardID))
+	}
+	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
+	if err != nil {
+		return nil, err
+	}
+
+	firstShardID, lastShardID := int32(1), adh.numberOfHistoryShards
+	if shardID != 0 {
+		firstShardID, lastShardID = shardID, shardID
+	}
+	var hosts []historyHostShard
+	seen := make(map[string]struct{})
+	for id := firstShardID; id <= lastShardID; id++ {
+		host, err := resolver.Lookup(convert.Int32ToString(id))
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[host.GetAddress()]; ok {
+			continue
+		}
+		seen[host.GetAddress()] = struct{}{}
+		hosts = append(hosts, historyHostShard{address: host.GetAddress(), shardID: id})
+	}
+	return hosts, nil
+}
+
+// forEachHistoryHost calls fn concurrently for each host, and onError for each host fn failed or panicked for
+func (adh *AdminHandler) forEachHistoryHost(
+	hosts []historyHostShard,
+	fn func(i int, host historyHostShard) error,
+	onError func(i int, err error),
+) {
+	var wg sync.WaitGroup
+	for i, host := range hosts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := func() (retError error) {
+				defer log.CapturePanic(adh.logger, &retError)
+				return fn(i, host)
+			}()
+			if err != nil {
+				onError(i, err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// UnloadTaskQueuePartition unloads a task queue partition from the matching host owning it, it will be reloaded
+// on next access
+func (adh *AdminHandler) UnloadTaskQueuePartition(
+	ctx context.Context,
+	request *adminservice.UnloadTaskQueuePartitionRequest,
+) (_ *adminservice.UnloadTaskQueuePartitionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	taskQueue, err := tqid.NewTaskQueueFamily(namespaceID.String(), request.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	if request.GetPartitionId() < 0 {
+		return nil, serviceerror.NewInvalidArgument("partition ID must not be negative")
+	}
+	partition := taskQueue.TaskQueue(request.GetTaskQueueType()).NormalPartition(int(request.GetPartitionId()))
+
+	resp, err := adh.matchingClient.ForceUnloadTaskQueue(ctx, &matchingservice.ForceUnloadTaskQueueRequest{
+		NamespaceId:   namespaceID.String(),
+		TaskQueue:     partition.RpcName(),
+		TaskQueueType: request.GetTaskQueueType(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UnloadTaskQueuePartitionResponse{WasLoaded: resp.GetWasLoaded()}, nil
+}
+
+// ListTaskQueuePollers returns the pollers recently seen by a task queue partition, it is served from the poller
+// history of the matching host owning the partition
+func (adh *AdminHandler) ListTaskQueuePollers(
+	ctx context.Context,
+	request *adminservice.ListTaskQueuePollersRequest,
+) (_ *adminservice.ListTaskQueuePollersResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tqid.NewTaskQueueFamily(namespaceID.String(), request.GetTaskQueue()); err != nil {
+		return nil, err
+	}
+	if request.GetPartitionId() < 0 {
+		return nil, serviceerror.NewInvalidArgument("partition ID must not be negative")
+	}
+
+	resp, err := adh.matchingClient.ListTaskQueuePollers(ctx, &matchingservice.ListTaskQueuePollersRequest{
+		NamespaceId: namespaceID.String(),
+		TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+			TaskQueue:     request.GetTaskQueue(),
+			TaskQueueType: request.GetTaskQueueType(),
+			PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: request.GetPartitionId()},
+		},
+		MaxPollers: request.GetMaxPollers(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ListTaskQueuePollersResponse{Pollers: resp.GetPollers()}, nil
+}
+
+// ForceResyncNamespace rewinds the replication streams of the requested history shards (all shards by default)
+// towards the target cluster, so that the replication tasks of the namespace still retained by the shards are
+// resent. The outcome is reported per shard, so that the call can be retried for the failed shards only
+func (adh *AdminHandler) ForceResyncNamespace(
+	ctx context.Context,
+	request *adminservice.ForceResyncNamespaceRequest,
+) (_ *adminservice.ForceResyncNamespaceResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if !adh.forceResyncNamespaceRateLimiter.Allow() {
+		return nil, errForceResyncNamespaceRateLimited
+	}
+	namespaceEntry, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	if !namespaceEntry.IsGlobalNamespace() {
+		return nil, serviceerror.NewInvalidArgument("namespace is not a global namespace")
+	}
+	targetCluster := request.GetTargetCluster()
+	if targetCluster == adh.clusterMetadata.GetCurrentClusterName() || !slices.Contains(namespaceEntry.ClusterNames(), targetCluster) {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid target cluster: %v", targetCluster))
+	}
+
+	shardIDs := request.GetShardIds()
+	if len(shardIDs) == 0 {
+		shardIDs = make([]int32, 0, adh.numberOfHistoryShards)
+		for shardID := int32(1); shardID <= adh.numberOfHistoryShards; shardID++ {
+			shardIDs = append(shardIDs, shardID)
+		}
+	}
+	for _, shardID := range shardIDs {
+		if shardID < 1 || shardID > adh.numberOfHistoryShards {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid shard ID: %v", shardID))
+		}
+	}
+
+	adh.logger.Info("Force resync namespace",
+		tag.WorkflowNamespace(request.GetNamespace()),
+		tag.TargetCluster(targetCluster),
+		tag.NewInt("shard-count", len(shardIDs)),
+	)
+	results := make([]*adminservice.ForceResyncNamespaceShardResult, len(shardIDs))
+	concurrencyLimiter := make(chan struct{}, forceResyncNamespaceConcurrency)
+	var wg sync.WaitGroup
+	for i, shardID := range shardIDs {
+		concurrencyLimiter <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-concurrencyLimiter
+				wg.Done()
+			}()
+			result := &adminservice.ForceResyncNamespaceShardResult{ShardId: shardID}
+			resp, err := adh.historyClient.ForceResyncNamespace(ctx, &historyservice.ForceResyncNamespaceRequest{
+				NamespaceId:   namespaceEntry.ID().String(),
+				ShardId:       shardID,
+				TargetCluster: targetCluster,
+			})
+			if err != nil {
+				result.Error = err.Error()
+			} else {
+				result.InclusiveBeginTaskId = resp.GetInclusiveBeginTaskId()
+				result.ExclusiveEndTaskId = resp.GetExclusiveEndTaskId()
+			}
+			results[i] = result
+		}()
+	}
+	wg.Wait()
+	return &adminservice.ForceResyncNamespaceResponse{Shards: results}, nil
+}
+
+// GetBuildIdTaskQueueMapping returns the task queues mapped to a build ID, so that operators can see what counts
+// against TaskQueuesPerBuildIdLimit before raising it
+func (adh *AdminHandler) GetBuildIdTaskQueueMapping(
+	ctx context.Context,
+	request *adminservice.GetBuildIdTaskQueueMappingRequest,
+) (_ *adminservice.GetBuildIdTaskQueueMappingResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetBuildId() == "" {
+		return nil, serviceerror.NewInvalidArgument("build ID is not set on request")
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.matchingClient.GetBuildIdTaskQueueMapping(ctx, &matchingservice.GetBuildIdTaskQueueMappingRequest{
+		NamespaceId: namespaceID.String(),
+		BuildId:     request.GetBuildId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	taskQueues := resp.GetTaskQueues()
+	return &adminservice.GetBuildIdTaskQueueMappingResponse{
+		TaskQueues:     taskQueues[:min(len(taskQueues), adh.config.ReachabilityTaskQueueScanLimit())],
+		TaskQueueCount: int32(len(taskQueues)),
+	}, nil
+}
+
+// ListNamespaces lists namespaces, optionally only those carrying the given data key and value
+func (adh *AdminHandler) ListNamespaces(
+	ctx context.Context,
+	request *adminservice.ListNamespacesRequest,
+) (_ *adminservice.ListNamespacesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetDataKey() == "" && request.GetDataValue() != "" {
+		return nil, serviceerror.NewInvalidArgument("DataKey must be set when DataValue is set.")
+	}
+
+	resp, err := adh.namespaceHandler.ListNamespacesWithData(
+		ctx,
+		&workflowservice.ListNamespacesRequest{
+			PageSize:        request.GetPageSize(),
+			NextPageToken:   request.GetNextPageToken(),
+			NamespaceFilter: request.GetNamespaceFilter(),
+		},
+		request.GetDataKey(),
+		request.GetDataValue(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ListNamespacesResponse{
+		Namespaces:    resp.GetNamespaces(),
+		NextPageToken: resp.GetNextPageToken(),
+	}, nil
+}
+
+// DescribeNamespaces describes each of the given namespaces, reporting the namespaces that cannot be described in
+// their own result instead of failing the call
+func (adh *AdminHandler) DescribeNamespaces(
+	ctx context.Context,
+	request *adminservice.DescribeNamespacesRequest,
+) (_ *adminservice.DescribeNamespacesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	for _, ns := range request.GetNamespaces() {
+		if ns == "" {
+			return nil, errNamespaceNotSet
+		}
+	}
+
+	results, err := adh.namespaceHandler.DescribeNamespaces(ctx, request.GetNamespaces())
+	if err != nil {
+		return nil, err
+	}
+	response := &adminservice.DescribeNamespacesResponse{
+		Results: make([]*adminservice.DescribeNamespacesResult, 0, len(results)),
+	}
+	for _, result := range results {
+		describeResult := &adminservice.DescribeNamespacesResult{
+			Namespace: result.Namespace,
+			Response:  result.Response,
+		}
+		if result.Err != nil {
+			describeResult.Failure = failure.NewFailureFromError(result.Err)
+		}
+		response.Results = append(response.Results, describeResult)
+	}
+	return response, nil
+}
+
 func (adh *AdminHandler) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -874,6 +1351,28 @@ func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *admin
 	}, err
 }
 
+// DescribeHistoryHostHealth returns the health signals of a history host that are currently failing
+func (adh *AdminHandler) DescribeHistoryHostHealth(ctx context.Context, request *adminservice.DescribeHistoryHostHealthRequest) (_ *adminservice.DescribeHistoryHostHealthResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetHostAddress()) == 0 {
+		return nil, serviceerror.NewInvalidArgument("host address is not set on request")
+	}
+
+	resp, err := adh.historyClient.DescribeHealth(ctx, &historyservice.DescribeHealthRequest{
+		HostAddress: request.GetHostAddress(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeHistoryHostHealthResponse{
+		FailingSignals: resp.GetFailingSignals(),
+	}, nil
+}
+
 func (adh *AdminHandler) GetWorkflowExecutionRawHistory(
 	ctx context.Context,
 	request *adminservice.GetWorkflowExecutionRawHistoryRequest,
@@ -1488,6 +1987,34 @@ func (adh *AdminHandler) RefreshWorkflowTasks(
 	return &adminservice.RefreshWorkflowTasksResponse{}, nil
 }
 
+// RecomputeMutableStateChecksum recomputes and persists the checksum of a workflow's mutable state
+func (adh *AdminHandler) RecomputeMutableStateChecksum(
+	ctx context.Context,
+	request *adminservice.RecomputeMutableStateChecksumRequest,
+) (_ *adminservice.RecomputeMutableStateChecksumResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	namespaceEntry, err := adh.namespaceRegistry.GetNamespaceByID(namespace.ID(request.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.historyClient.RecomputeMutableStateChecksum(ctx, &historyservice.RecomputeMutableStateChecksumRequest{
+		NamespaceId: namespaceEntry.ID().String(),
+		Request:     request,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.RecomputeMutableStateChecksumResponse{}, nil
+}
+
 // ResendReplicationTasks requests replication task from remote cluster
 func (adh *AdminHandler) ResendReplicationTasks(
 	ctx context.Context,
@@ -1534,6 +2061,7 @@ func (adh *AdminHandler) ResendReplicationTasks(
 		adh.eventSerializer,
 		nil,
 		adh.logger,
+		adh.metricsHandler,
 		nil,
 	)
 	if err := resender.SendSingleWorkflowHistory(
@@ -1801,9 +2329,10 @@ func (adh *AdminHandler) GetDLQTasks(
 	request *adminservice.GetDLQTasksRequest,
 ) (*adminservice.GetDLQTasksResponse, error) {
 	response, err := adh.historyClient.GetDLQTasks(ctx, &historyservice.GetDLQTasksRequest{
-		DlqKey:        request.DlqKey,
-		PageSize:      request.PageSize,
-		NextPageToken: request.NextPageToken,
+		DlqKey:               request.DlqKey,
+		PageSize:             request.PageSize,
+		NextPageToken:        request.NextPageToken,
+		FailureReasonPattern: request.FailureReasonPattern,
 	})
 	if err != nil {
 		return nil, err
@@ -1821,6 +2350,9 @@ func (adh *AdminHandler) PurgeDLQTasks(
 	if err := validateHistoryDLQKey(request.DlqKey); err != nil {
 		return nil, err
 	}
+	if err := validateDLQFailureReasonPattern(request.FailureReasonPattern); err != nil {
+		return nil, err
+	}
 
 	workflowID := adh.getDLQWorkflowID(request.DlqKey)
 	client := adh.sdkClientFactory.GetSystemClient()
@@ -1835,7 +2367,8 @@ func (adh *AdminHandler) PurgeDLQTasks(
 				SourceCluster:  request.DlqKey.SourceCluster,
 				TargetCluster:  request.DlqKey.TargetCluster,
 			},
-			MaxMessageID: request.InclusiveMaxTaskMetadata.MessageId,
+			MaxMessageID:         request.InclusiveMaxTaskMetadata.MessageId,
+			FailureReasonPattern: request.FailureReasonPattern,
 		},
 	})
 	if err != nil {
@@ -1859,6 +2392,9 @@ func (adh *AdminHandler) MergeDLQTasks(ctx context.Context, request *adminservic
 	if err := validateHistoryDLQKey(request.DlqKey); err != nil {
 		return nil, err
 	}
+	if err := validateDLQFailureReasonPattern(request.FailureReasonPattern); err != nil {
+		return nil, err
+	}
 
 	workflowID := adh.getDLQWorkflowID(request.DlqKey)
 	client := adh.sdkClientFactory.GetSystemClient()
@@ -1873,8 +2409,9 @@ func (adh *AdminHandler) MergeDLQTasks(ctx context.Context, request *adminservic
 				SourceCluster:  request.DlqKey.SourceCluster,
 				TargetCluster:  request.DlqKey.TargetCluster,
 			},
-			MaxMessageID: request.InclusiveMaxTaskMetadata.MessageId,
-			BatchSize:    int(request.BatchSize), // Let the workflow code validate and set the default value if needed.
+			MaxMessageID:         request.InclusiveMaxTaskMetadata.MessageId,
+			BatchSize:            int(request.BatchSize), // Let the workflow code validate and set the default value if needed.
+			FailureReasonPattern: request.FailureReasonPattern,
 		},
 	})
 	if err != nil {
@@ -1987,8 +2524,9 @@ func (adh *AdminHandler) AddTasks(
 		}
 	}
 	historyServiceRequest := &historyservice.AddTasksRequest{
-		ShardId: request.ShardId,
-		Tasks:   historyTasks,
+		ShardId:          request.ShardId,
+		Tasks:            historyTasks,
+		IdempotencyToken: request.IdempotencyToken,
 	}
 	_, err := adh.historyClient.AddTasks(ctx, historyServiceRequest)
 	if err != nil {
@@ -2023,6 +2561,32 @@ func (adh *AdminHandler) ListQueues(
 	}, nil
 }
 
+// DescribeKeepAliveParameters returns the gRPC keepalive parameters in effect on this frontend host
+func (adh *AdminHandler) DescribeKeepAliveParameters(
+	_ context.Context,
+	request *adminservice.DescribeKeepAliveParametersRequest,
+) (_ *adminservice.DescribeKeepAliveParametersResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	kep, kp := keepAliveParams(adh.config)
+	effective := effectiveKeepAliveServerParams(kp)
+	return &adminservice.DescribeKeepAliveParametersResponse{
+		Address:               adh.hostInfoProvider.HostInfo().GetAddress(),
+		MinTime:               durationpb.New(kep.MinTime),
+		PermitWithoutStream:   kep.PermitWithoutStream,
+		MaxConnectionIdle:     durationpb.New(effective.MaxConnectionIdle),
+		MaxConnectionAge:      durationpb.New(effective.MaxConnectionAge),
+		MaxConnectionAgeGrace: durationpb.New(effective.MaxConnectionAgeGrace),
+		Time:                  durationpb.New(effective.Time),
+		Timeout:               durationpb.New(effective.Timeout),
+		Warnings:              validateKeepAliveParams(kp),
+	}, nil
+}
+
 func (adh *AdminHandler) getDLQWorkflowID(
 	key *commonspb.HistoryDLQKey,
 ) string {
@@ -2053,6 +2617,13 @@ func validateHistoryDLQKey(
 	return nil
 }
 
+func validateDLQFailureReasonPattern(pattern string) error {
+	if _, err := persistence.MatchDLQErrorPattern(pattern, ""); err != nil {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid failure reason pattern: %v", err))
+	}
+	return nil
+}
+
 func convertClusterReplicationConfigToProto(
 	input []string,
 ) []*replicationpb.ClusterReplicationConfig {
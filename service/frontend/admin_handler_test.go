@@ -52,14 +52,17 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 
 	commonspb "go.temporal.io/server/api/common/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	clientmocks "go.temporal.io/server/client"
 	historyclient "go.temporal.io/server/client/history"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
@@ -68,9 +71,11 @@ import (
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/resourcetest"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/testing/mocksdk"
+	"go.temporal.io/server/common/testing/protomock"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker/dlq"
 )
@@ -139,7 +144,8 @@ func (s *adminHandlerSuite) SetupTest() {
 	}
 
 	cfg := &Config{
-		NumHistoryShards: 4,
+		NumHistoryShards:             4,
+		AdminForceResyncNamespaceRPS: dynamicconfig.GetFloatPropertyFn(1),
 	}
 	args := NewAdminHandlerArgs{
 		persistenceConfig,
@@ -156,6 +162,7 @@ func (s *adminHandlerSuite) SetupTest() {
 		s.mockResource.GetClientFactory(),
 		s.mockResource.GetClientBean(),
 		s.mockResource.GetHistoryClient(),
+		s.mockResource.GetMatchingClient(),
 		s.mockResource.GetSDKClientFactory(),
 		s.mockResource.GetMembershipMonitor(),
 		s.mockResource.GetHostInfoProvider(),
@@ -167,6 +174,8 @@ func (s *adminHandlerSuite) SetupTest() {
 		health.NewServer(),
 		serialization.NewSerializer(),
 		clock.NewRealTimeSource(),
+		s.mockResource.GetArchivalMetadata(),
+		s.mockResource.GetArchiverProvider(),
 		tasks.NewDefaultTaskCategoryRegistry(),
 	}
 	s.mockMetadata.EXPECT().GetCurrentClusterName().Return(uuid.New()).AnyTimes()
@@ -1321,6 +1330,23 @@ func (s *adminHandlerSuite) TestPurgeDLQTasks_ClusterNotSet() {
 	s.ErrorContains(err, errSourceClusterNotSet.Error())
 }
 
+func (s *adminHandlerSuite) TestPurgeDLQTasks_InvalidFailureReasonPattern() {
+	_, err := s.handler.PurgeDLQTasks(context.Background(), &adminservice.PurgeDLQTasksRequest{
+		DlqKey: &commonspb.HistoryDLQKey{
+			TaskCategory:  1,
+			SourceCluster: "test-source-cluster",
+			TargetCluster: "test-target-cluster",
+		},
+		InclusiveMaxTaskMetadata: &commonspb.HistoryDLQTaskMetadata{
+			MessageId: 42,
+		},
+		FailureReasonPattern: "(",
+	})
+	s.Error(err)
+	s.Equal(codes.InvalidArgument, serviceerror.ToStatus(err).Code())
+	s.ErrorContains(err, "failure reason pattern")
+}
+
 func (s *adminHandlerSuite) TestDescribeDLQJob() {
 	workflowID := "test-workflow-id"
 	runID := "test-run-id"
@@ -1656,3 +1682,381 @@ func (s *adminHandlerSuite) TestListQueues_Err() {
 	_, err := s.handler.ListQueues(context.Background(), &adminservice.ListQueuesRequest{})
 	s.ErrorIs(err, assert.AnError)
 }
+
+func (s *adminHandlerSuite) TestUnloadTaskQueuePartition() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockResource.MatchingClient.EXPECT().ForceUnloadTaskQueue(gomock.Any(), &matchingservice.ForceUnloadTaskQueueRequest{
+		NamespaceId:   s.namespaceID.String(),
+		TaskQueue:     "/_sys/test-tq/3",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	}).Return(&matchingservice.ForceUnloadTaskQueueResponse{WasLoaded: true}, nil)
+
+	resp, err := s.handler.UnloadTaskQueuePartition(context.Background(), &adminservice.UnloadTaskQueuePartitionRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "test-tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		PartitionId:   3,
+	})
+	s.NoError(err)
+	s.True(resp.GetWasLoaded())
+}
+
+func (s *adminHandlerSuite) TestUnloadTaskQueuePartition_InvalidTaskQueue() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+
+	_, err := s.handler.UnloadTaskQueuePartition(context.Background(), &adminservice.UnloadTaskQueuePartitionRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "/_sys/test-tq/3",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestListTaskQueuePollers() {
+	pollers := []*taskqueuespb.PollerStats{{Identity: "worker-1", PollCount: 3}}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockResource.MatchingClient.EXPECT().ListTaskQueuePollers(gomock.Any(), &matchingservice.ListTaskQueuePollersRequest{
+		NamespaceId: s.namespaceID.String(),
+		TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+			TaskQueue:     "test-tq",
+			TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+			PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: 2},
+		},
+		MaxPollers: 10,
+	}).Return(&matchingservice.ListTaskQueuePollersResponse{Pollers: pollers}, nil)
+
+	resp, err := s.handler.ListTaskQueuePollers(context.Background(), &adminservice.ListTaskQueuePollersRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "test-tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		PartitionId:   2,
+		MaxPollers:    10,
+	})
+	s.NoError(err)
+	s.Equal(pollers, resp.GetPollers())
+}
+
+func (s *adminHandlerSuite) TestGetBuildIdTaskQueueMapping() {
+	s.handler.config.ReachabilityTaskQueueScanLimit = dynamicconfig.GetIntPropertyFn(2)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockResource.MatchingClient.EXPECT().GetBuildIdTaskQueueMapping(gomock.Any(), &matchingservice.GetBuildIdTaskQueueMappingRequest{
+		NamespaceId: s.namespaceID.String(),
+		BuildId:     "build-1",
+	}).Return(&matchingservice.GetBuildIdTaskQueueMappingResponse{
+		TaskQueues: []string{"tq-1", "tq-2", "tq-3"},
+	}, nil)
+
+	resp, err := s.handler.GetBuildIdTaskQueueMapping(context.Background(), &adminservice.GetBuildIdTaskQueueMappingRequest{
+		Namespace: s.namespace.String(),
+		BuildId:   "build-1",
+	})
+	s.NoError(err)
+	s.Equal([]string{"tq-1", "tq-2"}, resp.GetTaskQueues())
+	s.Equal(int32(3), resp.GetTaskQueueCount())
+
+	_, err = s.handler.GetBuildIdTaskQueueMapping(context.Background(), &adminservice.GetBuildIdTaskQueueMappingRequest{
+		Namespace: s.namespace.String(),
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestDescribeWorkflowUpdates() {
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "workflow-id",
+		RunId:      uuid.New(),
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().DescribeWorkflowUpdates(gomock.Any(), &historyservice.DescribeWorkflowUpdatesRequest{
+		NamespaceId: s.namespaceID.String(),
+		Execution:   execution,
+	}).Return(&historyservice.DescribeWorkflowUpdatesResponse{
+		InFlightUpdates:    3,
+		TotalUpdates:       12,
+		MaxInFlightUpdates: 10,
+		MaxTotalUpdates:    2000,
+	}, nil)
+
+	resp, err := s.handler.DescribeWorkflowUpdates(context.Background(), &adminservice.DescribeWorkflowUpdatesRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+	})
+	s.NoError(err)
+	s.Equal(int32(3), resp.GetInFlightUpdates())
+	s.Equal(int32(12), resp.GetTotalUpdates())
+	s.Equal(int32(10), resp.GetMaxInFlightUpdates())
+	s.Equal(int32(2000), resp.GetMaxTotalUpdates())
+
+	_, err = s.handler.DescribeWorkflowUpdates(context.Background(), &adminservice.DescribeWorkflowUpdatesRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.ErrorIs(err, errExecutionNotSet)
+}
+
+func (s *adminHandlerSuite) TestDescribeHistoryHostHealth() {
+	s.mockHistoryClient.EXPECT().DescribeHealth(gomock.Any(), &historyservice.DescribeHealthRequest{
+		HostAddress: "127.0.0.1:7234",
+	}).Return(&historyservice.DescribeHealthResponse{
+		FailingSignals: []enumsspb.HealthSignal{enumsspb.HEALTH_SIGNAL_PERSISTENCE_LATENCY},
+	}, nil)
+
+	resp, err := s.handler.DescribeHistoryHostHealth(context.Background(), &adminservice.DescribeHistoryHostHealthRequest{
+		HostAddress: "127.0.0.1:7234",
+	})
+	s.NoError(err)
+	s.Equal([]enumsspb.HealthSignal{enumsspb.HEALTH_SIGNAL_PERSISTENCE_LATENCY}, resp.GetFailingSignals())
+
+	_, err = s.handler.DescribeHistoryHostHealth(context.Background(), &adminservice.DescribeHistoryHostHealthRequest{})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestDescribeNamespaces() {
+	s.handler.namespaceHandler.supportsSchedules = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	existing := &persistencespb.NamespaceDetail{
+		Info: &persistencespb.NamespaceInfo{
+			Id:    s.namespaceID.String(),
+			State: enumspb.NAMESPACE_STATE_REGISTERED,
+			Name:  s.namespace.String(),
+		},
+		Config: &persistencespb.NamespaceConfig{},
+		ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: "active",
+			Clusters:          []string{"active"},
+		},
+	}
+	missing := "missing-namespace"
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: existing.Info.Name,
+	}).Return(&persistence.GetNamespaceResponse{Namespace: existing}, nil)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: missing,
+	}).Return(nil, serviceerror.NewNamespaceNotFound(missing))
+
+	resp, err := s.handler.DescribeNamespaces(context.Background(), &adminservice.DescribeNamespacesRequest{
+		Namespaces: []string{existing.Info.Name, missing},
+	})
+	s.NoError(err)
+	s.Len(resp.GetResults(), 2)
+
+	s.Equal(existing.Info.Name, resp.GetResults()[0].GetNamespace())
+	s.Nil(resp.GetResults()[0].GetFailure())
+	s.Equal(existing.Info.Id, resp.GetResults()[0].GetResponse().GetNamespaceInfo().GetId())
+
+	s.Equal(missing, resp.GetResults()[1].GetNamespace())
+	s.Nil(resp.GetResults()[1].GetResponse())
+	s.Equal("NamespaceNotFound", resp.GetResults()[1].GetFailure().GetApplicationFailureInfo().GetType())
+	s.Equal(serviceerror.NewNamespaceNotFound(missing).Error(), resp.GetResults()[1].GetFailure().GetMessage())
+
+	_, err = s.handler.DescribeNamespaces(context.Background(), &adminservice.DescribeNamespacesRequest{
+		Namespaces: []string{""},
+	})
+	s.ErrorIs(err, errNamespaceNotSet)
+}
+
+func (s *adminHandlerSuite) TestDescribeOutboundDestination_AllHistoryHosts() {
+	s.handler.numberOfHistoryShards = 3
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockResource.HistoryServiceResolver.EXPECT().Lookup("1").Return(membership.NewHostInfoFromAddress("host-a"), nil)
+	s.mockResource.HistoryServiceResolver.EXPECT().Lookup("2").Return(membership.NewHostInfoFromAddress("host-b"), nil)
+	s.mockResource.HistoryServiceResolver.EXPECT().Lookup("3").Return(membership.NewHostInfoFromAddress("host-a"), nil)
+	circuitBreakers := []*adminservice.OutboundCircuitBreakerInfo{{TaskGroup: "group", State: "open"}}
+	s.mockHistoryClient.EXPECT().DescribeOutboundDestination(gomock.Any(), protomock.Eq(&historyservice.DescribeOutboundDestinationRequest{
+		NamespaceId: s.namespaceID.String(),
+		Destination: "destination",
+		ShardId:     1,
+	})).Return(&historyservice.DescribeOutboundDestinationResponse{CircuitBreakers: circuitBreakers}, nil)
+	s.mockHistoryClient.EXPECT().DescribeOutboundDestination(gomock.Any(), protomock.Eq(&historyservice.DescribeOutboundDestinationRequest{
+		NamespaceId: s.namespaceID.String(),
+		Destination: "destination",
+		ShardId:     2,
+	})).Return(nil, serviceerror.NewUnavailable("host unavailable"))
+
+	resp, err := s.handler.DescribeOutboundDestination(context.Background(), &adminservice.DescribeOutboundDestinationRequest{
+		Namespace:   s.namespace.String(),
+		Destination: "destination",
+	})
+	s.NoError(err)
+	s.Len(resp.GetHosts(), 2)
+	s.Equal("host-a", resp.GetHosts()[0].GetAddress())
+	s.Equal(int32(1), resp.GetHosts()[0].GetShardId())
+	s.Len(resp.GetHosts()[0].GetCircuitBreakers(), 1)
+	s.Equal("open", resp.GetHosts()[0].GetCircuitBreakers()[0].GetState())
+	s.Nil(resp.GetHosts()[0].GetFailure())
+	s.Equal("host-b", resp.GetHosts()[1].GetAddress())
+	s.Equal(int32(2), resp.GetHosts()[1].GetShardId())
+	s.Empty(resp.GetHosts()[1].GetCircuitBreakers())
+	s.Equal("Unavailable", resp.GetHosts()[1].GetFailure().GetApplicationFailureInfo().GetType())
+}
+
+func (s *adminHandlerSuite) TestResetOutboundCircuitBreaker_SingleShard() {
+	s.handler.numberOfHistoryShards = 3
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockResource.HistoryServiceResolver.EXPECT().Lookup("2").Return(membership.NewHostInfoFromAddress("host-b"), nil)
+	s.mockHistoryClient.EXPECT().ResetOutboundCircuitBreaker(gomock.Any(), protomock.Eq(&historyservice.ResetOutboundCircuitBreakerRequest{
+		NamespaceId: s.namespaceID.String(),
+		Destination: "destination",
+		ShardId:     2,
+	})).Return(&historyservice.ResetOutboundCircuitBreakerResponse{}, nil)
+
+	resp, err := s.handler.ResetOutboundCircuitBreaker(context.Background(), &adminservice.ResetOutboundCircuitBreakerRequest{
+		Namespace:   s.namespace.String(),
+		Destination: "destination",
+		ShardId:     2,
+	})
+	s.NoError(err)
+	s.Len(resp.GetHosts(), 1)
+	s.Equal("host-b", resp.GetHosts()[0].GetAddress())
+	s.Nil(resp.GetHosts()[0].GetFailure())
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	_, err = s.handler.ResetOutboundCircuitBreaker(context.Background(), &adminservice.ResetOutboundCircuitBreakerRequest{
+		Namespace:   s.namespace.String(),
+		Destination: "destination",
+		ShardId:     4,
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestListNamespaces_DataFilter() {
+	s.handler.namespaceHandler.supportsSchedules = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	tagged := &persistencespb.NamespaceDetail{
+		Info: &persistencespb.NamespaceInfo{
+			Id:    s.namespaceID.String(),
+			State: enumspb.NAMESPACE_STATE_REGISTERED,
+			Name:  s.namespace.String(),
+			Data:  map[string]string{"tenant": "a"},
+		},
+		Config: &persistencespb.NamespaceConfig{},
+		ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: "active",
+			Clusters:          []string{"active"},
+		},
+	}
+	s.mockResource.MetadataMgr.EXPECT().ListNamespaces(gomock.Any(), &persistence.ListNamespacesRequest{
+		PageSize:       10,
+		NextPageToken:  []byte("token"),
+		IncludeDeleted: true,
+		DataKey:        "tenant",
+		DataValue:      "a",
+	}).Return(&persistence.ListNamespacesResponse{
+		Namespaces:    []*persistence.GetNamespaceResponse{{Namespace: tagged}},
+		NextPageToken: []byte("next-token"),
+	}, nil)
+
+	resp, err := s.handler.ListNamespaces(context.Background(), &adminservice.ListNamespacesRequest{
+		PageSize:        10,
+		NextPageToken:   []byte("token"),
+		NamespaceFilter: &namespacepb.NamespaceFilter{IncludeDeleted: true},
+		DataKey:         "tenant",
+		DataValue:       "a",
+	})
+	s.NoError(err)
+	s.Len(resp.GetNamespaces(), 1)
+	s.Equal(tagged.Info.Id, resp.GetNamespaces()[0].GetNamespaceInfo().GetId())
+	s.Equal(map[string]string{"tenant": "a"}, resp.GetNamespaces()[0].GetNamespaceInfo().GetData())
+	s.Equal([]byte("next-token"), resp.GetNextPageToken())
+
+	_, err = s.handler.ListNamespaces(context.Background(), &adminservice.ListNamespacesRequest{
+		DataValue: "a",
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestForceResyncNamespace() {
+	namespaceEntry := namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+		nil,
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: "active",
+			Clusters:          []string{"active", "standby"},
+		},
+		1,
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil)
+	s.mockHistoryClient.EXPECT().ForceResyncNamespace(gomock.Any(), &historyservice.ForceResyncNamespaceRequest{
+		NamespaceId:   s.namespaceID.String(),
+		ShardId:       1,
+		TargetCluster: "standby",
+	}).Return(&historyservice.ForceResyncNamespaceResponse{
+		InclusiveBeginTaskId: 10,
+		ExclusiveEndTaskId:   20,
+	}, nil)
+
+	resp, err := s.handler.ForceResyncNamespace(context.Background(), &adminservice.ForceResyncNamespaceRequest{
+		Namespace:     s.namespace.String(),
+		TargetCluster: "standby",
+	})
+	s.NoError(err)
+	s.Len(resp.GetShards(), 1)
+	s.Equal(int32(1), resp.GetShards()[0].GetShardId())
+	s.Equal(int64(10), resp.GetShards()[0].GetInclusiveBeginTaskId())
+	s.Equal(int64(20), resp.GetShards()[0].GetExclusiveEndTaskId())
+	s.Empty(resp.GetShards()[0].GetError())
+}
+
+func (s *adminHandlerSuite) TestForceResyncNamespace_ShardFailure() {
+	namespaceEntry := namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+		nil,
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: "active",
+			Clusters:          []string{"active", "standby"},
+		},
+		1,
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil).Times(2)
+	s.mockHistoryClient.EXPECT().ForceResyncNamespace(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewUnavailable("shard unavailable"))
+
+	resp, err := s.handler.ForceResyncNamespace(context.Background(), &adminservice.ForceResyncNamespaceRequest{
+		Namespace:     s.namespace.String(),
+		TargetCluster: "standby",
+		ShardIds:      []int32{1},
+	})
+	s.NoError(err)
+	s.Len(resp.GetShards(), 1)
+	s.Equal(int32(1), resp.GetShards()[0].GetShardId())
+	s.Equal("shard unavailable", resp.GetShards()[0].GetError())
+
+	_, err = s.handler.ForceResyncNamespace(context.Background(), &adminservice.ForceResyncNamespaceRequest{
+		Namespace:     s.namespace.String(),
+		TargetCluster: "standby",
+		ShardIds:      []int32{2},
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestForceResyncNamespace_InvalidTargetCluster() {
+	namespaceEntry := namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+		nil,
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: "active",
+			Clusters:          []string{"active", "standby"},
+		},
+		1,
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil)
+
+	_, err := s.handler.ForceResyncNamespace(context.Background(), &adminservice.ForceResyncNamespaceRequest{
+		Namespace:     s.namespace.String(),
+		TargetCluster: "unknown",
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestForceResyncNamespace_RateLimited() {
+	s.handler.forceResyncNamespaceRateLimiter = quotas.NewRateLimiter(0, 0)
+
+	_, err := s.handler.ForceResyncNamespace(context.Background(), &adminservice.ForceResyncNamespaceRequest{
+		Namespace:     s.namespace.String(),
+		TargetCluster: "standby",
+	})
+	s.Equal(errForceResyncNamespaceRateLimited, err)
+}
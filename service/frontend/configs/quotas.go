@@ -31,6 +31,7 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/rpc/interceptor"
 )
 
 const (
@@ -140,6 +141,8 @@ var (
 		"/temporal.api.workflowservice.v1.WorkflowService/PollNexusTaskQueue":                 5,
 		"/temporal.api.workflowservice.v1.WorkflowService/ResetStickyTaskQueue":               5,
 		"/temporal.api.workflowservice.v1.WorkflowService/GetWorkflowExecutionHistoryReverse": 5,
+		// GetWorkflowExecutionHistory waiting for new events, unless disabled by ReducePollWorkflowHistoryRequestPriority
+		interceptor.PollWorkflowHistoryAPIName: 5,
 
 		// P6: Informational API that aren't required for the temporal service to function
 		OpenAPIV3APIName: 6,
@@ -239,10 +242,11 @@ func NewRequestToRateLimiter(
 	visibilityRateBurstFn quotas.RateBurst,
 	namespaceReplicationInducingRateBurstFn quotas.RateBurst,
 	operatorRPSRatio dynamicconfig.FloatPropertyFn,
+	reducePollWorkflowHistoryPriority dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 ) quotas.RequestRateLimiter {
 	mapping := make(map[string]quotas.RequestRateLimiter)
 
-	executionRateLimiter := NewExecutionPriorityRateLimiter(executionRateBurstFn, operatorRPSRatio)
+	executionRateLimiter := NewExecutionPriorityRateLimiter(executionRateBurstFn, operatorRPSRatio, reducePollWorkflowHistoryPriority)
 	visibilityRateLimiter := NewVisibilityPriorityRateLimiter(visibilityRateBurstFn, operatorRPSRatio)
 	namespaceReplicationInducingRateLimiter := NewNamespaceReplicationInducingAPIPriorityRateLimiter(namespaceReplicationInducingRateBurstFn, operatorRPSRatio)
 
@@ -262,6 +266,7 @@ func NewRequestToRateLimiter(
 func NewExecutionPriorityRateLimiter(
 	rateBurstFn quotas.RateBurst,
 	operatorRPSRatio dynamicconfig.FloatPropertyFn,
+	reducePollWorkflowHistoryPriority dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 ) quotas.RequestRateLimiter {
 	rateLimiters := make(map[int]quotas.RequestRateLimiter)
 	for priority := range ExecutionAPIPrioritiesOrdered {
@@ -275,6 +280,10 @@ func NewExecutionPriorityRateLimiter(
 		if req.CallerType == headers.CallerTypeOperator {
 			return OperatorPriority
 		}
+		// req.Caller is the namespace name, or empty for requests without a namespace
+		if req.API == interceptor.PollWorkflowHistoryAPIName && !reducePollWorkflowHistoryPriority(req.Caller) {
+			return APIToPriority["/temporal.api.workflowservice.v1.WorkflowService/GetWorkflowExecutionHistory"]
+		}
 		if priority, ok := APIToPriority[req.API]; ok {
 			return priority
 		}
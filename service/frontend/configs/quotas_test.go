@@ -34,14 +34,18 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	"golang.org/x/exp/slices"
 
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/testing/temporalapi"
 )
 
 var (
 	testRateBurstFn        = quotas.NewDefaultIncomingRateBurst(func() float64 { return 5 })
 	testOperatorRPSRatioFn = func() float64 { return 0.2 }
+
+	testReducePollWorkflowHistoryPriorityFn = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
 )
 
 type (
@@ -181,10 +185,32 @@ func (s *quotasSuite) TestAllAPIs() {
 }
 
 func (s *quotasSuite) TestOperatorPriority_Execution() {
-	limiter := NewExecutionPriorityRateLimiter(testRateBurstFn, testOperatorRPSRatioFn)
+	limiter := NewExecutionPriorityRateLimiter(testRateBurstFn, testOperatorRPSRatioFn, testReducePollWorkflowHistoryPriorityFn)
 	s.testOperatorPrioritized(limiter, "DescribeWorkflowExecution")
 }
 
+func (s *quotasSuite) TestPollWorkflowHistoryPriority_NamespaceExemption() {
+	limiter := NewExecutionPriorityRateLimiter(testRateBurstFn, testOperatorRPSRatioFn, func(namespaceName string) bool {
+		return namespaceName != "exempt-namespace"
+	})
+	newRequest := func(api string, namespaceName string) quotas.Request {
+		return quotas.NewRequest(api, 1, namespaceName, headers.CallerTypeAPI, -1, "")
+	}
+
+	// exhaust the poll priority, higher priorities still have capacity
+	requestTime := time.Now()
+	pollRequest := newRequest("/temporal.api.workflowservice.v1.WorkflowService/PollWorkflowTaskQueue", "test-namespace")
+	for i := 0; i < 100; i++ {
+		if !limiter.Allow(requestTime, pollRequest) {
+			break
+		}
+	}
+	s.False(limiter.Allow(requestTime, pollRequest))
+
+	s.False(limiter.Allow(requestTime, newRequest(interceptor.PollWorkflowHistoryAPIName, "test-namespace")))
+	s.True(limiter.Allow(requestTime, newRequest(interceptor.PollWorkflowHistoryAPIName, "exempt-namespace")))
+}
+
 func (s *quotasSuite) TestOperatorPriority_Visibility() {
 	limiter := NewVisibilityPriorityRateLimiter(testRateBurstFn, testOperatorRPSRatioFn)
 	s.testOperatorPrioritized(limiter, "ListOpenWorkflowExecutions")
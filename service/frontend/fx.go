@@ -32,7 +32,6 @@ import (
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
-	"google.golang.org/grpc/keepalive"
 
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
@@ -230,16 +229,9 @@ func GrpcServerOptionsProvider(
 	customInterceptors []grpc.UnaryServerInterceptor,
 	metricsHandler metrics.Handler,
 ) GrpcServerOptions {
-	kep := keepalive.EnforcementPolicy{
-		MinTime:             serviceConfig.KeepAliveMinTime(),
-		PermitWithoutStream: serviceConfig.KeepAlivePermitWithoutStream(),
-	}
-	kp := keepalive.ServerParameters{
-		MaxConnectionIdle:     serviceConfig.KeepAliveMaxConnectionIdle(),
-		MaxConnectionAge:      serviceConfig.KeepAliveMaxConnectionAge(),
-		MaxConnectionAgeGrace: serviceConfig.KeepAliveMaxConnectionAgeGrace(),
-		Time:                  serviceConfig.KeepAliveTime(),
-		Timeout:               serviceConfig.KeepAliveTimeout(),
+	kep, kp := keepAliveParams(serviceConfig)
+	for _, warning := range validateKeepAliveParams(kp) {
+		logger.Warn("Misconfigured keepalive parameters", tag.NewStringTag("warning", warning))
 	}
 	var grpcServerOptions []grpc.ServerOption
 	var err error
@@ -396,6 +388,7 @@ func RateLimitInterceptorProvider(
 			quotas.NewDefaultIncomingRateBurst(rateFn),
 			quotas.NewDefaultIncomingRateBurst(namespaceReplicationInducingRateFn),
 			serviceConfig.OperatorRPSRatio,
+			serviceConfig.ReducePollWorkflowHistoryRequestPriority,
 		),
 		map[string]int{
 			healthpb.Health_Check_FullMethodName: 0, // exclude health check requests from rate limiting.
@@ -406,9 +399,10 @@ func RateLimitInterceptorProvider(
 func MaskInternalErrorDetailsInterceptorProvider(
 	serviceConfig *Config,
 	namespaceRegistry namespace.Registry,
+	logger log.Logger,
 ) *interceptor.MaskInternalErrorDetailsInterceptor {
 	return interceptor.NewMaskInternalErrorDetailsInterceptor(
-		serviceConfig.MaskInternalErrorDetails, namespaceRegistry,
+		serviceConfig.MaskInternalErrorDetails, namespaceRegistry, logger,
 	)
 }
 
@@ -466,6 +460,7 @@ func NamespaceRateLimitInterceptorProvider(
 				configs.NewNamespaceRateBurst(req.Caller, visibilityRateFn, serviceConfig.MaxNamespaceVisibilityBurstRatioPerInstance),
 				configs.NewNamespaceRateBurst(req.Caller, namespaceReplicationInducingRateFn, serviceConfig.MaxNamespaceNamespaceReplicationInducingAPIsBurstRatioPerInstance),
 				serviceConfig.OperatorRPSRatio,
+				serviceConfig.ReducePollWorkflowHistoryRequestPriority,
 			)
 		},
 	)
@@ -484,6 +479,7 @@ func NamespaceCountLimitInterceptorProvider(
 		logger,
 		serviceConfig.MaxConcurrentLongRunningRequestsPerInstance,
 		serviceConfig.MaxGlobalConcurrentLongRunningRequests,
+		serviceConfig.MaxConcurrentLongRunningRequestsPerInstancePerAPI,
 		configs.ExecutionAPICountLimitOverride,
 	)
 }
@@ -550,8 +546,10 @@ func VisibilityManagerProvider(
 		serviceConfig.OperatorRPSRatio,
 		serviceConfig.EnableReadFromSecondaryVisibility,
 		serviceConfig.VisibilityEnableShadowReadMode,
+		serviceConfig.VisibilityShadowReadDiffSampleRate,
 		dynamicconfig.GetStringPropertyFn(visibility.SecondaryVisibilityWritingModeOff), // frontend visibility never write
 		serviceConfig.VisibilityDisableOrderByClause,
+		serviceConfig.VisibilityOrderByAllowedFields,
 		serviceConfig.VisibilityEnableManualPagination,
 		metricsHandler,
 		logger,
@@ -591,6 +589,7 @@ func AdminHandlerProvider(
 	clientFactory client.Factory,
 	clientBean client.Bean,
 	historyClient resource.HistoryClient,
+	matchingClient resource.MatchingClient,
 	sdkClientFactory sdk.ClientFactory,
 	membershipMonitor membership.Monitor,
 	hostInfoProvider membership.HostInfoProvider,
@@ -602,6 +601,8 @@ func AdminHandlerProvider(
 	healthServer *health.Server,
 	eventSerializer serialization.Serializer,
 	timeSource clock.TimeSource,
+	archivalMetadata archiver.ArchivalMetadata,
+	archiverProvider provider.ArchiverProvider,
 	taskCategoryRegistry tasks.TaskCategoryRegistry,
 ) *AdminHandler {
 	args := NewAdminHandlerArgs{
@@ -619,6 +620,7 @@ func AdminHandlerProvider(
 		clientFactory,
 		clientBean,
 		historyClient,
+		matchingClient,
 		sdkClientFactory,
 		membershipMonitor,
 		hostInfoProvider,
@@ -630,6 +632,8 @@ func AdminHandlerProvider(
 		healthServer,
 		eventSerializer,
 		timeSource,
+		archivalMetadata,
+		archiverProvider,
 		taskCategoryRegistry,
 	}
 	return NewAdminHandler(args)
@@ -695,6 +699,7 @@ func HandlerProvider(
 	membershipMonitor membership.Monitor,
 	healthInterceptor *interceptor.HealthInterceptor,
 	scheduleSpecBuilder *scheduler.SpecBuilder,
+	serviceName primitives.ServiceName,
 ) Handler {
 	wfHandler := NewWorkflowHandler(
 		serviceConfig,
@@ -702,6 +707,7 @@ func HandlerProvider(
 		visibilityMgr,
 		logger,
 		throttledLogger,
+		metricsHandler,
 		persistenceExecutionManager.GetName(),
 		clusterMetadataManager,
 		persistenceMetadataManager,
@@ -719,6 +725,7 @@ func HandlerProvider(
 		membershipMonitor,
 		healthInterceptor,
 		scheduleSpecBuilder,
+		serviceName,
 	)
 	return wfHandler
 }
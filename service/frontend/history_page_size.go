@@ -0,0 +1,62 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/common/primitives"
+)
+
+// EffectiveHistoryPageSizeHeader is the response header carrying the page size the frontend
+// used when serving a history read, after defaulting and clamping the requested size.
+const EffectiveHistoryPageSizeHeader = "temporal-effective-history-page-size"
+
+// effectiveHistoryPageSize returns the page size a GetWorkflowExecutionHistory(Reverse) request
+// is served with: the requested size, or the frontend default when none was requested, capped
+// at primitives.GetHistoryMaxPageSize.
+func effectiveHistoryPageSize(requested int32, frontendMaxPageSize int) int32 {
+	pageSize := requested
+	if pageSize <= 0 {
+		pageSize = int32(frontendMaxPageSize)
+	}
+	if pageSize > primitives.GetHistoryMaxPageSize {
+		pageSize = primitives.GetHistoryMaxPageSize
+	}
+	return pageSize
+}
+
+// setEffectiveHistoryPageSizeHeader reports the page size used to serve a history read to the
+// caller. It is a no-op when the context does not belong to a gRPC server call.
+func setEffectiveHistoryPageSizeHeader(ctx context.Context, pageSize int32) error {
+	if grpc.ServerTransportStreamFromContext(ctx) == nil {
+		return nil
+	}
+	return grpc.SetHeader(ctx, metadata.Pairs(
+		EffectiveHistoryPageSizeHeader, strconv.Itoa(int(pageSize)),
+	))
+}
@@ -0,0 +1,97 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/primitives"
+)
+
+func TestEffectiveHistoryPageSize(t *testing.T) {
+	t.Parallel()
+
+	dc := dynamicconfig.StaticClient{
+		dynamicconfig.FrontendHistoryMaxPageSize.Key(): []dynamicconfig.ConstrainedValue{
+			{
+				Constraints: dynamicconfig.Constraints{Namespace: "small-ns"},
+				Value:       50,
+			},
+			{
+				Value: 2 * primitives.GetHistoryMaxPageSize,
+			},
+		},
+	}
+	serviceConfig := NewConfig(dynamicconfig.NewCollection(dc, log.NewNoopLogger()), 1)
+
+	// The frontend default is above the hard cap, so unsized requests are served at the cap.
+	frontendMax := serviceConfig.HistoryMaxPageSize("other-ns")
+	assert.Equal(t, int32(primitives.GetHistoryMaxPageSize), effectiveHistoryPageSize(0, frontendMax))
+	assert.Equal(t, int32(primitives.GetHistoryMaxPageSize), effectiveHistoryPageSize(1000, frontendMax))
+	assert.Equal(t, int32(10), effectiveHistoryPageSize(10, frontendMax))
+
+	// The per-namespace default only applies to unsized requests.
+	frontendMax = serviceConfig.HistoryMaxPageSize("small-ns")
+	assert.Equal(t, int32(50), effectiveHistoryPageSize(0, frontendMax))
+	assert.Equal(t, int32(100), effectiveHistoryPageSize(100, frontendMax))
+}
+
+func TestSetEffectiveHistoryPageSizeHeader(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, setEffectiveHistoryPageSizeHeader(context.Background(), 10))
+
+	stream := &headerRecordingServerTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	require.NoError(t, setEffectiveHistoryPageSizeHeader(ctx, 10))
+	assert.Equal(t, []string{"10"}, stream.header.Get(EffectiveHistoryPageSizeHeader))
+}
+
+type headerRecordingServerTransportStream struct {
+	header metadata.MD
+}
+
+func (s *headerRecordingServerTransportStream) Method() string {
+	return "GetWorkflowExecutionHistory"
+}
+
+func (s *headerRecordingServerTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *headerRecordingServerTransportStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *headerRecordingServerTransportStream) SetTrailer(metadata.MD) error {
+	return nil
+}
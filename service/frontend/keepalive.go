@@ -0,0 +1,96 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/keepalive"
+)
+
+const (
+	// grpc-go raises any server keepalive time below this value to this value.
+	minKeepAliveTime = time.Second
+	// grpc-go defaults used when a server keepalive parameter is left at zero.
+	defaultKeepAliveTime    = 2 * time.Hour
+	defaultKeepAliveTimeout = 20 * time.Second
+)
+
+// keepAliveParams returns the keepalive enforcement policy and server parameters for the frontend
+// gRPC server as configured through dynamic config.
+func keepAliveParams(serviceConfig *Config) (keepalive.EnforcementPolicy, keepalive.ServerParameters) {
+	kep := keepalive.EnforcementPolicy{
+		MinTime:             serviceConfig.KeepAliveMinTime(),
+		PermitWithoutStream: serviceConfig.KeepAlivePermitWithoutStream(),
+	}
+	kp := keepalive.ServerParameters{
+		MaxConnectionIdle:     serviceConfig.KeepAliveMaxConnectionIdle(),
+		MaxConnectionAge:      serviceConfig.KeepAliveMaxConnectionAge(),
+		MaxConnectionAgeGrace: serviceConfig.KeepAliveMaxConnectionAgeGrace(),
+		Time:                  serviceConfig.KeepAliveTime(),
+		Timeout:               serviceConfig.KeepAliveTimeout(),
+	}
+	return kep, kp
+}
+
+// effectiveKeepAliveServerParams applies the same adjustments grpc-go makes to the server
+// parameters when a transport is created. Zero values for the connection idle/age limits mean
+// "infinite" and are left untouched.
+func effectiveKeepAliveServerParams(kp keepalive.ServerParameters) keepalive.ServerParameters {
+	if kp.Time == 0 {
+		kp.Time = defaultKeepAliveTime
+	} else if kp.Time < minKeepAliveTime {
+		kp.Time = minKeepAliveTime
+	}
+	if kp.Timeout == 0 {
+		kp.Timeout = defaultKeepAliveTimeout
+	}
+	return kp
+}
+
+// validateKeepAliveParams returns a warning for every combination of keepalive settings that is
+// accepted by grpc-go but is unlikely to do what the operator intended.
+func validateKeepAliveParams(kp keepalive.ServerParameters) []string {
+	var warnings []string
+	if kp.Time != 0 && kp.Time < minKeepAliveTime {
+		warnings = append(warnings, fmt.Sprintf(
+			"keepalive time %v is below the minimum of %v and will be raised to %v",
+			kp.Time, minKeepAliveTime, minKeepAliveTime,
+		))
+	}
+	effective := effectiveKeepAliveServerParams(kp)
+	if effective.Timeout >= effective.Time {
+		warnings = append(warnings, fmt.Sprintf(
+			"keepalive timeout %v is not shorter than keepalive time %v, dead connections will not be detected before the next ping",
+			effective.Timeout, effective.Time,
+		))
+	}
+	if kp.MaxConnectionAge > 0 && kp.MaxConnectionIdle > 0 && kp.MaxConnectionIdle >= kp.MaxConnectionAge {
+		warnings = append(warnings, fmt.Sprintf(
+			"keepalive max connection idle %v is not shorter than max connection age %v and will never take effect",
+			kp.MaxConnectionIdle, kp.MaxConnectionAge,
+		))
+	}
+	return warnings
+}
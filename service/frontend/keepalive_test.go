@@ -0,0 +1,102 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/keepalive"
+)
+
+func TestValidateKeepAliveParams(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		params   keepalive.ServerParameters
+		warnings []string
+	}{
+		{
+			name: "defaults",
+			params: keepalive.ServerParameters{
+				MaxConnectionIdle:     2 * time.Minute,
+				MaxConnectionAge:      5 * time.Minute,
+				MaxConnectionAgeGrace: 70 * time.Second,
+				Time:                  time.Minute,
+				Timeout:               10 * time.Second,
+			},
+		},
+		{
+			name:   "zero values use grpc defaults",
+			params: keepalive.ServerParameters{},
+		},
+		{
+			name: "timeout longer than time",
+			params: keepalive.ServerParameters{
+				Time:    10 * time.Second,
+				Timeout: time.Minute,
+			},
+			warnings: []string{
+				"keepalive timeout 1m0s is not shorter than keepalive time 10s, dead connections will not be detected before the next ping",
+			},
+		},
+		{
+			name: "time below minimum",
+			params: keepalive.ServerParameters{
+				Time:    100 * time.Millisecond,
+				Timeout: 500 * time.Millisecond,
+			},
+			warnings: []string{
+				"keepalive time 100ms is below the minimum of 1s and will be raised to 1s",
+			},
+		},
+		{
+			name: "idle not shorter than age",
+			params: keepalive.ServerParameters{
+				MaxConnectionIdle: 10 * time.Minute,
+				MaxConnectionAge:  5 * time.Minute,
+			},
+			warnings: []string{
+				"keepalive max connection idle 10m0s is not shorter than max connection age 5m0s and will never take effect",
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.warnings, validateKeepAliveParams(tc.params))
+		})
+	}
+}
+
+func TestEffectiveKeepAliveServerParams(t *testing.T) {
+	t.Parallel()
+
+	effective := effectiveKeepAliveServerParams(keepalive.ServerParameters{Time: 100 * time.Millisecond})
+	require.Equal(t, minKeepAliveTime, effective.Time)
+	require.Equal(t, defaultKeepAliveTimeout, effective.Timeout)
+	require.Zero(t, effective.MaxConnectionIdle)
+}
@@ -29,6 +29,7 @@ package frontend
 import (
 	"context"
 	"fmt"
+	"maps"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -61,6 +62,8 @@ type (
 	// such as registering, updating, and querying namespaces.
 	namespaceHandler struct {
 		maxBadBinaryCount      dynamicconfig.IntPropertyFnWithNamespaceFilter
+		maxFailoverHistorySize dynamicconfig.IntPropertyFn
+		registerIfNotExists    dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		logger                 log.Logger
 		metadataMgr            persistence.MetadataManager
 		clusterMetadata        cluster.Metadata
@@ -71,18 +74,26 @@ type (
 		supportsSchedules      dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		timeSource             clock.TimeSource
 	}
-)
 
-const (
-	maxReplicationHistorySize = 10
+	// DescribeNamespacesResult is the outcome of describing a single namespace as part of DescribeNamespaces.
+	// Exactly one of Response and Err is set.
+	DescribeNamespacesResult struct {
+		Namespace string
+		Response  *workflowservice.DescribeNamespaceResponse
+		Err       error
+	}
 )
 
+// maxDescribeNamespacesBatchSize is the max number of namespaces that can be described in one DescribeNamespaces call.
+const maxDescribeNamespacesBatchSize = 100
+
 var (
 	// err indicating that this cluster is not the master, so cannot do namespace registration or update
 	errNotMasterCluster                   = serviceerror.NewInvalidArgument("Cluster is not master cluster, cannot do namespace registration or namespace update.")
 	errCannotDoNamespaceFailoverAndUpdate = serviceerror.NewInvalidArgument("Cannot set active cluster to current cluster when other parameters are set.")
 	errInvalidRetentionPeriod             = serviceerror.NewInvalidArgument("A valid retention period is not set on request.")
 	errInvalidNamespaceStateUpdate        = serviceerror.NewInvalidArgument("Invalid namespace state update.")
+	errTooManyNamespacesToDescribe        = serviceerror.NewInvalidArgument(fmt.Sprintf("Cannot describe more than %d namespaces in one call.", maxDescribeNamespacesBatchSize))
 
 	errCustomSearchAttributeFieldAlreadyAllocated = serviceerror.NewInvalidArgument("Custom search attribute field name already allocated.")
 )
@@ -90,6 +101,8 @@ var (
 // newNamespaceHandler create a new namespace handler
 func newNamespaceHandler(
 	maxBadBinaryCount dynamicconfig.IntPropertyFnWithNamespaceFilter,
+	maxFailoverHistorySize dynamicconfig.IntPropertyFn,
+	registerIfNotExists dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	logger log.Logger,
 	metadataMgr persistence.MetadataManager,
 	clusterMetadata cluster.Metadata,
@@ -101,6 +114,8 @@ func newNamespaceHandler(
 ) *namespaceHandler {
 	return &namespaceHandler{
 		maxBadBinaryCount:      maxBadBinaryCount,
+		maxFailoverHistorySize: maxFailoverHistorySize,
+		registerIfNotExists:    registerIfNotExists,
 		logger:                 logger,
 		metadataMgr:            metadataMgr,
 		clusterMetadata:        clusterMetadata,
@@ -142,9 +157,13 @@ func (d *namespaceHandler) RegisterNamespace(
 	}
 
 	// first check if the name is already registered as the local namespace
-	_, err := d.metadataMgr.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: registerRequest.GetNamespace()})
+	getResponse, err := d.metadataMgr.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: registerRequest.GetNamespace()})
 	switch err.(type) {
 	case nil:
+		if d.registerIfNotExists(registerRequest.GetNamespace()) && d.registrationMatchesNamespace(registerRequest, getResponse) {
+			// namespace already exists with the requested configuration, nothing to do
+			return &workflowservice.RegisterNamespaceResponse{}, nil
+		}
 		// namespace already exists, cannot proceed
 		return nil, serviceerror.NewNamespaceAlreadyExists("Namespace already exists.")
 	case *serviceerror.NamespaceNotFound:
@@ -154,19 +173,7 @@ func (d *namespaceHandler) RegisterNamespace(
 		return nil, err
 	}
 
-	var activeClusterName string
-	// input validation on cluster names
-	if registerRequest.GetActiveClusterName() != "" {
-		activeClusterName = registerRequest.GetActiveClusterName()
-	} else {
-		activeClusterName = d.clusterMetadata.GetCurrentClusterName()
-	}
-	var clusters []string
-	for _, clusterConfig := range registerRequest.Clusters {
-		clusterName := clusterConfig.GetClusterName()
-		clusters = append(clusters, clusterName)
-	}
-	clusters = persistence.GetOrUseDefaultClusters(activeClusterName, clusters)
+	activeClusterName, clusters := d.registrationClusters(registerRequest)
 
 	currentHistoryArchivalState := namespace.NeverEnabledState()
 	nextHistoryArchivalState := currentHistoryArchivalState
@@ -294,11 +301,95 @@ func (d *namespaceHandler) RegisterNamespace(
 	return &workflowservice.RegisterNamespaceResponse{}, nil
 }
 
+// registrationClusters returns the active cluster and the clusters of a namespace registered with the request.
+func (d *namespaceHandler) registrationClusters(
+	registerRequest *workflowservice.RegisterNamespaceRequest,
+) (string, []string) {
+	var activeClusterName string
+	// input validation on cluster names
+	if registerRequest.GetActiveClusterName() != "" {
+		activeClusterName = registerRequest.GetActiveClusterName()
+	} else {
+		activeClusterName = d.clusterMetadata.GetCurrentClusterName()
+	}
+	var clusters []string
+	for _, clusterConfig := range registerRequest.Clusters {
+		clusterName := clusterConfig.GetClusterName()
+		clusters = append(clusters, clusterName)
+	}
+	return activeClusterName, persistence.GetOrUseDefaultClusters(activeClusterName, clusters)
+}
+
+// registrationMatchesNamespace returns true if registering the namespace with the request would result in the
+// configuration of the existing namespace. Clusters are compared regardless of order, and archival settings are only
+// compared if the request sets them.
+func (d *namespaceHandler) registrationMatchesNamespace(
+	registerRequest *workflowservice.RegisterNamespaceRequest,
+	existing *persistence.GetNamespaceResponse,
+) bool {
+	info := existing.Namespace.GetInfo()
+	config := existing.Namespace.GetConfig()
+	replicationConfig := existing.Namespace.GetReplicationConfig()
+	activeClusterName, clusters := d.registrationClusters(registerRequest)
+
+	if existing.IsGlobalNamespace != registerRequest.GetIsGlobalNamespace() ||
+		info.GetDescription() != registerRequest.GetDescription() ||
+		info.GetOwner() != registerRequest.GetOwnerEmail() ||
+		!maps.Equal(info.GetData(), registerRequest.GetData()) ||
+		timestamp.DurationValue(config.GetRetention()) != timestamp.DurationValue(registerRequest.GetWorkflowExecutionRetentionPeriod()) ||
+		replicationConfig.GetActiveClusterName() != activeClusterName ||
+		!maps.Equal(clusterSet(replicationConfig.GetClusters()), clusterSet(clusters)) {
+		return false
+	}
+
+	if state := registerRequest.GetHistoryArchivalState(); state != enumspb.ARCHIVAL_STATE_UNSPECIFIED && state != config.GetHistoryArchivalState() {
+		return false
+	}
+	if uri := registerRequest.GetHistoryArchivalUri(); uri != "" && uri != config.GetHistoryArchivalUri() {
+		return false
+	}
+	if state := registerRequest.GetVisibilityArchivalState(); state != enumspb.ARCHIVAL_STATE_UNSPECIFIED && state != config.GetVisibilityArchivalState() {
+		return false
+	}
+	if uri := registerRequest.GetVisibilityArchivalUri(); uri != "" && uri != config.GetVisibilityArchivalUri() {
+		return false
+	}
+	return true
+}
+
+func clusterSet(clusters []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(clusters))
+	for _, cluster := range clusters {
+		set[cluster] = struct{}{}
+	}
+	return set
+}
+
 // ListNamespaces list all namespaces
 func (d *namespaceHandler) ListNamespaces(
 	ctx context.Context,
 	listRequest *workflowservice.ListNamespacesRequest,
 ) (*workflowservice.ListNamespacesResponse, error) {
+	return d.listNamespaces(ctx, listRequest, "", "")
+}
+
+// ListNamespacesWithData is like ListNamespaces, but only includes the namespaces whose data contains dataKey and,
+// if dataValue is set, whose value for dataKey equals dataValue
+func (d *namespaceHandler) ListNamespacesWithData(
+	ctx context.Context,
+	listRequest *workflowservice.ListNamespacesRequest,
+	dataKey string,
+	dataValue string,
+) (*workflowservice.ListNamespacesResponse, error) {
+	return d.listNamespaces(ctx, listRequest, dataKey, dataValue)
+}
+
+func (d *namespaceHandler) listNamespaces(
+	ctx context.Context,
+	listRequest *workflowservice.ListNamespacesRequest,
+	dataKey string,
+	dataValue string,
+) (*workflowservice.ListNamespacesResponse, error) {
 
 	pageSize := 100
 	if listRequest.GetPageSize() != 0 {
@@ -309,6 +400,8 @@ func (d *namespaceHandler) ListNamespaces(
 		PageSize:       pageSize,
 		NextPageToken:  listRequest.NextPageToken,
 		IncludeDeleted: listRequest.GetNamespaceFilter().GetIncludeDeleted(),
+		DataKey:        dataKey,
+		DataValue:      dataValue,
 	})
 
 	if err != nil {
@@ -362,9 +455,30 @@ func (d *namespaceHandler) DescribeNamespace(
 	return response, nil
 }
 
+// DescribeNamespaces describes each of the given namespaces. A namespace that cannot be described is reported in
+// its own result rather than failing the whole call.
+func (d *namespaceHandler) DescribeNamespaces(
+	ctx context.Context,
+	namespaces []string,
+) ([]DescribeNamespacesResult, error) {
+
+	if len(namespaces) > maxDescribeNamespacesBatchSize {
+		return nil, errTooManyNamespacesToDescribe
+	}
+
+	results := make([]DescribeNamespacesResult, 0, len(namespaces))
+	for _, ns := range namespaces {
+		resp, err := d.DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{Namespace: ns})
+		results = append(results, DescribeNamespacesResult{
+			Namespace: ns,
+			Response:  resp,
+			Err:       err,
+		})
+	}
+	return results, nil
+}
+
 // UpdateNamespace update the namespace
-//
-//nolint:revive // cognitive complexity grandfathered
 func (d *namespaceHandler) UpdateNamespace(
 	ctx context.Context,
 	updateRequest *workflowservice.UpdateNamespaceRequest,
@@ -378,11 +492,48 @@ func (d *namespaceHandler) UpdateNamespace(
 	if err != nil {
 		return nil, err
 	}
-	notificationVersion := metadata.NotificationVersion
 	getResponse, err := d.metadataMgr.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: updateRequest.GetNamespace()})
 	if err != nil {
 		return nil, err
 	}
+	return d.updateNamespace(ctx, updateRequest, metadata.NotificationVersion, getResponse)
+}
+
+// PromoteNamespaceToGlobal promotes a local namespace to a global namespace, leaving the rest of its
+// configuration untouched.
+func (d *namespaceHandler) PromoteNamespaceToGlobal(
+	ctx context.Context,
+	namespaceName string,
+) (*workflowservice.UpdateNamespaceResponse, error) {
+
+	// must get the metadata (notificationVersion) first, see UpdateNamespace
+	metadata, err := d.metadataMgr.GetMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	getResponse, err := d.metadataMgr.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: namespaceName})
+	if err != nil {
+		return nil, err
+	}
+	if getResponse.IsGlobalNamespace {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("namespace is already a global namespace: %v", namespaceName))
+	}
+	// a request with nothing but the promotion flag set leaves the rest of the namespace untouched
+	return d.updateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+		Namespace:        namespaceName,
+		PromoteNamespace: true,
+	}, metadata.NotificationVersion, getResponse)
+}
+
+// updateNamespace applies the update request to the namespace read at the given notification version.
+//
+//nolint:revive // cognitive complexity grandfathered
+func (d *namespaceHandler) updateNamespace(
+	ctx context.Context,
+	updateRequest *workflowservice.UpdateNamespaceRequest,
+	notificationVersion int64,
+	getResponse *persistence.GetNamespaceResponse,
+) (*workflowservice.UpdateNamespaceResponse, error) {
 
 	info := getResponse.Namespace.Info
 	config := getResponse.Namespace.Config
@@ -603,13 +754,13 @@ func (d *namespaceHandler) UpdateNamespace(
 			IsGlobalNamespace:   isGlobalNamespace,
 			NotificationVersion: notificationVersion,
 		}
-		err = d.metadataMgr.UpdateNamespace(ctx, updateReq)
+		err := d.metadataMgr.UpdateNamespace(ctx, updateReq)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	err = d.namespaceReplicator.HandleTransmissionTask(
+	err := d.namespaceReplicator.HandleTransmissionTask(
 		ctx,
 		enumsspb.NAMESPACE_OPERATION_UPDATE,
 		info,
@@ -881,8 +1032,9 @@ func (d *namespaceHandler) maybeUpdateFailoverHistory(
 			},
 		)
 	}
-	if l := len(failoverHistory); l > maxReplicationHistorySize {
-		failoverHistory = failoverHistory[l-maxReplicationHistorySize : l]
+	maxFailoverHistorySize := max(d.maxFailoverHistorySize(), 0)
+	if l := len(failoverHistory); l > maxFailoverHistorySize {
+		failoverHistory = failoverHistory[l-maxFailoverHistorySize : l]
 	}
 	return failoverHistory
 }
@@ -64,6 +64,8 @@ type (
 		controller *gomock.Controller
 
 		maxBadBinaryCount       int
+		maxFailoverHistorySize  int
+		registerIfNotExists     map[string]bool
 		mockMetadataMgr         *persistence.MockMetadataManager
 		mockClusterMetadata     *cluster.MockMetadata
 		mockProducer            *persistence.MockNamespaceReplicationQueue
@@ -94,6 +96,7 @@ func (s *namespaceHandlerCommonSuite) SetupTest() {
 	dcCollection := dc.NewNoopCollection()
 	s.controller = gomock.NewController(s.T())
 	s.maxBadBinaryCount = 10
+	s.maxFailoverHistorySize = 10
 	s.mockMetadataMgr = persistence.NewMockMetadataManager(s.controller)
 	s.mockClusterMetadata = cluster.NewMockMetadata(s.controller)
 	s.mockProducer = persistence.NewMockNamespaceReplicationQueue(s.controller)
@@ -110,6 +113,8 @@ func (s *namespaceHandlerCommonSuite) SetupTest() {
 	s.fakeClock = clock.NewEventTimeSource()
 	s.handler = newNamespaceHandler(
 		dc.GetIntPropertyFnFilteredByNamespace(s.maxBadBinaryCount),
+		func() int { return s.maxFailoverHistorySize },
+		func(namespace string) bool { return s.registerIfNotExists[namespace] },
 		logger,
 		s.mockMetadataMgr,
 		s.mockClusterMetadata,
@@ -383,6 +388,56 @@ func (s *namespaceHandlerCommonSuite) TestListNamespace() {
 	}
 }
 
+func (s *namespaceHandlerCommonSuite) TestDescribeNamespaces_PartialFailure() {
+	existing := &persistencespb.NamespaceDetail{
+		Info: &persistencespb.NamespaceInfo{
+			Id:    uuid.New(),
+			State: enumspb.NAMESPACE_STATE_REGISTERED,
+			Name:  s.getRandomNamespace(),
+		},
+		Config: &persistencespb.NamespaceConfig{
+			Retention: durationpb.New(24 * time.Hour),
+		},
+		ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: "cluster1",
+			Clusters:          []string{"cluster1"},
+		},
+	}
+	missing := s.getRandomNamespace()
+
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: existing.Info.Name,
+	}).Return(&persistence.GetNamespaceResponse{Namespace: existing}, nil)
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: missing,
+	}).Return(nil, serviceerror.NewNamespaceNotFound(missing))
+
+	results, err := s.handler.DescribeNamespaces(context.Background(), []string{existing.Info.Name, missing})
+	s.NoError(err)
+	s.Len(results, 2)
+
+	s.Equal(existing.Info.Name, results[0].Namespace)
+	s.NoError(results[0].Err)
+	s.Equal(existing.Info.Id, results[0].Response.GetNamespaceInfo().GetId())
+	s.Equal(existing.Info.Name, results[0].Response.GetNamespaceInfo().GetName())
+
+	s.Equal(missing, results[1].Namespace)
+	s.Nil(results[1].Response)
+	var nsNotFound *serviceerror.NamespaceNotFound
+	s.ErrorAs(results[1].Err, &nsNotFound)
+}
+
+func (s *namespaceHandlerCommonSuite) TestDescribeNamespaces_TooMany() {
+	namespaces := make([]string, maxDescribeNamespacesBatchSize+1)
+	for i := range namespaces {
+		namespaces[i] = s.getRandomNamespace()
+	}
+
+	results, err := s.handler.DescribeNamespaces(context.Background(), namespaces)
+	s.ErrorIs(err, errTooManyNamespacesToDescribe)
+	s.Nil(results)
+}
+
 func (s *namespaceHandlerCommonSuite) TestRegisterNamespace_WithOneCluster() {
 	const namespace = "namespace-to-register"
 	clusterName := "cluster1"
@@ -420,6 +475,75 @@ func (s *namespaceHandlerCommonSuite) TestRegisterNamespace_WithOneCluster() {
 	s.NoError(err)
 }
 
+func (s *namespaceHandlerCommonSuite) TestRegisterNamespace_IfNotExists() {
+	const namespace = "namespace-to-register"
+	clusterName := "cluster1"
+	clusterName2 := "cluster2"
+	retention := durationpb.New(10 * 24 * time.Hour)
+	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(true).AnyTimes()
+	s.mockClusterMetadata.EXPECT().IsMasterCluster().Return(true).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(clusterName).AnyTimes()
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: namespace}).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:          uuid.New(),
+				Name:        namespace,
+				State:       enumspb.NAMESPACE_STATE_REGISTERED,
+				Description: "some description",
+				Owner:       "some owner",
+			},
+			Config: &persistencespb.NamespaceConfig{
+				Retention: retention,
+			},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: clusterName,
+				Clusters:          []string{clusterName, clusterName2},
+			},
+			FailoverVersion: 1,
+		},
+		IsGlobalNamespace: true,
+	}, nil).AnyTimes()
+	// the existing namespace is never created or updated
+	s.mockMetadataMgr.EXPECT().CreateNamespace(gomock.Any(), gomock.Any()).Times(0)
+
+	newRegisterRequest := func() *workflowservice.RegisterNamespaceRequest {
+		return &workflowservice.RegisterNamespaceRequest{
+			Namespace:                        namespace,
+			Description:                      "some description",
+			OwnerEmail:                       "some owner",
+			WorkflowExecutionRetentionPeriod: retention,
+			Clusters:                         []*replicationpb.ClusterReplicationConfig{{ClusterName: clusterName}, {ClusterName: clusterName2}},
+			IsGlobalNamespace:                true,
+		}
+	}
+
+	s.registerIfNotExists = map[string]bool{namespace: true}
+	_, err := s.handler.RegisterNamespace(context.Background(), newRegisterRequest())
+	s.NoError(err)
+
+	// clusters are compared regardless of order
+	reorderedRequest := newRegisterRequest()
+	reorderedRequest.Clusters = []*replicationpb.ClusterReplicationConfig{{ClusterName: clusterName2}, {ClusterName: clusterName}}
+	_, err = s.handler.RegisterNamespace(context.Background(), reorderedRequest)
+	s.NoError(err)
+
+	conflictingRequest := newRegisterRequest()
+	conflictingRequest.WorkflowExecutionRetentionPeriod = durationpb.New(30 * 24 * time.Hour)
+	_, err = s.handler.RegisterNamespace(context.Background(), conflictingRequest)
+	var alreadyExistsErr *serviceerror.NamespaceAlreadyExists
+	s.ErrorAs(err, &alreadyExistsErr)
+
+	conflictingRequest = newRegisterRequest()
+	conflictingRequest.Clusters = []*replicationpb.ClusterReplicationConfig{{ClusterName: clusterName}}
+	_, err = s.handler.RegisterNamespace(context.Background(), conflictingRequest)
+	s.ErrorAs(err, &alreadyExistsErr)
+
+	// identical re-registration fails unless enabled for the namespace
+	s.registerIfNotExists = map[string]bool{"other-namespace": true}
+	_, err = s.handler.RegisterNamespace(context.Background(), newRegisterRequest())
+	s.ErrorAs(err, &alreadyExistsErr)
+}
+
 func (s *namespaceHandlerCommonSuite) TestRegisterNamespace_WithTwoCluster() {
 	const namespace = "namespace-to-register"
 	clusterName := "cluster1"
@@ -607,6 +731,139 @@ func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_PromoteLocalNamespace(
 	s.NoError(err)
 }
 
+func (s *namespaceHandlerCommonSuite) TestPromoteNamespaceToGlobal() {
+	namespace := "local-ns-to-be-promoted"
+	clusterName := "cluster1"
+	version := int64(1)
+	nid := uuid.New()
+	newNamespaceDetail := func() *persistencespb.NamespaceDetail {
+		return &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:          nid,
+				Name:        namespace,
+				State:       enumspb.NAMESPACE_STATE_REGISTERED,
+				Description: "some description",
+				Owner:       "some owner",
+				Data:        map[string]string{"some key": "some value"},
+			},
+			Config: &persistencespb.NamespaceConfig{
+				Retention: durationpb.New(7 * 24 * time.Hour),
+			},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: clusterName,
+				Clusters:          []string{clusterName},
+			},
+			ConfigVersion: 5,
+		}
+	}
+	s.mockMetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{
+		NotificationVersion: version,
+	}, nil)
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: namespace}).Return(&persistence.GetNamespaceResponse{
+		Namespace: newNamespaceDetail(),
+	}, nil)
+	// only the global flag and the failover versions change
+	promotedNamespaceDetail := newNamespaceDetail()
+	promotedNamespaceDetail.FailoverVersion = 2
+	promotedNamespaceDetail.FailoverNotificationVersion = version
+	s.mockMetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), &persistence.UpdateNamespaceRequest{
+		Namespace:           promotedNamespaceDetail,
+		IsGlobalNamespace:   true,
+		NotificationVersion: version,
+	})
+	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(true).AnyTimes()
+	s.mockClusterMetadata.EXPECT().IsMasterCluster().Return(true).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		clusterName: {
+			Enabled:                true,
+			InitialFailoverVersion: 2,
+		},
+	}).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(clusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetNextFailoverVersion(clusterName, int64(0)).Return(int64(2))
+
+	resp, err := s.handler.PromoteNamespaceToGlobal(context.Background(), namespace)
+	s.NoError(err)
+	s.True(resp.IsGlobalNamespace)
+	s.Equal(int64(2), resp.FailoverVersion)
+	s.Equal("some description", resp.NamespaceInfo.Description)
+	s.Equal(7*24*time.Hour, resp.Config.WorkflowExecutionRetentionTtl.AsDuration())
+}
+
+func (s *namespaceHandlerCommonSuite) TestPromoteNamespaceToGlobal_AlreadyGlobal() {
+	namespace := "global-ns"
+	s.mockMetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{
+		NotificationVersion: 1,
+	}, nil)
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:   &persistencespb.NamespaceInfo{Id: uuid.New(), Name: namespace},
+			Config: &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: "cluster1",
+				Clusters:          []string{"cluster1"},
+			},
+		},
+		IsGlobalNamespace: true,
+	}, nil)
+
+	_, err := s.handler.PromoteNamespaceToGlobal(context.Background(), namespace)
+	var invalidArgErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgErr)
+}
+
+func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_UpdateActiveCluster_NotInClusters() {
+	namespace := "global-ns-to-be-migrated"
+	clusterName1 := "cluster1"
+	clusterName2 := "cluster2"
+	clusterName3 := "cluster3"
+	s.mockMetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{
+		NotificationVersion: 100,
+	}, nil)
+	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(true).AnyTimes()
+	s.mockClusterMetadata.EXPECT().IsMasterCluster().Return(true).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		clusterName1: {
+			Enabled:                true,
+			InitialFailoverVersion: 1,
+		},
+		clusterName2: {
+			Enabled:                true,
+			InitialFailoverVersion: 2,
+		},
+		clusterName3: {
+			Enabled:                true,
+			InitialFailoverVersion: 3,
+		},
+	}).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(clusterName1).AnyTimes()
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   uuid.New(),
+				Name: namespace,
+			},
+			Config: &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: clusterName1,
+				Clusters:          []string{clusterName1, clusterName2},
+			},
+		},
+		IsGlobalNamespace: true,
+	}, nil)
+
+	// cluster3 is a known cluster, but the namespace doesn't replicate to it, so neither a new failover
+	// version is computed nor the namespace updated
+	_, err := s.handler.UpdateNamespace(context.Background(), &workflowservice.UpdateNamespaceRequest{
+		Namespace: namespace,
+		ReplicationConfig: &replicationpb.NamespaceReplicationConfig{
+			ActiveClusterName: clusterName3,
+		},
+	})
+	var invalidArgErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgErr)
+}
+
 func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_UpdateActiveClusterWithHandoverState() {
 	s.mockProducer.EXPECT().Publish(gomock.Any(), gomock.Any()).AnyTimes()
 	update1Time := time.Date(2011, 12, 27, 23, 44, 55, 999999, time.UTC)
@@ -859,6 +1116,107 @@ func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_UpdateActiveCluster_Li
 	s.NoError(err)
 }
 
+func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_UpdateActiveCluster_ConfiguredFailoverHistorySize() {
+	s.maxFailoverHistorySize = 3
+	s.mockProducer.EXPECT().Publish(gomock.Any(), gomock.Any()).AnyTimes()
+	update1Time := time.Date(2011, 12, 27, 23, 44, 55, 999999, time.UTC)
+	namespace := "global-ns-to-be-migrated"
+	nid := uuid.New()
+	version := int64(100)
+	clusterName1 := "cluster1"
+	clusterName2 := "cluster2"
+	failoverHistory := []*persistencespb.FailoverStatus{
+		{
+			FailoverTime:    timestamppb.New(update1Time),
+			FailoverVersion: int64(2),
+		},
+		{
+			FailoverTime:    timestamppb.New(update1Time),
+			FailoverVersion: int64(11),
+		},
+		{
+			FailoverTime:    timestamppb.New(update1Time),
+			FailoverVersion: int64(12),
+		},
+		{
+			FailoverTime:    timestamppb.New(update1Time),
+			FailoverVersion: int64(21),
+		},
+		{
+			FailoverTime:    timestamppb.New(update1Time),
+			FailoverVersion: int64(22),
+		},
+	}
+	updateRequest := &workflowservice.UpdateNamespaceRequest{
+		Namespace: namespace,
+		ReplicationConfig: &replicationpb.NamespaceReplicationConfig{
+			ActiveClusterName: "cluster2",
+		},
+		PromoteNamespace: true,
+	}
+	s.mockMetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{
+		NotificationVersion: version,
+	}, nil)
+	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(true).AnyTimes()
+	s.mockClusterMetadata.EXPECT().IsMasterCluster().Return(true).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		clusterName1: {
+			Enabled:                true,
+			InitialFailoverVersion: 1,
+		},
+		clusterName2: {
+			Enabled:                true,
+			InitialFailoverVersion: 2,
+		},
+	}).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(clusterName1).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetNextFailoverVersion(clusterName2, int64(0)).Return(int64(32))
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(&persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   nid,
+				Name: namespace,
+			},
+			Config: &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: clusterName1,
+				Clusters:          []string{clusterName1, clusterName2},
+				FailoverHistory:   failoverHistory,
+			},
+		},
+	}, nil)
+	sizeLimitedFailoverHistory := slices.Clone(failoverHistory)
+	sizeLimitedFailoverHistory = append(sizeLimitedFailoverHistory, &persistencespb.FailoverStatus{
+		FailoverTime:    timestamppb.New(update1Time),
+		FailoverVersion: 32,
+	})
+	// the oldest entries are dropped to stay within the configured size
+	sizeLimitedFailoverHistory = sizeLimitedFailoverHistory[3:]
+	s.Len(sizeLimitedFailoverHistory, s.maxFailoverHistorySize)
+	s.mockMetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), &persistence.UpdateNamespaceRequest{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   nid,
+				Name: namespace,
+			},
+			Config: &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: clusterName2,
+				Clusters:          []string{clusterName1, clusterName2},
+				FailoverHistory:   sizeLimitedFailoverHistory,
+			},
+			ConfigVersion:               0,
+			FailoverNotificationVersion: version,
+			FailoverVersion:             32,
+		},
+		IsGlobalNamespace:   true,
+		NotificationVersion: version,
+	})
+	s.fakeClock.Update(update1Time)
+	_, err := s.handler.UpdateNamespace(context.Background(), updateRequest)
+	s.NoError(err)
+}
+
 func (s *namespaceHandlerCommonSuite) TestRegisterLocalNamespace_InvalidGlobalNamespace() {
 	namespace := s.getRandomNamespace()
 	description := "some random description"
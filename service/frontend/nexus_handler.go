@@ -207,7 +207,7 @@ func (c *operationContext) interceptRequest(ctx context.Context, request *matchi
 		return commonnexus.ConvertGRPCError(err, true)
 	}
 
-	if err := c.rateLimitInterceptor.Allow(c.apiName, header); err != nil {
+	if err := c.rateLimitInterceptor.Allow(c.namespace.Name(), c.apiName, header); err != nil {
 		c.metricsHandler = c.metricsHandler.WithTags(metrics.NexusOutcomeTag("global_rate_limited"))
 		return commonnexus.ConvertGRPCError(err, true)
 	}
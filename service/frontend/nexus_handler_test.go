@@ -144,6 +144,7 @@ func newOperationContext(options contextOptions) *operationContext {
 		oc.logger,
 		func(ns string) int { return options.quota },
 		func(ns string) int { return options.quota },
+		nil,
 		map[string]int{
 			oc.apiName: 1,
 		},
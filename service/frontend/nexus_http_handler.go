@@ -67,6 +67,7 @@ type NexusHTTPHandler struct {
 	namespaceConcurrencyLimitInterceptor *interceptor.ConcurrentRequestLimitInterceptor
 	rateLimitInterceptor                 *interceptor.RateLimitInterceptor
 	enabled                              dynamicconfig.BoolPropertyFn
+	enabledForNamespace                  dynamicconfig.BoolPropertyFnWithNamespaceFilter
 }
 
 func NewNexusHTTPHandler(
@@ -96,6 +97,7 @@ func NewNexusHTTPHandler(
 		namespaceConcurrencyLimitInterceptor: namespaceConcurrencyLimitIntercptor,
 		rateLimitInterceptor:                 rateLimitInterceptor,
 		enabled:                              serviceConfig.EnableNexusAPIs,
+		enabledForNamespace:                  serviceConfig.EnableNexusAPIsForNamespace,
 		preprocessErrorCounter:               metricsHandler.Counter(metrics.NexusRequestPreProcessErrors.Name()).Record,
 		nexusHandler: nexus.NewHTTPHandler(nexus.HandlerOptions{
 			Handler: &nexusHandler{
@@ -325,6 +327,11 @@ func (h *NexusHTTPHandler) parseTlsAndAuthInfo(r *http.Request, nc *nexusContext
 }
 
 func (h *NexusHTTPHandler) serveResolvedURL(w http.ResponseWriter, r *http.Request, u *url.URL, nc *nexusContext) {
+	if !h.enabledForNamespace(nc.namespaceName) {
+		h.writeNexusFailure(w, http.StatusNotFound, &nexus.Failure{Message: "nexus endpoints disabled for namespace"})
+		return
+	}
+
 	// Attach Nexus context to response writer and request context.
 	w = newNexusHTTPResponseWriter(w, nc)
 	r = r.WithContext(context.WithValue(r.Context(), nexusContextKey{}, nc))
@@ -32,6 +32,7 @@ import (
 	"go.temporal.io/api/temporalproto/openapi"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/service/frontend/configs"
 )
@@ -55,7 +56,8 @@ func NewOpenAPIHTTPHandler(
 func (h *OpenAPIHTTPHandler) RegisterRoutes(r *mux.Router) {
 	serve := func(version int, apiName string, contentType string, spec []byte) func(http.ResponseWriter, *http.Request) {
 		return func(w http.ResponseWriter, r *http.Request) {
-			if err := h.rateLimitInterceptor.Allow(apiName, r.Header); err != nil {
+			// The OpenAPI spec isn't scoped to a namespace.
+			if err := h.rateLimitInterceptor.Allow(namespace.EmptyName, apiName, r.Header); err != nil {
 				w.WriteHeader(http.StatusTooManyRequests)
 				return
 			}
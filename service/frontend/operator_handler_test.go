@@ -48,6 +48,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
 	"go.temporal.io/server/common/persistence/visibility"
@@ -240,6 +241,8 @@ func (s *operatorHandlerSuite) Test_AddSearchAttributes_DualVisibility() {
 		mockVisManager2,
 		mockManagerSelector,
 		dynamicconfig.GetBoolPropertyFn(false),
+		dynamicconfig.GetFloatPropertyFn(0),
+		metrics.NoopMetricsHandler,
 	)
 	s.handler.visibilityMgr = mockDualVisManager
 
@@ -1159,11 +1162,12 @@ func (s *operatorHandlerSuite) Test_DeleteNamespace() {
 	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient).AnyTimes()
 
 	handler.config = &Config{
-		DeleteNamespaceDeleteActivityRPS:                    dynamicconfig.GetIntPropertyFn(22),
-		DeleteNamespacePageSize:                             dynamicconfig.GetIntPropertyFn(8),
-		DeleteNamespacePagesPerExecution:                    dynamicconfig.GetIntPropertyFn(78),
-		DeleteNamespaceConcurrentDeleteExecutionsActivities: dynamicconfig.GetIntPropertyFn(3),
-		DeleteNamespaceNamespaceDeleteDelay:                 dynamicconfig.GetDurationPropertyFn(22 * time.Hour),
+		DeleteNamespaceDeleteActivityRPS:                         dynamicconfig.GetIntPropertyFn(22),
+		DeleteNamespacePageSize:                                  dynamicconfig.GetIntPropertyFn(8),
+		DeleteNamespacePagesPerExecution:                         dynamicconfig.GetIntPropertyFn(78),
+		DeleteNamespaceConcurrentDeleteExecutionsActivities:      dynamicconfig.GetIntPropertyFn(3),
+		DeleteNamespaceTotalConcurrentDeleteExecutionsActivities: dynamicconfig.GetIntPropertyFn(12),
+		DeleteNamespaceNamespaceDeleteDelay:                      dynamicconfig.GetDurationPropertyFn(22 * time.Hour),
 	}
 
 	// Start workflow failed.
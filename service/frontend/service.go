@@ -64,7 +64,9 @@ type Config struct {
 	VisibilityMaxPageSize                 dynamicconfig.IntPropertyFnWithNamespaceFilter
 	EnableReadFromSecondaryVisibility     dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	VisibilityEnableShadowReadMode        dynamicconfig.BoolPropertyFn
+	VisibilityShadowReadDiffSampleRate    dynamicconfig.FloatPropertyFn
 	VisibilityDisableOrderByClause        dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	VisibilityOrderByAllowedFields        dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]string]
 	VisibilityEnableManualPagination      dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	VisibilityAllowList                   dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	SuppressErrorSetSystemSearchAttribute dynamicconfig.BoolPropertyFnWithNamespaceFilter
@@ -74,10 +76,12 @@ type Config struct {
 	GlobalRPS                                                         dynamicconfig.IntPropertyFn
 	OperatorRPSRatio                                                  dynamicconfig.FloatPropertyFn
 	NamespaceReplicationInducingAPIsRPS                               dynamicconfig.IntPropertyFn
+	ReducePollWorkflowHistoryRequestPriority                          dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	MaxNamespaceRPSPerInstance                                        dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxNamespaceBurstRatioPerInstance                                 dynamicconfig.FloatPropertyFnWithNamespaceFilter
 	MaxConcurrentLongRunningRequestsPerInstance                       dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxGlobalConcurrentLongRunningRequests                            dynamicconfig.IntPropertyFnWithNamespaceFilter
+	MaxConcurrentLongRunningRequestsPerInstancePerAPI                 dynamicconfig.TypedPropertyFnWithNamespaceFilter[map[string]int]
 	MaxNamespaceVisibilityRPSPerInstance                              dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxNamespaceVisibilityBurstRatioPerInstance                       dynamicconfig.FloatPropertyFnWithNamespaceFilter
 	MaxNamespaceNamespaceReplicationInducingAPIsRPSPerInstance        dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -98,7 +102,9 @@ type Config struct {
 	ShutdownDrainDuration                                             dynamicconfig.DurationPropertyFn
 	ShutdownFailHealthCheckDuration                                   dynamicconfig.DurationPropertyFn
 
-	MaxBadBinaries dynamicconfig.IntPropertyFnWithNamespaceFilter
+	MaxBadBinaries                  dynamicconfig.IntPropertyFnWithNamespaceFilter
+	MaxNamespaceFailoverHistorySize dynamicconfig.IntPropertyFn
+	RegisterNamespaceIfNotExists    dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	// security protection settings
 	DisableListVisibilityByFilter dynamicconfig.BoolPropertyFnWithNamespaceFilter
@@ -112,9 +118,10 @@ type Config struct {
 	// Namespace specific config
 	EnableNamespaceNotActiveAutoForwarding dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
-	SearchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithNamespaceFilter
-	SearchAttributesSizeOfValueLimit  dynamicconfig.IntPropertyFnWithNamespaceFilter
-	SearchAttributesTotalSizeLimit    dynamicconfig.IntPropertyFnWithNamespaceFilter
+	SearchAttributesNumberOfKeysLimit     dynamicconfig.IntPropertyFnWithNamespaceFilter
+	SearchAttributesNumberOfKeysLimitWarn dynamicconfig.IntPropertyFnWithNamespaceFilter
+	SearchAttributesSizeOfValueLimit      dynamicconfig.IntPropertyFnWithNamespaceFilter
+	SearchAttributesTotalSizeLimit        dynamicconfig.IntPropertyFnWithNamespaceFilter
 
 	// DefaultWorkflowRetryPolicy represents default values for unset fields on a Workflow's
 	// specified RetryPolicy
@@ -165,6 +172,9 @@ type Config struct {
 	// Must be not greater than 256 and number of worker cores in the cluster.
 	// Default is 4.
 	DeleteNamespaceConcurrentDeleteExecutionsActivities dynamicconfig.IntPropertyFn
+	// Number of concurrent delete executions activities shared by all namespaces being deleted at the same time.
+	// Default is 0, means, no shared budget.
+	DeleteNamespaceTotalConcurrentDeleteExecutionsActivities dynamicconfig.IntPropertyFn
 	// Duration for how long namespace stays in database
 	// after all namespace resources (i.e. workflow executions) are deleted.
 	// Default is 0, means, namespace will be deleted immediately.
@@ -182,7 +192,8 @@ type Config struct {
 	EnableUpdateWorkflowExecution              dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	EnableUpdateWorkflowExecutionAsyncAccepted dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
-	EnableExecuteMultiOperation dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	EnableExecuteMultiOperation    dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	MaxOperationsPerMultiOperation dynamicconfig.IntPropertyFnWithNamespaceFilter
 
 	EnableWorkerVersioningData     dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	EnableWorkerVersioningWorkflow dynamicconfig.BoolPropertyFnWithNamespaceFilter
@@ -190,13 +201,18 @@ type Config struct {
 
 	// EnableNexusAPIs controls whether to allow invoking Nexus related APIs.
 	EnableNexusAPIs dynamicconfig.BoolPropertyFn
+	// EnableNexusAPIsForNamespace controls whether to allow invoking Nexus related APIs for a namespace.
+	EnableNexusAPIsForNamespace dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	CallbackURLMaxLength        dynamicconfig.IntPropertyFnWithNamespaceFilter
 	CallbackHeaderMaxSize       dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxCallbacksPerWorkflow     dynamicconfig.IntPropertyFnWithNamespaceFilter
+	CallbackHeaderMaxTotalSize  dynamicconfig.IntPropertyFnWithNamespaceFilter
 	CallbackEndpointConfigs     dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]callbacks.AddressMatchRule]
 	AdminEnableListHistoryTasks dynamicconfig.BoolPropertyFn
 
+	AdminForceResyncNamespaceRPS dynamicconfig.FloatPropertyFn
+
 	MaskInternalErrorDetails dynamicconfig.BoolPropertyFnWithNamespaceFilter
 }
 
@@ -220,7 +236,9 @@ func NewConfig(
 		VisibilityMaxPageSize:                 dynamicconfig.FrontendVisibilityMaxPageSize.Get(dc),
 		EnableReadFromSecondaryVisibility:     dynamicconfig.EnableReadFromSecondaryVisibility.Get(dc),
 		VisibilityEnableShadowReadMode:        dynamicconfig.VisibilityEnableShadowReadMode.Get(dc),
+		VisibilityShadowReadDiffSampleRate:    dynamicconfig.VisibilityShadowReadDiffSampleRate.Get(dc),
 		VisibilityDisableOrderByClause:        dynamicconfig.VisibilityDisableOrderByClause.Get(dc),
+		VisibilityOrderByAllowedFields:        dynamicconfig.VisibilityOrderByAllowedFields.Get(dc),
 		VisibilityEnableManualPagination:      dynamicconfig.VisibilityEnableManualPagination.Get(dc),
 		VisibilityAllowList:                   dynamicconfig.VisibilityAllowList.Get(dc),
 		SuppressErrorSetSystemSearchAttribute: dynamicconfig.SuppressErrorSetSystemSearchAttribute.Get(dc),
@@ -231,10 +249,13 @@ func NewConfig(
 		OperatorRPSRatio:                    dynamicconfig.OperatorRPSRatio.Get(dc),
 		NamespaceReplicationInducingAPIsRPS: dynamicconfig.FrontendNamespaceReplicationInducingAPIsRPS.Get(dc),
 
+		ReducePollWorkflowHistoryRequestPriority: dynamicconfig.ReducePollWorkflowHistoryRequestPriority.Get(dc),
+
 		MaxNamespaceRPSPerInstance:                                        dynamicconfig.FrontendMaxNamespaceRPSPerInstance.Get(dc),
 		MaxNamespaceBurstRatioPerInstance:                                 dynamicconfig.FrontendMaxNamespaceBurstRatioPerInstance.Get(dc),
 		MaxConcurrentLongRunningRequestsPerInstance:                       dynamicconfig.FrontendMaxConcurrentLongRunningRequestsPerInstance.Get(dc),
 		MaxGlobalConcurrentLongRunningRequests:                            dynamicconfig.FrontendGlobalMaxConcurrentLongRunningRequests.Get(dc),
+		MaxConcurrentLongRunningRequestsPerInstancePerAPI:                 dynamicconfig.FrontendMaxConcurrentLongRunningRequestsPerInstancePerAPI.Get(dc),
 		MaxNamespaceVisibilityRPSPerInstance:                              dynamicconfig.FrontendMaxNamespaceVisibilityRPSPerInstance.Get(dc),
 		MaxNamespaceVisibilityBurstRatioPerInstance:                       dynamicconfig.FrontendMaxNamespaceVisibilityBurstRatioPerInstance.Get(dc),
 		MaxNamespaceNamespaceReplicationInducingAPIsRPSPerInstance:        dynamicconfig.FrontendMaxNamespaceNamespaceReplicationInducingAPIsRPSPerInstance.Get(dc),
@@ -254,6 +275,8 @@ func NewConfig(
 		ReachabilityCacheClosedWFsTTL:            dynamicconfig.ReachabilityCacheClosedWFsTTL.Get(dc),
 		ReachabilityQuerySetDurationSinceDefault: dynamicconfig.ReachabilityQuerySetDurationSinceDefault.Get(dc),
 		MaxBadBinaries:                           dynamicconfig.FrontendMaxBadBinaries.Get(dc),
+		MaxNamespaceFailoverHistorySize:          dynamicconfig.FrontendMaxNamespaceFailoverHistorySize.Get(dc),
+		RegisterNamespaceIfNotExists:             dynamicconfig.FrontendRegisterNamespaceIfNotExists.Get(dc),
 		DisableListVisibilityByFilter:            dynamicconfig.DisableListVisibilityByFilter.Get(dc),
 		BlobSizeLimitError:                       dynamicconfig.BlobSizeLimitError.Get(dc),
 		BlobSizeLimitWarn:                        dynamicconfig.BlobSizeLimitWarn.Get(dc),
@@ -262,6 +285,7 @@ func NewConfig(
 		ShutdownFailHealthCheckDuration:          dynamicconfig.FrontendShutdownFailHealthCheckDuration.Get(dc),
 		EnableNamespaceNotActiveAutoForwarding:   dynamicconfig.EnableNamespaceNotActiveAutoForwarding.Get(dc),
 		SearchAttributesNumberOfKeysLimit:        dynamicconfig.SearchAttributesNumberOfKeysLimit.Get(dc),
+		SearchAttributesNumberOfKeysLimitWarn:    dynamicconfig.SearchAttributesNumberOfKeysLimitWarn.Get(dc),
 		SearchAttributesSizeOfValueLimit:         dynamicconfig.SearchAttributesSizeOfValueLimit.Get(dc),
 		SearchAttributesTotalSizeLimit:           dynamicconfig.SearchAttributesTotalSizeLimit.Get(dc),
 		VisibilityArchivalQueryMaxPageSize:       dynamicconfig.VisibilityArchivalQueryMaxPageSize.Get(dc),
@@ -279,11 +303,12 @@ func NewConfig(
 		KeepAliveTime:                            dynamicconfig.KeepAliveTime.Get(dc),
 		KeepAliveTimeout:                         dynamicconfig.KeepAliveTimeout.Get(dc),
 
-		DeleteNamespaceDeleteActivityRPS:                    dynamicconfig.DeleteNamespaceDeleteActivityRPS.Get(dc),
-		DeleteNamespacePageSize:                             dynamicconfig.DeleteNamespacePageSize.Get(dc),
-		DeleteNamespacePagesPerExecution:                    dynamicconfig.DeleteNamespacePagesPerExecution.Get(dc),
-		DeleteNamespaceConcurrentDeleteExecutionsActivities: dynamicconfig.DeleteNamespaceConcurrentDeleteExecutionsActivities.Get(dc),
-		DeleteNamespaceNamespaceDeleteDelay:                 dynamicconfig.DeleteNamespaceNamespaceDeleteDelay.Get(dc),
+		DeleteNamespaceDeleteActivityRPS:                         dynamicconfig.DeleteNamespaceDeleteActivityRPS.Get(dc),
+		DeleteNamespacePageSize:                                  dynamicconfig.DeleteNamespacePageSize.Get(dc),
+		DeleteNamespacePagesPerExecution:                         dynamicconfig.DeleteNamespacePagesPerExecution.Get(dc),
+		DeleteNamespaceConcurrentDeleteExecutionsActivities:      dynamicconfig.DeleteNamespaceConcurrentDeleteExecutionsActivities.Get(dc),
+		DeleteNamespaceTotalConcurrentDeleteExecutionsActivities: dynamicconfig.DeleteNamespaceTotalConcurrentDeleteExecutionsActivities.Get(dc),
+		DeleteNamespaceNamespaceDeleteDelay:                      dynamicconfig.DeleteNamespaceNamespaceDeleteDelay.Get(dc),
 
 		EnableSchedules: dynamicconfig.FrontendEnableSchedules.Get(dc),
 
@@ -291,7 +316,8 @@ func NewConfig(
 		MaxConcurrentBatchOperation:     dynamicconfig.FrontendMaxConcurrentBatchOperationPerNamespace.Get(dc),
 		MaxExecutionCountBatchOperation: dynamicconfig.FrontendMaxExecutionCountBatchOperationPerNamespace.Get(dc),
 
-		EnableExecuteMultiOperation: dynamicconfig.FrontendEnableExecuteMultiOperation.Get(dc),
+		EnableExecuteMultiOperation:    dynamicconfig.FrontendEnableExecuteMultiOperation.Get(dc),
+		MaxOperationsPerMultiOperation: dynamicconfig.FrontendMaxOperationsPerMultiOperation.Get(dc),
 
 		EnableUpdateWorkflowExecution:              dynamicconfig.FrontendEnableUpdateWorkflowExecution.Get(dc),
 		EnableUpdateWorkflowExecutionAsyncAccepted: dynamicconfig.FrontendEnableUpdateWorkflowExecutionAsyncAccepted.Get(dc),
@@ -301,12 +327,16 @@ func NewConfig(
 		EnableWorkerVersioningRules:    dynamicconfig.FrontendEnableWorkerVersioningRuleAPIs.Get(dc),
 
 		EnableNexusAPIs:             dynamicconfig.EnableNexus.Get(dc),
+		EnableNexusAPIsForNamespace: dynamicconfig.EnableNexusForNamespace.Get(dc),
 		CallbackURLMaxLength:        dynamicconfig.FrontendCallbackURLMaxLength.Get(dc),
 		CallbackHeaderMaxSize:       dynamicconfig.FrontendCallbackHeaderMaxSize.Get(dc),
 		MaxCallbacksPerWorkflow:     dynamicconfig.MaxCallbacksPerWorkflow.Get(dc),
+		CallbackHeaderMaxTotalSize:  dynamicconfig.FrontendCallbackHeaderMaxTotalSizePerWorkflow.Get(dc),
 		CallbackEndpointConfigs:     callbacks.AllowedAddresses.Get(dc),
 		AdminEnableListHistoryTasks: dynamicconfig.AdminEnableListHistoryTasks.Get(dc),
 
+		AdminForceResyncNamespaceRPS: dynamicconfig.AdminForceResyncNamespaceRPS.Get(dc),
+
 		MaskInternalErrorDetails: dynamicconfig.FrontendMaskInternalErrorDetails.Get(dc),
 	}
 }
@@ -138,6 +138,9 @@ type (
 		membershipMonitor               membership.Monitor
 		healthInterceptor               *interceptor.HealthInterceptor
 		scheduleSpecBuilder             *scheduler.SpecBuilder
+		// internalFrontend is true if this handler serves the internal frontend, which only accepts
+		// requests from server components.
+		internalFrontend bool
 	}
 )
 
@@ -148,6 +151,7 @@ func NewWorkflowHandler(
 	visibilityMgr manager.VisibilityManager,
 	logger log.Logger,
 	throttledLogger log.Logger,
+	metricsHandler metrics.Handler,
 	persistenceExecutionName string,
 	clusterMetadataManager persistence.ClusterMetadataManager,
 	persistenceMetadataManager persistence.MetadataManager,
@@ -165,6 +169,7 @@ func NewWorkflowHandler(
 	membershipMonitor membership.Monitor,
 	healthInterceptor *interceptor.HealthInterceptor,
 	scheduleSpecBuilder *scheduler.SpecBuilder,
+	serviceName primitives.ServiceName,
 ) *WorkflowHandler {
 
 	handler := &WorkflowHandler{
@@ -174,6 +179,8 @@ func NewWorkflowHandler(
 		versionChecker:  headers.NewDefaultVersionChecker(),
 		namespaceHandler: newNamespaceHandler(
 			config.MaxBadBinaries,
+			config.MaxNamespaceFailoverHistorySize,
+			config.RegisterNamespaceIfNotExists,
 			logger,
 			persistenceMetadataManager,
 			clusterMetadata,
@@ -200,6 +207,7 @@ func NewWorkflowHandler(
 			saProvider,
 			saMapperProvider,
 			config.SearchAttributesNumberOfKeysLimit,
+			config.SearchAttributesNumberOfKeysLimitWarn,
 			config.SearchAttributesSizeOfValueLimit,
 			config.SearchAttributesTotalSizeLimit,
 			visibilityMgr,
@@ -208,6 +216,8 @@ func NewWorkflowHandler(
 				config.VisibilityAllowList,
 			),
 			config.SuppressErrorSetSystemSearchAttribute,
+			metricsHandler,
+			throttledLogger,
 		),
 		archivalMetadata:    archivalMetadata,
 		healthServer:        healthServer,
@@ -215,6 +225,7 @@ func NewWorkflowHandler(
 		membershipMonitor:   membershipMonitor,
 		healthInterceptor:   healthInterceptor,
 		scheduleSpecBuilder: scheduleSpecBuilder,
+		internalFrontend:    serviceName == primitives.InternalFrontendService,
 	}
 
 	return handler
@@ -367,16 +378,15 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 	}
 	wh.logger.Debug("Start workflow execution request namespaceID.", tag.WorkflowNamespaceID(namespaceID.String()))
 
-	resp, err := wh.historyClient.StartWorkflowExecution(
-		ctx,
-		common.CreateHistoryStartWorkflowRequest(
-			namespaceID.String(),
-			request,
-			nil,
-			nil,
-			time.Now().UTC(),
-		),
+	historyRequest := common.CreateHistoryStartWorkflowRequest(
+		namespaceID.String(),
+		request,
+		nil,
+		nil,
+		time.Now().UTC(),
 	)
+	historyRequest.InternalCaller = wh.internalFrontend
+	resp, err := wh.historyClient.StartWorkflowExecution(ctx, historyRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -495,6 +505,10 @@ func (wh *WorkflowHandler) ExecuteMultiOperation(
 		return nil, errMultiOperationAPINotAllowed
 	}
 
+	if maxOperations := wh.config.MaxOperationsPerMultiOperation(request.Namespace); len(request.Operations) > maxOperations {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errTooManyMultiOperationsMessage, len(request.Operations), maxOperations))
+	}
+
 	// as a temporary limitation, the only allowed list of operations is exactly [Start, Update]
 	if len(request.Operations) != 2 {
 		return nil, errMultiOpNotStartAndUpdate
@@ -663,10 +677,6 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 		return nil, err
 	}
 
-	if request.GetMaximumPageSize() <= 0 {
-		request.MaximumPageSize = int32(wh.config.HistoryMaxPageSize(request.GetNamespace()))
-	}
-
 	enums.SetDefaultHistoryEventFilterType(&request.HistoryEventFilterType)
 
 	namespaceID, err := wh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
@@ -680,7 +690,10 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 			tag.WorkflowID(request.Execution.GetWorkflowId()),
 			tag.WorkflowRunID(request.Execution.GetRunId()),
 			tag.WorkflowNamespaceID(namespaceID.String()), tag.WorkflowSize(int64(request.GetMaximumPageSize())))
-		request.MaximumPageSize = primitives.GetHistoryMaxPageSize
+	}
+	request.MaximumPageSize = effectiveHistoryPageSize(request.GetMaximumPageSize(), wh.config.HistoryMaxPageSize(request.GetNamespace()))
+	if err := setEffectiveHistoryPageSizeHeader(ctx, request.GetMaximumPageSize()); err != nil {
+		wh.throttledLogger.Warn("Failed to add effective history page size header to response", tag.Error(err))
 	}
 
 	if !request.GetSkipArchival() {
@@ -715,10 +728,6 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistoryReverse(ctx context.Contex
 		return nil, err
 	}
 
-	if request.GetMaximumPageSize() <= 0 {
-		request.MaximumPageSize = int32(wh.config.HistoryMaxPageSize(request.GetNamespace()))
-	}
-
 	namespaceID, err := wh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
 	if err != nil {
 		return nil, err
@@ -730,7 +739,10 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistoryReverse(ctx context.Contex
 			tag.WorkflowID(request.Execution.GetWorkflowId()),
 			tag.WorkflowRunID(request.Execution.GetRunId()),
 			tag.WorkflowNamespaceID(namespaceID.String()), tag.WorkflowSize(int64(request.GetMaximumPageSize())))
-		request.MaximumPageSize = primitives.GetHistoryMaxPageSize
+	}
+	request.MaximumPageSize = effectiveHistoryPageSize(request.GetMaximumPageSize(), wh.config.HistoryMaxPageSize(request.GetNamespace()))
+	if err := setEffectiveHistoryPageSizeHeader(ctx, request.GetMaximumPageSize()); err != nil {
+		wh.throttledLogger.Warn("Failed to add effective history page size header to response", tag.Error(err))
 	}
 
 	response, err := wh.historyClient.GetWorkflowExecutionHistoryReverse(ctx,
@@ -4312,6 +4324,9 @@ func (wh *WorkflowHandler) PollNexusTaskQueue(ctx context.Context, request *work
 	}
 
 	namespaceName := namespace.Name(request.GetNamespace())
+	if !wh.config.EnableNexusAPIsForNamespace(namespaceName.String()) {
+		return nil, errNexusAPIsNotAllowed
+	}
 	if err := wh.validateTaskQueue(request.TaskQueue); err != nil {
 		return nil, err
 	}
@@ -4375,6 +4390,9 @@ func (wh *WorkflowHandler) RespondNexusTaskCompleted(ctx context.Context, reques
 	if request == nil {
 		return nil, errRequestNotSet
 	}
+	if !wh.config.EnableNexusAPIsForNamespace(request.GetNamespace()) {
+		return nil, errNexusAPIsNotAllowed
+	}
 
 	// Both the task token and the request have a reference to a namespace. We prefer using the namespace ID from
 	// the token as it is a more stable identifier.
@@ -4416,6 +4434,9 @@ func (wh *WorkflowHandler) RespondNexusTaskFailed(ctx context.Context, request *
 	if request == nil {
 		return nil, errRequestNotSet
 	}
+	if !wh.config.EnableNexusAPIsForNamespace(request.GetNamespace()) {
+		return nil, errNexusAPIsNotAllowed
+	}
 
 	// Both the task token and the request have a reference to a namespace. We prefer using the namespace ID from
 	// the token as it is a more stable identifier.
@@ -4491,7 +4512,7 @@ func (wh *WorkflowHandler) validateWorkflowCompletionCallbacks(
 	ns namespace.Name,
 	callbacks []*commonpb.Callback,
 ) error {
-	if len(callbacks) > 0 && !wh.config.EnableNexusAPIs() {
+	if len(callbacks) > 0 && (!wh.config.EnableNexusAPIs() || !wh.config.EnableNexusAPIsForNamespace(ns.String())) {
 		return status.Error(
 			codes.InvalidArgument,
 			"attaching workflow callbacks is disabled for this namespace",
@@ -4508,6 +4529,7 @@ func (wh *WorkflowHandler) validateWorkflowCompletionCallbacks(
 		)
 	}
 
+	totalHeaderSize := 0
 	for _, callback := range callbacks {
 		switch cb := callback.GetVariant().(type) {
 		case *commonpb.Callback_Nexus_:
@@ -4529,6 +4551,17 @@ func (wh *WorkflowHandler) validateWorkflowCompletionCallbacks(
 				)
 			}
 
+			totalHeaderSize += headerSize
+			if totalHeaderSize > wh.config.CallbackHeaderMaxTotalSize(ns.String()) {
+				return status.Error(
+					codes.InvalidArgument,
+					fmt.Sprintf(
+						"invalid header: accumulated header size of all callbacks longer than max allowed size of %d",
+						wh.config.CallbackHeaderMaxTotalSize(ns.String()),
+					),
+				)
+			}
+
 		default:
 			return status.Error(codes.Unimplemented, fmt.Sprintf("unknown callback variant: %T", cb))
 		}
@@ -27,6 +27,7 @@ package frontend
 import (
 	"context"
 	"errors"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -50,7 +51,9 @@ import (
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -77,6 +80,7 @@ import (
 	"go.temporal.io/server/common/resourcetest"
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/components/callbacks"
 	e "go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/worker/batcher"
 	"go.temporal.io/server/service/worker/scheduler"
@@ -179,6 +183,7 @@ func (s *workflowHandlerSuite) getWorkflowHandler(config *Config) *WorkflowHandl
 		s.mockResource.GetVisibilityManager(),
 		s.mockResource.GetLogger(),
 		s.mockResource.GetThrottledLogger(),
+		s.mockResource.GetMetricsHandler(),
 		s.mockResource.GetExecutionManager().GetName(),
 		s.mockResource.GetClusterMetadataManager(),
 		s.mockResource.GetMetadataManager(),
@@ -196,6 +201,7 @@ func (s *workflowHandlerSuite) getWorkflowHandler(config *Config) *WorkflowHandl
 		s.mockResource.GetMembershipMonitor(),
 		healthInterceptor,
 		scheduler.NewSpecBuilder(),
+		primitives.FrontendService,
 	)
 }
 
@@ -362,6 +368,38 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_WorkflowIdNotSe
 	s.Equal(errWorkflowIDNotSet, err)
 }
 
+func (s *workflowHandlerSuite) TestValidateWorkflowCompletionCallbacks_HeaderTotalSize() {
+	config := s.newConfig()
+	config.EnableNexusAPIs = dc.GetBoolPropertyFn(true)
+	config.EnableNexusAPIsForNamespace = dc.GetBoolPropertyFnFilteredByNamespace(true)
+	config.CallbackEndpointConfigs = func(string) []callbacks.AddressMatchRule {
+		return []callbacks.AddressMatchRule{{Regexp: regexp.MustCompile(".*"), AllowInsecure: true}}
+	}
+	config.CallbackHeaderMaxSize = dc.GetIntPropertyFnFilteredByNamespace(10)
+	config.CallbackHeaderMaxTotalSize = dc.GetIntPropertyFnFilteredByNamespace(20)
+	wh := s.getWorkflowHandler(config)
+
+	mkCallback := func() *commonpb.Callback {
+		return &commonpb.Callback{
+			Variant: &commonpb.Callback_Nexus_{
+				Nexus: &commonpb.Callback_Nexus{
+					Url:    "http://localhost/callback",
+					Header: map[string]string{"key": "value12"}, // size 10
+				},
+			},
+		}
+	}
+
+	// up to the budget
+	err := wh.validateWorkflowCompletionCallbacks("test-namespace", []*commonpb.Callback{mkCallback(), mkCallback()})
+	s.NoError(err)
+
+	// past the budget
+	err = wh.validateWorkflowCompletionCallbacks("test-namespace", []*commonpb.Callback{mkCallback(), mkCallback(), mkCallback()})
+	s.Error(err)
+	s.Equal(codes.InvalidArgument, status.Code(err))
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_WorkflowTypeNotSet() {
 	config := s.newConfig()
 	config.RPS = dc.GetIntPropertyFn(10)
@@ -662,6 +700,29 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_DefaultWorkflowIdDupli
 	s.True(resp.Started)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_InternalCaller() {
+	for _, internalFrontend := range []bool{false, true} {
+		s.mockSearchAttributesMapperProvider.EXPECT().GetMapper(gomock.Any()).Return(nil, nil)
+		s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(namespace.NewID(), nil)
+		s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), mock.MatchedBy(
+			func(request *historyservice.StartWorkflowExecutionRequest) bool {
+				return request.InternalCaller == internalFrontend
+			},
+		)).Return(&historyservice.StartWorkflowExecutionResponse{Started: true}, nil)
+
+		wh := s.getWorkflowHandler(s.newConfig())
+		wh.internalFrontend = internalFrontend
+		req := &workflowservice.StartWorkflowExecutionRequest{
+			WorkflowId:   testWorkflowID,
+			WorkflowType: &commonpb.WorkflowType{Name: "WORKFLOW"},
+			TaskQueue:    &taskqueuepb.TaskQueue{Name: "TASK_QUEUE", Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+		}
+
+		_, err := wh.StartWorkflowExecution(context.Background(), req)
+		s.NoError(err)
+	}
+}
+
 func (s *workflowHandlerSuite) TestSignalWithStartWorkflowExecution_InvalidWorkflowIdConflictPolicy() {
 	config := s.newConfig()
 	wh := s.getWorkflowHandler(config)
@@ -1811,6 +1872,50 @@ func (s *workflowHandlerSuite) TestGetSystemInfo() {
 	s.False(resp.Capabilities.Nexus)
 }
 
+func (s *workflowHandlerSuite) TestNexusAPIs_DisabledForNamespace() {
+	config := s.newConfig()
+	config.EnableNexusAPIsForNamespace = func(ns string) bool { return ns != s.testNamespace.String() }
+	wh := s.getWorkflowHandler(config)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	pollResp, err := wh.PollNexusTaskQueue(ctx, &workflowservice.PollNexusTaskQueueRequest{
+		Namespace: s.testNamespace.String(),
+		TaskQueue: &taskqueuepb.TaskQueue{Name: "task-queue"},
+	})
+	s.Nil(pollResp)
+	s.Equal(errNexusAPIsNotAllowed, err)
+
+	completedResp, err := wh.RespondNexusTaskCompleted(ctx, &workflowservice.RespondNexusTaskCompletedRequest{
+		Namespace: s.testNamespace.String(),
+	})
+	s.Nil(completedResp)
+	s.Equal(errNexusAPIsNotAllowed, err)
+
+	failedResp, err := wh.RespondNexusTaskFailed(ctx, &workflowservice.RespondNexusTaskFailedRequest{
+		Namespace: s.testNamespace.String(),
+	})
+	s.Nil(failedResp)
+	s.Equal(errNexusAPIsNotAllowed, err)
+
+	// other namespaces are not affected and fail further down on the missing task token
+	_, err = wh.RespondNexusTaskCompleted(ctx, &workflowservice.RespondNexusTaskCompletedRequest{
+		Namespace: "other-namespace",
+	})
+	s.Error(err)
+	s.NotEqual(errNexusAPIsNotAllowed, err)
+
+	// activity polls of the namespace are not Nexus APIs
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(namespace.EmptyID, serviceerror.NewNamespaceNotFound(s.testNamespace.String()))
+	_, err = wh.PollActivityTaskQueue(ctx, &workflowservice.PollActivityTaskQueueRequest{
+		Namespace: s.testNamespace.String(),
+		TaskQueue: &taskqueuepb.TaskQueue{Name: "task-queue"},
+	})
+	var namespaceNotFound *serviceerror.NamespaceNotFound
+	s.ErrorAs(err, &namespaceNotFound)
+}
+
 func (s *workflowHandlerSuite) TestStartBatchOperation_Terminate() {
 	testNamespace := namespace.Name("test-namespace")
 	namespaceID := namespace.ID(uuid.New())
@@ -2789,6 +2894,38 @@ func (s *workflowHandlerSuite) TestExecuteMultiOperation() {
 
 	// NOTE: functional tests are testing the happy case
 
+	s.Run("operations list that exceeds the configured limit is invalid", func() {
+		defer func(orig dc.IntPropertyFnWithNamespaceFilter) {
+			config.MaxOperationsPerMultiOperation = orig
+		}(config.MaxOperationsPerMultiOperation)
+
+		ops := []*workflowservice.ExecuteMultiOperationRequest_Operation{
+			newStartOp(nil), newStartOp(nil), newStartOp(nil),
+		}
+
+		// at the limit: passes the size check and fails on the operation shape instead
+		config.MaxOperationsPerMultiOperation = dc.GetIntPropertyFnFilteredByNamespace(3)
+		resp, err := wh.ExecuteMultiOperation(ctx, &workflowservice.ExecuteMultiOperationRequest{
+			Namespace:  s.testNamespace.String(),
+			Operations: ops,
+		})
+
+		s.Nil(resp)
+		s.Equal(errMultiOpNotStartAndUpdate, err)
+
+		// over the limit
+		config.MaxOperationsPerMultiOperation = dc.GetIntPropertyFnFilteredByNamespace(2)
+		resp, err = wh.ExecuteMultiOperation(ctx, &workflowservice.ExecuteMultiOperationRequest{
+			Namespace:  s.testNamespace.String(),
+			Operations: ops,
+		})
+
+		s.Nil(resp)
+		var invalidArgErr *serviceerror.InvalidArgument
+		s.ErrorAs(err, &invalidArgErr)
+		s.Equal("Too many operations in request: 3, the limit is 2.", invalidArgErr.Message)
+	})
+
 	s.Run("operations list that is not [Start, Update] is invalid", func() {
 		// empty list
 		resp, err := wh.ExecuteMultiOperation(ctx, &workflowservice.ExecuteMultiOperationRequest{
@@ -69,7 +69,8 @@ type (
 		TelemetryInterceptor   *interceptor.TelemetryInterceptor
 		RateLimitInterceptor   *interceptor.RateLimitInterceptor
 		TracingInterceptor     telemetry.ServerTraceInterceptor
-		AdditionalInterceptors []grpc.UnaryServerInterceptor `optional:"true"`
+		HealthCheckInterceptor *interceptor.HealthCheckInterceptor `optional:"true"`
+		AdditionalInterceptors []grpc.UnaryServerInterceptor       `optional:"true"`
 	}
 )
 
@@ -166,6 +167,9 @@ func getUnaryInterceptors(params GrpcServerOptionsParams) []grpc.UnaryServerInte
 		params.TelemetryInterceptor.UnaryIntercept,
 	}
 
+	if params.HealthCheckInterceptor != nil {
+		interceptors = append(interceptors, params.HealthCheckInterceptor.Intercept)
+	}
 	interceptors = append(interceptors, params.AdditionalInterceptors...)
 
 	return append(
@@ -125,11 +125,12 @@ func Invoke(
 
 	for workflowKey, taskBatch := range taskBatches {
 		err := shardContext.AddTasks(ctx, &persistence.AddHistoryTasksRequest{
-			ShardID:     shardContext.GetShardID(),
-			RangeID:     shardContext.GetRangeID(),
-			NamespaceID: workflowKey.NamespaceID,
-			WorkflowID:  workflowKey.WorkflowID,
-			Tasks:       taskBatch,
+			ShardID:          shardContext.GetShardID(),
+			RangeID:          shardContext.GetRangeID(),
+			NamespaceID:      workflowKey.NamespaceID,
+			WorkflowID:       workflowKey.WorkflowID,
+			Tasks:            taskBatch,
+			IdempotencyToken: batchIdempotencyToken(req.IdempotencyToken, workflowKey),
 		})
 		if err != nil {
 			return nil, err
@@ -138,3 +139,12 @@ func Invoke(
 
 	return &historyservice.AddTasksResponse{}, nil
 }
+
+// batchIdempotencyToken derives the token for one of the per-workflow batches that a request is split into, so that
+// the batches of a request don't dedupe each other.
+func batchIdempotencyToken(requestToken string, workflowKey definition.WorkflowKey) string {
+	if requestToken == "" {
+		return ""
+	}
+	return requestToken + "/" + workflowKey.NamespaceID + "/" + workflowKey.WorkflowID
+}
@@ -152,6 +152,24 @@ func TestInvoke(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "idempotency token",
+			configure: func(t *testing.T, params *testParams) {
+				params.req.IdempotencyToken = "test-token"
+				params.shardContext.(*shard.MockContext).EXPECT().AddTasks(
+					gomock.Any(),
+					gomock.Any(),
+				).DoAndReturn(func(_ context.Context, req *persistence.AddHistoryTasksRequest) error {
+					assert.Equal(t, "test-token/"+string(tests.NamespaceID)+"/"+tests.WorkflowID, req.IdempotencyToken,
+						"Each workflow batch should get its own token derived from the request token")
+					return nil
+				})
+				params.expectation = func(resp *historyservice.AddTasksResponse, err error) {
+					require.NoError(t, err)
+					assert.NotNil(t, resp)
+				}
+			},
+		},
 		{
 			name: "too many tasks",
 			configure: func(t *testing.T, params *testParams) {
@@ -305,6 +305,16 @@ func ValidateStartWorkflowExecutionRequest(
 	if timestamp.DurationValue(request.GetWorkflowTaskTimeout()) < 0 {
 		return serviceerror.NewInvalidArgument("Invalid WorkflowTaskTimeoutSeconds.")
 	}
+	// timeouts above the namespace ceiling are clamped by OverrideStartWorkflowExecutionRequest, unless rejected here
+	if _, err := common.EnforceMaxWorkflowTaskTimeout(
+		namespaceEntry.Name().String(),
+		timestamp.DurationValue(request.GetWorkflowTaskTimeout()),
+		timestamp.DurationValue(request.GetWorkflowTaskTimeout()),
+		shard.GetConfig().MaxWorkflowTaskTimeout,
+		shard.GetConfig().RejectWorkflowTaskTimeoutAboveMax,
+	); err != nil {
+		return err
+	}
 	if request.TaskQueue == nil || request.TaskQueue.GetName() == "" {
 		return serviceerror.NewInvalidArgument("Missing Taskqueue.")
 	}
@@ -367,6 +377,17 @@ func OverrideStartWorkflowExecutionRequest(
 		timestamp.DurationValue(request.GetWorkflowRunTimeout()),
 		shard.GetConfig().DefaultWorkflowTaskTimeout,
 	)
+	// clamp to the namespace ceiling, an explicitly requested timeout that is to be rejected
+	// is left as is for ValidateStartWorkflowExecutionRequest
+	if maxTimeout, err := common.EnforceMaxWorkflowTaskTimeout(
+		namespace,
+		timestamp.DurationValue(request.GetWorkflowTaskTimeout()),
+		workflowTaskStartToCloseTimeout,
+		shard.GetConfig().MaxWorkflowTaskTimeout,
+		shard.GetConfig().RejectWorkflowTaskTimeoutAboveMax,
+	); err == nil {
+		workflowTaskStartToCloseTimeout = maxTimeout
+	}
 	if workflowTaskStartToCloseTimeout != timestamp.DurationValue(request.GetWorkflowTaskTimeout()) {
 		request.WorkflowTaskTimeout = durationpb.New(workflowTaskStartToCloseTimeout)
 		metrics.WorkflowTaskTimeoutOverrideCount.With(metricsHandler).Record(
@@ -26,15 +26,20 @@ package deletedlqtasks
 
 import (
 	"context"
+	"fmt"
 
 	"go.temporal.io/api/serviceerror"
 
+	commonspb "go.temporal.io/server/api/common/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/tasks"
 )
 
+// readPageSize is the number of tasks read at a time when only deleting the tasks which match a failure reason pattern.
+const readPageSize = 100
+
 func Invoke(
 	ctx context.Context,
 	historyTaskQueueManager persistence.HistoryTaskQueueManager,
@@ -50,13 +55,18 @@ func Invoke(
 		return nil, serviceerror.NewInvalidArgument("must supply inclusive_max_task_metadata")
 	}
 
+	queueKey := persistence.QueueKey{
+		QueueType:     persistence.QueueTypeHistoryDLQ,
+		Category:      category,
+		SourceCluster: req.DlqKey.SourceCluster,
+		TargetCluster: req.DlqKey.TargetCluster,
+	}
+	if req.FailureReasonPattern != "" {
+		return deleteMatchingTasks(ctx, historyTaskQueueManager, queueKey, req)
+	}
+
 	resp, err := historyTaskQueueManager.DeleteTasks(ctx, &persistence.DeleteTasksRequest{
-		QueueKey: persistence.QueueKey{
-			QueueType:     persistence.QueueTypeHistoryDLQ,
-			Category:      category,
-			SourceCluster: req.DlqKey.SourceCluster,
-			TargetCluster: req.DlqKey.TargetCluster,
-		},
+		QueueKey: queueKey,
 		InclusiveMaxMessageMetadata: persistence.MessageMetadata{
 			ID: req.InclusiveMaxTaskMetadata.MessageId,
 		},
@@ -67,3 +77,84 @@ func Invoke(
 
 	return &historyservice.DeleteDLQTasksResponse{MessagesDeleted: resp.MessagesDeleted}, nil
 }
+
+// deleteMatchingTasks deletes the tasks up to the max message ID whose failure reason matches the pattern. The queue
+// can only delete a range of messages, so the range is processed a page at a time from the head of the DLQ: the tasks
+// of the page which don't match are enqueued again at the end of the DLQ, and then the page is deleted. Moving the
+// tasks before deleting them means that a failure in between leaves duplicates in the DLQ instead of losing tasks,
+// and a retry duplicates at most the tasks of one page.
+func deleteMatchingTasks(
+	ctx context.Context,
+	historyTaskQueueManager persistence.HistoryTaskQueueManager,
+	queueKey persistence.QueueKey,
+	req *historyservice.DeleteDLQTasksRequest,
+) (*historyservice.DeleteDLQTasksResponse, error) {
+	if _, err := persistence.MatchDLQErrorPattern(req.FailureReasonPattern, ""); err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid failure reason pattern: %v", err))
+	}
+
+	response := &historyservice.DeleteDLQTasksResponse{}
+	maxMessageID := req.InclusiveMaxTaskMetadata.MessageId
+	for {
+		// Always read the first page: the pages before it have been deleted already.
+		resp, err := historyTaskQueueManager.ReadTasks(ctx, &persistence.ReadTasksRequest{
+			QueueKey: queueKey,
+			PageSize: readPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		done := len(resp.NextPageToken) == 0
+		lastReadMessageID := int64(persistence.FirstQueueMessageID - 1)
+		var tasksToMove []persistence.HistoryTask
+		for _, task := range resp.Tasks {
+			if task.MessageMetadata.ID > maxMessageID {
+				done = true
+				break
+			}
+			lastReadMessageID = task.MessageMetadata.ID
+			// The pattern was validated above, so matching can't fail here.
+			if match, _ := persistence.MatchDLQErrorPattern(req.FailureReasonPattern, task.FailureReason); !match {
+				tasksToMove = append(tasksToMove, task)
+			}
+		}
+		if lastReadMessageID < persistence.FirstQueueMessageID {
+			return response, nil
+		}
+
+		for _, task := range tasksToMove {
+			resp, err := historyTaskQueueManager.EnqueueTask(ctx, &persistence.EnqueueTaskRequest{
+				QueueType:     queueKey.QueueType,
+				SourceCluster: queueKey.SourceCluster,
+				TargetCluster: queueKey.TargetCluster,
+				Task:          task.Task,
+				SourceShardID: task.SourceShardID,
+				FailureReason: task.FailureReason,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if response.FirstMovedTaskMetadata == nil {
+				response.FirstMovedTaskMetadata = &commonspb.HistoryDLQTaskMetadata{MessageId: resp.Metadata.ID}
+				// Don't read the moved tasks again, even if the max message ID is past the end of the DLQ.
+				maxMessageID = min(maxMessageID, resp.Metadata.ID-1)
+			}
+		}
+
+		deleteResp, err := historyTaskQueueManager.DeleteTasks(ctx, &persistence.DeleteTasksRequest{
+			QueueKey: queueKey,
+			InclusiveMaxMessageMetadata: persistence.MessageMetadata{
+				ID: lastReadMessageID,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		response.MessagesDeleted += deleteResp.MessagesDeleted - int64(len(tasksToMove))
+
+		if done {
+			return response, nil
+		}
+	}
+}
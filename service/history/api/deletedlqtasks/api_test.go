@@ -72,3 +72,23 @@ func TestInvoke_ErrDeleteMissingMessageIDUpperBound(t *testing.T) {
 	assert.Equal(t, codes.InvalidArgument, serviceerror.ToStatus(err).Code())
 	assert.ErrorContains(t, err, "inclusive_max_task_metadata")
 }
+
+func TestInvoke_InvalidFailureReasonPattern(t *testing.T) {
+	t.Parallel()
+
+	queueKey := persistencetest.GetQueueKey(t, persistencetest.WithQueueType(persistence.QueueTypeHistoryDLQ))
+	_, err := deletedlqtasks.Invoke(context.Background(), nil, &historyservice.DeleteDLQTasksRequest{
+		DlqKey: &commonspb.HistoryDLQKey{
+			TaskCategory:  int32(queueKey.Category.ID()),
+			SourceCluster: queueKey.SourceCluster,
+			TargetCluster: queueKey.TargetCluster,
+		},
+		InclusiveMaxTaskMetadata: &commonspb.HistoryDLQTaskMetadata{
+			MessageId: persistence.FirstQueueMessageID,
+		},
+		FailureReasonPattern: "(",
+	}, tasks.NewDefaultTaskCategoryRegistry())
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, serviceerror.ToStatus(err).Code())
+	assert.ErrorContains(t, err, "failure reason pattern")
+}
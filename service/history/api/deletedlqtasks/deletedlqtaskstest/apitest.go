@@ -26,6 +26,8 @@ package deletedlqtaskstest
 
 import (
 	"context"
+	"math"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -80,6 +82,101 @@ func TestInvoke(t *testing.T, manager persistence.HistoryTaskQueueManager) {
 		require.Len(t, resp.Tasks, 1)
 		assert.Equal(t, int64(persistence.FirstQueueMessageID+2), resp.Tasks[0].MessageMetadata.ID)
 	})
+	t.Run("FailureReasonPattern", func(t *testing.T) {
+		t.Parallel()
+
+		queueKey := persistencetest.GetQueueKey(t, persistencetest.WithQueueType(persistence.QueueTypeHistoryDLQ))
+		_, err := manager.CreateQueue(ctx, &persistence.CreateQueueRequest{
+			QueueKey: queueKey,
+		})
+		require.NoError(t, err)
+		for _, failureReason := range []string{"transient error", "poisoned task", "transient timeout"} {
+			_, err := manager.EnqueueTask(ctx, &persistence.EnqueueTaskRequest{
+				QueueType:     queueKey.QueueType,
+				SourceCluster: queueKey.SourceCluster,
+				TargetCluster: queueKey.TargetCluster,
+				Task:          &tasks.WorkflowTask{},
+				SourceShardID: 1,
+				FailureReason: failureReason,
+			})
+			require.NoError(t, err)
+		}
+		res, err := deletedlqtasks.Invoke(ctx, manager, &historyservice.DeleteDLQTasksRequest{
+			DlqKey: &commonspb.HistoryDLQKey{
+				TaskCategory:  int32(queueKey.Category.ID()),
+				SourceCluster: queueKey.SourceCluster,
+				TargetCluster: queueKey.TargetCluster,
+			},
+			InclusiveMaxTaskMetadata: &commonspb.HistoryDLQTaskMetadata{
+				MessageId: persistence.FirstQueueMessageID + 2,
+			},
+			FailureReasonPattern: "transient.*",
+		}, tasks.NewDefaultTaskCategoryRegistry())
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), res.MessagesDeleted)
+		require.NotNil(t, res.FirstMovedTaskMetadata)
+		assert.Equal(t, int64(persistence.FirstQueueMessageID+3), res.FirstMovedTaskMetadata.MessageId)
+		resp, err := manager.ReadTasks(ctx, &persistence.ReadTasksRequest{
+			QueueKey: queueKey,
+			PageSize: 10,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Tasks, 1)
+		assert.Equal(t, int64(persistence.FirstQueueMessageID+3), resp.Tasks[0].MessageMetadata.ID)
+		assert.Equal(t, "poisoned task", resp.Tasks[0].FailureReason)
+		assert.Equal(t, 1, resp.Tasks[0].SourceShardID)
+	})
+	t.Run("FailureReasonPattern_MultiplePages", func(t *testing.T) {
+		t.Parallel()
+
+		queueKey := persistencetest.GetQueueKey(t, persistencetest.WithQueueType(persistence.QueueTypeHistoryDLQ))
+		enqueueTasksWithFailureReasons(ctx, t, manager, queueKey, 250)
+		res, err := deletedlqtasks.Invoke(ctx, manager, &historyservice.DeleteDLQTasksRequest{
+			DlqKey: &commonspb.HistoryDLQKey{
+				TaskCategory:  int32(queueKey.Category.ID()),
+				SourceCluster: queueKey.SourceCluster,
+				TargetCluster: queueKey.TargetCluster,
+			},
+			// past the end of the DLQ, so the moved tasks must not be read again
+			InclusiveMaxTaskMetadata: &commonspb.HistoryDLQTaskMetadata{
+				MessageId: math.MaxInt64,
+			},
+			FailureReasonPattern: "transient.*",
+		}, tasks.NewDefaultTaskCategoryRegistry())
+		require.NoError(t, err)
+		assert.Equal(t, int64(125), res.MessagesDeleted)
+		require.NotNil(t, res.FirstMovedTaskMetadata)
+		assert.Equal(t, int64(persistence.FirstQueueMessageID+250), res.FirstMovedTaskMetadata.MessageId)
+		failureReasons := readFailureReasons(ctx, t, manager, queueKey)
+		assert.Len(t, failureReasons, 125)
+		assert.Equal(t, []string{"poisoned task"}, slices.Compact(failureReasons))
+	})
+	t.Run("FailureReasonPattern_RetryAfterFailedDelete", func(t *testing.T) {
+		t.Parallel()
+
+		queueKey := persistencetest.GetQueueKey(t, persistencetest.WithQueueType(persistence.QueueTypeHistoryDLQ))
+		enqueueTasksWithFailureReasons(ctx, t, manager, queueKey, 250)
+		failingManager := &failDeleteOnceManager{HistoryTaskQueueManager: manager}
+		req := &historyservice.DeleteDLQTasksRequest{
+			DlqKey: &commonspb.HistoryDLQKey{
+				TaskCategory:  int32(queueKey.Category.ID()),
+				SourceCluster: queueKey.SourceCluster,
+				TargetCluster: queueKey.TargetCluster,
+			},
+			InclusiveMaxTaskMetadata: &commonspb.HistoryDLQTaskMetadata{
+				MessageId: persistence.FirstQueueMessageID + 249,
+			},
+			FailureReasonPattern: "transient.*",
+		}
+		_, err := deletedlqtasks.Invoke(ctx, failingManager, req, tasks.NewDefaultTaskCategoryRegistry())
+		require.Error(t, err)
+		_, err = deletedlqtasks.Invoke(ctx, failingManager, req, tasks.NewDefaultTaskCategoryRegistry())
+		require.NoError(t, err)
+		// Only the 50 tasks moved from the first page before its delete failed are duplicated.
+		failureReasons := readFailureReasons(ctx, t, manager, queueKey)
+		assert.Len(t, failureReasons, 175)
+		assert.Equal(t, []string{"poisoned task"}, slices.Compact(failureReasons))
+	})
 	t.Run("QueueDoesNotExist", func(t *testing.T) {
 		t.Parallel()
 
@@ -98,3 +195,74 @@ func TestInvoke(t *testing.T, manager persistence.HistoryTaskQueueManager) {
 		assert.Equal(t, codes.NotFound, serviceerror.ToStatus(err).Code(), err.Error())
 	})
 }
+
+type failDeleteOnceManager struct {
+	persistence.HistoryTaskQueueManager
+	failed bool
+}
+
+func (m *failDeleteOnceManager) DeleteTasks(
+	ctx context.Context,
+	request *persistence.DeleteTasksRequest,
+) (*persistence.DeleteTasksResponse, error) {
+	if !m.failed {
+		m.failed = true
+		return nil, serviceerror.NewUnavailable("injected DeleteTasks failure")
+	}
+	return m.HistoryTaskQueueManager.DeleteTasks(ctx, request)
+}
+
+// enqueueTasksWithFailureReasons creates the queue and enqueues numTasks tasks whose failure reasons alternate between
+// a transient error and a poisoned task.
+func enqueueTasksWithFailureReasons(
+	ctx context.Context,
+	t *testing.T,
+	manager persistence.HistoryTaskQueueManager,
+	queueKey persistence.QueueKey,
+	numTasks int,
+) {
+	_, err := manager.CreateQueue(ctx, &persistence.CreateQueueRequest{
+		QueueKey: queueKey,
+	})
+	require.NoError(t, err)
+	for i := 0; i < numTasks; i++ {
+		failureReason := "transient error"
+		if i%2 == 1 {
+			failureReason = "poisoned task"
+		}
+		_, err := manager.EnqueueTask(ctx, &persistence.EnqueueTaskRequest{
+			QueueType:     queueKey.QueueType,
+			SourceCluster: queueKey.SourceCluster,
+			TargetCluster: queueKey.TargetCluster,
+			Task:          &tasks.WorkflowTask{},
+			SourceShardID: 1,
+			FailureReason: failureReason,
+		})
+		require.NoError(t, err)
+	}
+}
+
+func readFailureReasons(
+	ctx context.Context,
+	t *testing.T,
+	manager persistence.HistoryTaskQueueManager,
+	queueKey persistence.QueueKey,
+) []string {
+	var failureReasons []string
+	var nextPageToken []byte
+	for {
+		resp, err := manager.ReadTasks(ctx, &persistence.ReadTasksRequest{
+			QueueKey:      queueKey,
+			PageSize:      100,
+			NextPageToken: nextPageToken,
+		})
+		require.NoError(t, err)
+		for _, task := range resp.Tasks {
+			failureReasons = append(failureReasons, task.FailureReason)
+		}
+		if len(resp.NextPageToken) == 0 {
+			return failureReasons
+		}
+		nextPageToken = resp.NextPageToken
+	}
+}
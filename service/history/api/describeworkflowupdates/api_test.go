@@ -0,0 +1,138 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package describeworkflowupdates_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+
+	clockspb "go.temporal.io/server/api/clock/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/api/describeworkflowupdates"
+	"go.temporal.io/server/service/history/workflow"
+	"go.temporal.io/server/service/history/workflow/update"
+)
+
+type (
+	mockWFConsistencyChecker struct {
+		api.WorkflowConsistencyChecker
+		lease api.WorkflowLease
+	}
+
+	mockReg struct {
+		update.Registry
+		inFlight    int
+		total       int
+		maxInFlight int
+		maxTotal    int
+	}
+)
+
+func (m mockWFConsistencyChecker) GetWorkflowLease(
+	_ context.Context,
+	_ *clockspb.VectorClock,
+	_ definition.WorkflowKey,
+	_ workflow.LockPriority,
+) (api.WorkflowLease, error) {
+	return m.lease, nil
+}
+
+func (m mockReg) Len() int         { return m.inFlight }
+func (m mockReg) TotalLen() int    { return m.total }
+func (m mockReg) MaxInFlight() int { return m.maxInFlight }
+func (m mockReg) MaxTotal() int    { return m.maxTotal }
+
+func TestDescribeWorkflowUpdates(t *testing.T) {
+	namespaceID := "6f0a3e4d-3c2b-4a7c-9a5e-5c8d4f1b2a11"
+	mockController := gomock.NewController(t)
+
+	wfCtx := workflow.NewMockContext(mockController)
+	wfCtx.EXPECT().UpdateRegistry(gomock.Any(), gomock.Any()).Return(mockReg{
+		inFlight:    2,
+		total:       7,
+		maxInFlight: 10,
+		maxTotal:    2000,
+	})
+
+	released := false
+	wfcc := mockWFConsistencyChecker{
+		lease: api.NewWorkflowLease(wfCtx, func(error) { released = true }, nil),
+	}
+
+	resp, err := describeworkflowupdates.Invoke(
+		context.Background(),
+		&historyservice.DescribeWorkflowUpdatesRequest{
+			NamespaceId: namespaceID,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: t.Name() + "-workflow-id",
+				RunId:      t.Name() + "-run-id",
+			},
+		},
+		nil,
+		wfcc,
+	)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), resp.GetInFlightUpdates())
+	require.Equal(t, int32(7), resp.GetTotalUpdates())
+	require.Equal(t, int32(10), resp.GetMaxInFlightUpdates())
+	require.Equal(t, int32(2000), resp.GetMaxTotalUpdates())
+	require.True(t, released)
+}
+
+func TestDescribeWorkflowUpdates_UnsetLimits(t *testing.T) {
+	mockController := gomock.NewController(t)
+
+	wfCtx := workflow.NewMockContext(mockController)
+	wfCtx.EXPECT().UpdateRegistry(gomock.Any(), gomock.Any()).Return(mockReg{
+		maxInFlight: math.MaxInt,
+		maxTotal:    math.MaxInt,
+	})
+	wfcc := mockWFConsistencyChecker{
+		lease: api.NewWorkflowLease(wfCtx, func(error) {}, nil),
+	}
+
+	resp, err := describeworkflowupdates.Invoke(
+		context.Background(),
+		&historyservice.DescribeWorkflowUpdatesRequest{
+			NamespaceId: "6f0a3e4d-3c2b-4a7c-9a5e-5c8d4f1b2a11",
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: t.Name() + "-workflow-id",
+				RunId:      t.Name() + "-run-id",
+			},
+		},
+		nil,
+		wfcc,
+	)
+	require.NoError(t, err)
+	require.Equal(t, int32(math.MaxInt32), resp.GetMaxInFlightUpdates())
+	require.Equal(t, int32(math.MaxInt32), resp.GetMaxTotalUpdates())
+}
@@ -52,6 +52,9 @@ func Invoke(
 	if err != nil {
 		return nil, err
 	}
+	if _, err := persistence.MatchDLQErrorPattern(req.FailureReasonPattern, ""); err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid failure reason pattern: %v", err))
+	}
 
 	response, err := historyTaskQueueManager.ReadRawTasks(ctx, &persistence.ReadTasksRequest{
 		QueueKey: persistence.QueueKey{
@@ -71,17 +74,24 @@ func Invoke(
 		return nil, serviceerror.NewUnavailable(fmt.Sprintf("GetDLQTasks failed. Error: %v", err))
 	}
 
-	dlqTasks := make([]*commonspb.HistoryDLQTask, len(response.Tasks))
-	for i, task := range response.Tasks {
-		dlqTasks[i] = &commonspb.HistoryDLQTask{
+	dlqTasks := make([]*commonspb.HistoryDLQTask, 0, len(response.Tasks))
+	for _, task := range response.Tasks {
+		if req.FailureReasonPattern != "" {
+			// The pattern was validated above, so matching can't fail here.
+			if match, _ := persistence.MatchDLQErrorPattern(req.FailureReasonPattern, task.Payload.FailureReason); !match {
+				continue
+			}
+		}
+		dlqTasks = append(dlqTasks, &commonspb.HistoryDLQTask{
 			Metadata: &commonspb.HistoryDLQTaskMetadata{
 				MessageId: task.MessageMetadata.ID,
 			},
 			Payload: &commonspb.HistoryTask{
-				ShardId: task.Payload.ShardId,
-				Blob:    task.Payload.Blob,
+				ShardId:       task.Payload.ShardId,
+				Blob:          task.Payload.Blob,
+				FailureReason: task.Payload.FailureReason,
 			},
-		}
+		})
 	}
 
 	return &historyservice.GetDLQTasksResponse{
@@ -65,6 +65,28 @@ func TestInvoke_InvalidTaskCategory(t *testing.T) {
 	assert.ErrorContains(t, invalidArgErr, "-1")
 }
 
+func TestInvoke_InvalidFailureReasonPattern(t *testing.T) {
+	t.Parallel()
+
+	_, err := getdlqtasks.Invoke(
+		context.Background(),
+		nil,
+		tasks.NewDefaultTaskCategoryRegistry(),
+		&historyservice.GetDLQTasksRequest{
+			DlqKey: &commonspb.HistoryDLQKey{
+				TaskCategory: int32(tasks.CategoryTransfer.ID()),
+			},
+			PageSize:             1,
+			FailureReasonPattern: "(",
+		},
+	)
+
+	var invalidArgErr *serviceerror.InvalidArgument
+
+	require.ErrorAs(t, err, &invalidArgErr)
+	assert.ErrorContains(t, invalidArgErr, "failure reason pattern")
+}
+
 func TestInvoke_ZeroPageSize(t *testing.T) {
 	t.Parallel()
 
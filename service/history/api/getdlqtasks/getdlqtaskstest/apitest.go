@@ -40,7 +40,8 @@ import (
 )
 
 // TestInvoke is a library test function intended to be invoked from a persistence test suite. It works by
-// enqueueing a task into the DLQ and then calling [getdlqtasks.Invoke] to verify that the right task is returned.
+// enqueueing a task into the DLQ and then calling [getdlqtasks.Invoke] to verify that the right task is returned. It
+// then enqueues a task with a failure reason to verify that tasks can be filtered by it.
 func TestInvoke(t *testing.T, manager persistence.HistoryTaskQueueManager) {
 	ctx := context.Background()
 	inTask := &tasks.WorkflowTask{
@@ -87,4 +88,32 @@ func TestInvoke(t *testing.T, manager persistence.HistoryTaskQueueManager) {
 	outTask, err := serializer.DeserializeTask(tasks.CategoryTransfer, res.DlqTasks[0].Payload.Blob)
 	require.NoError(t, err)
 	assert.Equal(t, inTask, outTask)
+
+	_, err = manager.EnqueueTask(ctx, &persistence.EnqueueTaskRequest{
+		QueueType:     queueType,
+		SourceCluster: sourceCluster,
+		TargetCluster: targetCluster,
+		Task:          inTask,
+		SourceShardID: 1,
+		FailureReason: "transient error",
+	})
+	require.NoError(t, err)
+	res, err = getdlqtasks.Invoke(
+		context.Background(),
+		manager,
+		tasks.NewDefaultTaskCategoryRegistry(),
+		&historyservice.GetDLQTasksRequest{
+			DlqKey: &commonspb.HistoryDLQKey{
+				TaskCategory:  int32(tasks.CategoryTransfer.ID()),
+				SourceCluster: sourceCluster,
+				TargetCluster: targetCluster,
+			},
+			PageSize:             10,
+			FailureReasonPattern: "transient.*",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.DlqTasks))
+	assert.Equal(t, int64(persistence.FirstQueueMessageID+1), res.DlqTasks[0].Metadata.MessageId)
+	assert.Equal(t, "transient error", res.DlqTasks[0].Payload.FailureReason)
 }
@@ -34,6 +34,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/tasks"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -42,6 +43,7 @@ func Invoke(
 	ctx context.Context,
 	taskCategoryRegistry tasks.TaskCategoryRegistry,
 	executionManager persistence.ExecutionManager,
+	config *configs.Config,
 	request *historyservice.ListTasksRequest,
 ) (*historyservice.ListTasksResponse, error) {
 	adminRequest := request.Request
@@ -77,6 +79,16 @@ func Invoke(
 		}
 	}
 
+	if err := persistence.ValidateTaskRange(
+		taskCategory.Type(),
+		minTaskKey,
+		maxTaskKey,
+		config.ListTasksMaxScheduledRangeWidth(),
+		int64(config.ListTasksMaxImmediateRangeWidth()),
+	); err != nil {
+		return nil, err
+	}
+
 	resp, err := executionManager.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
 		ShardID:             adminRequest.ShardId,
 		TaskCategory:        taskCategory,
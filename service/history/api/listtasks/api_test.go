@@ -39,8 +39,10 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/testing/protoassert"
+	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/tests"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -55,6 +57,7 @@ type (
 		mockExecutionManager *persistence.MockExecutionManager
 
 		taskCategoryRegistry tasks.TaskCategoryRegistry
+		config               *configs.Config
 	}
 )
 
@@ -70,6 +73,7 @@ func (s *apiSuite) SetupTest() {
 	s.mockExecutionManager = persistence.NewMockExecutionManager(s.controller)
 
 	s.taskCategoryRegistry = tasks.NewDefaultTaskCategoryRegistry()
+	s.config = tests.NewDynamicConfig()
 }
 
 func (s *apiSuite) TestInvalidTaskCategory() {
@@ -95,6 +99,7 @@ func (s *apiSuite) TestInvalidTaskCategory() {
 		context.Background(),
 		s.taskCategoryRegistry,
 		s.mockExecutionManager,
+		s.config,
 		request,
 	)
 	s.Error(err)
@@ -127,6 +132,7 @@ func (s *apiSuite) TestInvalidTaskRange() {
 		context.Background(),
 		s.taskCategoryRegistry,
 		s.mockExecutionManager,
+		s.config,
 		request,
 	)
 	s.Error(err)
@@ -189,6 +195,7 @@ func (s *apiSuite) TestGetHistoryTasks() {
 		context.Background(),
 		s.taskCategoryRegistry,
 		s.mockExecutionManager,
+		s.config,
 		request,
 	)
 	s.NoError(err)
@@ -214,3 +221,59 @@ func (s *apiSuite) TestGetHistoryTasks() {
 		},
 	}, resp)
 }
+
+func (s *apiSuite) TestTaskRangeTooWide() {
+	s.config.ListTasksMaxImmediateRangeWidth = dynamicconfig.GetIntPropertyFn(100)
+	request := &historyservice.ListTasksRequest{
+		Request: &adminservice.ListHistoryTasksRequest{
+			ShardId:  1,
+			Category: tasks.CategoryIDTransfer,
+			TaskRange: &history.TaskRange{
+				InclusiveMinTaskKey: &history.TaskKey{
+					TaskId:   100,
+					FireTime: timestamppb.New(time.Unix(0, 0)),
+				},
+				ExclusiveMaxTaskKey: &history.TaskKey{
+					TaskId:   201,
+					FireTime: timestamppb.New(time.Unix(0, 0)),
+				},
+			},
+			BatchSize: 100,
+		},
+	}
+
+	_, err := Invoke(
+		context.Background(),
+		s.taskCategoryRegistry,
+		s.mockExecutionManager,
+		s.config,
+		request,
+	)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *apiSuite) TestTaskRangeTooWide_MissingMinTaskKey() {
+	s.config.ListTasksMaxImmediateRangeWidth = dynamicconfig.GetIntPropertyFn(100)
+	request := &historyservice.ListTasksRequest{
+		Request: &adminservice.ListHistoryTasksRequest{
+			ShardId:  1,
+			Category: tasks.CategoryIDTransfer,
+			TaskRange: &history.TaskRange{
+				ExclusiveMaxTaskKey: &history.TaskKey{
+					TaskId:   201,
+					FireTime: timestamppb.New(time.Unix(0, 0)),
+				},
+			},
+			BatchSize: 100,
+		},
+	}
+
+	_, err := Invoke(
+		context.Background(),
+		s.taskCategoryRegistry,
+		s.mockExecutionManager,
+		s.config,
+		request,
+	)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
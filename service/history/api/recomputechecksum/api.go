@@ -0,0 +1,92 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package recomputechecksum
+
+import (
+	"context"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/workflow"
+)
+
+func Invoke(
+	ctx context.Context,
+	request *historyservice.RecomputeMutableStateChecksumRequest,
+	shardContext shard.Context,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (_ *historyservice.RecomputeMutableStateChecksumResponse, retError error) {
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if err := api.ValidateNamespaceUUID(namespaceID); err != nil {
+		return nil, err
+	}
+
+	execution := request.GetRequest().GetExecution()
+	workflowLease, err := workflowConsistencyChecker.GetWorkflowLease(
+		ctx,
+		nil,
+		definition.NewWorkflowKey(
+			namespaceID.String(),
+			execution.GetWorkflowId(),
+			execution.GetRunId(),
+		),
+		workflow.LockPriorityLow,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { workflowLease.GetReleaseFn()(retError) }()
+
+	if err := recomputeChecksum(ctx, shardContext, workflowLease); err != nil {
+		return nil, err
+	}
+	return &historyservice.RecomputeMutableStateChecksumResponse{}, nil
+}
+
+func recomputeChecksum(
+	ctx context.Context,
+	shardContext shard.Context,
+	workflowLease api.WorkflowLease,
+) error {
+	mutableState := workflowLease.GetMutableState()
+	snapshot, _, err := mutableState.CloseTransactionAsSnapshot(workflow.TransactionPolicyPassive)
+	if err != nil {
+		return err
+	}
+
+	// checksum generation on transaction close is sampled, always generate one here
+	snapshot.Checksum, err = workflow.GenerateMutableStateChecksum(mutableState)
+	if err != nil {
+		return err
+	}
+	if err := workflow.NewTransaction(shardContext).SetWorkflowExecution(ctx, snapshot); err != nil {
+		return err
+	}
+
+	// in-memory mutable state still holds the sampled checksum, reload it from DB on next access
+	workflowLease.GetContext().Clear()
+	return nil
+}
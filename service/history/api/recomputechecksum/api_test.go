@@ -0,0 +1,157 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package recomputechecksum
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/testing/protorequire"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/hsm"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tests"
+	"go.temporal.io/server/service/history/workflow"
+	wcache "go.temporal.io/server/service/history/workflow/cache"
+)
+
+type (
+	apiSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller                 *gomock.Controller
+		shardContext               *shard.ContextTest
+		mockExecutionMgr           *persistence.MockExecutionManager
+		workflowConsistencyChecker api.WorkflowConsistencyChecker
+	}
+)
+
+func TestAPISuite(t *testing.T) {
+	suite.Run(t, new(apiSuite))
+}
+
+func (s *apiSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+
+	config := tests.NewDynamicConfig()
+	// disable sampled checksum generation so any checksum persisted comes from the recompute
+	config.MutableStateChecksumGenProbability = dynamicconfig.GetIntPropertyFnFilteredByNamespace(0)
+	s.shardContext = shard.NewTestContext(
+		s.controller,
+		&persistencespb.ShardInfo{
+			ShardId: 1,
+			RangeId: 1,
+		},
+		config,
+	)
+
+	reg := hsm.NewRegistry()
+	s.NoError(workflow.RegisterStateMachine(reg))
+	s.shardContext.SetStateMachineRegistry(reg)
+
+	s.shardContext.Resource.NamespaceCache.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.GlobalNamespaceEntry, nil).AnyTimes()
+	s.shardContext.Resource.ClusterMetadata.EXPECT().GetClusterID().Return(int64(1)).AnyTimes()
+	s.shardContext.Resource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.shardContext.Resource.ClusterMetadata.EXPECT().ClusterNameForFailoverVersion(true, tests.Version).Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockExecutionMgr = s.shardContext.Resource.ExecutionMgr
+	s.shardContext.MockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+	mockEngine := shard.NewMockEngine(s.controller)
+	mockEngine.EXPECT().NotifyNewTasks(gomock.Any()).AnyTimes()
+	s.shardContext.SetEngineForTesting(mockEngine)
+
+	s.workflowConsistencyChecker = api.NewWorkflowConsistencyChecker(
+		s.shardContext,
+		wcache.NewHostLevelCache(s.shardContext.GetConfig(), metrics.NoopMetricsHandler),
+	)
+}
+
+func (s *apiSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *apiSuite) TestRecomputeChecksum() {
+	ms := workflow.TestGlobalMutableState(s.shardContext, s.shardContext.MockEventsCache, s.shardContext.GetLogger(), tests.Version, tests.WorkflowID, tests.RunID)
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+	_, err := ms.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: tests.NamespaceID.String(),
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowId:   tests.WorkflowID,
+				WorkflowType: &commonpb.WorkflowType{Name: "wType"},
+				TaskQueue:    &taskqueuepb.TaskQueue{Name: "testTaskQueue"},
+			},
+		},
+	)
+	s.NoError(err)
+	dbState := workflow.TestCloneToProto(ms)
+	s.Nil(dbState.Checksum)
+	expectedChecksum, err := workflow.GenerateMutableStateChecksum(ms)
+	s.NoError(err)
+
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(&persistence.GetWorkflowExecutionResponse{State: dbState}, nil)
+	var setRequest *persistence.SetWorkflowExecutionRequest
+	s.mockExecutionMgr.EXPECT().SetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.SetWorkflowExecutionRequest) (*persistence.SetWorkflowExecutionResponse, error) {
+			setRequest = request
+			return &persistence.SetWorkflowExecutionResponse{}, nil
+		},
+	)
+
+	_, err = Invoke(
+		context.Background(),
+		&historyservice.RecomputeMutableStateChecksumRequest{
+			NamespaceId: tests.NamespaceID.String(),
+			Request: &adminservice.RecomputeMutableStateChecksumRequest{
+				NamespaceId: tests.NamespaceID.String(),
+				Execution:   execution,
+			},
+		},
+		s.shardContext,
+		s.workflowConsistencyChecker,
+	)
+	s.NoError(err)
+	s.NotNil(setRequest)
+	protorequire.ProtoEqual(s.T(), expectedChecksum, setRequest.SetWorkflowSnapshot.Checksum)
+}
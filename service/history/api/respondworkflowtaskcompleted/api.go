@@ -323,6 +323,13 @@ func (handler *WorkflowTaskCompletedHandler) Invoke(
 				numPendingActivitiesLimit:      handler.config.NumPendingActivitiesLimit(namespace.String()),
 				numPendingSignalsLimit:         handler.config.NumPendingSignalsLimit(namespace.String()),
 				numPendingCancelsRequestLimit:  handler.config.NumPendingCancelsRequestLimit(namespace.String()),
+				numPendingActivitiesPerTaskQueueLimit: func(taskQueue string) int {
+					return handler.config.NumPendingActivitiesPerTaskQueueLimit(
+						namespace.String(),
+						taskQueue,
+						enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+					)
+				},
 			},
 			ms,
 			handler.searchAttributesValidator,
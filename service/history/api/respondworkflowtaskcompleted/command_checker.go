@@ -72,6 +72,9 @@ type (
 		numPendingActivitiesLimit      int
 		numPendingSignalsLimit         int
 		numPendingCancelsRequestLimit  int
+		// numPendingActivitiesPerTaskQueueLimit returns the limit of pending activities on the given
+		// activity task queue. Non-positive values disable the task queue specific limit.
+		numPendingActivitiesPerTaskQueueLimit func(taskQueue string) int
 	}
 
 	workflowSizeChecker struct {
@@ -225,15 +228,48 @@ func (c *workflowSizeChecker) checkIfNumChildWorkflowsExceedsLimit() error {
 	)
 }
 
-func (c *workflowSizeChecker) checkIfNumPendingActivitiesExceedsLimit() error {
-	return c.checkCountConstraint(
-		len(c.mutableState.GetPendingActivityInfos()),
+func (c *workflowSizeChecker) checkIfNumPendingActivitiesExceedsLimit(taskQueue string) error {
+	pendingActivityInfos := c.mutableState.GetPendingActivityInfos()
+	if err := c.checkCountConstraint(
+		len(pendingActivityInfos),
 		c.numPendingActivitiesLimit,
 		metrics.TooManyPendingActivities.Name(),
 		PendingActivitiesDescription,
+	); err != nil {
+		return err
+	}
+
+	taskQueueLimit := c.pendingActivitiesPerTaskQueueLimit(taskQueue)
+	if taskQueueLimit <= 0 {
+		return nil
+	}
+	numPendingOnTaskQueue := 0
+	for _, activityInfo := range pendingActivityInfos {
+		if activityInfo.GetTaskQueue() == taskQueue {
+			numPendingOnTaskQueue++
+		}
+	}
+	return c.checkCountConstraint(
+		numPendingOnTaskQueue,
+		taskQueueLimit,
+		metrics.TooManyPendingActivities.Name(),
+		fmt.Sprintf("%s on task queue %s", PendingActivitiesDescription, taskQueue),
 	)
 }
 
+// pendingActivitiesPerTaskQueueLimit returns the limit of pending activities on the task queue, capped by the
+// namespace limit, or 0 if the task queue has no limit of its own.
+func (c *workflowSizeChecker) pendingActivitiesPerTaskQueueLimit(taskQueue string) int {
+	if c.numPendingActivitiesPerTaskQueueLimit == nil {
+		return 0
+	}
+	limit := c.numPendingActivitiesPerTaskQueueLimit(taskQueue)
+	if limit > 0 && c.numPendingActivitiesLimit > 0 {
+		return min(limit, c.numPendingActivitiesLimit)
+	}
+	return limit
+}
+
 func (c *workflowSizeChecker) checkIfNumPendingCancelRequestsExceedsLimit() error {
 	return c.checkCountConstraint(
 		len(c.mutableState.GetPendingRequestCancelExternalInfos()),
@@ -697,7 +733,14 @@ func (v *commandAttrValidator) validateContinueAsNewWorkflowExecutionAttributes(
 
 	attributes.WorkflowRunTimeout = durationpb.New(common.OverrideWorkflowRunTimeout(attributes.GetWorkflowRunTimeout().AsDuration(), executionInfo.GetWorkflowExecutionTimeout().AsDuration()))
 
-	attributes.WorkflowTaskTimeout = durationpb.New(common.OverrideWorkflowTaskTimeout(namespace.String(), attributes.GetWorkflowTaskTimeout().AsDuration(), attributes.GetWorkflowRunTimeout().AsDuration(), v.config.DefaultWorkflowTaskTimeout))
+	requestedWorkflowTaskTimeout := attributes.GetWorkflowTaskTimeout().AsDuration()
+	attributes.WorkflowTaskTimeout = durationpb.New(common.OverrideWorkflowTaskTimeout(namespace.String(), requestedWorkflowTaskTimeout, attributes.GetWorkflowRunTimeout().AsDuration(), v.config.DefaultWorkflowTaskTimeout))
+
+	workflowTaskTimeout, err := common.EnforceMaxWorkflowTaskTimeout(namespace.String(), requestedWorkflowTaskTimeout, attributes.GetWorkflowTaskTimeout().AsDuration(), v.config.MaxWorkflowTaskTimeout, v.config.RejectWorkflowTaskTimeoutAboveMax)
+	if err != nil {
+		return failedCause, fmt.Errorf("invalid WorkflowTaskTimeout on ContinueAsNewWorkflowExecutionCommand: %w. WorkflowType=%s TaskQueue=%s", err, wfType, taskQueue)
+	}
+	attributes.WorkflowTaskTimeout = durationpb.New(workflowTaskTimeout)
 
 	if err := v.validateWorkflowRetryPolicy(namespace, attributes.RetryPolicy); err != nil {
 		return failedCause, fmt.Errorf("invalid WorkflowRetryPolicy on ContinueAsNewWorkflowExecutionCommand: %w. WorkflowType=%s TaskQueue=%s", err, wfType, taskQueue)
@@ -802,7 +845,14 @@ func (v *commandAttrValidator) validateStartChildExecutionAttributes(
 
 	attributes.WorkflowRunTimeout = durationpb.New(common.OverrideWorkflowRunTimeout(attributes.GetWorkflowRunTimeout().AsDuration(), attributes.GetWorkflowExecutionTimeout().AsDuration()))
 
-	attributes.WorkflowTaskTimeout = durationpb.New(common.OverrideWorkflowTaskTimeout(targetNamespace.String(), attributes.GetWorkflowTaskTimeout().AsDuration(), attributes.GetWorkflowRunTimeout().AsDuration(), defaultWorkflowTaskTimeoutFn))
+	requestedWorkflowTaskTimeout := attributes.GetWorkflowTaskTimeout().AsDuration()
+	attributes.WorkflowTaskTimeout = durationpb.New(common.OverrideWorkflowTaskTimeout(targetNamespace.String(), requestedWorkflowTaskTimeout, attributes.GetWorkflowRunTimeout().AsDuration(), defaultWorkflowTaskTimeoutFn))
+
+	workflowTaskTimeout, err := common.EnforceMaxWorkflowTaskTimeout(targetNamespace.String(), requestedWorkflowTaskTimeout, attributes.GetWorkflowTaskTimeout().AsDuration(), v.config.MaxWorkflowTaskTimeout, v.config.RejectWorkflowTaskTimeoutAboveMax)
+	if err != nil {
+		return failedCause, fmt.Errorf("invalid WorkflowTaskTimeout on StartChildWorkflowExecutionCommand: %w. WorkflowId=%s WorkflowType=%s Namespace=%s", err, wfID, wfType, ns)
+	}
+	attributes.WorkflowTaskTimeout = durationpb.New(workflowTaskTimeout)
 
 	return enumspb.WORKFLOW_TASK_FAILED_CAUSE_UNSPECIFIED, nil
 }
@@ -128,14 +128,17 @@ func (s *commandAttrValidatorSuite) SetupTest() {
 		AnyTimes()
 
 	config := &configs.Config{
-		MaxIDLengthLimit:                  dynamicconfig.GetIntPropertyFn(1000),
-		SearchAttributesNumberOfKeysLimit: dynamicconfig.GetIntPropertyFnFilteredByNamespace(100),
-		SearchAttributesSizeOfValueLimit:  dynamicconfig.GetIntPropertyFnFilteredByNamespace(2 * 1024),
-		SearchAttributesTotalSizeLimit:    dynamicconfig.GetIntPropertyFnFilteredByNamespace(40 * 1024),
-		DefaultActivityRetryPolicy:        func(string) retrypolicy.DefaultRetrySettings { return retrypolicy.DefaultDefaultRetrySettings },
-		DefaultWorkflowRetryPolicy:        func(string) retrypolicy.DefaultRetrySettings { return retrypolicy.DefaultDefaultRetrySettings },
-		EnableCrossNamespaceCommands:      dynamicconfig.GetBoolPropertyFn(true),
-		DefaultWorkflowTaskTimeout:        dynamicconfig.GetDurationPropertyFnFilteredByNamespace(primitives.DefaultWorkflowTaskTimeout),
+		MaxIDLengthLimit:                      dynamicconfig.GetIntPropertyFn(1000),
+		SearchAttributesNumberOfKeysLimit:     dynamicconfig.GetIntPropertyFnFilteredByNamespace(100),
+		SearchAttributesNumberOfKeysLimitWarn: dynamicconfig.GetIntPropertyFnFilteredByNamespace(80),
+		SearchAttributesSizeOfValueLimit:      dynamicconfig.GetIntPropertyFnFilteredByNamespace(2 * 1024),
+		SearchAttributesTotalSizeLimit:        dynamicconfig.GetIntPropertyFnFilteredByNamespace(40 * 1024),
+		DefaultActivityRetryPolicy:            func(string) retrypolicy.DefaultRetrySettings { return retrypolicy.DefaultDefaultRetrySettings },
+		DefaultWorkflowRetryPolicy:            func(string) retrypolicy.DefaultRetrySettings { return retrypolicy.DefaultDefaultRetrySettings },
+		EnableCrossNamespaceCommands:          dynamicconfig.GetBoolPropertyFn(true),
+		DefaultWorkflowTaskTimeout:            dynamicconfig.GetDurationPropertyFnFilteredByNamespace(primitives.DefaultWorkflowTaskTimeout),
+		MaxWorkflowTaskTimeout:                dynamicconfig.GetDurationPropertyFnFilteredByNamespace(0),
+		RejectWorkflowTaskTimeoutAboveMax:     dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 	}
 	s.validator = newCommandAttrValidator(
 		s.mockNamespaceCache,
@@ -144,11 +147,14 @@ func (s *commandAttrValidatorSuite) SetupTest() {
 			searchattribute.NewTestProvider(),
 			searchattribute.NewTestMapperProvider(nil),
 			config.SearchAttributesNumberOfKeysLimit,
+			config.SearchAttributesNumberOfKeysLimitWarn,
 			config.SearchAttributesSizeOfValueLimit,
 			config.SearchAttributesTotalSizeLimit,
 			s.mockVisibilityManager,
 			dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 			dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+			metrics.NoopMetricsHandler,
+			log.NewNoopLogger(),
 		))
 }
 
@@ -267,6 +273,105 @@ func (s *commandAttrValidatorSuite) TestValidateContinueAsNewWorkflowExecutionAt
 	s.Equal(common.MaxWorkflowTaskStartToCloseTimeout, attributes.GetWorkflowTaskTimeout().AsDuration())
 }
 
+func (s *commandAttrValidatorSuite) TestValidateContinueAsNewWorkflowExecutionAttributes_MaxWorkflowTaskTimeout() {
+	maxWorkflowTaskTimeout := 10 * time.Second
+	s.validator.config.MaxWorkflowTaskTimeout = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(maxWorkflowTaskTimeout)
+
+	executionInfo := &persistencespb.WorkflowExecutionInfo{
+		WorkflowTypeName:         "workflowType",
+		TaskQueue:                "taskQueue",
+		WorkflowExecutionTimeout: durationpb.New(time.Hour),
+	}
+
+	// clamp
+	s.validator.config.RejectWorkflowTaskTimeoutAboveMax = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false)
+	attributes := &commandpb.ContinueAsNewWorkflowExecutionCommandAttributes{
+		WorkflowTaskTimeout: durationpb.New(maxWorkflowTaskTimeout * 2),
+	}
+	fc, err := s.validator.validateContinueAsNewWorkflowExecutionAttributes(
+		tests.Namespace,
+		attributes,
+		executionInfo,
+	)
+	s.NoError(err)
+	s.Equal(enumspb.WORKFLOW_TASK_FAILED_CAUSE_UNSPECIFIED, fc)
+	s.Equal(maxWorkflowTaskTimeout, attributes.GetWorkflowTaskTimeout().AsDuration())
+
+	// reject
+	s.validator.config.RejectWorkflowTaskTimeoutAboveMax = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	attributes = &commandpb.ContinueAsNewWorkflowExecutionCommandAttributes{
+		WorkflowTaskTimeout: durationpb.New(maxWorkflowTaskTimeout * 2),
+	}
+	fc, err = s.validator.validateContinueAsNewWorkflowExecutionAttributes(
+		tests.Namespace,
+		attributes,
+		executionInfo,
+	)
+	var invalidArgErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgErr)
+	s.Equal(enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_CONTINUE_AS_NEW_ATTRIBUTES, fc)
+}
+
+func (s *commandAttrValidatorSuite) TestValidateStartChildExecutionAttributes_MaxWorkflowTaskTimeout() {
+	maxWorkflowTaskTimeout := 10 * time.Second
+	s.validator.config.MaxWorkflowTaskTimeout = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(maxWorkflowTaskTimeout)
+	s.validator.config.RejectWorkflowTaskTimeoutAboveMax = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	defaultWorkflowTaskTimeoutFn := dynamicconfig.GetDurationPropertyFnFilteredByNamespace(maxWorkflowTaskTimeout * 2)
+
+	parentInfo := &persistencespb.WorkflowExecutionInfo{
+		TaskQueue: "taskQueue",
+	}
+	newAttributes := func(workflowTaskTimeout time.Duration) *commandpb.StartChildWorkflowExecutionCommandAttributes {
+		return &commandpb.StartChildWorkflowExecutionCommandAttributes{
+			WorkflowId:          "workflowID",
+			WorkflowType:        &commonpb.WorkflowType{Name: "workflowType"},
+			WorkflowTaskTimeout: durationpb.New(workflowTaskTimeout),
+		}
+	}
+
+	// an unset timeout is clamped after defaulting, even if rejection is enabled
+	attributes := newAttributes(0)
+	fc, err := s.validator.validateStartChildExecutionAttributes(
+		tests.NamespaceID,
+		tests.NamespaceID,
+		tests.Namespace,
+		attributes,
+		parentInfo,
+		defaultWorkflowTaskTimeoutFn,
+	)
+	s.NoError(err)
+	s.Equal(enumspb.WORKFLOW_TASK_FAILED_CAUSE_UNSPECIFIED, fc)
+	s.Equal(maxWorkflowTaskTimeout, attributes.GetWorkflowTaskTimeout().AsDuration())
+
+	// an explicit timeout above the ceiling is rejected
+	fc, err = s.validator.validateStartChildExecutionAttributes(
+		tests.NamespaceID,
+		tests.NamespaceID,
+		tests.Namespace,
+		newAttributes(maxWorkflowTaskTimeout*2),
+		parentInfo,
+		defaultWorkflowTaskTimeoutFn,
+	)
+	var invalidArgErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgErr)
+	s.Equal(enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_START_CHILD_EXECUTION_ATTRIBUTES, fc)
+
+	// or clamped if rejection is disabled
+	s.validator.config.RejectWorkflowTaskTimeoutAboveMax = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false)
+	attributes = newAttributes(maxWorkflowTaskTimeout * 2)
+	fc, err = s.validator.validateStartChildExecutionAttributes(
+		tests.NamespaceID,
+		tests.NamespaceID,
+		tests.Namespace,
+		attributes,
+		parentInfo,
+		defaultWorkflowTaskTimeoutFn,
+	)
+	s.NoError(err)
+	s.Equal(enumspb.WORKFLOW_TASK_FAILED_CAUSE_UNSPECIFIED, fc)
+	s.Equal(maxWorkflowTaskTimeout, attributes.GetWorkflowTaskTimeout().AsDuration())
+}
+
 func (s *commandAttrValidatorSuite) TestValidateModifyWorkflowProperties() {
 	namespace := namespace.Name("tests.Namespace")
 	var attributes *commandpb.ModifyWorkflowPropertiesCommandAttributes
@@ -922,7 +1027,7 @@ func TestWorkflowSizeChecker_NumChildWorkflows(t *testing.T) {
 				assert.NoError(t, err)
 			}
 
-			err = checker.checkIfNumPendingActivitiesExceedsLimit()
+			err = checker.checkIfNumPendingActivitiesExceedsLimit("test-task-queue")
 			if len(c.ExpectedActivitiesErrorMsg) > 0 {
 				require.Error(t, err)
 				assert.Equal(t, c.ExpectedActivitiesErrorMsg, err.Error())
@@ -948,3 +1053,90 @@ func TestWorkflowSizeChecker_NumChildWorkflows(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkflowSizeChecker_NumPendingActivitiesPerTaskQueue(t *testing.T) {
+	const taskQueue = "fan-out-task-queue"
+
+	for _, c := range []struct {
+		Name                 string
+		NumPendingActivities map[string]int
+		NamespaceLimit       int
+		TaskQueueLimits      map[string]int
+		ExpectedErrorMsg     string
+	}{
+		{
+			Name:                 "Task queue limit not set falls back to namespace limit",
+			NumPendingActivities: map[string]int{taskQueue: 2},
+			NamespaceLimit:       3,
+		},
+		{
+			Name:                 "Namespace limit applies when task queue override targets another queue",
+			NumPendingActivities: map[string]int{taskQueue: 2},
+			NamespaceLimit:       2,
+			TaskQueueLimits:      map[string]int{"other-task-queue": 10},
+			ExpectedErrorMsg:     "the number of pending activities, 2, has reached the per-workflow limit of 2",
+		},
+		{
+			Name:                 "Task queue limit applies below higher namespace limit",
+			NumPendingActivities: map[string]int{taskQueue: 2},
+			NamespaceLimit:       10,
+			TaskQueueLimits:      map[string]int{taskQueue: 2},
+			ExpectedErrorMsg:     "the number of pending activities on task queue fan-out-task-queue, 2, has reached the per-workflow limit of 2",
+		},
+		{
+			Name:                 "Task queue limit does not raise lower namespace limit",
+			NumPendingActivities: map[string]int{taskQueue: 2},
+			NamespaceLimit:       2,
+			TaskQueueLimits:      map[string]int{taskQueue: 3},
+			ExpectedErrorMsg:     "the number of pending activities, 2, has reached the per-workflow limit of 2",
+		},
+		{
+			Name:                 "Task queue limit only counts activities on the task queue",
+			NumPendingActivities: map[string]int{taskQueue: 1, "other-task-queue": 5},
+			NamespaceLimit:       10,
+			TaskQueueLimits:      map[string]int{taskQueue: 2},
+		},
+	} {
+		t.Run(c.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mutableState := workflow.NewMockMutableState(ctrl)
+			logger := log.NewMockLogger(ctrl)
+			metricsHandler := metrics.NewMockHandler(ctrl)
+
+			mutableState.EXPECT().GetWorkflowKey().Return(definition.NewWorkflowKey(
+				"test-namespace-id",
+				"test-workflow-id",
+				"test-run-id",
+			)).AnyTimes()
+			activityInfos := make(map[int64]*persistencespb.ActivityInfo)
+			for activityTaskQueue, numPending := range c.NumPendingActivities {
+				for i := 0; i < numPending; i++ {
+					activityInfos[int64(len(activityInfos))] = &persistencespb.ActivityInfo{TaskQueue: activityTaskQueue}
+				}
+			}
+			mutableState.EXPECT().GetPendingActivityInfos().Return(activityInfos)
+
+			if len(c.ExpectedErrorMsg) > 0 {
+				counterMetric := metrics.NewMockCounterIface(ctrl)
+				metricsHandler.EXPECT().Counter("wf_too_many_pending_activities").Return(counterMetric)
+				counterMetric.EXPECT().Record(int64(1))
+				logger.EXPECT().Error(c.ExpectedErrorMsg, gomock.Any())
+			}
+
+			checker := newWorkflowSizeChecker(workflowSizeLimits{
+				numPendingActivitiesLimit: c.NamespaceLimit,
+				numPendingActivitiesPerTaskQueueLimit: func(taskQueue string) int {
+					return c.TaskQueueLimits[taskQueue]
+				},
+			}, mutableState, nil, metricsHandler, logger)
+
+			err := checker.checkIfNumPendingActivitiesExceedsLimit(taskQueue)
+			if len(c.ExpectedErrorMsg) > 0 {
+				require.Error(t, err)
+				assert.Equal(t, c.ExpectedErrorMsg, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -475,7 +475,7 @@ func (handler *workflowTaskCompletedHandler) handleCommandScheduleActivity(
 	); err != nil {
 		return nil, nil, handler.terminateWorkflow(enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SCHEDULE_ACTIVITY_ATTRIBUTES, err)
 	}
-	if err := handler.sizeLimitChecker.checkIfNumPendingActivitiesExceedsLimit(); err != nil {
+	if err := handler.sizeLimitChecker.checkIfNumPendingActivitiesExceedsLimit(attr.TaskQueue.GetName()); err != nil {
 		return nil, nil, handler.failWorkflowTask(enumspb.WORKFLOW_TASK_FAILED_CAUSE_PENDING_ACTIVITIES_LIMIT_EXCEEDED, err)
 	}
 
@@ -173,6 +173,7 @@ func createWorkflowMutationFunction(
 		workflowKey,
 		namespaceEntry,
 		newRunID,
+		false, // server components do not use signal-with-start
 		currentExecutionState.State,
 		currentExecutionState.Status,
 		currentExecutionState.CreateRequestId,
@@ -390,6 +390,7 @@ func (s *Starter) resolveDuplicateWorkflowID(
 		workflowKey,
 		s.namespace,
 		creationParams.runID,
+		s.request.GetInternalCaller(),
 		currentWorkflowConditionFailed.State,
 		currentWorkflowConditionFailed.Status,
 		currentWorkflowConditionFailed.RequestID,
@@ -58,6 +58,7 @@ func ResolveDuplicateWorkflowID(
 	workflowKey definition.WorkflowKey,
 	namespaceEntry *namespace.Namespace,
 	newRunID string,
+	internalCaller bool,
 	currentState enumsspb.WorkflowExecutionState,
 	currentStatus enumspb.WorkflowExecutionStatus,
 	currentStartRequestID string,
@@ -76,7 +77,7 @@ func ResolveDuplicateWorkflowID(
 		case enumspb.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING:
 			return nil, ErrUseCurrentExecution
 		case enumspb.WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING:
-			return resolveDuplicateWorkflowStart(shardContext, currentWorkflowStartTime, workflowKey, namespaceEntry, newRunID)
+			return resolveDuplicateWorkflowStart(shardContext, currentWorkflowStartTime, workflowKey, namespaceEntry, newRunID, internalCaller)
 		default:
 			return nil, serviceerror.NewInternal(fmt.Sprintf("Failed to process start workflow id conflict policy: %v.", wfIDConflictPolicy))
 		}
@@ -109,12 +110,14 @@ func ResolveDuplicateWorkflowID(
 
 // A minimal interval between workflow starts is used to prevent multiple starts with the same ID too rapidly.
 // If the new workflow is started before the interval elapsed, the workflow start is aborted.
+// Starts from server components (e.g. schedules) may bypass the interval, see isMinimalReuseIntervalExempt.
 func resolveDuplicateWorkflowStart(
 	shardContext shard.Context,
 	currentWorkflowStartTime time.Time,
 	workflowKey definition.WorkflowKey,
 	namespaceEntry *namespace.Namespace,
 	newRunID string,
+	internalCaller bool,
 ) (UpdateWorkflowActionFunc, error) {
 
 	if namespaceEntry == nil {
@@ -124,6 +127,9 @@ func resolveDuplicateWorkflowStart(
 	}
 
 	nsName := namespaceEntry.Name().String()
+	if isMinimalReuseIntervalExempt(shardContext, nsName, internalCaller) {
+		return terminateWorkflowAction(newRunID)
+	}
 	minimalReuseInterval := shardContext.GetConfig().WorkflowIdReuseMinimalInterval(nsName)
 
 	now := shardContext.GetTimeSource().Now().UTC()
@@ -147,6 +153,17 @@ func resolveDuplicateWorkflowStart(
 	}
 }
 
+// isMinimalReuseIntervalExempt returns true if the start was received by the internal frontend, i.e. it
+// comes from a server component rather than a client, and the namespace allows such starts to bypass the
+// minimal reuse interval. The caller type header is not used here since clients can set it.
+func isMinimalReuseIntervalExempt(
+	shardContext shard.Context,
+	nsName string,
+	internalCaller bool,
+) bool {
+	return internalCaller && shardContext.GetConfig().WorkflowIdReuseMinimalIntervalExemptSystemCallers(nsName)
+}
+
 func terminateWorkflowAction(
 	newRunID string,
 ) (UpdateWorkflowActionFunc, error) {
@@ -90,7 +90,7 @@ func TestResolveDuplicateWorkflowStart(t *testing.T) {
 			WorkflowID:  "workflowID",
 			RunID:       "oldRunID",
 		}
-		_, err := resolveDuplicateWorkflowStart(mockShard, tc.currentWorkflowStart, workflowKey, namespaceEntry, "newRunID")
+		_, err := resolveDuplicateWorkflowStart(mockShard, tc.currentWorkflowStart, workflowKey, namespaceEntry, "newRunID", false)
 
 		if tc.expectError {
 			assert.Error(t, err)
@@ -102,3 +102,69 @@ func TestResolveDuplicateWorkflowStart(t *testing.T) {
 		}
 	}
 }
+
+func TestResolveDuplicateWorkflowStart_InternalCallerBypass(t *testing.T) {
+	timeSource := clock.NewEventTimeSource()
+	now := timeSource.Now()
+
+	testCases := []struct {
+		name             string
+		internalCaller   bool
+		exemptSystemCall bool
+		expectError      bool
+	}{
+		{
+			name:             "internal caller bypasses",
+			internalCaller:   true,
+			exemptSystemCall: true,
+			expectError:      false,
+		},
+		{
+			name:             "external caller is throttled",
+			internalCaller:   false,
+			exemptSystemCall: true,
+			expectError:      true,
+		},
+		{
+			name:             "internal caller is throttled when exemption is disabled",
+			internalCaller:   true,
+			exemptSystemCall: false,
+			expectError:      true,
+		},
+	}
+
+	config := tests.NewDynamicConfig()
+	config.WorkflowIdReuseMinimalInterval = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(time.Second)
+
+	mockShard := shard.NewTestContextWithTimeSource(
+		gomock.NewController(t),
+		&persistencespb.ShardInfo{RangeId: 1},
+		config,
+		timeSource,
+	)
+
+	namespaceEntry := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{},
+		&persistencespb.NamespaceConfig{},
+		"target_cluster",
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config.WorkflowIdReuseMinimalIntervalExemptSystemCallers = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(tc.exemptSystemCall)
+			workflowKey := definition.WorkflowKey{
+				NamespaceID: uuid.NewUUID().String(),
+				WorkflowID:  "workflowID",
+				RunID:       "oldRunID",
+			}
+			_, err := resolveDuplicateWorkflowStart(mockShard, now, workflowKey, namespaceEntry, "newRunID", tc.internalCaller)
+
+			if tc.expectError {
+				var resourceErr *serviceerror.ResourceExhausted
+				assert.ErrorAs(t, err, &resourceErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
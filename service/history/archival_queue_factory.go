@@ -187,6 +187,7 @@ func (f *archivalQueueFactory) newScheduledQueue(shard shard.Context, executor q
 		f.Config.TaskDLQUnexpectedErrorAttempts,
 		f.Config.TaskDLQInternalErrors,
 		f.Config.TaskDLQErrorPattern,
+		f.Config.TaskTypeDLQErrorPattern,
 	)
 	return queues.NewScheduledQueue(
 		shard,
@@ -204,6 +205,10 @@ func (f *archivalQueueFactory) newScheduledQueue(shard shard.Context, executor q
 				PendingTasksCriticalCount:   f.Config.QueuePendingTaskCriticalCount,
 				ReaderStuckCriticalAttempts: f.Config.QueueReaderStuckCriticalAttempts,
 				SliceCountCriticalThreshold: f.Config.QueueCriticalSlicesCount,
+				PendingTasksPerNamespaceCriticalCount: NewNamespacePendingTaskCriticalCountFn(
+					shard.GetNamespaceRegistry(),
+					f.Config.QueuePendingTaskPerNamespaceCriticalCount,
+				),
 			},
 			MaxPollRPS:                          f.Config.ArchivalProcessorMaxPollRPS,
 			MaxPollInterval:                     f.Config.ArchivalProcessorMaxPollInterval,
@@ -211,6 +216,7 @@ func (f *archivalQueueFactory) newScheduledQueue(shard shard.Context, executor q
 			CheckpointInterval:                  f.Config.ArchivalProcessorUpdateAckInterval,
 			CheckpointIntervalJitterCoefficient: f.Config.ArchivalProcessorUpdateAckIntervalJitterCoefficient,
 			MaxReaderCount:                      f.Config.ArchivalQueueMaxReaderCount,
+			StartupJitter:                       f.Config.ProcessorStartupJitter,
 		},
 		f.HostReaderRateLimiter,
 		logger,
@@ -45,6 +45,7 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
@@ -359,9 +360,7 @@ func TestArchivalQueueTaskExecutor(t *testing.T) {
 
 			shardContext.EXPECT().GetNamespaceRegistry().Return(namespaceRegistry).AnyTimes()
 			cfg := tests.NewDynamicConfig()
-			cfg.RetentionTimerJitterDuration = func() time.Duration {
-				return 0
-			}
+			cfg.RetentionTimerJitterDuration = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(0)
 			shardContext.EXPECT().GetConfig().Return(cfg).AnyTimes()
 			mockMetadata := cluster.NewMockMetadata(p.Controller)
 			mockMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(true).AnyTimes()
@@ -25,6 +25,9 @@
 package configs
 
 import (
+	"time"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
@@ -50,17 +53,25 @@ type Config struct {
 	PersistenceDynamicRateLimitingParams dynamicconfig.TypedPropertyFn[dynamicconfig.DynamicRateLimitingParams]
 	PersistenceQPSBurstRatio             dynamicconfig.FloatPropertyFn
 
+	HealthPersistenceLatencyFailure dynamicconfig.FloatPropertyFn
+	HealthPersistenceErrorRatio     dynamicconfig.FloatPropertyFn
+	HealthRPCLatencyFailure         dynamicconfig.FloatPropertyFn
+	HealthRPCErrorRatio             dynamicconfig.FloatPropertyFn
+
 	VisibilityPersistenceMaxReadQPS       dynamicconfig.IntPropertyFn
 	VisibilityPersistenceMaxWriteQPS      dynamicconfig.IntPropertyFn
 	EnableReadFromSecondaryVisibility     dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	VisibilityEnableShadowReadMode        dynamicconfig.BoolPropertyFn
+	VisibilityShadowReadDiffSampleRate    dynamicconfig.FloatPropertyFn
 	SecondaryVisibilityWritingMode        dynamicconfig.StringPropertyFn
 	VisibilityDisableOrderByClause        dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	VisibilityOrderByAllowedFields        dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]string]
 	VisibilityEnableManualPagination      dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	VisibilityAllowList                   dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	SuppressErrorSetSystemSearchAttribute dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	EmitShardLagLog            dynamicconfig.BoolPropertyFn
+	EmitShardLagMetrics        dynamicconfig.BoolPropertyFn
 	MaxAutoResetPoints         dynamicconfig.IntPropertyFnWithNamespaceFilter
 	ThrottledLogRPS            dynamicconfig.IntPropertyFn
 	EnableStickyQuery          dynamicconfig.BoolPropertyFnWithNamespaceFilter
@@ -83,12 +94,16 @@ type Config struct {
 	EnableTransitionHistory               dynamicconfig.BoolPropertyFn
 
 	// EventsCache settings
-	// Change of these configs require shard restart
+	// Changes of the cache sizes and TTL are applied at runtime
 	EventsShardLevelCacheMaxSizeBytes dynamicconfig.IntPropertyFn
 	EventsCacheTTL                    dynamicconfig.DurationPropertyFn
-	// Change of these configs require service restart
-	EnableHostLevelEventsCache       dynamicconfig.BoolPropertyFn
-	EventsHostLevelCacheMaxSizeBytes dynamicconfig.IntPropertyFn
+	EventsHostLevelCacheMaxSizeBytes  dynamicconfig.IntPropertyFn
+	// Pinned events settings, applied at runtime
+	EventsShardLevelCachePinnedMaxSizeBytes dynamicconfig.IntPropertyFn
+	EventsHostLevelCachePinnedMaxSizeBytes  dynamicconfig.IntPropertyFn
+	EventsCachePinnedWorkflowIDs            dynamicconfig.TypedPropertyFnWithNamespaceIDFilter[map[string]struct{}]
+	// Change of this config requires service restart
+	EnableHostLevelEventsCache dynamicconfig.BoolPropertyFn
 
 	// ShardController settings
 	RangeSizeBits                uint
@@ -105,16 +120,26 @@ type Config struct {
 	StandbyClusterDelay                  dynamicconfig.DurationPropertyFn
 	StandbyTaskMissingEventsResendDelay  dynamicconfig.DurationPropertyFnWithTaskTypeFilter
 	StandbyTaskMissingEventsDiscardDelay dynamicconfig.DurationPropertyFnWithTaskTypeFilter
-
-	QueuePendingTaskCriticalCount    dynamicconfig.IntPropertyFn
-	QueueReaderStuckCriticalAttempts dynamicconfig.IntPropertyFn
-	QueueCriticalSlicesCount         dynamicconfig.IntPropertyFn
-	QueuePendingTaskMaxCount         dynamicconfig.IntPropertyFn
+	// StandbyTaskMissingEventsResendDelayOverride and StandbyTaskMissingEventsDiscardDelayOverride take
+	// precedence over the task type defaults when set for a namespace.
+	// Use ResolveStandbyTaskMissingEventsDelays to get the effective values.
+	StandbyTaskMissingEventsResendDelayOverride  dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	StandbyTaskMissingEventsDiscardDelayOverride dynamicconfig.DurationPropertyFnWithNamespaceFilter
+
+	QueuePendingTaskCriticalCount             dynamicconfig.IntPropertyFn
+	QueuePendingTaskPerNamespaceCriticalCount dynamicconfig.IntPropertyFnWithNamespaceFilter
+	QueueReaderStuckCriticalAttempts          dynamicconfig.IntPropertyFn
+	QueueCriticalSlicesCount                  dynamicconfig.IntPropertyFn
+	QueuePendingTaskMaxCount                  dynamicconfig.IntPropertyFn
+	ProcessorStartupJitter                    dynamicconfig.DurationPropertyFn
+	ListTasksMaxScheduledRangeWidth           dynamicconfig.DurationPropertyFn
+	ListTasksMaxImmediateRangeWidth           dynamicconfig.IntPropertyFn
 
 	TaskDLQEnabled                 dynamicconfig.BoolPropertyFn
 	TaskDLQUnexpectedErrorAttempts dynamicconfig.IntPropertyFn
 	TaskDLQInternalErrors          dynamicconfig.BoolPropertyFn
 	TaskDLQErrorPattern            dynamicconfig.StringPropertyFn
+	TaskTypeDLQErrorPattern        dynamicconfig.StringPropertyFnWithTaskTypeFilter
 
 	TaskSchedulerEnableRateLimiter           dynamicconfig.BoolPropertyFn
 	TaskSchedulerEnableRateLimiterShadowMode dynamicconfig.BoolPropertyFn
@@ -138,7 +163,7 @@ type Config struct {
 	TimerProcessorPollBackoffInterval                dynamicconfig.DurationPropertyFn
 	TimerProcessorMaxTimeShift                       dynamicconfig.DurationPropertyFn
 	TimerQueueMaxReaderCount                         dynamicconfig.IntPropertyFn
-	RetentionTimerJitterDuration                     dynamicconfig.DurationPropertyFn
+	RetentionTimerJitterDuration                     dynamicconfig.DurationPropertyFnWithNamespaceFilter
 
 	MemoryTimerProcessorSchedulerWorkerCount dynamicconfig.IntPropertyFn
 
@@ -155,6 +180,7 @@ type Config struct {
 	TransferProcessorUpdateAckIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
 	TransferProcessorPollBackoffInterval                dynamicconfig.DurationPropertyFn
 	TransferProcessorEnsureCloseBeforeDelete            dynamicconfig.BoolPropertyFn
+	DeleteManagerEnsureCloseBeforeDelete                dynamicconfig.BoolPropertyFn
 	TransferQueueMaxReaderCount                         dynamicconfig.IntPropertyFn
 
 	// OutboundQueueProcessor settings
@@ -170,6 +196,8 @@ type Config struct {
 	OutboundQueueGroupLimiterBufferSize                 dynamicconfig.IntPropertyFnWithDestinationFilter
 	OutboundQueueGroupLimiterConcurrency                dynamicconfig.IntPropertyFnWithDestinationFilter
 	OutboundQueueHostSchedulerMaxTaskRPS                dynamicconfig.FloatPropertyFnWithDestinationFilter
+	OutboundQueueHostSchedulerMaxConcurrency            dynamicconfig.IntPropertyFn
+	OutboundQueueHostSchedulerPriority                  dynamicconfig.IntPropertyFnWithDestinationFilter
 	OutboundQueueCircuitBreakerSettings                 dynamicconfig.TypedPropertyFnWithDestinationFilter[dynamicconfig.CircuitBreakerSettings]
 
 	// ReplicatorQueueProcessor settings
@@ -181,17 +209,21 @@ type Config struct {
 	// System Limits
 	MaximumBufferedEventsBatch       dynamicconfig.IntPropertyFn
 	MaximumBufferedEventsSizeInBytes dynamicconfig.IntPropertyFn
+	BufferedEventsOverflowBehavior   dynamicconfig.StringPropertyFnWithNamespaceFilter
 	MaximumSignalsPerExecution       dynamicconfig.IntPropertyFnWithNamespaceFilter
 
 	// ShardUpdateMinInterval is the minimum time interval within which the shard info can be updated.
 	ShardUpdateMinInterval dynamicconfig.DurationPropertyFn
 
 	// ShardFirstUpdateMinInterval defines how soon _first_ hard update should happen.
+	// Use ResolveShardFirstUpdateInterval to get the value validated against ShardUpdateMinInterval.
 	ShardFirstUpdateInterval dynamicconfig.DurationPropertyFn
 
 	// ShardUpdateMinTasksCompleted is the minimum number of tasks which must be completed before the shard info can be updated before
 	// history.shardUpdateMinInterval has passed
 	ShardUpdateMinTasksCompleted dynamicconfig.IntPropertyFn
+	// ShardUpdateMinTasksCompletedPerQueue overrides ShardUpdateMinTasksCompleted for individual queue categories
+	ShardUpdateMinTasksCompletedPerQueue dynamicconfig.TypedPropertyFn[map[string]int]
 	// ShardSyncMinInterval is the minimum time interval within which the shard info can be synced to the remote.
 	ShardSyncMinInterval            dynamicconfig.DurationPropertyFn
 	ShardSyncTimerJitterCoefficient dynamicconfig.FloatPropertyFn
@@ -226,10 +258,11 @@ type Config struct {
 	HistoryMaxPageSize                        dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MutableStateActivityFailureSizeLimitError dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MutableStateActivityFailureSizeLimitWarn  dynamicconfig.IntPropertyFnWithNamespaceFilter
-	MutableStateSizeLimitError                dynamicconfig.IntPropertyFn
+	MutableStateSizeLimitError                dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MutableStateSizeLimitWarn                 dynamicconfig.IntPropertyFn
 	NumPendingChildExecutionsLimit            dynamicconfig.IntPropertyFnWithNamespaceFilter
 	NumPendingActivitiesLimit                 dynamicconfig.IntPropertyFnWithNamespaceFilter
+	NumPendingActivitiesPerTaskQueueLimit     dynamicconfig.IntPropertyFnWithTaskQueueFilter
 	NumPendingSignalsLimit                    dynamicconfig.IntPropertyFnWithNamespaceFilter
 	NumPendingCancelsRequestLimit             dynamicconfig.IntPropertyFnWithNamespaceFilter
 
@@ -244,14 +277,19 @@ type Config struct {
 	// Workflow task settings
 	// DefaultWorkflowTaskTimeout the default workflow task timeout
 	DefaultWorkflowTaskTimeout dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	// MaxWorkflowTaskTimeout the ceiling on the requested workflow task timeout
+	MaxWorkflowTaskTimeout dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	// RejectWorkflowTaskTimeoutAboveMax whether to reject instead of clamp timeouts above MaxWorkflowTaskTimeout
+	RejectWorkflowTaskTimeoutAboveMax dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	// WorkflowTaskHeartbeatTimeout is to timeout behavior of: RespondWorkflowTaskComplete with ForceCreateNewWorkflowTask == true
 	// without any commands or messages. After this timeout workflow task will be scheduled to another worker(by clear stickyness).
 	WorkflowTaskHeartbeatTimeout dynamicconfig.DurationPropertyFnWithNamespaceFilter
 	WorkflowTaskCriticalAttempts dynamicconfig.IntPropertyFn
 	WorkflowTaskRetryMaxInterval dynamicconfig.DurationPropertyFn
+	WorkflowTaskTimeoutLogRate   dynamicconfig.FloatPropertyFnWithNamespaceFilter
 
 	// The following is used by the new RPC replication stack
-	ReplicationTaskApplyTimeout                          dynamicconfig.DurationPropertyFn
+	ReplicationTaskApplyTimeout                          dynamicconfig.DurationPropertyFnWithNamespaceFilter
 	ReplicationTaskFetcherParallelism                    dynamicconfig.IntPropertyFn
 	ReplicationTaskFetcherAggregationInterval            dynamicconfig.DurationPropertyFn
 	ReplicationTaskFetcherTimerJitterCoefficient         dynamicconfig.FloatPropertyFn
@@ -281,8 +319,9 @@ type Config struct {
 	EnableReplicationTaskTieredProcessing               dynamicconfig.BoolPropertyFn
 	ReplicationStreamSenderHighPriorityQPS              dynamicconfig.IntPropertyFn
 	ReplicationStreamSenderLowPriorityQPS               dynamicconfig.IntPropertyFn
+	ReplicationTaskLowPriorityPolicy                    dynamicconfig.TypedPropertyFn[dynamicconfig.ReplicationTaskPriorityPolicy]
 	ReplicationReceiverMaxOutstandingTaskCount          dynamicconfig.IntPropertyFn
-	ReplicationResendMaxBatchCount                      dynamicconfig.IntPropertyFn
+	ReplicationResendMaxBatchCount                      dynamicconfig.IntPropertyFnWithNamespaceFilter
 
 	// The following are used by consistent query
 	MaxBufferedQueryCount dynamicconfig.IntPropertyFn
@@ -314,15 +353,17 @@ type Config struct {
 	VisibilityProcessorEnableCloseWorkflowCleanup         dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	VisibilityQueueMaxReaderCount                         dynamicconfig.IntPropertyFn
 
-	SearchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithNamespaceFilter
-	SearchAttributesSizeOfValueLimit  dynamicconfig.IntPropertyFnWithNamespaceFilter
-	SearchAttributesTotalSizeLimit    dynamicconfig.IntPropertyFnWithNamespaceFilter
-	IndexerConcurrency                dynamicconfig.IntPropertyFn
-	ESProcessorNumOfWorkers           dynamicconfig.IntPropertyFn
-	ESProcessorBulkActions            dynamicconfig.IntPropertyFn // max number of requests in bulk
-	ESProcessorBulkSize               dynamicconfig.IntPropertyFn // max total size of bytes in bulk
-	ESProcessorFlushInterval          dynamicconfig.DurationPropertyFn
-	ESProcessorAckTimeout             dynamicconfig.DurationPropertyFn
+	SearchAttributesNumberOfKeysLimit     dynamicconfig.IntPropertyFnWithNamespaceFilter
+	SearchAttributesNumberOfKeysLimitWarn dynamicconfig.IntPropertyFnWithNamespaceFilter
+	SearchAttributesSizeOfValueLimit      dynamicconfig.IntPropertyFnWithNamespaceFilter
+	SearchAttributesTotalSizeLimit        dynamicconfig.IntPropertyFnWithNamespaceFilter
+	IndexerConcurrency                    dynamicconfig.IntPropertyFn
+	ESProcessorNumOfWorkers               dynamicconfig.IntPropertyFn
+	ESProcessorBulkActions                dynamicconfig.IntPropertyFn // max number of requests in bulk
+	ESProcessorBulkSize                   dynamicconfig.IntPropertyFn // max total size of bytes in bulk
+	ESProcessorAdaptiveBulkSize           dynamicconfig.BoolPropertyFn
+	ESProcessorFlushInterval              dynamicconfig.DurationPropertyFn
+	ESProcessorAckTimeout                 dynamicconfig.DurationPropertyFn
 
 	EnableCrossNamespaceCommands  dynamicconfig.BoolPropertyFn
 	EnableActivityEagerExecution  dynamicconfig.BoolPropertyFnWithNamespaceFilter
@@ -343,12 +384,14 @@ type Config struct {
 	ArchivalBackendMaxRPS                               dynamicconfig.FloatPropertyFn
 	ArchivalQueueMaxReaderCount                         dynamicconfig.IntPropertyFn
 
-	WorkflowExecutionMaxInFlightUpdates dynamicconfig.IntPropertyFnWithNamespaceFilter
-	WorkflowExecutionMaxTotalUpdates    dynamicconfig.IntPropertyFnWithNamespaceFilter
+	WorkflowExecutionMaxInFlightUpdates                           dynamicconfig.IntPropertyFnWithNamespaceFilter
+	WorkflowExecutionMaxTotalUpdates                              dynamicconfig.IntPropertyFnWithNamespaceFilter
+	WorkflowExecutionMaxTotalUpdatesSuggestContinueAsNewThreshold dynamicconfig.FloatPropertyFnWithNamespaceFilter
 
 	SendRawWorkflowHistory dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
-	WorkflowIdReuseMinimalInterval dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	WorkflowIdReuseMinimalInterval                    dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	WorkflowIdReuseMinimalIntervalExemptSystemCallers dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	UseExperimentalHsmScheduler dynamicconfig.BoolPropertyFnWithNamespaceFilter
 }
@@ -378,18 +421,28 @@ func NewConfig(
 		StartupMembershipJoinDelay:           dynamicconfig.HistoryStartupMembershipJoinDelay.Get(dc),
 		MaxAutoResetPoints:                   dynamicconfig.HistoryMaxAutoResetPoints.Get(dc),
 		DefaultWorkflowTaskTimeout:           dynamicconfig.DefaultWorkflowTaskTimeout.Get(dc),
+		MaxWorkflowTaskTimeout:               dynamicconfig.MaxWorkflowTaskTimeout.Get(dc),
+		RejectWorkflowTaskTimeoutAboveMax:    dynamicconfig.RejectWorkflowTaskTimeoutAboveMax.Get(dc),
+
+		HealthPersistenceLatencyFailure: dynamicconfig.HealthPersistenceLatencyFailure.Get(dc),
+		HealthPersistenceErrorRatio:     dynamicconfig.HealthPersistenceErrorRatio.Get(dc),
+		HealthRPCLatencyFailure:         dynamicconfig.HealthRPCLatencyFailure.Get(dc),
+		HealthRPCErrorRatio:             dynamicconfig.HealthRPCErrorRatio.Get(dc),
 
 		VisibilityPersistenceMaxReadQPS:       dynamicconfig.VisibilityPersistenceMaxReadQPS.Get(dc),
 		VisibilityPersistenceMaxWriteQPS:      dynamicconfig.VisibilityPersistenceMaxWriteQPS.Get(dc),
 		EnableReadFromSecondaryVisibility:     dynamicconfig.EnableReadFromSecondaryVisibility.Get(dc),
 		VisibilityEnableShadowReadMode:        dynamicconfig.VisibilityEnableShadowReadMode.Get(dc),
+		VisibilityShadowReadDiffSampleRate:    dynamicconfig.VisibilityShadowReadDiffSampleRate.Get(dc),
 		SecondaryVisibilityWritingMode:        dynamicconfig.SecondaryVisibilityWritingMode.Get(dc),
 		VisibilityDisableOrderByClause:        dynamicconfig.VisibilityDisableOrderByClause.Get(dc),
+		VisibilityOrderByAllowedFields:        dynamicconfig.VisibilityOrderByAllowedFields.Get(dc),
 		VisibilityEnableManualPagination:      dynamicconfig.VisibilityEnableManualPagination.Get(dc),
 		VisibilityAllowList:                   dynamicconfig.VisibilityAllowList.Get(dc),
 		SuppressErrorSetSystemSearchAttribute: dynamicconfig.SuppressErrorSetSystemSearchAttribute.Get(dc),
 
-		EmitShardLagLog: dynamicconfig.EmitShardLagLog.Get(dc),
+		EmitShardLagLog:     dynamicconfig.EmitShardLagLog.Get(dc),
+		EmitShardLagMetrics: dynamicconfig.EmitShardLagMetrics.Get(dc),
 		// HistoryCacheLimitSizeBased should not change during runtime.
 		HistoryCacheLimitSizeBased:            dynamicconfig.HistoryCacheSizeBasedLimit.Get(dc)(),
 		HistoryCacheInitialSize:               dynamicconfig.HistoryCacheInitialSize.Get(dc),
@@ -404,10 +457,13 @@ func NewConfig(
 		EnableWorkflowExecutionTimeoutTimer:   dynamicconfig.EnableWorkflowExecutionTimeoutTimer.Get(dc),
 		EnableTransitionHistory:               dynamicconfig.EnableTransitionHistory.Get(dc),
 
-		EventsShardLevelCacheMaxSizeBytes: dynamicconfig.EventsCacheMaxSizeBytes.Get(dc),          // 512KB
-		EventsHostLevelCacheMaxSizeBytes:  dynamicconfig.EventsHostLevelCacheMaxSizeBytes.Get(dc), // 256MB
-		EventsCacheTTL:                    dynamicconfig.EventsCacheTTL.Get(dc),
-		EnableHostLevelEventsCache:        dynamicconfig.EnableHostLevelEventsCache.Get(dc),
+		EventsShardLevelCacheMaxSizeBytes:       dynamicconfig.EventsCacheMaxSizeBytes.Get(dc),          // 512KB
+		EventsHostLevelCacheMaxSizeBytes:        dynamicconfig.EventsHostLevelCacheMaxSizeBytes.Get(dc), // 256MB
+		EventsCacheTTL:                          dynamicconfig.EventsCacheTTL.Get(dc),
+		EventsShardLevelCachePinnedMaxSizeBytes: dynamicconfig.EventsCachePinnedMaxSizeBytes.Get(dc),
+		EventsHostLevelCachePinnedMaxSizeBytes:  dynamicconfig.EventsHostLevelCachePinnedMaxSizeBytes.Get(dc),
+		EventsCachePinnedWorkflowIDs:            dynamicconfig.EventsCachePinnedWorkflowIDs.Get(dc),
+		EnableHostLevelEventsCache:              dynamicconfig.EnableHostLevelEventsCache.Get(dc),
 
 		RangeSizeBits: 20, // 20 bits for sequencer, 2^20 sequence number for any range
 
@@ -424,15 +480,23 @@ func NewConfig(
 		StandbyTaskMissingEventsResendDelay:  dynamicconfig.StandbyTaskMissingEventsResendDelay.Get(dc),
 		StandbyTaskMissingEventsDiscardDelay: dynamicconfig.StandbyTaskMissingEventsDiscardDelay.Get(dc),
 
-		QueuePendingTaskCriticalCount:    dynamicconfig.QueuePendingTaskCriticalCount.Get(dc),
-		QueueReaderStuckCriticalAttempts: dynamicconfig.QueueReaderStuckCriticalAttempts.Get(dc),
-		QueueCriticalSlicesCount:         dynamicconfig.QueueCriticalSlicesCount.Get(dc),
-		QueuePendingTaskMaxCount:         dynamicconfig.QueuePendingTaskMaxCount.Get(dc),
+		StandbyTaskMissingEventsResendDelayOverride:  dynamicconfig.StandbyTaskMissingEventsResendDelayOverride.Get(dc),
+		StandbyTaskMissingEventsDiscardDelayOverride: dynamicconfig.StandbyTaskMissingEventsDiscardDelayOverride.Get(dc),
+
+		QueuePendingTaskCriticalCount:             dynamicconfig.QueuePendingTaskCriticalCount.Get(dc),
+		QueuePendingTaskPerNamespaceCriticalCount: dynamicconfig.QueuePendingTaskPerNamespaceCriticalCount.Get(dc),
+		QueueReaderStuckCriticalAttempts:          dynamicconfig.QueueReaderStuckCriticalAttempts.Get(dc),
+		QueueCriticalSlicesCount:                  dynamicconfig.QueueCriticalSlicesCount.Get(dc),
+		QueuePendingTaskMaxCount:                  dynamicconfig.QueuePendingTaskMaxCount.Get(dc),
+		ProcessorStartupJitter:                    dynamicconfig.ProcessorStartupJitter.Get(dc),
+		ListTasksMaxScheduledRangeWidth:           dynamicconfig.ListTasksMaxScheduledRangeWidth.Get(dc),
+		ListTasksMaxImmediateRangeWidth:           dynamicconfig.ListTasksMaxImmediateRangeWidth.Get(dc),
 
 		TaskDLQEnabled:                 dynamicconfig.HistoryTaskDLQEnabled.Get(dc),
 		TaskDLQUnexpectedErrorAttempts: dynamicconfig.HistoryTaskDLQUnexpectedErrorAttempts.Get(dc),
 		TaskDLQInternalErrors:          dynamicconfig.HistoryTaskDLQInternalErrors.Get(dc),
 		TaskDLQErrorPattern:            dynamicconfig.HistoryTaskDLQErrorPattern.Get(dc),
+		TaskTypeDLQErrorPattern:        dynamicconfig.HistoryTaskTypeDLQErrorPattern.Get(dc),
 
 		TaskSchedulerEnableRateLimiter:           dynamicconfig.TaskSchedulerEnableRateLimiter.Get(dc),
 		TaskSchedulerEnableRateLimiterShadowMode: dynamicconfig.TaskSchedulerEnableRateLimiterShadowMode.Get(dc),
@@ -471,6 +535,7 @@ func NewConfig(
 		TransferProcessorUpdateAckIntervalJitterCoefficient: dynamicconfig.TransferProcessorUpdateAckIntervalJitterCoefficient.Get(dc),
 		TransferProcessorPollBackoffInterval:                dynamicconfig.TransferProcessorPollBackoffInterval.Get(dc),
 		TransferProcessorEnsureCloseBeforeDelete:            dynamicconfig.TransferProcessorEnsureCloseBeforeDelete.Get(dc),
+		DeleteManagerEnsureCloseBeforeDelete:                dynamicconfig.DeleteManagerEnsureCloseBeforeDelete.Get(dc),
 		TimerQueueMaxReaderCount:                            dynamicconfig.TimerQueueMaxReaderCount.Get(dc),
 
 		OutboundTaskBatchSize:                               dynamicconfig.OutboundTaskBatchSize.Get(dc),
@@ -485,6 +550,8 @@ func NewConfig(
 		OutboundQueueGroupLimiterBufferSize:                 dynamicconfig.OutboundQueueGroupLimiterBufferSize.Get(dc),
 		OutboundQueueGroupLimiterConcurrency:                dynamicconfig.OutboundQueueGroupLimiterConcurrency.Get(dc),
 		OutboundQueueHostSchedulerMaxTaskRPS:                dynamicconfig.OutboundQueueHostSchedulerMaxTaskRPS.Get(dc),
+		OutboundQueueHostSchedulerMaxConcurrency:            dynamicconfig.OutboundQueueHostSchedulerMaxConcurrency.Get(dc),
+		OutboundQueueHostSchedulerPriority:                  dynamicconfig.OutboundQueueHostSchedulerPriority.Get(dc),
 		OutboundQueueCircuitBreakerSettings:                 dynamicconfig.OutboundQueueCircuitBreakerSettings.Get(dc),
 
 		ReplicatorProcessorMaxPollInterval:                  dynamicconfig.ReplicatorProcessorMaxPollInterval.Get(dc),
@@ -506,11 +573,13 @@ func NewConfig(
 		EnableReplicationTaskTieredProcessing:               dynamicconfig.EnableReplicationTaskTieredProcessing.Get(dc),
 		ReplicationStreamSenderHighPriorityQPS:              dynamicconfig.ReplicationStreamSenderHighPriorityQPS.Get(dc),
 		ReplicationStreamSenderLowPriorityQPS:               dynamicconfig.ReplicationStreamSenderLowPriorityQPS.Get(dc),
+		ReplicationTaskLowPriorityPolicy:                    dynamicconfig.ReplicationTaskLowPriorityPolicy.Get(dc),
 		ReplicationReceiverMaxOutstandingTaskCount:          dynamicconfig.ReplicationReceiverMaxOutstandingTaskCount.Get(dc),
 		ReplicationResendMaxBatchCount:                      dynamicconfig.ReplicationResendMaxBatchCount.Get(dc),
 
 		MaximumBufferedEventsBatch:       dynamicconfig.MaximumBufferedEventsBatch.Get(dc),
 		MaximumBufferedEventsSizeInBytes: dynamicconfig.MaximumBufferedEventsSizeInBytes.Get(dc),
+		BufferedEventsOverflowBehavior:   dynamicconfig.BufferedEventsOverflowBehavior.Get(dc),
 		MaximumSignalsPerExecution:       dynamicconfig.MaximumSignalsPerExecution.Get(dc),
 		ShardUpdateMinInterval:           dynamicconfig.ShardUpdateMinInterval.Get(dc),
 		ShardFirstUpdateInterval:         dynamicconfig.ShardFirstUpdateInterval.Get(dc),
@@ -518,6 +587,8 @@ func NewConfig(
 		ShardSyncMinInterval:             dynamicconfig.ShardSyncMinInterval.Get(dc),
 		ShardSyncTimerJitterCoefficient:  dynamicconfig.TransferProcessorMaxPollIntervalJitterCoefficient.Get(dc),
 
+		ShardUpdateMinTasksCompletedPerQueue: dynamicconfig.ShardUpdateMinTasksCompletedPerQueue.Get(dc),
+
 		// history client: client/history/client.go set the client timeout 30s
 		// TODO: Return this value to the client: go.temporal.io/server/issues/294
 		LongPollExpirationInterval:          dynamicconfig.HistoryLongPollExpirationInterval.Get(dc),
@@ -533,6 +604,7 @@ func NewConfig(
 		MemoSizeLimitWarn:                         dynamicconfig.MemoSizeLimitWarn.Get(dc),
 		NumPendingChildExecutionsLimit:            dynamicconfig.NumPendingChildExecutionsLimitError.Get(dc),
 		NumPendingActivitiesLimit:                 dynamicconfig.NumPendingActivitiesLimitError.Get(dc),
+		NumPendingActivitiesPerTaskQueueLimit:     dynamicconfig.NumPendingActivitiesPerTaskQueueLimitError.Get(dc),
 		NumPendingSignalsLimit:                    dynamicconfig.NumPendingSignalsLimitError.Get(dc),
 		NumPendingCancelsRequestLimit:             dynamicconfig.NumPendingCancelRequestsLimitError.Get(dc),
 		HistorySizeLimitError:                     dynamicconfig.HistorySizeLimitError.Get(dc),
@@ -555,6 +627,7 @@ func NewConfig(
 		WorkflowTaskHeartbeatTimeout: dynamicconfig.WorkflowTaskHeartbeatTimeout.Get(dc),
 		WorkflowTaskCriticalAttempts: dynamicconfig.WorkflowTaskCriticalAttempts.Get(dc),
 		WorkflowTaskRetryMaxInterval: dynamicconfig.WorkflowTaskRetryMaxInterval.Get(dc),
+		WorkflowTaskTimeoutLogRate:   dynamicconfig.WorkflowTaskTimeoutLogRate.Get(dc),
 
 		ReplicationTaskApplyTimeout:                  dynamicconfig.ReplicationTaskApplyTimeout.Get(dc),
 		ReplicationTaskFetcherParallelism:            dynamicconfig.ReplicationTaskFetcherParallelism.Get(dc),
@@ -597,16 +670,19 @@ func NewConfig(
 		VisibilityProcessorEnableCloseWorkflowCleanup:         dynamicconfig.VisibilityProcessorEnableCloseWorkflowCleanup.Get(dc),
 		VisibilityQueueMaxReaderCount:                         dynamicconfig.VisibilityQueueMaxReaderCount.Get(dc),
 
-		SearchAttributesNumberOfKeysLimit: dynamicconfig.SearchAttributesNumberOfKeysLimit.Get(dc),
-		SearchAttributesSizeOfValueLimit:  dynamicconfig.SearchAttributesSizeOfValueLimit.Get(dc),
-		SearchAttributesTotalSizeLimit:    dynamicconfig.SearchAttributesTotalSizeLimit.Get(dc),
-		IndexerConcurrency:                dynamicconfig.WorkerIndexerConcurrency.Get(dc),
-		ESProcessorNumOfWorkers:           dynamicconfig.WorkerESProcessorNumOfWorkers.Get(dc),
+		SearchAttributesNumberOfKeysLimit:     dynamicconfig.SearchAttributesNumberOfKeysLimit.Get(dc),
+		SearchAttributesNumberOfKeysLimitWarn: dynamicconfig.SearchAttributesNumberOfKeysLimitWarn.Get(dc),
+		SearchAttributesSizeOfValueLimit:      dynamicconfig.SearchAttributesSizeOfValueLimit.Get(dc),
+		SearchAttributesTotalSizeLimit:        dynamicconfig.SearchAttributesTotalSizeLimit.Get(dc),
+		IndexerConcurrency:                    dynamicconfig.WorkerIndexerConcurrency.Get(dc),
+		ESProcessorNumOfWorkers:               dynamicconfig.WorkerESProcessorNumOfWorkers.Get(dc),
 		// Should not be greater than number of visibility task queue workers VisibilityProcessorSchedulerWorkerCount (default 512)
 		// Otherwise, visibility queue processors won't be able to fill up bulk with documents (even under heavy load) and bulk will flush due to interval, not number of actions.
 		ESProcessorBulkActions: dynamicconfig.WorkerESProcessorBulkActions.Get(dc),
 		// 16MB - just a sanity check. With ES document size ~1Kb it should never be reached.
 		ESProcessorBulkSize: dynamicconfig.WorkerESProcessorBulkSize.Get(dc),
+		// Flush before ESProcessorBulkSize is reached when recently added documents are large.
+		ESProcessorAdaptiveBulkSize: dynamicconfig.WorkerESProcessorAdaptiveBulkSize.Get(dc),
 		// Bulk processor will flush every this interval regardless of last flush due to bulk actions.
 		ESProcessorFlushInterval: dynamicconfig.WorkerESProcessorFlushInterval.Get(dc),
 		ESProcessorAckTimeout:    dynamicconfig.WorkerESProcessorAckTimeout.Get(dc),
@@ -631,11 +707,13 @@ func NewConfig(
 		ArchivalQueueMaxReaderCount:                         dynamicconfig.ArchivalQueueMaxReaderCount.Get(dc),
 
 		// workflow update related
-		WorkflowExecutionMaxInFlightUpdates: dynamicconfig.WorkflowExecutionMaxInFlightUpdates.Get(dc),
-		WorkflowExecutionMaxTotalUpdates:    dynamicconfig.WorkflowExecutionMaxTotalUpdates.Get(dc),
+		WorkflowExecutionMaxInFlightUpdates:                           dynamicconfig.WorkflowExecutionMaxInFlightUpdates.Get(dc),
+		WorkflowExecutionMaxTotalUpdates:                              dynamicconfig.WorkflowExecutionMaxTotalUpdates.Get(dc),
+		WorkflowExecutionMaxTotalUpdatesSuggestContinueAsNewThreshold: dynamicconfig.WorkflowExecutionMaxTotalUpdatesSuggestContinueAsNewThreshold.Get(dc),
 
-		SendRawWorkflowHistory:         dynamicconfig.SendRawWorkflowHistory.Get(dc),
-		WorkflowIdReuseMinimalInterval: dynamicconfig.WorkflowIdReuseMinimalInterval.Get(dc),
+		SendRawWorkflowHistory:                            dynamicconfig.SendRawWorkflowHistory.Get(dc),
+		WorkflowIdReuseMinimalInterval:                    dynamicconfig.WorkflowIdReuseMinimalInterval.Get(dc),
+		WorkflowIdReuseMinimalIntervalExemptSystemCallers: dynamicconfig.WorkflowIdReuseMinimalIntervalExemptSystemCallers.Get(dc),
 
 		UseExperimentalHsmScheduler: schedulerhsm.UseExperimentalHsmScheduler.Get(dc),
 	}
@@ -647,3 +725,31 @@ func NewConfig(
 func (config *Config) GetShardID(namespaceID namespace.ID, workflowID string) int32 {
 	return common.WorkflowIDToHistoryShard(namespaceID.String(), workflowID, config.NumberOfShards)
 }
+
+// ResolveShardFirstUpdateInterval returns ShardFirstUpdateInterval, clamped to ShardUpdateMinInterval
+// if it is configured to be larger. The returned bool reports whether the configured value was clamped.
+func (config *Config) ResolveShardFirstUpdateInterval() (time.Duration, bool) {
+	firstUpdateInterval := config.ShardFirstUpdateInterval()
+	updateMinInterval := config.ShardUpdateMinInterval()
+	if firstUpdateInterval > updateMinInterval {
+		return updateMinInterval, true
+	}
+	return firstUpdateInterval, false
+}
+
+// ResolveStandbyTaskMissingEventsDelays returns the resend and discard delays for a standby task of the given
+// namespace and type. A positive namespace override takes precedence over the task type default.
+func (config *Config) ResolveStandbyTaskMissingEventsDelays(
+	namespaceName string,
+	taskType enumsspb.TaskType,
+) (resendDelay time.Duration, discardDelay time.Duration) {
+	resendDelay = config.StandbyTaskMissingEventsResendDelay(taskType)
+	if override := config.StandbyTaskMissingEventsResendDelayOverride(namespaceName); override > 0 {
+		resendDelay = override
+	}
+	discardDelay = config.StandbyTaskMissingEventsDiscardDelay(taskType)
+	if override := config.StandbyTaskMissingEventsDiscardDelayOverride(namespaceName); override > 0 {
+		discardDelay = override
+	}
+	return resendDelay, discardDelay
+}
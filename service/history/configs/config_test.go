@@ -0,0 +1,101 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package configs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+func TestResolveShardFirstUpdateInterval(t *testing.T) {
+	testCases := []struct {
+		name             string
+		firstUpdate      time.Duration
+		updateMin        time.Duration
+		expectedInterval time.Duration
+		expectedClamped  bool
+	}{
+		{
+			name:             "valid",
+			firstUpdate:      10 * time.Second,
+			updateMin:        5 * time.Minute,
+			expectedInterval: 10 * time.Second,
+		},
+		{
+			name:             "equal",
+			firstUpdate:      time.Minute,
+			updateMin:        time.Minute,
+			expectedInterval: time.Minute,
+		},
+		{
+			name:             "clamped",
+			firstUpdate:      10 * time.Minute,
+			updateMin:        5 * time.Minute,
+			expectedInterval: 5 * time.Minute,
+			expectedClamped:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := NewConfig(dynamicconfig.NewNoopCollection(), 1)
+			config.ShardFirstUpdateInterval = dynamicconfig.GetDurationPropertyFn(tc.firstUpdate)
+			config.ShardUpdateMinInterval = dynamicconfig.GetDurationPropertyFn(tc.updateMin)
+
+			interval, clamped := config.ResolveShardFirstUpdateInterval()
+			require.Equal(t, tc.expectedInterval, interval)
+			require.Equal(t, tc.expectedClamped, clamped)
+		})
+	}
+}
+
+func TestResolveStandbyTaskMissingEventsDelays(t *testing.T) {
+	config := NewConfig(dynamicconfig.NewNoopCollection(), 1)
+	config.StandbyTaskMissingEventsResendDelay = dynamicconfig.GetDurationPropertyFnFilteredByTaskType(10 * time.Minute)
+	config.StandbyTaskMissingEventsDiscardDelay = dynamicconfig.GetDurationPropertyFnFilteredByTaskType(15 * time.Minute)
+	config.StandbyTaskMissingEventsResendDelayOverride = func(namespaceName string) time.Duration {
+		if namespaceName == "slow-replication" {
+			return time.Hour
+		}
+		return 0
+	}
+	config.StandbyTaskMissingEventsDiscardDelayOverride = func(namespaceName string) time.Duration {
+		if namespaceName == "slow-replication" {
+			return 2 * time.Hour
+		}
+		return 0
+	}
+
+	resendDelay, discardDelay := config.ResolveStandbyTaskMissingEventsDelays("default", enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK)
+	require.Equal(t, 10*time.Minute, resendDelay)
+	require.Equal(t, 15*time.Minute, discardDelay)
+
+	resendDelay, discardDelay = config.ResolveStandbyTaskMissingEventsDelays("slow-replication", enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK)
+	require.Equal(t, time.Hour, resendDelay)
+	require.Equal(t, 2*time.Hour, discardDelay)
+}
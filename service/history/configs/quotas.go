@@ -40,8 +40,10 @@ var (
 		"/temporal.server.api.historyservice.v1.HistoryService/CloseShard":                             1,
 		"/temporal.server.api.historyservice.v1.HistoryService/GetShard":                               1,
 		"/temporal.server.api.historyservice.v1.HistoryService/DeleteWorkflowExecution":                1,
+		"/temporal.server.api.historyservice.v1.HistoryService/DescribeHealth":                         1,
 		"/temporal.server.api.historyservice.v1.HistoryService/DescribeHistoryHost":                    1,
 		"/temporal.server.api.historyservice.v1.HistoryService/DescribeMutableState":                   1,
+		"/temporal.server.api.historyservice.v1.HistoryService/DescribeWorkflowUpdates":                1,
 		"/temporal.server.api.historyservice.v1.HistoryService/DescribeWorkflowExecution":              1,
 		"/temporal.server.api.historyservice.v1.HistoryService/GetDLQMessages":                         1,
 		"/temporal.server.api.historyservice.v1.HistoryService/GetDLQReplicationMessages":              1,
@@ -62,6 +64,7 @@ var (
 		"/temporal.server.api.historyservice.v1.HistoryService/VerifyChildExecutionCompletionRecorded": 1,
 		"/temporal.server.api.historyservice.v1.HistoryService/RecordWorkflowTaskStarted":              1,
 		"/temporal.server.api.historyservice.v1.HistoryService/RefreshWorkflowTasks":                   1,
+		"/temporal.server.api.historyservice.v1.HistoryService/RecomputeMutableStateChecksum":          1,
 		"/temporal.server.api.historyservice.v1.HistoryService/RemoveSignalMutableState":               1,
 		"/temporal.server.api.historyservice.v1.HistoryService/RemoveTask":                             1,
 		"/temporal.server.api.historyservice.v1.HistoryService/ReplicateEventsV2":                      1,
@@ -99,6 +102,10 @@ var (
 		"/temporal.server.api.historyservice.v1.HistoryService/AddTasks":                               1,
 		"/temporal.server.api.historyservice.v1.HistoryService/ListQueues":                             1,
 		"/temporal.server.api.historyservice.v1.HistoryService/ListTasks":                              1,
+		"/temporal.server.api.historyservice.v1.HistoryService/CompactQueueSlices":                     1,
+		"/temporal.server.api.historyservice.v1.HistoryService/DescribeOutboundDestination":            1,
+		"/temporal.server.api.historyservice.v1.HistoryService/ResetOutboundCircuitBreaker":            1,
+		"/temporal.server.api.historyservice.v1.HistoryService/ForceResyncNamespace":                   1,
 		"/temporal.server.api.historyservice.v1.HistoryService/CompleteNexusOperation":                 1,
 	}
 
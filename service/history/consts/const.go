@@ -123,6 +123,12 @@ var (
 		Scope:   enumspb.RESOURCE_EXHAUSTED_SCOPE_NAMESPACE,
 		Message: "Workflow is busy.",
 	}
+	// ErrBufferedEventsLimitExceeded is an error indicating the request would overflow the buffered events limit while a workflow task is in flight
+	ErrBufferedEventsLimitExceeded = &serviceerror.ResourceExhausted{
+		Cause:   enumspb.RESOURCE_EXHAUSTED_CAUSE_BUSY_WORKFLOW,
+		Scope:   enumspb.RESOURCE_EXHAUSTED_SCOPE_NAMESPACE,
+		Message: "Workflow has too many buffered events, retry after the in-flight workflow task completes.",
+	}
 	// ErrResourceExhaustedAPSLimit is an error indicating user has reached their action per second limit
 	ErrResourceExhaustedAPSLimit = &serviceerror.ResourceExhausted{
 		Cause:   enumspb.RESOURCE_EXHAUSTED_CAUSE_APS_LIMIT,
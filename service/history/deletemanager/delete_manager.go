@@ -28,8 +28,10 @@ package deletemanager
 
 import (
 	"context"
+	"fmt"
 
 	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/definition"
@@ -165,18 +167,23 @@ func (m *DeleteManagerImpl) deleteWorkflowExecutionInternal(
 	metricsHandler metrics.Handler,
 ) error {
 
+	if err := m.ensureExecutionClosed(we, ms); err != nil {
+		return err
+	}
+
 	currentBranchToken, err := ms.GetCurrentBranchToken()
 	if err != nil {
 		return err
 	}
 
+	workflowKey := definition.WorkflowKey{
+		NamespaceID: namespaceID.String(),
+		WorkflowID:  we.GetWorkflowId(),
+		RunID:       we.GetRunId(),
+	}
 	if err := m.shardContext.DeleteWorkflowExecution(
 		ctx,
-		definition.WorkflowKey{
-			NamespaceID: namespaceID.String(),
-			WorkflowID:  we.GetWorkflowId(),
-			RunID:       we.GetRunId(),
-		},
+		workflowKey,
 		currentBranchToken,
 		ms.GetExecutionInfo().GetCloseVisibilityTaskId(),
 		stage,
@@ -186,7 +193,27 @@ func (m *DeleteManagerImpl) deleteWorkflowExecutionInternal(
 
 	// Clear workflow execution context here to prevent further readers to get stale copy of non-exiting workflow execution.
 	weCtx.Clear()
+	m.shardContext.GetEventsCache().DeletePinnedEvents(workflowKey)
 
 	metrics.WorkflowCleanupDeleteCount.With(metricsHandler).Record(1)
 	return nil
 }
+
+// ensureExecutionClosed returns a FailedPrecondition error if the execution is still running and its namespace is
+// active in the current cluster. In a passive cluster, running executions are deleted regardless of their state.
+func (m *DeleteManagerImpl) ensureExecutionClosed(
+	we *commonpb.WorkflowExecution,
+	ms workflow.MutableState,
+) error {
+	if !m.config.DeleteManagerEnsureCloseBeforeDelete() || !ms.IsWorkflowExecutionRunning() {
+		return nil
+	}
+	if !ms.GetNamespaceEntry().ActiveInCluster(m.shardContext.GetClusterMetadata().GetCurrentClusterName()) {
+		return nil
+	}
+	return serviceerror.NewFailedPrecondition(fmt.Sprintf(
+		"Unable to delete workflow execution %v/%v that is still running",
+		we.GetWorkflowId(),
+		we.GetRunId(),
+	))
+}
@@ -37,9 +37,12 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/tests"
@@ -55,11 +58,13 @@ type (
 		controller            *gomock.Controller
 		mockCache             *wcache.MockCache
 		mockShardContext      *shard.MockContext
+		mockEventsCache       *events.MockCache
 		mockClock             *clock.EventTimeSource
 		mockNamespaceRegistry *namespace.MockRegistry
 		mockMetadata          *cluster.MockMetadata
 		mockVisibilityManager *manager.MockVisibilityManager
 
+		config        *configs.Config
 		deleteManager DeleteManager
 	}
 )
@@ -88,16 +93,18 @@ func (s *deleteManagerWorkflowSuite) SetupTest() {
 	s.mockVisibilityManager = manager.NewMockVisibilityManager(s.controller)
 	s.mockVisibilityManager.EXPECT().GetIndexName().Return("").AnyTimes()
 
-	config := tests.NewDynamicConfig()
+	s.config = tests.NewDynamicConfig()
 	s.mockShardContext = shard.NewMockContext(s.controller)
 	s.mockShardContext.EXPECT().GetMetricsHandler().Return(metrics.NoopMetricsHandler).AnyTimes()
 	s.mockShardContext.EXPECT().GetNamespaceRegistry().Return(s.mockNamespaceRegistry).AnyTimes()
 	s.mockShardContext.EXPECT().GetClusterMetadata().Return(s.mockMetadata).AnyTimes()
+	s.mockEventsCache = events.NewMockCache(s.controller)
+	s.mockShardContext.EXPECT().GetEventsCache().Return(s.mockEventsCache).AnyTimes()
 
 	s.deleteManager = NewDeleteManager(
 		s.mockShardContext,
 		s.mockCache,
-		config,
+		s.config,
 		s.mockClock,
 		s.mockVisibilityManager,
 	)
@@ -130,6 +137,7 @@ func (s *deleteManagerWorkflowSuite) TestDeleteDeletedWorkflowExecution() {
 		&stage,
 	).Return(nil)
 	mockWeCtx.EXPECT().Clear()
+	s.mockEventsCache.EXPECT().DeletePinnedEvents(definition.NewWorkflowKey(tests.NamespaceID.String(), tests.WorkflowID, tests.RunID))
 
 	err := s.deleteManager.DeleteWorkflowExecution(
 		context.Background(),
@@ -209,6 +217,7 @@ func (s *deleteManagerWorkflowSuite) TestDeleteWorkflowExecution_OpenWorkflow()
 		&stage,
 	).Return(nil)
 	mockWeCtx.EXPECT().Clear()
+	s.mockEventsCache.EXPECT().DeletePinnedEvents(definition.NewWorkflowKey(tests.NamespaceID.String(), tests.WorkflowID, tests.RunID))
 
 	err := s.deleteManager.DeleteWorkflowExecution(
 		context.Background(),
@@ -221,3 +230,75 @@ func (s *deleteManagerWorkflowSuite) TestDeleteWorkflowExecution_OpenWorkflow()
 	)
 	s.NoError(err)
 }
+
+func (s *deleteManagerWorkflowSuite) TestDeleteWorkflowExecution_EnsureCloseBeforeDelete_ActiveRunning() {
+	s.config.DeleteManagerEnsureCloseBeforeDelete = dynamicconfig.GetBoolPropertyFn(true)
+	we := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+
+	mockWeCtx := workflow.NewMockContext(s.controller)
+	mockMutableState := workflow.NewMockMutableState(s.controller)
+	mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true)
+	mockMutableState.EXPECT().GetNamespaceEntry().Return(tests.GlobalNamespaceEntry)
+	s.mockMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName)
+	stage := tasks.DeleteWorkflowExecutionStageNone
+
+	err := s.deleteManager.DeleteWorkflowExecution(
+		context.Background(),
+		tests.NamespaceID,
+		&we,
+		mockWeCtx,
+		mockMutableState,
+		false,
+		&stage,
+	)
+	var failedPreconditionErr *serviceerror.FailedPrecondition
+	s.ErrorAs(err, &failedPreconditionErr)
+}
+
+func (s *deleteManagerWorkflowSuite) TestDeleteWorkflowExecution_EnsureCloseBeforeDelete_PassiveRunning() {
+	s.config.DeleteManagerEnsureCloseBeforeDelete = dynamicconfig.GetBoolPropertyFn(true)
+	we := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+
+	mockWeCtx := workflow.NewMockContext(s.controller)
+	mockMutableState := workflow.NewMockMutableState(s.controller)
+	mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true)
+	mockMutableState.EXPECT().GetNamespaceEntry().Return(tests.GlobalStandbyNamespaceEntry)
+	s.mockMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName)
+	closeExecutionVisibilityTaskID := int64(39)
+	mockMutableState.EXPECT().GetCurrentBranchToken().Return([]byte{22, 8, 78}, nil)
+	mockMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+		CloseVisibilityTaskId: closeExecutionVisibilityTaskID,
+	})
+	stage := tasks.DeleteWorkflowExecutionStageNone
+
+	s.mockShardContext.EXPECT().DeleteWorkflowExecution(
+		gomock.Any(),
+		definition.WorkflowKey{
+			NamespaceID: tests.NamespaceID.String(),
+			WorkflowID:  tests.WorkflowID,
+			RunID:       tests.RunID,
+		},
+		[]byte{22, 8, 78},
+		closeExecutionVisibilityTaskID,
+		&stage,
+	).Return(nil)
+	mockWeCtx.EXPECT().Clear()
+	s.mockEventsCache.EXPECT().DeletePinnedEvents(definition.NewWorkflowKey(tests.NamespaceID.String(), tests.WorkflowID, tests.RunID))
+
+	err := s.deleteManager.DeleteWorkflowExecution(
+		context.Background(),
+		tests.NamespaceID,
+		&we,
+		mockWeCtx,
+		mockMutableState,
+		false,
+		&stage,
+	)
+	s.NoError(err)
+}
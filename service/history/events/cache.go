@@ -28,6 +28,7 @@ package events
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	historypb "go.temporal.io/api/history/v1"
@@ -35,6 +36,9 @@ import (
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -56,6 +60,8 @@ type (
 		GetEvent(ctx context.Context, shardID int32, key EventKey, firstEventID int64, branchToken []byte) (*historypb.HistoryEvent, error)
 		PutEvent(key EventKey, event *historypb.HistoryEvent)
 		DeleteEvent(key EventKey)
+		// DeletePinnedEvents drops the pinned events of a deleted workflow, so they no longer use the pinned budget.
+		DeletePinnedEvents(workflowKey definition.WorkflowKey)
 	}
 
 	CacheImpl struct {
@@ -64,6 +70,17 @@ type (
 		metricsHandler   metrics.Handler
 		logger           log.Logger
 		disabled         bool
+		pinned           *pinnedEvents
+		// pinnedWorkflowIDs is, per namespace ID, the set of workflows whose events are kept out of LRU eviction
+		pinnedWorkflowIDs dynamicconfig.TypedPropertyFnWithNamespaceIDFilter[map[string]struct{}]
+
+		maxSize        dynamicconfig.IntPropertyFn
+		ttl            dynamicconfig.DurationPropertyFn
+		currentMaxSize atomic.Int64
+		currentTTL     atomic.Int64
+		timeSource     clock.TimeSource
+		// nextOptionsRefresh is the unix nano time after which maxSize and ttl are read again
+		nextOptionsRefresh atomic.Int64
 	}
 
 	historyEventCacheItemImpl struct {
@@ -71,6 +88,9 @@ type (
 	}
 )
 
+// optionsRefreshInterval is how often the cache max size and TTL are re-read from dynamic config.
+const optionsRefreshInterval = 10 * time.Second
+
 var (
 	errEventNotFoundInBatch = serviceerror.NewInternal("History event not found within expected batch")
 )
@@ -84,7 +104,17 @@ func NewHostLevelEventsCache(
 	logger log.Logger,
 	disabled bool,
 ) Cache {
-	return newEventsCache(executionManager, handler, logger, config.EventsHostLevelCacheMaxSizeBytes(), config.EventsCacheTTL(), disabled)
+	return newEventsCache(
+		executionManager,
+		handler,
+		metrics.HostCacheLevelTagValue,
+		logger,
+		config.EventsHostLevelCacheMaxSizeBytes,
+		config.EventsCacheTTL,
+		config.EventsHostLevelCachePinnedMaxSizeBytes,
+		config.EventsCachePinnedWorkflowIDs,
+		disabled,
+	)
 }
 
 func NewShardLevelEventsCache(
@@ -94,27 +124,75 @@ func NewShardLevelEventsCache(
 	logger log.Logger,
 	disabled bool,
 ) Cache {
-	return newEventsCache(executionManager, handler, logger, config.EventsShardLevelCacheMaxSizeBytes(), config.EventsCacheTTL(), disabled)
+	return newEventsCache(
+		executionManager,
+		handler,
+		metrics.ShardCacheLevelTagValue,
+		logger,
+		config.EventsShardLevelCacheMaxSizeBytes,
+		config.EventsCacheTTL,
+		config.EventsShardLevelCachePinnedMaxSizeBytes,
+		config.EventsCachePinnedWorkflowIDs,
+		disabled,
+	)
 }
 
 func newEventsCache(
 	executionManager persistence.ExecutionManager,
 	metricsHandler metrics.Handler,
+	cacheLevel string,
 	logger log.Logger,
-	maxSize int,
-	ttl time.Duration,
+	maxSize dynamicconfig.IntPropertyFn,
+	ttl dynamicconfig.DurationPropertyFn,
+	pinnedMaxSize dynamicconfig.IntPropertyFn,
+	pinnedWorkflowIDs dynamicconfig.TypedPropertyFnWithNamespaceIDFilter[map[string]struct{}],
 	disabled bool,
 ) *CacheImpl {
 	opts := &cache.Options{}
-	opts.TTL = ttl
+	opts.TTL = ttl()
+	opts.Level = cacheLevel
 
+	initialMaxSize := maxSize()
+	timeSource := clock.NewRealTimeSource()
 	taggedMetricHandler := metricsHandler.WithTags(metrics.CacheTypeTag(metrics.EventsCacheTypeTagValue))
-	return &CacheImpl{
-		Cache:            cache.NewWithMetrics(maxSize, opts, taggedMetricHandler),
-		executionManager: executionManager,
-		metricsHandler:   taggedMetricHandler,
-		logger:           logger,
-		disabled:         disabled,
+	eventsCache := &CacheImpl{
+		Cache:             cache.NewWithMetrics(initialMaxSize, opts, taggedMetricHandler),
+		executionManager:  executionManager,
+		metricsHandler:    taggedMetricHandler,
+		logger:            logger,
+		disabled:          disabled,
+		pinnedWorkflowIDs: pinnedWorkflowIDs,
+		maxSize:           maxSize,
+		ttl:               ttl,
+		timeSource:        timeSource,
+	}
+	eventsCache.currentMaxSize.Store(int64(initialMaxSize))
+	eventsCache.currentTTL.Store(int64(opts.TTL))
+	// pinned events expire with the TTL currently applied to the LRU cache
+	eventsCache.pinned = newPinnedEvents(
+		pinnedMaxSize,
+		func() time.Duration { return time.Duration(eventsCache.currentTTL.Load()) },
+		timeSource,
+	)
+	return eventsCache
+}
+
+// refreshOptions applies dynamic config changes of the cache max size and TTL,
+// so that they don't require a restart. Dynamic config is read at most once
+// per optionsRefreshInterval, so changes take up to that long to apply.
+func (e *CacheImpl) refreshOptions() {
+	now := e.timeSource.Now().UnixNano()
+	nextRefresh := e.nextOptionsRefresh.Load()
+	if now < nextRefresh || !e.nextOptionsRefresh.CompareAndSwap(nextRefresh, now+int64(optionsRefreshInterval)) {
+		return
+	}
+	if maxSize := e.maxSize(); e.currentMaxSize.Load() != int64(maxSize) {
+		e.SetMaxSize(maxSize)
+		e.currentMaxSize.Store(int64(maxSize))
+	}
+	if ttl := e.ttl(); e.currentTTL.Load() != int64(ttl) {
+		e.SetTTL(ttl)
+		e.currentTTL.Store(int64(ttl))
 	}
 }
 
@@ -137,10 +215,20 @@ func (e *CacheImpl) GetEvent(ctx context.Context, shardID int32, key EventKey, f
 	startTime := time.Now().UTC()
 	defer func() { metrics.CacheLatency.With(handler).Record(time.Since(startTime)) }()
 
+	e.refreshOptions()
 	validKey := e.validateKey(key)
 
 	// Test hook for disabling cache
 	if !e.disabled {
+		if event, pinned := e.pinned.get(key); pinned {
+			if !e.isPinned(key) {
+				// the workflow was unpinned, hand its events back to the LRU cache
+				for releasedKey, releasedEvent := range e.pinned.release(workflowKeyOf(key)) {
+					e.Put(releasedKey, newHistoryEventCacheItem(releasedEvent))
+				}
+			}
+			return event, nil
+		}
 		eventItem, cacheHit := e.Cache.Get(key).(*historyEventCacheItemImpl)
 		if cacheHit {
 			return eventItem.event, nil
@@ -173,6 +261,7 @@ func (e *CacheImpl) PutEvent(key EventKey, event *historypb.HistoryEvent) {
 	startTime := time.Now().UTC()
 	defer func() { metrics.CacheLatency.With(handler).Record(time.Since(startTime)) }()
 
+	e.refreshOptions()
 	if !e.validateKey(key) {
 		return
 	}
@@ -186,9 +275,19 @@ func (e *CacheImpl) DeleteEvent(key EventKey) {
 	defer func() { metrics.CacheLatency.With(handler).Record(time.Since(startTime)) }()
 
 	e.validateKey(key) // just for log message, delete anyway
+	e.pinned.delete(key)
 	e.Delete(key)
 }
 
+func (e *CacheImpl) DeletePinnedEvents(workflowKey definition.WorkflowKey) {
+	e.pinned.release(workflowKey)
+}
+
+func (e *CacheImpl) isPinned(key EventKey) bool {
+	_, ok := e.pinnedWorkflowIDs(key.NamespaceID.String())[key.WorkflowID]
+	return ok
+}
+
 func (e *CacheImpl) getHistoryEventFromStore(
 	ctx context.Context,
 	shardID int32,
@@ -236,8 +335,13 @@ func (e *CacheImpl) getHistoryEventFromStore(
 	return nil, errEventNotFoundInBatch
 }
 
-func (e *CacheImpl) put(key EventKey, event *historypb.HistoryEvent) interface{} {
-	return e.Put(key, newHistoryEventCacheItem(event))
+func (e *CacheImpl) put(key EventKey, event *historypb.HistoryEvent) {
+	if e.isPinned(key) && e.pinned.put(key, event) {
+		// the pinned copy takes precedence, drop any stale copy from the LRU cache
+		e.Delete(key)
+		return
+	}
+	e.Put(key, newHistoryEventCacheItem(event))
 }
 
 var _ cache.SizeGetter = (*historyEventCacheItemImpl)(nil)
@@ -37,10 +37,15 @@ import (
 	historypb "go.temporal.io/api/history/v1"
 
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/configs"
 )
 
 type (
@@ -88,9 +93,12 @@ func (s *eventsCacheSuite) TearDownTest() {
 func (s *eventsCacheSuite) newTestEventsCache() *CacheImpl {
 	return newEventsCache(s.mockExecutionManager,
 		metrics.NoopMetricsHandler,
+		metrics.ShardCacheLevelTagValue,
 		s.logger,
-		32,
-		time.Minute,
+		dynamicconfig.GetIntPropertyFn(32),
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+		dynamicconfig.GetIntPropertyFn(0),
+		dynamicconfig.GetTypedPropertyFnFilteredByNamespaceID(map[string]struct{}(nil)),
 		false)
 }
 
@@ -324,3 +332,228 @@ func (s *eventsCacheSuite) TestEventsCacheInvalidKey() {
 		int64(11), branchToken)
 	s.Equal(gotEvent2, event1)
 }
+
+func (s *eventsCacheSuite) TestEventsCacheEvictionMetrics() {
+	key1 := EventKey{"events-cache-eviction-namespace", "events-cache-eviction-workflow-id", "events-cache-eviction-run-id", 11, common.EmptyVersion}
+	key2 := key1
+	key2.EventID = 12
+	event := &historypb.HistoryEvent{EventId: key1.EventID, EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED}
+
+	config := &configs.Config{
+		// Only one event fits in the cache.
+		EventsHostLevelCacheMaxSizeBytes:  dynamicconfig.GetIntPropertyFn(event.Size()),
+		EventsShardLevelCacheMaxSizeBytes: dynamicconfig.GetIntPropertyFn(event.Size()),
+		EventsCacheTTL:                    dynamicconfig.GetDurationPropertyFn(time.Millisecond),
+		EventsCachePinnedWorkflowIDs:      dynamicconfig.GetTypedPropertyFnFilteredByNamespaceID(map[string]struct{}(nil)),
+	}
+
+	s.Run("ttl expiry", func() {
+		metricsHandler := metricstest.NewCaptureHandler()
+		capture := metricsHandler.StartCapture()
+		defer metricsHandler.StopCapture(capture)
+
+		eventsCache := NewHostLevelEventsCache(s.mockExecutionManager, config, metricsHandler, s.logger, false).(*CacheImpl)
+		eventsCache.PutEvent(key1, event)
+		time.Sleep(2 * time.Millisecond)
+		s.Nil(eventsCache.Get(key1))
+
+		snapshot := capture.Snapshot()
+		s.Empty(snapshot[metrics.CacheSizeEvictions.Name()])
+		s.Len(snapshot[metrics.CacheTTLEvictions.Name()], 1)
+		recording := snapshot[metrics.CacheTTLEvictions.Name()][0]
+		s.Equal(int64(1), recording.Value)
+		s.Equal(metrics.EventsCacheTypeTagValue, recording.Tags[metrics.CacheTypeTagName])
+		s.Equal(metrics.HostCacheLevelTagValue, recording.Tags[metrics.CacheLevelTagName])
+		// only the eviction counters are tagged with the cache level, other cache metrics keep their labels
+		s.NotContains(snapshot[metrics.CacheUsage.Name()][0].Tags, metrics.CacheLevelTagName)
+	})
+
+	s.Run("size pressure", func() {
+		metricsHandler := metricstest.NewCaptureHandler()
+		capture := metricsHandler.StartCapture()
+		defer metricsHandler.StopCapture(capture)
+
+		eventsCache := NewShardLevelEventsCache(s.mockExecutionManager, config, metricsHandler, s.logger, false).(*CacheImpl)
+		eventsCache.PutEvent(key1, event)
+		eventsCache.PutEvent(key2, event)
+		s.Nil(eventsCache.Get(key1))
+
+		snapshot := capture.Snapshot()
+		s.Empty(snapshot[metrics.CacheTTLEvictions.Name()])
+		s.Len(snapshot[metrics.CacheSizeEvictions.Name()], 1)
+		recording := snapshot[metrics.CacheSizeEvictions.Name()][0]
+		s.Equal(int64(1), recording.Value)
+		s.Equal(metrics.EventsCacheTypeTagValue, recording.Tags[metrics.CacheTypeTagName])
+		s.Equal(metrics.ShardCacheLevelTagValue, recording.Tags[metrics.CacheLevelTagName])
+	})
+}
+
+func (s *eventsCacheSuite) TestEventsCacheOptionsChangeAtRuntime() {
+	key1 := EventKey{"events-cache-resize-namespace", "events-cache-resize-workflow-id", "events-cache-resize-run-id", 11, common.EmptyVersion}
+	key2 := key1
+	key2.EventID = 12
+	key3 := key1
+	key3.EventID = 13
+	event := &historypb.HistoryEvent{EventId: key1.EventID, EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED}
+
+	maxSize := event.Size()
+	config := &configs.Config{
+		EventsHostLevelCacheMaxSizeBytes: func() int { return maxSize },
+		EventsCacheTTL:                   dynamicconfig.GetDurationPropertyFn(time.Minute),
+		EventsCachePinnedWorkflowIDs:     dynamicconfig.GetTypedPropertyFnFilteredByNamespaceID(map[string]struct{}(nil)),
+	}
+	eventsCache := NewHostLevelEventsCache(s.mockExecutionManager, config, metrics.NoopMetricsHandler, s.logger, false).(*CacheImpl)
+	timeSource := clock.NewEventTimeSource()
+	eventsCache.timeSource = timeSource
+
+	// only one event fits in the cache
+	eventsCache.PutEvent(key1, event)
+	eventsCache.PutEvent(key2, event)
+	s.Nil(eventsCache.Get(key1))
+	s.NotNil(eventsCache.Get(key2))
+
+	// changes are picked up after the refresh interval
+	maxSize = 2 * event.Size()
+	eventsCache.PutEvent(key1, event)
+	s.Nil(eventsCache.Get(key2))
+
+	// growing the cache makes room for both events without a restart
+	timeSource.Advance(optionsRefreshInterval)
+	eventsCache.PutEvent(key2, event)
+	s.NotNil(eventsCache.Get(key1))
+	s.NotNil(eventsCache.Get(key2))
+	s.Equal(2*event.Size(), eventsCache.Size())
+
+	// shrinking the cache evicts events on the next access
+	maxSize = event.Size()
+	timeSource.Advance(optionsRefreshInterval)
+	eventsCache.PutEvent(key3, event)
+	s.Equal(event.Size(), eventsCache.Size())
+	s.NotNil(eventsCache.Get(key3))
+}
+
+func (s *eventsCacheSuite) TestEventsCachePinnedEventsSurviveEviction() {
+	pinnedWorkflow := definition.NewWorkflowKey("events-cache-pin-namespace", "events-cache-pin-workflow-id", "events-cache-pin-run-id")
+	pinnedKey1 := EventKey{namespace.ID(pinnedWorkflow.NamespaceID), pinnedWorkflow.WorkflowID, pinnedWorkflow.RunID, 11, common.EmptyVersion}
+	pinnedKey2 := pinnedKey1
+	pinnedKey2.EventID = 12
+	otherKey1 := EventKey{"events-cache-pin-namespace", "events-cache-other-workflow-id", "events-cache-other-run-id", 11, common.EmptyVersion}
+	otherKey2 := otherKey1
+	otherKey2.EventID = 12
+	event := &historypb.HistoryEvent{EventId: 11, EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED}
+
+	// the LRU cache only fits one event, the pinned budget fits two
+	pinnedWorkflowIDs := map[string]struct{}{pinnedWorkflow.WorkflowID: {}}
+	eventsCache := newEventsCache(s.mockExecutionManager,
+		metrics.NoopMetricsHandler,
+		metrics.ShardCacheLevelTagValue,
+		s.logger,
+		dynamicconfig.GetIntPropertyFn(event.Size()),
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+		dynamicconfig.GetIntPropertyFn(2*event.Size()),
+		func(namespaceID string) map[string]struct{} { return pinnedWorkflowIDs },
+		false)
+
+	eventsCache.PutEvent(pinnedKey1, event)
+	eventsCache.PutEvent(pinnedKey2, event)
+	eventsCache.PutEvent(otherKey1, event)
+	eventsCache.PutEvent(otherKey2, event)
+
+	// pinned events are served without reading from persistence, while the other workflow's
+	// first event was evicted from the LRU cache
+	for _, key := range []EventKey{pinnedKey1, pinnedKey2, otherKey2} {
+		actualEvent, err := eventsCache.GetEvent(context.Background(), 1, key, key.EventID, nil)
+		s.NoError(err)
+		s.Equal(event, actualEvent)
+	}
+	s.Nil(eventsCache.Get(otherKey1))
+
+	// once the workflow is unpinned, its events are handed back to the LRU cache on the next read
+	pinnedWorkflowIDs = nil
+	actualEvent, err := eventsCache.GetEvent(context.Background(), 1, pinnedKey1, pinnedKey1.EventID, nil)
+	s.NoError(err)
+	s.Equal(event, actualEvent)
+	s.Zero(eventsCache.pinned.sizeBytes)
+	s.Equal(event.Size(), eventsCache.Size())
+}
+
+func (s *eventsCacheSuite) TestEventsCachePinnedBudget() {
+	workflow1 := definition.NewWorkflowKey("events-cache-pin-namespace", "events-cache-pin-workflow-id-1", "events-cache-pin-run-id")
+	workflow2 := definition.NewWorkflowKey("events-cache-pin-namespace", "events-cache-pin-workflow-id-2", "events-cache-pin-run-id")
+	event := &historypb.HistoryEvent{EventId: 11, EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED}
+	pinnedMaxSize := 2 * event.Size()
+
+	eventsCache := newEventsCache(s.mockExecutionManager,
+		metrics.NoopMetricsHandler,
+		metrics.ShardCacheLevelTagValue,
+		s.logger,
+		dynamicconfig.GetIntPropertyFn(32),
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+		func() int { return pinnedMaxSize },
+		dynamicconfig.GetTypedPropertyFnFilteredByNamespaceID(map[string]struct{}{workflow1.WorkflowID: {}, workflow2.WorkflowID: {}}),
+		false)
+
+	newKey := func(workflowKey definition.WorkflowKey, eventID int64) EventKey {
+		return EventKey{namespace.ID(workflowKey.NamespaceID), workflowKey.WorkflowID, workflowKey.RunID, eventID, common.EmptyVersion}
+	}
+	// the events of both workflows share the budget, the oldest ones are released first
+	keys := []EventKey{newKey(workflow1, 11), newKey(workflow1, 12), newKey(workflow2, 11)}
+	for _, key := range keys {
+		eventsCache.PutEvent(key, event)
+		s.LessOrEqual(eventsCache.pinned.sizeBytes, pinnedMaxSize)
+	}
+	for i, key := range keys {
+		_, pinned := eventsCache.pinned.get(key)
+		s.Equal(i > 0, pinned)
+	}
+
+	// deleting a workflow frees its share of the budget
+	eventsCache.DeletePinnedEvents(workflow2)
+	s.Equal(event.Size(), eventsCache.pinned.sizeBytes)
+	_, pinned := eventsCache.pinned.get(keys[2])
+	s.False(pinned)
+
+	// an event larger than the whole budget is not pinned and falls back to the LRU cache,
+	// and the events that no longer fit in the shrunk budget are released
+	pinnedMaxSize = event.Size() - 1
+	key := newKey(workflow1, 15)
+	eventsCache.PutEvent(key, event)
+	_, pinned = eventsCache.pinned.get(key)
+	s.False(pinned)
+	s.NotNil(eventsCache.Get(key))
+	s.Zero(eventsCache.pinned.sizeBytes)
+
+	// with no budget, lookups skip the pinned events entirely
+	pinnedMaxSize = 0
+	_, pinned = eventsCache.pinned.get(key)
+	s.False(pinned)
+}
+
+func (s *eventsCacheSuite) TestEventsCachePinnedEventsExpire() {
+	workflow := definition.NewWorkflowKey("events-cache-pin-namespace", "events-cache-pin-workflow-id", "events-cache-pin-run-id")
+	key := EventKey{namespace.ID(workflow.NamespaceID), workflow.WorkflowID, workflow.RunID, 11, common.EmptyVersion}
+	event := &historypb.HistoryEvent{EventId: key.EventID, EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED}
+
+	eventsCache := newEventsCache(s.mockExecutionManager,
+		metrics.NoopMetricsHandler,
+		metrics.ShardCacheLevelTagValue,
+		s.logger,
+		dynamicconfig.GetIntPropertyFn(32),
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+		dynamicconfig.GetIntPropertyFn(2*event.Size()),
+		dynamicconfig.GetTypedPropertyFnFilteredByNamespaceID(map[string]struct{}{workflow.WorkflowID: {}}),
+		false)
+	timeSource := clock.NewEventTimeSource()
+	eventsCache.pinned.timeSource = timeSource
+
+	eventsCache.PutEvent(key, event)
+	timeSource.Advance(time.Minute - time.Second)
+	_, pinned := eventsCache.pinned.get(key)
+	s.True(pinned)
+
+	// pinned events expire with the same TTL as the LRU cache and free their share of the budget
+	timeSource.Advance(2 * time.Second)
+	_, pinned = eventsCache.pinned.get(key)
+	s.False(pinned)
+	s.Zero(eventsCache.pinned.sizeBytes)
+}
@@ -34,6 +34,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	v1 "go.temporal.io/api/history/v1"
+	definition "go.temporal.io/server/common/definition"
 )
 
 // MockCache is a mock of Cache interface.
@@ -71,6 +72,18 @@ func (mr *MockCacheMockRecorder) DeleteEvent(key interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvent", reflect.TypeOf((*MockCache)(nil).DeleteEvent), key)
 }
 
+// DeletePinnedEvents mocks base method.
+func (m *MockCache) DeletePinnedEvents(workflowKey definition.WorkflowKey) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeletePinnedEvents", workflowKey)
+}
+
+// DeletePinnedEvents indicates an expected call of DeletePinnedEvents.
+func (mr *MockCacheMockRecorder) DeletePinnedEvents(workflowKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePinnedEvents", reflect.TypeOf((*MockCache)(nil).DeletePinnedEvents), workflowKey)
+}
+
 // GetEvent mocks base method.
 func (m *MockCache) GetEvent(ctx context.Context, shardID int32, key EventKey, firstEventID int64, branchToken []byte) (*v1.HistoryEvent, error) {
 	m.ctrl.T.Helper()
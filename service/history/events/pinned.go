@@ -0,0 +1,188 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package events
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	historypb "go.temporal.io/api/history/v1"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+type (
+	// pinnedEvents holds events of pinned workflows outside of the LRU cache, so that they are not
+	// evicted under memory pressure. The total size of the held events is bounded by maxSizeBytes;
+	// once the budget is used up, the oldest pinned events across all workflows are released first.
+	// Pinned events expire after the same TTL as events in the LRU cache.
+	pinnedEvents struct {
+		sync.Mutex
+
+		maxSizeBytes dynamicconfig.IntPropertyFn
+		ttl          func() time.Duration
+		timeSource   clock.TimeSource
+		sizeBytes    int
+		// order holds the pinned event keys of all workflows in insertion order
+		order     *list.List
+		workflows map[definition.WorkflowKey]map[EventKey]struct{}
+		events    map[EventKey]*pinnedEvent
+	}
+
+	pinnedEvent struct {
+		event   *historypb.HistoryEvent
+		size    int
+		element *list.Element
+		// expiration is zero if the event doesn't expire
+		expiration time.Time
+	}
+)
+
+func newPinnedEvents(
+	maxSizeBytes dynamicconfig.IntPropertyFn,
+	ttl func() time.Duration,
+	timeSource clock.TimeSource,
+) *pinnedEvents {
+	return &pinnedEvents{
+		maxSizeBytes: maxSizeBytes,
+		ttl:          ttl,
+		timeSource:   timeSource,
+		order:        list.New(),
+		workflows:    make(map[definition.WorkflowKey]map[EventKey]struct{}),
+		events:       make(map[EventKey]*pinnedEvent),
+	}
+}
+
+func workflowKeyOf(key EventKey) definition.WorkflowKey {
+	return definition.NewWorkflowKey(key.NamespaceID.String(), key.WorkflowID, key.RunID)
+}
+
+// release removes the pinned events of the workflow and returns them.
+func (p *pinnedEvents) release(workflowKey definition.WorkflowKey) map[EventKey]*historypb.HistoryEvent {
+	p.Lock()
+	defer p.Unlock()
+
+	keys, ok := p.workflows[workflowKey]
+	if !ok {
+		return nil
+	}
+
+	released := make(map[EventKey]*historypb.HistoryEvent, len(keys))
+	for key := range keys {
+		released[key] = p.events[key].event
+		p.removeLocked(key)
+	}
+	return released
+}
+
+func (p *pinnedEvents) get(key EventKey) (*historypb.HistoryEvent, bool) {
+	if p.maxSizeBytes() <= 0 {
+		// pinning is disabled, don't contend on the lock
+		return nil, false
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	pinned, ok := p.events[key]
+	if !ok {
+		return nil, false
+	}
+	if !pinned.expiration.IsZero() && p.timeSource.Now().After(pinned.expiration) {
+		p.removeLocked(key)
+		return nil, false
+	}
+	return pinned.event, true
+}
+
+// put stores the event if it fits in the budget, releasing the oldest pinned events of any workflow
+// if needed. It returns false if the event was not stored, in which case the caller should fall back
+// to the LRU cache.
+func (p *pinnedEvents) put(key EventKey, event *historypb.HistoryEvent) bool {
+	p.Lock()
+	defer p.Unlock()
+
+	if _, ok := p.events[key]; ok {
+		p.removeLocked(key)
+	}
+
+	maxSizeBytes := p.maxSizeBytes()
+	size := event.Size()
+	if size > maxSizeBytes {
+		// the budget may have shrunk, release the events that no longer fit
+		for p.sizeBytes > maxSizeBytes {
+			p.removeLocked(p.order.Front().Value.(EventKey))
+		}
+		return false
+	}
+	for p.sizeBytes+size > maxSizeBytes {
+		p.removeLocked(p.order.Front().Value.(EventKey))
+	}
+
+	workflowKey := workflowKeyOf(key)
+	keys, ok := p.workflows[workflowKey]
+	if !ok {
+		keys = make(map[EventKey]struct{})
+		p.workflows[workflowKey] = keys
+	}
+	keys[key] = struct{}{}
+	var expiration time.Time
+	if ttl := p.ttl(); ttl != 0 {
+		expiration = p.timeSource.Now().Add(ttl)
+	}
+	p.events[key] = &pinnedEvent{
+		event:      event,
+		size:       size,
+		element:    p.order.PushBack(key),
+		expiration: expiration,
+	}
+	p.sizeBytes += size
+	return true
+}
+
+func (p *pinnedEvents) delete(key EventKey) {
+	p.Lock()
+	defer p.Unlock()
+
+	if _, ok := p.events[key]; ok {
+		p.removeLocked(key)
+	}
+}
+
+func (p *pinnedEvents) removeLocked(key EventKey) {
+	pinned := p.events[key]
+	p.order.Remove(pinned.element)
+	delete(p.events, key)
+	p.sizeBytes -= pinned.size
+
+	workflowKey := workflowKeyOf(key)
+	delete(p.workflows[workflowKey], key)
+	if len(p.workflows[workflowKey]) == 0 {
+		delete(p.workflows, workflowKey)
+	}
+}
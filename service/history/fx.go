@@ -84,6 +84,7 @@ var Module = fx.Options(
 	fx.Provide(RetryableInterceptorProvider),
 	fx.Provide(TelemetryInterceptorProvider),
 	fx.Provide(RateLimitInterceptorProvider),
+	fx.Provide(HealthCheckInterceptorProvider),
 	fx.Provide(service.GrpcServerOptionsProvider),
 	fx.Provide(ESProcessorConfigProvider),
 	fx.Provide(VisibilityManagerProvider),
@@ -157,6 +158,13 @@ func HandlerProvider(args NewHandlerArgs) *Handler {
 		tracer:                       args.TracerProvider.Tracer(consts.LibraryName),
 		taskQueueManager:             args.TaskQueueManager,
 		taskCategoryRegistry:         args.TaskCategoryRegistry,
+		outboundCircuitBreakerPool:   args.OutboundCircuitBreakerPool,
+		healthSignals: &healthSignals{
+			config:      args.Config,
+			persistence: args.PersistenceHealthSignals,
+			rpc:         args.HealthCheckInterceptor,
+			deadlock:    args.DeadlockDetector,
+		},
 
 		replicationTaskFetcherFactory:    args.ReplicationTaskFetcherFactory,
 		replicationTaskConverterProvider: args.ReplicationTaskConverterFactory,
@@ -221,16 +229,36 @@ func RateLimitInterceptorProvider(
 	)
 }
 
+func HealthCheckInterceptorProvider(
+	dynamicCollection *dynamicconfig.Collection,
+) *interceptor.HealthCheckInterceptor {
+	return interceptor.NewHealthCheckInterceptor(
+		dynamicconfig.HealthRPCSignalWindowSize.Get(dynamicCollection)(),
+		dynamicconfig.HealthRPCSignalBufferSize.Get(dynamicCollection)(),
+		// These APIs block on workflow progress or long polls, so their latency doesn't reflect the host health.
+		map[string]struct{}{
+			"DescribeHealth":              {},
+			"GetMutableState":             {},
+			"PollMutableState":            {},
+			"PollWorkflowExecutionUpdate": {},
+			"UpdateWorkflowExecution":     {},
+			"ExecuteMultiOperation":       {},
+			"QueryWorkflow":               {},
+		},
+	)
+}
+
 func ESProcessorConfigProvider(
 	serviceConfig *configs.Config,
 ) *elasticsearch.ProcessorConfig {
 	return &elasticsearch.ProcessorConfig{
-		IndexerConcurrency:       serviceConfig.IndexerConcurrency,
-		ESProcessorNumOfWorkers:  serviceConfig.ESProcessorNumOfWorkers,
-		ESProcessorBulkActions:   serviceConfig.ESProcessorBulkActions,
-		ESProcessorBulkSize:      serviceConfig.ESProcessorBulkSize,
-		ESProcessorFlushInterval: serviceConfig.ESProcessorFlushInterval,
-		ESProcessorAckTimeout:    serviceConfig.ESProcessorAckTimeout,
+		IndexerConcurrency:          serviceConfig.IndexerConcurrency,
+		ESProcessorNumOfWorkers:     serviceConfig.ESProcessorNumOfWorkers,
+		ESProcessorBulkActions:      serviceConfig.ESProcessorBulkActions,
+		ESProcessorBulkSize:         serviceConfig.ESProcessorBulkSize,
+		ESProcessorAdaptiveBulkSize: serviceConfig.ESProcessorAdaptiveBulkSize,
+		ESProcessorFlushInterval:    serviceConfig.ESProcessorFlushInterval,
+		ESProcessorAckTimeout:       serviceConfig.ESProcessorAckTimeout,
 	}
 }
 
@@ -295,8 +323,10 @@ func VisibilityManagerProvider(
 		serviceConfig.OperatorRPSRatio,
 		serviceConfig.EnableReadFromSecondaryVisibility,
 		serviceConfig.VisibilityEnableShadowReadMode,
+		serviceConfig.VisibilityShadowReadDiffSampleRate,
 		serviceConfig.SecondaryVisibilityWritingMode,
 		serviceConfig.VisibilityDisableOrderByClause,
+		serviceConfig.VisibilityOrderByAllowedFields,
 		serviceConfig.VisibilityEnableManualPagination,
 		metricsHandler,
 		logger,
@@ -28,6 +28,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"slices"
 	"sync"
 	"sync/atomic"
 
@@ -38,8 +39,10 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.uber.org/fx"
+	"golang.org/x/exp/maps"
 	"google.golang.org/grpc/metadata"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	namespacespb "go.temporal.io/server/api/namespace/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
@@ -50,6 +53,7 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/deadlock"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -61,6 +65,7 @@ import (
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/searchattribute"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/components/nexusoperations"
@@ -107,6 +112,8 @@ type (
 		tracer                       trace.Tracer
 		taskQueueManager             persistence.HistoryTaskQueueManager
 		taskCategoryRegistry         tasks.TaskCategoryRegistry
+		outboundCircuitBreakerPool   *OutboundQueueCircuitBreakerPool
+		healthSignals                *healthSignals
 
 		replicationTaskFetcherFactory    replication.TaskFetcherFactory
 		replicationTaskConverterProvider replication.SourceTaskConverterProvider
@@ -136,6 +143,10 @@ type (
 		TracerProvider               trace.TracerProvider
 		TaskQueueManager             persistence.HistoryTaskQueueManager
 		TaskCategoryRegistry         tasks.TaskCategoryRegistry
+		OutboundCircuitBreakerPool   *OutboundQueueCircuitBreakerPool
+		PersistenceHealthSignals     persistence.HealthSignalAggregator
+		HealthCheckInterceptor       *interceptor.HealthCheckInterceptor
+		DeadlockDetector             deadlock.Detector
 
 		ReplicationTaskFetcherFactory   replication.TaskFetcherFactory
 		ReplicationTaskConverterFactory replication.SourceTaskConverterProvider
@@ -672,6 +683,15 @@ func (h *Handler) DescribeHistoryHost(_ context.Context, _ *historyservice.Descr
 	return resp, nil
 }
 
+// DescribeHealth returns the health signals of this history host that are currently failing
+func (h *Handler) DescribeHealth(_ context.Context, _ *historyservice.DescribeHealthRequest) (_ *historyservice.DescribeHealthResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+
+	return &historyservice.DescribeHealthResponse{
+		FailingSignals: h.healthSignals.failing(),
+	}, nil
+}
+
 // RemoveTask returns information about the internal states of a history host
 func (h *Handler) RemoveTask(ctx context.Context, request *historyservice.RemoveTaskRequest) (_ *historyservice.RemoveTaskResponse, retError error) {
 	var err error
@@ -824,6 +844,36 @@ func (h *Handler) DescribeMutableState(ctx context.Context, request *historyserv
 	return resp, nil
 }
 
+// DescribeWorkflowUpdates - returns the update counts of a workflow execution
+func (h *Handler) DescribeWorkflowUpdates(ctx context.Context, request *historyservice.DescribeWorkflowUpdatesRequest) (_ *historyservice.DescribeWorkflowUpdatesResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.DescribeWorkflowUpdates(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return resp, nil
+}
+
 // GetMutableState - returns the id of the next event in the execution's history
 func (h *Handler) GetMutableState(ctx context.Context, request *historyservice.GetMutableStateRequest) (_ *historyservice.GetMutableStateResponse, retError error) {
 	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
@@ -1855,6 +1905,32 @@ func (h *Handler) RefreshWorkflowTasks(ctx context.Context, request *historyserv
 	return &historyservice.RefreshWorkflowTasksResponse{}, nil
 }
 
+func (h *Handler) RecomputeMutableStateChecksum(ctx context.Context, request *historyservice.RecomputeMutableStateChecksumRequest) (_ *historyservice.RecomputeMutableStateChecksumResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.RecomputeMutableStateChecksum(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return resp, nil
+}
+
 func (h *Handler) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
@@ -2287,6 +2363,131 @@ func (h *Handler) ListTasks(
 	return resp, nil
 }
 
+func (h *Handler) CompactQueueSlices(
+	ctx context.Context,
+	request *historyservice.CompactQueueSlicesRequest,
+) (_ *historyservice.CompactQueueSlicesResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	shardContext, err := h.controller.GetShardByID(request.GetShardId())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.CompactQueueSlices(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return resp, nil
+}
+
+func (h *Handler) DescribeOutboundDestination(
+	_ context.Context,
+	request *historyservice.DescribeOutboundDestinationRequest,
+) (_ *historyservice.DescribeOutboundDestinationResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	// Circuit breakers are kept per host, the shard is only used to make sure the request was routed to this host.
+	if _, err := h.controller.GetShardByID(request.GetShardId()); err != nil {
+		return nil, h.convertError(err)
+	}
+
+	circuitBreakers := h.outboundCircuitBreakerPool.GetByDestination(request.GetNamespaceId(), request.GetDestination())
+	taskGroups := maps.Keys(circuitBreakers)
+	slices.Sort(taskGroups)
+
+	infos := make([]*adminservice.OutboundCircuitBreakerInfo, 0, len(taskGroups))
+	for _, taskGroup := range taskGroups {
+		cb := circuitBreakers[taskGroup]
+		counts := cb.Counts()
+		infos = append(infos, &adminservice.OutboundCircuitBreakerInfo{
+			TaskGroup:            taskGroup,
+			State:                cb.State().String(),
+			Requests:             counts.Requests,
+			TotalSuccesses:       counts.TotalSuccesses,
+			TotalFailures:        counts.TotalFailures,
+			ConsecutiveSuccesses: counts.ConsecutiveSuccesses,
+			ConsecutiveFailures:  counts.ConsecutiveFailures,
+		})
+	}
+	return &historyservice.DescribeOutboundDestinationResponse{
+		CircuitBreakers: infos,
+	}, nil
+}
+
+func (h *Handler) ResetOutboundCircuitBreaker(
+	_ context.Context,
+	request *historyservice.ResetOutboundCircuitBreakerRequest,
+) (_ *historyservice.ResetOutboundCircuitBreakerResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	// Circuit breakers are kept per host, the shard is only used to make sure the request was routed to this host.
+	if _, err := h.controller.GetShardByID(request.GetShardId()); err != nil {
+		return nil, h.convertError(err)
+	}
+
+	for taskGroup, cb := range h.outboundCircuitBreakerPool.GetByDestination(
+		request.GetNamespaceId(),
+		request.GetDestination(),
+	) {
+		cb.Reset()
+		h.logger.Info("Outbound queue circuit breaker reset",
+			tag.WorkflowNamespaceID(request.GetNamespaceId()),
+			tag.Name(taskGroup),
+			tag.NewStringTag("destination", request.GetDestination()),
+		)
+	}
+	return &historyservice.ResetOutboundCircuitBreakerResponse{}, nil
+}
+
+func (h *Handler) ForceResyncNamespace(
+	_ context.Context,
+	request *historyservice.ForceResyncNamespaceRequest,
+) (_ *historyservice.ForceResyncNamespaceResponse, retError error) {
+	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	shardContext, err := h.controller.GetShardByID(request.GetShardId())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	inclusiveBeginTaskID, exclusiveEndTaskID, err := h.streamReceiverMonitor.ForceResyncNamespace(
+		shardContext,
+		request.GetTargetCluster(),
+		namespace.ID(request.GetNamespaceId()),
+	)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return &historyservice.ForceResyncNamespaceResponse{
+		InclusiveBeginTaskId: inclusiveBeginTaskID,
+		ExclusiveEndTaskId:   exclusiveEndTaskID,
+	}, nil
+}
+
 func (h *Handler) CompleteNexusOperation(ctx context.Context, request *historyservice.CompleteNexusOperationRequest) (_ *historyservice.CompleteNexusOperationResponse, retErr error) {
 	defer metrics.CapturePanic(h.logger, h.metricsHandler, &retErr)
 	h.startWG.Wait()
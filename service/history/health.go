@@ -0,0 +1,69 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/deadlock"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/configs"
+)
+
+type (
+	// rpcHealthSignals is implemented by interceptor.HealthCheckInterceptor.
+	rpcHealthSignals interface {
+		AverageLatency() float64
+		ErrorRatio() float64
+	}
+
+	// healthSignals combines the health signals of a history host.
+	healthSignals struct {
+		config      *configs.Config
+		persistence persistence.HealthSignalAggregator
+		rpc         rpcHealthSignals
+		deadlock    deadlock.Detector
+	}
+)
+
+// failing returns the health signals that are currently failing, or nil if the host is healthy.
+func (s *healthSignals) failing() []enumsspb.HealthSignal {
+	var failing []enumsspb.HealthSignal
+	if s.persistence.AverageLatency() > s.config.HealthPersistenceLatencyFailure() {
+		failing = append(failing, enumsspb.HEALTH_SIGNAL_PERSISTENCE_LATENCY)
+	}
+	if s.persistence.ErrorRatio() > s.config.HealthPersistenceErrorRatio() {
+		failing = append(failing, enumsspb.HEALTH_SIGNAL_PERSISTENCE_ERRORS)
+	}
+	if s.rpc.AverageLatency() > s.config.HealthRPCLatencyFailure() {
+		failing = append(failing, enumsspb.HEALTH_SIGNAL_RPC_LATENCY)
+	}
+	if s.rpc.ErrorRatio() > s.config.HealthRPCErrorRatio() {
+		failing = append(failing, enumsspb.HEALTH_SIGNAL_RPC_ERRORS)
+	}
+	if s.deadlock.FailedHealthCheck() {
+		failing = append(failing, enumsspb.HEALTH_SIGNAL_DEADLOCK)
+	}
+	return failing
+}
@@ -0,0 +1,131 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/configs"
+)
+
+type (
+	fakeSignals struct {
+		averageLatency float64
+		errorRatio     float64
+	}
+
+	fakePersistenceSignals struct {
+		persistence.HealthSignalAggregator
+		fakeSignals
+	}
+
+	fakeDeadlockDetector bool
+)
+
+func (s fakeSignals) AverageLatency() float64 { return s.averageLatency }
+func (s fakeSignals) ErrorRatio() float64     { return s.errorRatio }
+
+func (s fakePersistenceSignals) AverageLatency() float64 { return s.fakeSignals.AverageLatency() }
+func (s fakePersistenceSignals) ErrorRatio() float64     { return s.fakeSignals.ErrorRatio() }
+
+func (d fakeDeadlockDetector) FailedHealthCheck() bool { return bool(d) }
+
+func TestHealthSignalsFailing(t *testing.T) {
+	t.Parallel()
+
+	dc := dynamicconfig.StaticClient{
+		dynamicconfig.HealthPersistenceLatencyFailure.Key(): 100.0,
+		dynamicconfig.HealthPersistenceErrorRatio.Key():     0.5,
+		dynamicconfig.HealthRPCLatencyFailure.Key():         200.0,
+		dynamicconfig.HealthRPCErrorRatio.Key():             0.5,
+	}
+	config := configs.NewConfig(dynamicconfig.NewCollection(dc, log.NewNoopLogger()), 1)
+
+	testCases := []struct {
+		name        string
+		persistence fakeSignals
+		rpc         fakeSignals
+		deadlock    fakeDeadlockDetector
+		expected    []enumsspb.HealthSignal
+	}{
+		{
+			name:        "Healthy",
+			persistence: fakeSignals{averageLatency: 100, errorRatio: 0.5},
+			rpc:         fakeSignals{averageLatency: 200, errorRatio: 0.5},
+		},
+		{
+			name:        "PersistenceLatency",
+			persistence: fakeSignals{averageLatency: 101},
+			expected:    []enumsspb.HealthSignal{enumsspb.HEALTH_SIGNAL_PERSISTENCE_LATENCY},
+		},
+		{
+			name:        "PersistenceErrors",
+			persistence: fakeSignals{errorRatio: 0.6},
+			expected:    []enumsspb.HealthSignal{enumsspb.HEALTH_SIGNAL_PERSISTENCE_ERRORS},
+		},
+		{
+			name:     "RPCLatency",
+			rpc:      fakeSignals{averageLatency: 201},
+			expected: []enumsspb.HealthSignal{enumsspb.HEALTH_SIGNAL_RPC_LATENCY},
+		},
+		{
+			name:     "RPCErrors",
+			rpc:      fakeSignals{errorRatio: 0.6},
+			expected: []enumsspb.HealthSignal{enumsspb.HEALTH_SIGNAL_RPC_ERRORS},
+		},
+		{
+			name:     "Deadlock",
+			deadlock: true,
+			expected: []enumsspb.HealthSignal{enumsspb.HEALTH_SIGNAL_DEADLOCK},
+		},
+		{
+			name:        "Multiple",
+			persistence: fakeSignals{averageLatency: 101},
+			deadlock:    true,
+			expected: []enumsspb.HealthSignal{
+				enumsspb.HEALTH_SIGNAL_PERSISTENCE_LATENCY,
+				enumsspb.HEALTH_SIGNAL_DEADLOCK,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			signals := &healthSignals{
+				config:      config,
+				persistence: fakePersistenceSignals{fakeSignals: tc.persistence},
+				rpc:         tc.rpc,
+				deadlock:    tc.deadlock,
+			}
+			require.Equal(t, tc.expected, signals.failing())
+		})
+	}
+}
@@ -26,12 +26,14 @@ package history
 
 import (
 	"context"
+	"fmt"
 	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel/trace"
 	commonpb "go.temporal.io/api/common/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
 
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
@@ -61,6 +63,7 @@ import (
 	"go.temporal.io/server/service/history/api/deleteworkflow"
 	"go.temporal.io/server/service/history/api/describemutablestate"
 	"go.temporal.io/server/service/history/api/describeworkflow"
+	"go.temporal.io/server/service/history/api/describeworkflowupdates"
 	"go.temporal.io/server/service/history/api/getworkflowexecutionhistory"
 	"go.temporal.io/server/service/history/api/getworkflowexecutionhistoryreverse"
 	"go.temporal.io/server/service/history/api/getworkflowexecutionrawhistory"
@@ -72,6 +75,7 @@ import (
 	"go.temporal.io/server/service/history/api/pollupdate"
 	"go.temporal.io/server/service/history/api/queryworkflow"
 	"go.temporal.io/server/service/history/api/reapplyevents"
+	"go.temporal.io/server/service/history/api/recomputechecksum"
 	"go.temporal.io/server/service/history/api/recordactivitytaskheartbeat"
 	"go.temporal.io/server/service/history/api/recordactivitytaskstarted"
 	"go.temporal.io/server/service/history/api/recordchildworkflowcompleted"
@@ -280,6 +284,7 @@ func NewEngineWithShardContext(
 		shard.GetSearchAttributesProvider(),
 		shard.GetSearchAttributesMapperProvider(),
 		config.SearchAttributesNumberOfKeysLimit,
+		config.SearchAttributesNumberOfKeysLimitWarn,
 		config.SearchAttributesSizeOfValueLimit,
 		config.SearchAttributesTotalSizeLimit,
 		persistenceVisibilityMgr,
@@ -288,6 +293,8 @@ func NewEngineWithShardContext(
 			config.VisibilityAllowList,
 		),
 		config.SuppressErrorSetSystemSearchAttribute,
+		shard.GetMetricsHandler(),
+		shard.GetThrottledLogger(),
 	)
 
 	historyEngImpl.replicationDLQHandler = replication.NewLazyDLQHandler(
@@ -475,6 +482,13 @@ func (e *historyEngineImpl) DescribeMutableState(
 	return describemutablestate.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
 }
 
+func (e *historyEngineImpl) DescribeWorkflowUpdates(
+	ctx context.Context,
+	request *historyservice.DescribeWorkflowUpdatesRequest,
+) (*historyservice.DescribeWorkflowUpdatesResponse, error) {
+	return describeworkflowupdates.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
+}
+
 // ResetStickyTaskQueue reset the volatile information in mutable state of a given workflow.
 // Volatile information are the information related to client, such as:
 // 1. StickyTaskQueue
@@ -933,6 +947,13 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 	)
 }
 
+func (e *historyEngineImpl) RecomputeMutableStateChecksum(
+	ctx context.Context,
+	request *historyservice.RecomputeMutableStateChecksumRequest,
+) (_ *historyservice.RecomputeMutableStateChecksumResponse, retError error) {
+	return recomputechecksum.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
+}
+
 func (e *historyEngineImpl) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
@@ -994,10 +1015,28 @@ func (e *historyEngineImpl) ListTasks(
 		ctx,
 		e.taskCategoryRegistry,
 		e.executionManager,
+		e.config,
 		request,
 	)
 }
 
+func (e *historyEngineImpl) CompactQueueSlices(
+	_ context.Context,
+	request *historyservice.CompactQueueSlicesRequest,
+) (*historyservice.CompactQueueSlicesResponse, error) {
+	category, ok := e.taskCategoryRegistry.GetCategoryByID(int(request.GetCategory()))
+	if !ok {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid task category ID: %v", request.GetCategory()))
+	}
+	queue, ok := e.queueProcessors[category]
+	if !ok {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("No queue for task category: %v", category.Name()))
+	}
+	return &historyservice.CompactQueueSlicesResponse{
+		SliceCount: int32(queue.CompactSlices()),
+	}, nil
+}
+
 // StateMachineEnvironment implements shard.Engine.
 func (e *historyEngineImpl) StateMachineEnvironment() hsm.Environment {
 	return e.stateMachineEnvironment
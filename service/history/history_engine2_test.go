@@ -217,11 +217,14 @@ func (s *engine2Suite) SetupTest() {
 			searchattribute.NewTestProvider(),
 			s.mockShard.Resource.SearchAttributesMapperProvider,
 			s.config.SearchAttributesNumberOfKeysLimit,
+			s.config.SearchAttributesNumberOfKeysLimitWarn,
 			s.config.SearchAttributesSizeOfValueLimit,
 			s.config.SearchAttributesTotalSizeLimit,
 			s.mockVisibilityManager,
 			dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 			dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+			metrics.NoopMetricsHandler,
+			log.NewNoopLogger(),
 		),
 		workflowConsistencyChecker: api.NewWorkflowConsistencyChecker(mockShard, s.workflowCache),
 		persistenceVisibilityMgr:   s.mockVisibilityManager,
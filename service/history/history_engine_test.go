@@ -1140,6 +1140,44 @@ func (s *engineSuite) TestValidateSignalRequest() {
 	s.Error(err, "memo should be too big")
 }
 
+func (s *engineSuite) TestStartWorkflowExecutionRequest_MaxWorkflowTaskTimeout() {
+	maxWorkflowTaskTimeout := 5 * time.Second
+	s.config.MaxWorkflowTaskTimeout = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(maxWorkflowTaskTimeout)
+	s.config.RejectWorkflowTaskTimeoutAboveMax = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	newStartRequest := func(workflowTaskTimeout time.Duration) *workflowservice.StartWorkflowExecutionRequest {
+		return &workflowservice.StartWorkflowExecutionRequest{
+			Namespace:           tests.Namespace.String(),
+			WorkflowId:          "ID",
+			WorkflowType:        &commonpb.WorkflowType{Name: "testType"},
+			TaskQueue:           &taskqueuepb.TaskQueue{Name: "taskptr"},
+			WorkflowTaskTimeout: durationpb.New(workflowTaskTimeout),
+			RequestId:           "request-id",
+		}
+	}
+	prepare := func(startRequest *workflowservice.StartWorkflowExecutionRequest) error {
+		api.OverrideStartWorkflowExecutionRequest(startRequest, metrics.HistoryStartWorkflowExecutionScope, s.mockHistoryEngine.shardContext, metrics.NoopMetricsHandler)
+		return api.ValidateStartWorkflowExecutionRequest(
+			context.Background(), startRequest, s.mockHistoryEngine.shardContext, tests.LocalNamespaceEntry, "StartWorkflowExecution")
+	}
+
+	// the default timeout is clamped to the ceiling, even if rejection is enabled
+	startRequest := newStartRequest(0)
+	s.NoError(prepare(startRequest))
+	s.Equal(maxWorkflowTaskTimeout, startRequest.GetWorkflowTaskTimeout().AsDuration())
+
+	// an explicit timeout above the ceiling is rejected, without changing the request
+	startRequest = newStartRequest(2 * maxWorkflowTaskTimeout)
+	var invalidArgErr *serviceerror.InvalidArgument
+	s.ErrorAs(prepare(startRequest), &invalidArgErr)
+	s.Equal(2*maxWorkflowTaskTimeout, startRequest.GetWorkflowTaskTimeout().AsDuration())
+
+	// or clamped if rejection is disabled
+	s.config.RejectWorkflowTaskTimeoutAboveMax = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false)
+	startRequest = newStartRequest(2 * maxWorkflowTaskTimeout)
+	s.NoError(prepare(startRequest))
+	s.Equal(maxWorkflowTaskTimeout, startRequest.GetWorkflowTaskTimeout().AsDuration())
+}
+
 func (s *engineSuite) TestRespondWorkflowTaskCompleted_StaleCache() {
 	namespaceID := tests.NamespaceID
 	we := commonpb.WorkflowExecution{
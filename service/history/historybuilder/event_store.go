@@ -27,6 +27,7 @@ package historybuilder
 import (
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
 
 	"go.temporal.io/server/common"
@@ -34,6 +35,10 @@ import (
 	"go.temporal.io/server/common/metrics"
 )
 
+// historyEventsFieldNumber is the field number of events in historypb.History, which buffered events are
+// serialized as.
+const historyEventsFieldNumber = 1
+
 type EventStore struct {
 	state           HistoryBuilderState
 	timeSource      clock.TimeSource
@@ -140,17 +145,23 @@ func (b *EventStore) NumBufferedEvents() int {
 	return len(b.dbBufferBatch) + len(b.memBufferBatch)
 }
 
+// SizeInBytesOfBufferedEvents returns the size of the buffered events as they are persisted, i.e. including the
+// framing each event gets when serialized as part of a History blob.
 func (b *EventStore) SizeInBytesOfBufferedEvents() int {
 	size := 0
 	for _, ev := range b.dbBufferBatch {
-		size += proto.Size(ev)
+		size += serializedEventSize(ev)
 	}
 	for _, ev := range b.memBufferBatch {
-		size += proto.Size(ev)
+		size += serializedEventSize(ev)
 	}
 	return size
 }
 
+func serializedEventSize(event *historypb.HistoryEvent) int {
+	return protowire.SizeTag(historyEventsFieldNumber) + protowire.SizeBytes(proto.Size(event))
+}
+
 func (b *EventStore) FlushBufferToCurrentBatch() map[int64]int64 {
 	if len(b.dbBufferBatch) == 0 && len(b.memBufferBatch) == 0 {
 		return b.scheduledIDToStartedID
@@ -26,6 +26,7 @@ package historybuilder
 
 import (
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 
@@ -41,6 +42,7 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -50,6 +52,7 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/service/history/tests"
 )
@@ -2384,6 +2387,24 @@ func (s *historyBuilderSuite) TestBufferSize_DB() {
 	s.Assert().Zero(s.historyBuilder.SizeInBytesOfBufferedEvents())
 }
 
+func (s *historyBuilderSuite) TestBufferSize_MatchesSerializedSize() {
+	for i := 0; i < 3; i++ {
+		s.historyBuilder.AddWorkflowExecutionSignaledEvent(
+			"signal-name",
+			payloads.EncodeString(strings.Repeat("a", 200)),
+			"identity",
+			&commonpb.Header{},
+			false,
+			nil,
+		)
+	}
+	s.Assert().Equal(3, s.historyBuilder.NumBufferedEvents())
+
+	// the buffered events are persisted as a History blob, which adds framing to each event
+	serializedSize := proto.Size(&historypb.History{Events: s.historyBuilder.memBufferBatch})
+	s.Assert().Equal(serializedSize, s.historyBuilder.SizeInBytesOfBufferedEvents())
+}
+
 func (s *historyBuilderSuite) TestLastEventVersion() {
 	_, ok := s.historyBuilder.LastEventVersion()
 	s.False(ok)
@@ -35,8 +35,10 @@ import (
 
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/workflow"
@@ -244,12 +246,20 @@ func getHistoryResendInfo(
 func getStandbyPostActionFn(
 	taskInfo tasks.Task,
 	standbyNow standbyCurrentTimeFn,
-	standbyTaskMissingEventsResendDelay time.Duration,
-	standbyTaskMissingEventsDiscardDelay time.Duration,
+	config *configs.Config,
+	registry namespace.Registry,
+	metricsHandler metrics.Handler,
 	fetchHistoryStandbyPostActionFn standbyPostActionFn,
 	discardTaskStandbyPostActionFn standbyPostActionFn,
 ) standbyPostActionFn {
 
+	// an unknown namespace falls back to the task type defaults
+	namespaceName, _ := registry.GetNamespaceName(namespace.ID(taskInfo.GetNamespaceID()))
+	standbyTaskMissingEventsResendDelay, standbyTaskMissingEventsDiscardDelay := config.ResolveStandbyTaskMissingEventsDelays(
+		namespaceName.String(),
+		taskInfo.GetType(),
+	)
+
 	// this is for task retry, use machine time
 	now := standbyNow()
 	taskTime := taskInfo.GetVisibilityTime()
@@ -262,12 +272,20 @@ func getStandbyPostActionFn(
 	}
 
 	// task start time + StandbyTaskMissingEventsResendDelay <= now < task start time + StandbyTaskMissingEventsResendDelay
+	// resends are counted by the executors' fetchHistoryFromRemote, as not every task type resends history
 	if now.Before(discardTime) {
 		return fetchHistoryStandbyPostActionFn
 	}
 
 	// task start time + StandbyTaskMissingEventsResendDelay <= now
-	return discardTaskStandbyPostActionFn
+	metricsHandler = metricsHandler.WithTags(metrics.NamespaceTag(namespaceName.String()))
+	return func(ctx context.Context, task tasks.Task, postActionInfo interface{}, logger log.Logger) error {
+		err := discardTaskStandbyPostActionFn(ctx, task, postActionInfo, logger)
+		if errors.Is(err, consts.ErrTaskDiscarded) {
+			metrics.TaskStandbyMissingEventsDiscard.With(metricsHandler).Record(1)
+		}
+		return err
+	}
 }
 
 func getRemoteClusterName(
@@ -30,12 +30,14 @@ import (
 	"go.temporal.io/server/common/circuitbreaker"
 	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/locks"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/quotas"
 	ctasks "go.temporal.io/server/common/tasks"
+	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/hsm"
 	"go.temporal.io/server/service/history/queues"
 	"go.temporal.io/server/service/history/shard"
@@ -52,6 +54,67 @@ type outboundQueueFactoryParams struct {
 	fx.In
 
 	QueueFactoryBaseParams
+
+	CircuitBreakerPool *OutboundQueueCircuitBreakerPool
+}
+
+// OutboundQueueCircuitBreakerPool is the pool of circuit breakers shared by the outbound queues of all shards in
+// the host, keyed by task group, namespace and destination.
+type OutboundQueueCircuitBreakerPool struct {
+	*collection.OnceMap[queues.OutboundTaskGroupNamespaceIDAndDestination, circuitbreaker.TwoStepCircuitBreaker]
+}
+
+func NewOutboundQueueCircuitBreakerPool(
+	namespaceRegistry namespace.Registry,
+	config *configs.Config,
+	metricsHandler metrics.Handler,
+) *OutboundQueueCircuitBreakerPool {
+	metricsHandler = getOutbountQueueProcessorMetricsHandler(metricsHandler)
+	circuitBreakerSettings := config.OutboundQueueCircuitBreakerSettings
+	return &OutboundQueueCircuitBreakerPool{
+		OnceMap: collection.NewOnceMap(
+			func(key queues.OutboundTaskGroupNamespaceIDAndDestination) circuitbreaker.TwoStepCircuitBreaker {
+				return circuitbreaker.NewTwoStepCircuitBreakerWithDynamicSettings(circuitbreaker.Settings{
+					Name: fmt.Sprintf(
+						"circuit_breaker:%s:%s:%s",
+						key.TaskGroup,
+						key.NamespaceID,
+						key.Destination,
+					),
+					SettingsFn: func() dynamicconfig.CircuitBreakerSettings {
+						// This is intentionally not failing the function in case of error. The circuit
+						// breaker is agnostic to Task implementation, and thus the settings function is
+						// not expected to return an error. Also, in this case, if the namespace registry
+						// fails to get the name, then the task itself will fail when it is processed and
+						// tries to get the namespace name.
+						nsName := getNamespaceNameOrDefault(
+							namespaceRegistry,
+							key.NamespaceID,
+							"",
+							metricsHandler,
+						)
+						return circuitBreakerSettings(nsName, key.Destination)
+					},
+				})
+			},
+		),
+	}
+}
+
+// GetByDestination returns the circuit breakers of all task groups that have been created so far for the given
+// namespace and destination.
+func (p *OutboundQueueCircuitBreakerPool) GetByDestination(
+	namespaceID string,
+	destination string,
+) map[string]circuitbreaker.TwoStepCircuitBreaker {
+	result := make(map[string]circuitbreaker.TwoStepCircuitBreaker)
+	p.Range(func(key queues.OutboundTaskGroupNamespaceIDAndDestination, cb circuitbreaker.TwoStepCircuitBreaker) bool {
+		if key.NamespaceID == namespaceID && key.Destination == destination {
+			result[key.TaskGroup] = cb
+		}
+		return true
+	})
+	return result
 }
 
 type groupLimiter struct {
@@ -92,6 +155,15 @@ func (l groupLimiter) Concurrency() int {
 	return l.concurrency(nsName, l.key.Destination)
 }
 
+// destinationPriority maps the configured host scheduler priority of a destination to a semaphore priority.
+// Values above 0 are treated as low priority.
+func destinationPriority(priority int) locks.Priority {
+	if priority <= 0 {
+		return locks.PriorityHigh
+	}
+	return locks.PriorityLow
+}
+
 type outboundQueueFactory struct {
 	outboundQueueFactoryParams
 	hostReaderRateLimiter quotas.RequestRateLimiter
@@ -102,6 +174,21 @@ type outboundQueueFactory struct {
 }
 
 func NewOutboundQueueFactory(params outboundQueueFactoryParams) QueueFactory {
+	// Shared by all destinations so that high priority destinations are executed first once the host scheduler
+	// concurrency limit is reached.
+	var hostSemaphore locks.PrioritySemaphore
+	if maxConcurrency := params.Config.OutboundQueueHostSchedulerMaxConcurrency(); maxConcurrency > 0 {
+		hostSemaphore = locks.NewPrioritySemaphore(maxConcurrency)
+	}
+	return newOutboundQueueFactory(params, hostSemaphore)
+}
+
+// newOutboundQueueFactory creates the factory with the given host semaphore, which is nil if the host scheduler
+// concurrency is unlimited.
+func newOutboundQueueFactory(
+	params outboundQueueFactoryParams,
+	hostSemaphore locks.PrioritySemaphore,
+) *outboundQueueFactory {
 	metricsHandler := getOutbountQueueProcessorMetricsHandler(params.MetricsHandler)
 
 	rateLimiterPool := collection.NewOnceMap(
@@ -123,34 +210,6 @@ func NewOutboundQueueFactory(params outboundQueueFactoryParams) QueueFactory {
 		},
 	)
 
-	circuitBreakerSettings := params.Config.OutboundQueueCircuitBreakerSettings
-	circuitBreakerPool := collection.NewOnceMap(
-		func(key queues.OutboundTaskGroupNamespaceIDAndDestination) circuitbreaker.TwoStepCircuitBreaker {
-			return circuitbreaker.NewTwoStepCircuitBreakerWithDynamicSettings(circuitbreaker.Settings{
-				Name: fmt.Sprintf(
-					"circuit_breaker:%s:%s:%s",
-					key.TaskGroup,
-					key.NamespaceID,
-					key.Destination,
-				),
-				SettingsFn: func() dynamicconfig.CircuitBreakerSettings {
-					// This is intentionally not failing the function in case of error. The circuit
-					// breaker is agnostic to Task implementation, and thus the settings function is
-					// not expected to return an error. Also, in this case, if the namespace registry
-					// fails to get the name, then the task itself will fail when it is processed and
-					// tries to get the namespace name.
-					nsName := getNamespaceNameOrDefault(
-						params.NamespaceRegistry,
-						key.NamespaceID,
-						"",
-						metricsHandler,
-					)
-					return circuitBreakerSettings(nsName, key.Destination)
-				},
-			})
-		},
-	)
-
 	grouper := queues.GrouperStateMachineNamespaceIDAndDestination{}
 	f := &outboundQueueFactory{
 		outboundQueueFactoryParams: params,
@@ -184,14 +243,24 @@ func NewOutboundQueueFactory(params outboundQueueFactoryParams) QueueFactory {
 							metrics.NamespaceTag(nsName),
 							metrics.DestinationTag(key.Destination),
 						)
-						return ctasks.NewRateLimitedTaskRunnableFromTask(
-							ctasks.RunnableTask{
-								Task: queues.NewCircuitBreakerExecutable(
-									e,
-									circuitBreakerPool.Get(key),
-									taggedMetricsHandler,
+						var runnable ctasks.Runnable = ctasks.RunnableTask{
+							Task: queues.NewCircuitBreakerExecutable(
+								e,
+								params.CircuitBreakerPool.Get(key),
+								taggedMetricsHandler,
+							),
+						}
+						if hostSemaphore != nil {
+							runnable = ctasks.PrioritySemaphoreRunnable{
+								Runnable:  runnable,
+								Semaphore: hostSemaphore,
+								Priority: destinationPriority(
+									params.Config.OutboundQueueHostSchedulerPriority(nsName, key.Destination),
 								),
-							},
+							}
+						}
+						return ctasks.NewRateLimitedTaskRunnable(
+							runnable,
 							rateLimiterPool.Get(key),
 							taggedMetricsHandler,
 						)
@@ -300,6 +369,7 @@ func (f *outboundQueueFactory) CreateQueue(
 		f.Config.TaskDLQUnexpectedErrorAttempts,
 		f.Config.TaskDLQInternalErrors,
 		f.Config.TaskDLQErrorPattern,
+		f.Config.TaskTypeDLQErrorPattern,
 	)
 	return queues.NewImmediateQueue(
 		shardContext,
@@ -316,6 +386,10 @@ func (f *outboundQueueFactory) CreateQueue(
 				PendingTasksCriticalCount:   f.Config.QueuePendingTaskCriticalCount,
 				ReaderStuckCriticalAttempts: f.Config.QueueReaderStuckCriticalAttempts,
 				SliceCountCriticalThreshold: f.Config.QueueCriticalSlicesCount,
+				PendingTasksPerNamespaceCriticalCount: NewNamespacePendingTaskCriticalCountFn(
+					shardContext.GetNamespaceRegistry(),
+					f.Config.QueuePendingTaskPerNamespaceCriticalCount,
+				),
 			},
 			MaxPollRPS:                          f.Config.OutboundProcessorMaxPollRPS,
 			MaxPollInterval:                     f.Config.OutboundProcessorMaxPollInterval,
@@ -323,6 +397,7 @@ func (f *outboundQueueFactory) CreateQueue(
 			CheckpointInterval:                  f.Config.OutboundProcessorUpdateAckInterval,
 			CheckpointIntervalJitterCoefficient: f.Config.OutboundProcessorUpdateAckIntervalJitterCoefficient,
 			MaxReaderCount:                      f.Config.OutboundQueueMaxReaderCount,
+			StartupJitter:                       f.Config.ProcessorStartupJitter,
 		},
 		f.hostReaderRateLimiter,
 		queues.GrouperStateMachineNamespaceIDAndDestination{},
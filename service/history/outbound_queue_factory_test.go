@@ -0,0 +1,118 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/locks"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/queues"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+// recordingSemaphore is a [locks.PrioritySemaphore] that reports the priority of every acquisition and never blocks.
+type recordingSemaphore struct {
+	acquired chan locks.Priority
+}
+
+func (s recordingSemaphore) Acquire(_ context.Context, priority locks.Priority, _ int) error {
+	s.acquired <- priority
+	return nil
+}
+
+func (s recordingSemaphore) TryAcquire(int) bool {
+	return true
+}
+
+func (s recordingSemaphore) Release(int) {
+}
+
+func TestOutboundHostSchedulerDestinationPriority(t *testing.T) {
+	t.Parallel()
+
+	dc := dynamicconfig.StaticClient{
+		dynamicconfig.OutboundQueueHostSchedulerPriority.Key(): []dynamicconfig.ConstrainedValue{
+			{
+				Constraints: dynamicconfig.Constraints{Destination: "best-effort"},
+				Value:       1,
+			},
+		},
+	}
+	config := configs.NewConfig(dynamicconfig.NewCollection(dc, log.NewNoopLogger()), 1)
+	require.Equal(t, locks.PriorityHigh, destinationPriority(config.OutboundQueueHostSchedulerPriority("ns", "important")))
+	require.Equal(t, locks.PriorityLow, destinationPriority(config.OutboundQueueHostSchedulerPriority("ns", "best-effort")))
+
+	ctrl := gomock.NewController(t)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	namespaceRegistry.EXPECT().GetNamespaceName(gomock.Any()).Return(namespace.Name("ns"), nil).AnyTimes()
+
+	semaphore := recordingSemaphore{acquired: make(chan locks.Priority, 1)}
+	factory := newOutboundQueueFactory(
+		outboundQueueFactoryParams{
+			QueueFactoryBaseParams: QueueFactoryBaseParams{
+				NamespaceRegistry: namespaceRegistry,
+				Config:            config,
+				MetricsHandler:    metrics.NoopMetricsHandler,
+				Logger:            log.NewNoopLogger(),
+			},
+			CircuitBreakerPool: NewOutboundQueueCircuitBreakerPool(namespaceRegistry, config, metrics.NoopMetricsHandler),
+		},
+		semaphore,
+	)
+	factory.Start()
+	defer factory.Stop()
+
+	// Tasks are submitted one at a time and each one is waited for, so the recorded priority belongs to its destination.
+	for destination, expectedPriority := range map[string]locks.Priority{
+		"important":   locks.PriorityHigh,
+		"best-effort": locks.PriorityLow,
+	} {
+		acked := make(chan struct{})
+		executable := queues.NewMockExecutable(ctrl)
+		executable.EXPECT().GetTask().Return(&tasks.StateMachineOutboundTask{
+			StateMachineTask: tasks.StateMachineTask{
+				WorkflowKey: definition.NewWorkflowKey("namespace-id", "workflow-id", "run-id"),
+				Info:        &persistencespb.StateMachineTaskInfo{Type: "test-task-type"},
+			},
+			Destination: destination,
+		}).AnyTimes()
+		executable.EXPECT().Execute().Return(nil)
+		executable.EXPECT().HandleErr(nil).Return(nil)
+		executable.EXPECT().Ack().Do(func() { close(acked) })
+
+		require.True(t, factory.hostScheduler.TrySubmit(executable))
+		require.Equal(t, expectedPriority, <-semaphore.acquired, destination)
+		<-acked
+	}
+}
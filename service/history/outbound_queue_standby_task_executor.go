@@ -125,8 +125,9 @@ func (e *outboundQueueStandbyTaskExecutor) Execute(
 		getStandbyPostActionFn(
 			task,
 			e.Now,
-			e.config.StandbyTaskMissingEventsResendDelay(task.GetType()),
-			e.config.StandbyTaskMissingEventsDiscardDelay(task.GetType()),
+			e.config,
+			e.shardContext.GetNamespaceRegistry(),
+			e.metricsHandler,
 			e.noopPostProcessAction,
 			standbyOutboundTaskPostActionTaskDiscarded,
 		),
@@ -99,6 +99,7 @@ var QueueModule = fx.Options(
 			return tqm
 		},
 		queues.NewDLQWriter,
+		NewOutboundQueueCircuitBreakerPool,
 		fx.Annotated{
 			Group:  QueueFactoryFxGroup,
 			Target: NewTransferQueueFactory,
@@ -251,3 +252,18 @@ func NewHostRateLimiterRateFn(
 		return float64(persistenceMaxRPS()) * persistenceMaxRPSRatio
 	}
 }
+
+// NewNamespacePendingTaskCriticalCountFn adapts the per-namespace pending task critical count,
+// which is configured by namespace name, to the namespace ID known to queue monitors.
+func NewNamespacePendingTaskCriticalCountFn(
+	namespaceRegistry namespace.Registry,
+	criticalCount dynamicconfig.IntPropertyFnWithNamespaceFilter,
+) dynamicconfig.IntPropertyFnWithNamespaceIDFilter {
+	return func(namespaceID string) int {
+		namespaceName, err := namespaceRegistry.GetNamespaceName(namespace.ID(namespaceID))
+		if err != nil {
+			return 0
+		}
+		return criticalCount(namespaceName.String())
+	}
+}
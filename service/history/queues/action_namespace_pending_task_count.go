@@ -0,0 +1,163 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"golang.org/x/exp/slices"
+
+	"go.temporal.io/server/service/history/tasks"
+)
+
+var _ Action = (*actionNamespacePendingTask)(nil)
+
+type (
+	// actionNamespacePendingTask unloads pending tasks of a single namespace when
+	// that namespace alone exceeds its per-namespace pending task limit, so that
+	// other namespaces in the same queue are not affected.
+	actionNamespacePendingTask struct {
+		attributes     *AlertAttributesQueuePendingTaskCountPerNamespace
+		monitor        Monitor
+		maxReaderCount int64
+	}
+)
+
+func newNamespacePendingTaskAction(
+	attributes *AlertAttributesQueuePendingTaskCountPerNamespace,
+	monitor Monitor,
+	maxReaderCount int,
+) *actionNamespacePendingTask {
+	return &actionNamespacePendingTask{
+		attributes:     attributes,
+		monitor:        monitor,
+		maxReaderCount: int64(maxReaderCount),
+	}
+}
+
+func (a *actionNamespacePendingTask) Name() string {
+	return "namespace-pending-task"
+}
+
+func (a *actionNamespacePendingTask) Run(readerGroup *ReaderGroup) {
+	namespaceID := a.attributes.NamespaceID
+
+	// first check if the alert is still valid
+	if a.monitor.GetNamespacePendingTaskCount(namespaceID) <= a.attributes.CriticalPendingTaskCount {
+		return
+	}
+
+	// then try to shrink existing slices, which may reduce pending task count
+	readers := readerGroup.Readers()
+	for _, reader := range readers {
+		reader.ShrinkSlices()
+	}
+	currentPendingTasks := a.monitor.GetNamespacePendingTaskCount(namespaceID)
+	if currentPendingTasks <= a.attributes.CriticalPendingTaskCount {
+		return
+	}
+
+	// have to unload pending tasks of the namespace, starting from newer slices
+	slicesToClear := a.findSlicesToClear(
+		readers,
+		currentPendingTasks,
+		int(float64(a.attributes.CriticalPendingTaskCount)*targetLoadFactor),
+	)
+	a.splitAndClearSlices(readers, readerGroup, slicesToClear)
+}
+
+func (a *actionNamespacePendingTask) findSlicesToClear(
+	readers map[int64]Reader,
+	currentPendingTasks int,
+	targetPendingTasks int,
+) map[Slice]struct{} {
+	namespaceID := a.attributes.NamespaceID
+
+	var namespaceSlices []Slice
+	pendingPerSlice := make(map[Slice]int)
+	for _, reader := range readers {
+		reader.WalkSlices(func(s Slice) {
+			if pending := s.TaskStats().PendingPerNamespace[namespaceID]; pending > 0 {
+				namespaceSlices = append(namespaceSlices, s)
+				pendingPerSlice[s] = pending
+			}
+		})
+	}
+	slices.SortFunc(namespaceSlices, func(this, that Slice) int {
+		thisMin := this.Scope().Range.InclusiveMin
+		thatMin := that.Scope().Range.InclusiveMin
+		// sort in largest to smallest order
+		return thatMin.CompareTo(thisMin)
+	})
+
+	slicesToClear := make(map[Slice]struct{})
+	for _, s := range namespaceSlices {
+		if currentPendingTasks <= targetPendingTasks {
+			break
+		}
+		slicesToClear[s] = struct{}{}
+		currentPendingTasks -= pendingPerSlice[s]
+	}
+	return slicesToClear
+}
+
+func (a *actionNamespacePendingTask) splitAndClearSlices(
+	readers map[int64]Reader,
+	readerGroup *ReaderGroup,
+	slicesToClear map[Slice]struct{},
+) {
+	predicate := tasks.NewNamespacePredicate([]string{a.attributes.NamespaceID})
+	for readerID, reader := range readers {
+		if readerID == a.maxReaderCount-1 {
+			// we can't do further split, have to clear entire slice
+			cleared := false
+			reader.ClearSlices(func(s Slice) bool {
+				_, ok := slicesToClear[s]
+				cleared = cleared || ok
+				return ok
+			})
+			if cleared {
+				reader.Pause(clearSliceThrottleDuration)
+			}
+			continue
+		}
+
+		var splitSlices []Slice
+		reader.SplitSlices(func(s Slice) ([]Slice, bool) {
+			if _, ok := slicesToClear[s]; !ok {
+				return nil, false
+			}
+
+			split, remain := s.SplitByPredicate(predicate)
+			split.Clear()
+			splitSlices = append(splitSlices, split)
+			return []Slice{remain}, true
+		})
+
+		if len(splitSlices) == 0 {
+			continue
+		}
+
+		nextReader := readerGroup.GetOrCreateReader(readerID + 1)
+		nextReader.MergeSlices(splitSlices...)
+		nextReader.Pause(clearSliceThrottleDuration)
+	}
+}
@@ -35,6 +35,8 @@ type (
 		AlertAttributesQueuePendingTaskCount *AlertAttributesQueuePendingTaskCount
 		AlertAttributesReaderStuck           *AlertAttributesReaderStuck
 		AlertAttributesSliceCount            *AlertAttributesSlicesCount
+
+		AlertAttributesQueuePendingTaskCountPerNamespace *AlertAttributesQueuePendingTaskCountPerNamespace
 	}
 
 	AlertType int
@@ -44,6 +46,12 @@ type (
 		CiriticalPendingTaskCount int
 	}
 
+	AlertAttributesQueuePendingTaskCountPerNamespace struct {
+		NamespaceID              string
+		CurrentPendingTaskCount  int
+		CriticalPendingTaskCount int
+	}
+
 	AlertAttributesReaderStuck struct {
 		ReaderID         int64
 		CurrentWatermark tasks.Key
@@ -60,4 +68,5 @@ const (
 	AlertTypeQueuePendingTaskCount
 	AlertTypeReaderStuck
 	AlertTypeSliceCount
+	AlertTypeQueuePendingTaskCountPerNamespace
 )
@@ -77,8 +77,14 @@ func NewDLQWriter(w QueueWriter, m cluster.Metadata, h metrics.Handler, l log.Sn
 	}
 }
 
-// WriteTaskToDLQ writes a task to the DLQ, creating the underlying queue if it doesn't already exist.
-func (q *DLQWriter) WriteTaskToDLQ(ctx context.Context, sourceCluster, targetCluster string, task tasks.Task) error {
+// WriteTaskToDLQ writes a task to the DLQ, creating the underlying queue if it doesn't already exist. The failure
+// reason is stored with the task so that DLQ tasks can later be filtered by it.
+func (q *DLQWriter) WriteTaskToDLQ(
+	ctx context.Context,
+	sourceCluster, targetCluster string,
+	task tasks.Task,
+	failureReason string,
+) error {
 	queueKey := persistence.QueueKey{
 		QueueType:     persistence.QueueTypeHistoryDLQ,
 		Category:      task.GetCategory(),
@@ -105,6 +111,7 @@ func (q *DLQWriter) WriteTaskToDLQ(ctx context.Context, sourceCluster, targetClu
 		TargetCluster: queueKey.TargetCluster,
 		Task:          task,
 		SourceShardID: shardID,
+		FailureReason: failureReason,
 	})
 	if err != nil {
 		return fmt.Errorf("%w: %v", ErrSendTaskToDLQ, err)
@@ -75,6 +75,7 @@ func TestDLQWriter_ErrGetClusterMetadata(t *testing.T) {
 		"source-cluster",
 		"target-cluster",
 		&tasks.WorkflowTask{},
+		"",
 	)
 	assert.ErrorIs(t, err, queues.ErrGetClusterMetadata)
 	assert.Empty(t, queueWriter.EnqueueTaskRequests)
@@ -110,6 +111,7 @@ func TestDLQWriter_ErrGetNamespaceName(t *testing.T) {
 		"source-cluster",
 		"target-cluster",
 		task,
+		"failure reason",
 	)
 	require.NoError(t, err)
 	require.Len(t, queueWriter.EnqueueTaskRequests, 1)
@@ -159,12 +161,14 @@ func TestDLQWriter_Ok(t *testing.T) {
 		"source-cluster",
 		"target-cluster",
 		task,
+		"failure reason",
 	)
 	require.NoError(t, err)
 	require.Len(t, queueWriter.EnqueueTaskRequests, 1)
 	request := queueWriter.EnqueueTaskRequests[0]
 	expectedShardID := tasks.GetShardIDForTask(task, 100)
 	assert.Equal(t, expectedShardID, request.SourceShardID)
+	assert.Equal(t, "failure reason", request.FailureReason)
 	assert.NotEmpty(t, logger.records)
 	assert.Contains(t, logger.records[0].msg, "Task enqueued to DLQ")
 	assert.Contains(t, logger.records[0].tags, tag.DLQMessageID(0))
@@ -31,7 +31,6 @@ import (
 	"errors"
 	"fmt"
 	"math"
-	"regexp"
 	"runtime/debug"
 	"sync"
 	"time"
@@ -39,6 +38,7 @@ import (
 	"go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/circuitbreaker"
@@ -50,6 +50,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	ctasks "go.temporal.io/server/common/tasks"
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/service/history/consts"
@@ -174,6 +175,7 @@ type (
 		maxUnexpectedErrorAttempts dynamicconfig.IntPropertyFn
 		dlqInternalErrors          dynamicconfig.BoolPropertyFn
 		dlqErrorPattern            dynamicconfig.StringPropertyFn
+		dlqTaskTypeErrorPattern    dynamicconfig.StringPropertyFnWithTaskTypeFilter
 	}
 	ExecutableParams struct {
 		DLQEnabled                 dynamicconfig.BoolPropertyFn
@@ -181,6 +183,7 @@ type (
 		MaxUnexpectedErrorAttempts dynamicconfig.IntPropertyFn
 		DLQInternalErrors          dynamicconfig.BoolPropertyFn
 		DLQErrorPattern            dynamicconfig.StringPropertyFn
+		DLQTaskTypeErrorPattern    dynamicconfig.StringPropertyFnWithTaskTypeFilter
 	}
 	ExecutableOption func(*ExecutableParams)
 )
@@ -213,6 +216,9 @@ func NewExecutable(
 		DLQErrorPattern: func() string {
 			return ""
 		},
+		DLQTaskTypeErrorPattern: func(enumsspb.TaskType) string {
+			return ""
+		},
 	}
 	for _, opt := range opts {
 		opt(&params)
@@ -243,6 +249,7 @@ func NewExecutable(
 		maxUnexpectedErrorAttempts: params.MaxUnexpectedErrorAttempts,
 		dlqInternalErrors:          params.DLQInternalErrors,
 		dlqErrorPattern:            params.DLQErrorPattern,
+		dlqTaskTypeErrorPattern:    params.DLQTaskTypeErrorPattern,
 	}
 	executable.updatePriority()
 	return executable
@@ -347,6 +354,7 @@ func (e *executableImpl) writeToDLQ(ctx context.Context) error {
 		e.clusterMetadata.GetCurrentClusterName(),
 		e.clusterMetadata.GetCurrentClusterName(),
 		e.GetTask(),
+		e.terminalFailureCause.Error(),
 	)
 	if err != nil {
 		metrics.TaskDLQFailures.With(e.taggedMetricsHandler).Record(1)
@@ -356,6 +364,15 @@ func (e *executableImpl) writeToDLQ(ctx context.Context) error {
 	return err
 }
 
+// getDLQErrorPattern returns the DLQ error pattern for the task's type along with the key of the
+// setting it comes from. The global pattern is used if no pattern is set for the task type.
+func (e *executableImpl) getDLQErrorPattern() (string, dynamicconfig.Key) {
+	if pattern := e.dlqTaskTypeErrorPattern(e.GetType()); len(pattern) > 0 {
+		return pattern, dynamicconfig.HistoryTaskTypeDLQErrorPattern.Key()
+	}
+	return e.dlqErrorPattern(), dynamicconfig.HistoryTaskDLQErrorPattern.Key()
+}
+
 func (e *executableImpl) isSafeToDropError(err error) bool {
 	if errors.Is(err, consts.ErrStaleReference) {
 		// The task is stale and is safe to be dropped.
@@ -494,14 +511,14 @@ func (e *executableImpl) HandleErr(err error) (retErr error) {
 		}
 	}()
 
-	if len(e.dlqErrorPattern()) > 0 {
-		match, mErr := regexp.MatchString(e.dlqErrorPattern(), err.Error())
+	if dlqErrorPattern, dlqErrorPatternKey := e.getDLQErrorPattern(); len(dlqErrorPattern) > 0 {
+		match, mErr := persistence.MatchDLQErrorPattern(dlqErrorPattern, err.Error())
 		if mErr != nil {
-			e.logger.Error(fmt.Sprintf("Failed to match task processing error with %s", dynamicconfig.HistoryTaskDLQErrorPattern.Key()))
+			e.logger.Error(fmt.Sprintf("Failed to match task processing error with %s", dlqErrorPatternKey))
 		} else if match {
 			e.logger.Error(
 				fmt.Sprintf("Error matches with %s. Marking task as terminally failed, will send to DLQ",
-					dynamicconfig.HistoryTaskDLQErrorPattern.Key()),
+					dlqErrorPatternKey),
 				tag.Error(err),
 				tag.ErrorType(err))
 			e.terminalFailureCause = err
@@ -57,6 +57,7 @@ type (
 		attemptsBeforeSendingToDlq dynamicconfig.IntPropertyFn
 		dlqInternalErrors          dynamicconfig.BoolPropertyFn
 		dlqErrorPattern            dynamicconfig.StringPropertyFn
+		dlqTaskTypeErrorPattern    dynamicconfig.StringPropertyFnWithTaskTypeFilter
 	}
 )
 
@@ -79,6 +80,7 @@ func NewExecutableFactory(
 	attemptsBeforeSendingToDlq dynamicconfig.IntPropertyFn,
 	dlqInternalErrors dynamicconfig.BoolPropertyFn,
 	dlqErrorPattern dynamicconfig.StringPropertyFn,
+	dlqTaskTypeErrorPattern dynamicconfig.StringPropertyFnWithTaskTypeFilter,
 ) *executableFactoryImpl {
 	return &executableFactoryImpl{
 		executor:                   executor,
@@ -95,6 +97,7 @@ func NewExecutableFactory(
 		attemptsBeforeSendingToDlq: attemptsBeforeSendingToDlq,
 		dlqInternalErrors:          dlqInternalErrors,
 		dlqErrorPattern:            dlqErrorPattern,
+		dlqTaskTypeErrorPattern:    dlqTaskTypeErrorPattern,
 	}
 }
 
@@ -117,6 +120,7 @@ func (f *executableFactoryImpl) NewExecutable(task tasks.Task, readerID int64) E
 			params.MaxUnexpectedErrorAttempts = f.attemptsBeforeSendingToDlq
 			params.DLQInternalErrors = f.dlqInternalErrors
 			params.DLQErrorPattern = f.dlqErrorPattern
+			params.DLQTaskTypeErrorPattern = f.dlqTaskTypeErrorPattern
 		},
 	)
 }
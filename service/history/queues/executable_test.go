@@ -37,6 +37,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/metrics/metricstest"
 	"go.temporal.io/server/service/history/queues"
@@ -78,6 +79,7 @@ type (
 		maxUnexpectedErrorAttempts dynamicconfig.IntPropertyFn
 		dlqInternalErrors          dynamicconfig.BoolPropertyFn
 		dlqErrorPattern            dynamicconfig.StringPropertyFn
+		dlqTaskTypeErrorPattern    dynamicconfig.StringPropertyFnWithTaskTypeFilter
 	}
 	option func(*params)
 )
@@ -961,6 +963,162 @@ func (s *executableSuite) TestExecute_SendToDLQErrPatternMatchesMultiple() {
 	s.Len(queueWriter.EnqueueTaskRequests, 2)
 }
 
+func (s *executableSuite) TestExecute_SendToDLQTaskTypeErrPatternTakesPrecedence() {
+	queueWriter := &queuestest.FakeQueueWriter{}
+	executable := s.newTestExecutable(func(p *params) {
+		p.dlqWriter = queues.NewDLQWriter(queueWriter, s.mockClusterMetadata, metrics.NoopMetricsHandler, log.NewTestLogger(), s.mockNamespaceRegistry)
+		p.dlqEnabled = func() bool {
+			return true
+		}
+		p.dlqErrorPattern = func() string {
+			return "test substring"
+		}
+		p.dlqTaskTypeErrorPattern = func(taskType enumsspb.TaskType) string {
+			s.Equal(enumsspb.TASK_TYPE_UNSPECIFIED, taskType)
+			return "other substring"
+		}
+	})
+	executionError := errors.New("some random error with test substring")
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).Return(queues.ExecuteResponse{
+		ExecutionMetricTags: nil,
+		ExecutedAsActive:    false,
+		ExecutionErr:        executionError,
+	}).Times(2)
+
+	// Attempt 1
+	err := executable.Execute()
+	err2 := executable.HandleErr(err)
+	s.Error(err2)
+	s.NotErrorIs(err2, queues.ErrTerminalTaskFailure)
+	s.Contains(err2.Error(), executionError.Error())
+
+	// Attempt 2
+	s.Error(executable.Execute())
+	s.Error(executable.HandleErr(err))
+	s.Empty(queueWriter.EnqueueTaskRequests)
+}
+
+func (s *executableSuite) TestExecute_SendToDLQTaskTypeErrPatternMatches() {
+	queueWriter := &queuestest.FakeQueueWriter{}
+	executable := s.newTestExecutable(func(p *params) {
+		p.dlqWriter = queues.NewDLQWriter(queueWriter, s.mockClusterMetadata, metrics.NoopMetricsHandler, log.NewTestLogger(), s.mockNamespaceRegistry)
+		p.dlqEnabled = func() bool {
+			return true
+		}
+		p.dlqErrorPattern = func() string {
+			return "other substring"
+		}
+		p.dlqTaskTypeErrorPattern = func(enumsspb.TaskType) string {
+			return "test substring"
+		}
+	})
+	executionError := errors.New("some random error with test substring")
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).Return(queues.ExecuteResponse{
+		ExecutionMetricTags: nil,
+		ExecutedAsActive:    false,
+		ExecutionErr:        executionError,
+	}).Times(1)
+
+	// Attempt 1
+	err := executable.Execute()
+	err2 := executable.HandleErr(err)
+	s.Error(err2)
+	s.ErrorIs(err2, queues.ErrTerminalTaskFailure)
+	s.Contains(err2.Error(), executionError.Error())
+
+	// Attempt 2
+	s.NoError(executable.Execute())
+	s.Len(queueWriter.EnqueueTaskRequests, 1)
+}
+
+func (s *executableSuite) TestExecute_SendToDLQTaskTypeErrPatternFallsBackToGlobal() {
+	queueWriter := &queuestest.FakeQueueWriter{}
+	executable := s.newTestExecutable(func(p *params) {
+		p.dlqWriter = queues.NewDLQWriter(queueWriter, s.mockClusterMetadata, metrics.NoopMetricsHandler, log.NewTestLogger(), s.mockNamespaceRegistry)
+		p.dlqEnabled = func() bool {
+			return true
+		}
+		p.dlqErrorPattern = func() string {
+			return "test substring"
+		}
+		p.dlqTaskTypeErrorPattern = func(enumsspb.TaskType) string {
+			return ""
+		}
+	})
+	executionError := errors.New("some random error with test substring")
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).Return(queues.ExecuteResponse{
+		ExecutionMetricTags: nil,
+		ExecutedAsActive:    false,
+		ExecutionErr:        executionError,
+	}).Times(1)
+
+	// Attempt 1
+	err := executable.Execute()
+	err2 := executable.HandleErr(err)
+	s.Error(err2)
+	s.ErrorIs(err2, queues.ErrTerminalTaskFailure)
+	s.Contains(err2.Error(), executionError.Error())
+
+	// Attempt 2
+	s.NoError(executable.Execute())
+	s.Len(queueWriter.EnqueueTaskRequests, 1)
+}
+
+func (s *executableSuite) TestExecute_SendToDLQErrGlobPattern() {
+	queueWriter := &queuestest.FakeQueueWriter{}
+	executable := s.newTestExecutable(func(p *params) {
+		p.dlqWriter = queues.NewDLQWriter(queueWriter, s.mockClusterMetadata, metrics.NoopMetricsHandler, log.NewTestLogger(), s.mockNamespaceRegistry)
+		p.dlqEnabled = func() bool {
+			return true
+		}
+		p.dlqErrorPattern = func() string {
+			return "glob:*test [a-z]ubstring"
+		}
+	})
+	executionError := errors.New("some random error with test substring")
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).Return(queues.ExecuteResponse{
+		ExecutionMetricTags: nil,
+		ExecutedAsActive:    false,
+		ExecutionErr:        executionError,
+	}).Times(1)
+
+	// Attempt 1
+	err := executable.Execute()
+	err2 := executable.HandleErr(err)
+	s.Error(err2)
+	s.ErrorIs(err2, queues.ErrTerminalTaskFailure)
+	s.Contains(err2.Error(), executionError.Error())
+
+	// Attempt 2
+	s.NoError(executable.Execute())
+	s.Len(queueWriter.EnqueueTaskRequests, 1)
+}
+
+func (s *executableSuite) TestExecute_ErrGlobPatternMatchesWholeMessage() {
+	queueWriter := &queuestest.FakeQueueWriter{}
+	executable := s.newTestExecutable(func(p *params) {
+		p.dlqWriter = queues.NewDLQWriter(queueWriter, s.mockClusterMetadata, metrics.NoopMetricsHandler, log.NewTestLogger(), s.mockNamespaceRegistry)
+		p.dlqEnabled = func() bool {
+			return true
+		}
+		p.dlqErrorPattern = func() string {
+			return "glob:test substring"
+		}
+	})
+	executionError := errors.New("some random error with test substring")
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).Return(queues.ExecuteResponse{
+		ExecutionMetricTags: nil,
+		ExecutedAsActive:    false,
+		ExecutionErr:        executionError,
+	}).Times(1)
+
+	err := executable.Execute()
+	err2 := executable.HandleErr(err)
+	s.Error(err2)
+	s.NotErrorIs(err2, queues.ErrTerminalTaskFailure)
+	s.Empty(queueWriter.EnqueueTaskRequests)
+}
+
 func (s *executableSuite) TestExecute_ErrPatternIfDLQDisabled() {
 	queueWriter := &queuestest.FakeQueueWriter{}
 	executable := s.newTestExecutable(func(p *params) {
@@ -1045,6 +1203,9 @@ func (s *executableSuite) newTestExecutable(opts ...option) queues.Executable {
 		dlqErrorPattern: func() string {
 			return ""
 		},
+		dlqTaskTypeErrorPattern: func(enumsspb.TaskType) string {
+			return ""
+		},
 	}
 	for _, opt := range opts {
 		opt(&p)
@@ -1075,6 +1236,7 @@ func (s *executableSuite) newTestExecutable(opts ...option) queues.Executable {
 			params.MaxUnexpectedErrorAttempts = p.maxUnexpectedErrorAttempts
 			params.DLQInternalErrors = p.dlqInternalErrors
 			params.DLQErrorPattern = p.dlqErrorPattern
+			params.DLQTaskTypeErrorPattern = p.dlqTaskTypeErrorPattern
 		},
 	)
 }
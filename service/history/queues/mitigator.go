@@ -31,6 +31,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
 )
 
 var _ Mitigator = (*mitigatorImpl)(nil)
@@ -46,11 +47,12 @@ type (
 	mitigatorImpl struct {
 		sync.Mutex
 
-		readerGroup    *ReaderGroup
-		monitor        Monitor
-		logger         log.Logger
-		metricsHandler metrics.Handler
-		maxReaderCount dynamicconfig.IntPropertyFn
+		readerGroup       *ReaderGroup
+		monitor           Monitor
+		namespaceRegistry namespace.Registry
+		logger            log.Logger
+		metricsHandler    metrics.Handler
+		maxReaderCount    dynamicconfig.IntPropertyFn
 
 		// this is for overriding the behavior in unit tests
 		// since we don't really want to run the action in Mitigator unit tests
@@ -62,17 +64,19 @@ type (
 func newMitigator(
 	readerGroup *ReaderGroup,
 	monitor Monitor,
+	namespaceRegistry namespace.Registry,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 	maxReaderCount dynamicconfig.IntPropertyFn,
 	grouper Grouper,
 ) *mitigatorImpl {
 	return &mitigatorImpl{
-		readerGroup:    readerGroup,
-		monitor:        monitor,
-		logger:         logger,
-		metricsHandler: metricsHandler,
-		maxReaderCount: maxReaderCount,
+		readerGroup:       readerGroup,
+		monitor:           monitor,
+		namespaceRegistry: namespaceRegistry,
+		logger:            logger,
+		metricsHandler:    metricsHandler,
+		maxReaderCount:    maxReaderCount,
 
 		actionRunner: runAction,
 		grouper:      grouper,
@@ -102,6 +106,13 @@ func (m *mitigatorImpl) Mitigate(alert Alert) {
 			alert.AlertAttributesSliceCount,
 			m.monitor,
 		)
+	case AlertTypeQueuePendingTaskCountPerNamespace:
+		m.recordNamespacePendingTaskLimitExceeded(alert.AlertAttributesQueuePendingTaskCountPerNamespace.NamespaceID)
+		action = newNamespacePendingTaskAction(
+			alert.AlertAttributesQueuePendingTaskCountPerNamespace,
+			m.monitor,
+			m.maxReaderCount(),
+		)
 	default:
 		m.logger.Error("Unknown queue alert type", tag.QueueAlert(alert))
 		return
@@ -117,6 +128,14 @@ func (m *mitigatorImpl) Mitigate(alert Alert) {
 	m.monitor.ResolveAlert(alert.AlertType)
 }
 
+func (m *mitigatorImpl) recordNamespacePendingTaskLimitExceeded(namespaceID string) {
+	namespaceTag := metrics.NamespaceUnknownTag()
+	if namespaceName, err := m.namespaceRegistry.GetNamespaceName(namespace.ID(namespaceID)); err == nil {
+		namespaceTag = metrics.NamespaceTag(namespaceName.String())
+	}
+	metrics.QueueNamespacePendingTaskLimitExceeded.With(m.metricsHandler).Record(1, namespaceTag)
+}
+
 func runAction(
 	action Action,
 	readerGroup *ReaderGroup,
@@ -27,6 +27,7 @@ package queues
 import (
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
@@ -34,6 +35,8 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+	"go.temporal.io/server/common/namespace"
 )
 
 type (
@@ -41,7 +44,9 @@ type (
 		suite.Suite
 		*require.Assertions
 
-		mockTimeSource *clock.EventTimeSource
+		controller            *gomock.Controller
+		mockTimeSource        *clock.EventTimeSource
+		mockNamespaceRegistry *namespace.MockRegistry
 
 		monitor   *testMonitor
 		mitigator *mitigatorImpl
@@ -62,7 +67,9 @@ func TestMitigatorSuite(t *testing.T) {
 func (s *mitigatorSuite) SetupTest() {
 	s.Assertions = require.New(s.T())
 
+	s.controller = gomock.NewController(s.T())
 	s.mockTimeSource = clock.NewEventTimeSource()
+	s.mockNamespaceRegistry = namespace.NewMockRegistry(s.controller)
 	s.monitor = &testMonitor{}
 
 	// we use a different actionRunner implementation,
@@ -70,6 +77,7 @@ func (s *mitigatorSuite) SetupTest() {
 	s.mitigator = newMitigator(
 		nil,
 		s.monitor,
+		s.mockNamespaceRegistry,
 		log.NewTestLogger(),
 		metrics.NoopMetricsHandler,
 		dynamicconfig.GetIntPropertyFn(3),
@@ -112,7 +120,19 @@ func (s *mitigatorSuite) TestMitigate_ActionMatchAlert() {
 			},
 			expectedAction: &actionSliceCount{},
 		},
+		{
+			alert: Alert{
+				AlertType: AlertTypeQueuePendingTaskCountPerNamespace,
+				AlertAttributesQueuePendingTaskCountPerNamespace: &AlertAttributesQueuePendingTaskCountPerNamespace{
+					NamespaceID:              namespace.NewID().String(),
+					CurrentPendingTaskCount:  1000,
+					CriticalPendingTaskCount: 500,
+				},
+			},
+			expectedAction: &actionNamespacePendingTask{},
+		},
 	}
+	s.mockNamespaceRegistry.EXPECT().GetNamespaceName(gomock.Any()).Return(namespace.Name("test-namespace"), nil).AnyTimes()
 
 	var actualAction Action
 	s.mitigator.actionRunner = func(
@@ -151,6 +171,38 @@ func (s *mitigatorSuite) TestMitigate_ResolveAlert() {
 	s.Equal(alert.AlertType, s.monitor.resolvedAlertType)
 }
 
+func (s *mitigatorSuite) TestMitigate_NamespacePendingTaskLimitMetric() {
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	defer metricsHandler.StopCapture(capture)
+	s.mitigator.metricsHandler = metricsHandler
+	s.mitigator.actionRunner = func(
+		_ Action,
+		_ *ReaderGroup,
+		_ metrics.Handler,
+		_ log.Logger,
+	) {
+	}
+
+	namespaceID := namespace.NewID()
+	s.mockNamespaceRegistry.EXPECT().GetNamespaceName(namespaceID).Return(namespace.Name("test-namespace"), nil).Times(1)
+
+	s.mitigator.Mitigate(Alert{
+		AlertType: AlertTypeQueuePendingTaskCountPerNamespace,
+		AlertAttributesQueuePendingTaskCountPerNamespace: &AlertAttributesQueuePendingTaskCountPerNamespace{
+			NamespaceID:              namespaceID.String(),
+			CurrentPendingTaskCount:  1000,
+			CriticalPendingTaskCount: 500,
+		},
+	})
+
+	recordings := capture.Snapshot()[metrics.QueueNamespacePendingTaskLimitExceeded.Name()]
+	s.Len(recordings, 1)
+	s.Equal(int64(1), recordings[0].Value)
+	s.Equal("test-namespace", recordings[0].Tags["namespace"])
+	s.Equal(AlertTypeQueuePendingTaskCountPerNamespace, s.monitor.resolvedAlertType)
+}
+
 func (m *testMonitor) ResolveAlert(alertType AlertType) {
 	m.resolvedAlertType = alertType
 }
@@ -28,6 +28,8 @@ import (
 	"sync"
 	"time"
 
+	"golang.org/x/exp/maps"
+
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/service/history/tasks"
@@ -50,6 +52,9 @@ type (
 		GetSlicePendingTaskCount(slice Slice) int
 		SetSlicePendingTaskCount(slice Slice, count int)
 
+		GetNamespacePendingTaskCount(namespaceID string) int
+		SetSlicePendingTaskCountPerNamespace(slice Slice, countPerNamespace map[string]int)
+
 		GetReaderWatermark(readerID int64) (tasks.Key, bool)
 		SetReaderWatermark(readerID int64, watermark tasks.Key)
 
@@ -70,6 +75,9 @@ type (
 		PendingTasksCriticalCount   dynamicconfig.IntPropertyFn
 		ReaderStuckCriticalAttempts dynamicconfig.IntPropertyFn
 		SliceCountCriticalThreshold dynamicconfig.IntPropertyFn
+		// PendingTasksPerNamespaceCriticalCount is optional. When nil or returns a non-positive
+		// value for a namespace, no per-namespace limit is enforced for that namespace.
+		PendingTasksPerNamespaceCriticalCount dynamicconfig.IntPropertyFnWithNamespaceIDFilter
 	}
 
 	monitorImpl struct {
@@ -78,6 +86,8 @@ type (
 		totalPendingTaskCount int
 		totalSliceCount       int
 
+		pendingTaskCountPerNamespace map[string]int
+
 		readerStats map[int64]readerStats
 		sliceStats  map[Slice]sliceStats
 
@@ -102,7 +112,8 @@ type (
 	}
 
 	sliceStats struct {
-		pendingTaskCount int
+		pendingTaskCount             int
+		pendingTaskCountPerNamespace map[string]int
 	}
 )
 
@@ -112,8 +123,11 @@ func newMonitor(
 	options *MonitorOptions,
 ) *monitorImpl {
 	return &monitorImpl{
-		readerStats:    make(map[int64]readerStats),
-		sliceStats:     make(map[Slice]sliceStats),
+		readerStats: make(map[int64]readerStats),
+		sliceStats:  make(map[Slice]sliceStats),
+
+		pendingTaskCountPerNamespace: make(map[string]int),
+
 		categoryType:   categoryType,
 		timeSource:     timeSource,
 		options:        options,
@@ -163,6 +177,63 @@ func (m *monitorImpl) SetSlicePendingTaskCount(slice Slice, count int) {
 	}
 }
 
+func (m *monitorImpl) GetNamespacePendingTaskCount(namespaceID string) int {
+	m.Lock()
+	defer m.Unlock()
+
+	return m.pendingTaskCountPerNamespace[namespaceID]
+}
+
+func (m *monitorImpl) SetSlicePendingTaskCountPerNamespace(slice Slice, countPerNamespace map[string]int) {
+	// make a copy as the map is owned and updated by the slice, and resolve the
+	// critical counts (which may require a namespace registry lookup) before taking the lock
+	countPerNamespace = maps.Clone(countPerNamespace)
+	var criticalCountPerNamespace map[string]int
+	if m.options.PendingTasksPerNamespaceCriticalCount != nil {
+		criticalCountPerNamespace = make(map[string]int, len(countPerNamespace))
+		for namespaceID := range countPerNamespace {
+			criticalCountPerNamespace[namespaceID] = m.options.PendingTasksPerNamespaceCriticalCount(namespaceID)
+		}
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	stats := m.sliceStats[slice]
+	m.removeSlicePendingTaskCountPerNamespaceLocked(stats)
+
+	stats.pendingTaskCountPerNamespace = countPerNamespace
+	for namespaceID, count := range stats.pendingTaskCountPerNamespace {
+		m.pendingTaskCountPerNamespace[namespaceID] += count
+	}
+	m.sliceStats[slice] = stats
+
+	for namespaceID, criticalNamespaceTasks := range criticalCountPerNamespace {
+		currentNamespaceTasks := m.pendingTaskCountPerNamespace[namespaceID]
+		if criticalNamespaceTasks > 0 && currentNamespaceTasks > criticalNamespaceTasks {
+			// only one outstanding alert per alert type, so there's no point checking other namespaces
+			m.sendAlertLocked(&Alert{
+				AlertType: AlertTypeQueuePendingTaskCountPerNamespace,
+				AlertAttributesQueuePendingTaskCountPerNamespace: &AlertAttributesQueuePendingTaskCountPerNamespace{
+					NamespaceID:              namespaceID,
+					CurrentPendingTaskCount:  currentNamespaceTasks,
+					CriticalPendingTaskCount: criticalNamespaceTasks,
+				},
+			})
+			return
+		}
+	}
+}
+
+func (m *monitorImpl) removeSlicePendingTaskCountPerNamespaceLocked(stats sliceStats) {
+	for namespaceID, count := range stats.pendingTaskCountPerNamespace {
+		m.pendingTaskCountPerNamespace[namespaceID] -= count
+		if m.pendingTaskCountPerNamespace[namespaceID] <= 0 {
+			delete(m.pendingTaskCountPerNamespace, namespaceID)
+		}
+	}
+}
+
 func (m *monitorImpl) GetReaderWatermark(readerID int64) (tasks.Key, bool) {
 	m.Lock()
 	defer m.Unlock()
@@ -266,6 +337,7 @@ func (m *monitorImpl) RemoveSlice(slice Slice) {
 	}
 
 	m.totalPendingTaskCount -= stats.pendingTaskCount
+	m.removeSlicePendingTaskCountPerNamespaceLocked(stats)
 	delete(m.sliceStats, slice)
 }
 
@@ -37,6 +37,11 @@ import (
 	"go.temporal.io/server/service/history/tasks"
 )
 
+const (
+	testLimitedNamespaceID   = "limited-namespace-id"
+	testUnlimitedNamespaceID = "unlimited-namespace-id"
+)
+
 type (
 	monitorSuite struct {
 		suite.Suite
@@ -65,6 +70,12 @@ func (s *monitorSuite) SetupTest() {
 			PendingTasksCriticalCount:   dynamicconfig.GetIntPropertyFn(1000),
 			ReaderStuckCriticalAttempts: dynamicconfig.GetIntPropertyFn(5),
 			SliceCountCriticalThreshold: dynamicconfig.GetIntPropertyFn(50),
+			PendingTasksPerNamespaceCriticalCount: func(namespaceID string) int {
+				if namespaceID == testLimitedNamespaceID {
+					return 100
+				}
+				return 0
+			},
 		},
 	)
 	s.alertCh = s.monitor.AlertCh()
@@ -124,6 +135,50 @@ func (s *monitorSuite) TestPendingTasksStats() {
 	s.Equal(1, s.monitor.GetTotalPendingTaskCount())
 }
 
+func (s *monitorSuite) TestNamespacePendingTasksStats() {
+	s.Equal(0, s.monitor.GetNamespacePendingTaskCount(testLimitedNamespaceID))
+
+	threshold := s.monitor.options.PendingTasksPerNamespaceCriticalCount(testLimitedNamespaceID)
+
+	slice1 := &SliceImpl{}
+	s.monitor.SetSlicePendingTaskCountPerNamespace(slice1, map[string]int{
+		testLimitedNamespaceID:   threshold / 2,
+		testUnlimitedNamespaceID: threshold * 2,
+	})
+	s.Equal(threshold/2, s.monitor.GetNamespacePendingTaskCount(testLimitedNamespaceID))
+	s.Equal(threshold*2, s.monitor.GetNamespacePendingTaskCount(testUnlimitedNamespaceID))
+	select {
+	case <-s.alertCh:
+		s.Fail("should not trigger alert")
+	default:
+	}
+
+	slice2 := &SliceImpl{}
+	s.monitor.SetSlicePendingTaskCountPerNamespace(slice2, map[string]int{
+		testLimitedNamespaceID: threshold,
+	})
+	s.Equal(threshold/2+threshold, s.monitor.GetNamespacePendingTaskCount(testLimitedNamespaceID))
+	alert := <-s.alertCh
+	s.Equal(Alert{
+		AlertType: AlertTypeQueuePendingTaskCountPerNamespace,
+		AlertAttributesQueuePendingTaskCountPerNamespace: &AlertAttributesQueuePendingTaskCountPerNamespace{
+			NamespaceID:              testLimitedNamespaceID,
+			CurrentPendingTaskCount:  threshold/2 + threshold,
+			CriticalPendingTaskCount: threshold,
+		},
+	}, *alert)
+
+	// setting the count again replaces the previous count of the slice
+	s.monitor.SetSlicePendingTaskCountPerNamespace(slice2, map[string]int{
+		testLimitedNamespaceID: 1,
+	})
+	s.Equal(threshold/2+1, s.monitor.GetNamespacePendingTaskCount(testLimitedNamespaceID))
+
+	s.monitor.RemoveSlice(slice1)
+	s.Equal(1, s.monitor.GetNamespacePendingTaskCount(testLimitedNamespaceID))
+	s.Equal(0, s.monitor.GetNamespacePendingTaskCount(testUnlimitedNamespaceID))
+}
+
 func (s *monitorSuite) TestReaderWatermarkStats() {
 	_, ok := s.monitor.GetReaderWatermark(DefaultReaderId)
 	s.False(ok)
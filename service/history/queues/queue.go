@@ -35,6 +35,9 @@ type (
 		Category() tasks.Category
 		NotifyNewTasks(tasks []tasks.Task)
 		FailoverNamespace(namespaceID string)
+		// CompactSlices forces the slices of each queue reader to be merged into one and returns the
+		// resulting total slice count.
+		CompactSlices() int
 		Start()
 		Stop()
 	}
@@ -104,6 +104,7 @@ type (
 
 		checkpointRetrier backoff.Retrier
 		checkpointTimer   *time.Timer
+		startupDelay      time.Duration
 
 		alertCh <-chan *Alert
 	}
@@ -118,6 +119,7 @@ type (
 		CheckpointInterval                  dynamicconfig.DurationPropertyFn
 		CheckpointIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
 		MaxReaderCount                      dynamicconfig.IntPropertyFn
+		StartupJitter                       dynamicconfig.DurationPropertyFn
 	}
 )
 
@@ -200,7 +202,7 @@ func newQueueBase(
 		exclusiveDeletionHighWatermark = tasks.MinKey(exclusiveDeletionHighWatermark, scopes[0].Range.InclusiveMin)
 	}
 
-	mitigator := newMitigator(readerGroup, monitor, logger, metricsHandler, options.MaxReaderCount, grouper)
+	mitigator := newMitigator(readerGroup, monitor, shard.GetNamespaceRegistry(), logger, metricsHandler, options.MaxReaderCount, grouper)
 
 	return &queueBase{
 		shard: shard,
@@ -230,6 +232,7 @@ func newQueueBase(
 		),
 		readerRateLimiter: readerRateLimiter,
 		readerGroup:       readerGroup,
+		startupDelay:      backoff.FullJitter(options.StartupJitter()),
 
 		// pollTimer and checkpointTimer are initialized on Start()
 		checkpointRetrier: backoff.NewRetrier(
@@ -243,7 +246,7 @@ func newQueueBase(
 
 func (p *queueBase) Start() {
 	p.rescheduler.Start()
-	p.readerGroup.Start()
+	p.startReaderGroup()
 
 	p.checkpointTimer = time.NewTimer(backoff.Jitter(
 		p.options.CheckpointInterval(),
@@ -251,6 +254,29 @@ func (p *queueBase) Start() {
 	))
 }
 
+// startReaderGroup starts loading tasks after the randomized startup delay,
+// so that queues started at the same time don't poll persistence all at once.
+func (p *queueBase) startReaderGroup() {
+	if p.startupDelay <= 0 {
+		p.readerGroup.Start()
+		return
+	}
+
+	p.shutdownWG.Add(1)
+	go func() {
+		defer p.shutdownWG.Done()
+
+		timer := time.NewTimer(p.startupDelay)
+		defer timer.Stop()
+
+		select {
+		case <-p.shutdownCh:
+		case <-timer.C:
+			p.readerGroup.Start()
+		}
+	}()
+}
+
 func (p *queueBase) Stop() {
 	p.monitor.Close()
 	p.readerGroup.Stop()
@@ -268,6 +294,18 @@ func (p *queueBase) FailoverNamespace(
 	p.rescheduler.Reschedule(namespaceID)
 }
 
+func (p *queueBase) CompactSlices() int {
+	for _, reader := range p.readerGroup.Readers() {
+		// shrink first so that empty slices don't widen the scope of the compacted slice
+		reader.ShrinkSlices()
+		if len(reader.Scopes()) <= 1 {
+			continue
+		}
+		reader.CompactSlices(func(_ Slice) bool { return true })
+	}
+	return p.monitor.GetTotalSliceCount()
+}
+
 func (p *queueBase) processNewRange() {
 	newMaxKey := p.shard.GetQueueExclusiveHighReadWatermark(p.category)
 
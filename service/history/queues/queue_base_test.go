@@ -90,6 +90,7 @@ var testQueueOptions = &Options{
 	CheckpointInterval:                  dynamicconfig.GetDurationPropertyFn(100 * time.Millisecond),
 	CheckpointIntervalJitterCoefficient: dynamicconfig.GetFloatPropertyFn(0.15),
 	MaxReaderCount:                      dynamicconfig.GetIntPropertyFn(5),
+	StartupJitter:                       dynamicconfig.GetDurationPropertyFn(0),
 }
 
 func TestQueueBaseSuite(t *testing.T) {
@@ -213,6 +214,31 @@ func (s *queueBaseSuite) TestNewProcessBase_WithPreviousState_RestoreSucceed() {
 	s.ProtoEqual(persistenceState, ToPersistenceQueueState(queueState))
 }
 
+func (s *queueBaseSuite) TestStartupDelay_Jittered() {
+	mockShard := shard.NewTestContext(
+		s.controller,
+		&persistencespb.ShardInfo{
+			ShardId: 0,
+			RangeId: 10,
+		},
+		s.config,
+	)
+
+	startupJitter := time.Hour
+	options := *s.options
+	options.StartupJitter = dynamicconfig.GetDurationPropertyFn(startupJitter)
+	s.options = &options
+
+	base1 := s.newQueueBase(mockShard, tasks.CategoryTransfer, nil)
+	base2 := s.newQueueBase(mockShard, tasks.CategoryTransfer, nil)
+
+	for _, base := range []*queueBase{base1, base2} {
+		s.GreaterOrEqual(base.startupDelay, time.Duration(0))
+		s.Less(base.startupDelay, startupJitter)
+	}
+	s.NotEqual(base1.startupDelay, base2.startupDelay)
+}
+
 func (s *queueBaseSuite) TestStartStop() {
 	mockShard := shard.NewTestContext(
 		s.controller,
@@ -291,6 +317,64 @@ func (s *queueBaseSuite) TestProcessNewRange() {
 	s.True(base.nonReadableScope.Range.Equals(NewRange(scopes[0].Range.ExclusiveMax, tasks.MaximumKey)))
 }
 
+func (s *queueBaseSuite) TestCompactSlices() {
+	newUniversalScope := func(minTaskID, maxTaskID int64) *persistencespb.QueueSliceScope {
+		return &persistencespb.QueueSliceScope{
+			Range: &persistencespb.QueueSliceRange{
+				InclusiveMin: &persistencespb.TaskKey{FireTime: timestamppb.New(tasks.DefaultFireTime), TaskId: minTaskID},
+				ExclusiveMax: &persistencespb.TaskKey{FireTime: timestamppb.New(tasks.DefaultFireTime), TaskId: maxTaskID},
+			},
+			Predicate: &persistencespb.Predicate{
+				PredicateType: enumsspb.PREDICATE_TYPE_UNIVERSAL,
+				Attributes:    &persistencespb.Predicate_UniversalPredicateAttributes{},
+			},
+		}
+	}
+	persistenceState := &persistencespb.QueueState{
+		ReaderStates: map[int64]*persistencespb.QueueReaderState{
+			DefaultReaderId: {
+				Scopes: []*persistencespb.QueueSliceScope{
+					newUniversalScope(1000, 2000),
+					newUniversalScope(2000, 3000),
+					newUniversalScope(3000, 4000),
+				},
+			},
+			DefaultReaderId + 1: {
+				Scopes: []*persistencespb.QueueSliceScope{
+					newUniversalScope(2000, 3000),
+				},
+			},
+		},
+		ExclusiveReaderHighWatermark: &persistencespb.TaskKey{FireTime: timestamppb.New(tasks.DefaultFireTime), TaskId: 4000},
+	}
+
+	mockShard := shard.NewTestContext(
+		s.controller,
+		&persistencespb.ShardInfo{
+			ShardId: 0,
+			RangeId: 10,
+			QueueStates: map[int32]*persistencespb.QueueState{
+				int32(tasks.CategoryIDTransfer): persistenceState,
+			},
+		},
+		s.config,
+	)
+	base := s.newQueueBase(mockShard, tasks.CategoryTransfer, nil)
+	s.Equal(4, base.monitor.GetTotalSliceCount())
+
+	s.Equal(2, base.CompactSlices())
+
+	defaultReader, ok := base.readerGroup.ReaderByID(DefaultReaderId)
+	s.True(ok)
+	scopes := defaultReader.Scopes()
+	s.Len(scopes, 1)
+	s.True(scopes[0].Range.Equals(NewRange(
+		tasks.NewKey(tasks.DefaultFireTime, 1000),
+		tasks.NewKey(tasks.DefaultFireTime, 4000),
+	)))
+	s.True(scopes[0].Predicate.Equals(predicates.Universal[tasks.Task]()))
+}
+
 func (s *queueBaseSuite) TestCheckPoint_WithPendingTasks_PerformRangeCompletion() {
 	scopeMinKey := tasks.MaximumKey
 	readerScopes := map[int64][]Scope{}
@@ -580,6 +664,9 @@ func (s *queueBaseSuite) newQueueBase(
 		func() string {
 			return ""
 		},
+		func(enumsspb.TaskType) string {
+			return ""
+		},
 	)
 	return newQueueBase(
 		mockShard,
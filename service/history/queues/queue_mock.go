@@ -72,6 +72,20 @@ func (mr *MockQueueMockRecorder) Category() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Category", reflect.TypeOf((*MockQueue)(nil).Category))
 }
 
+// CompactSlices mocks base method.
+func (m *MockQueue) CompactSlices() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompactSlices")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// CompactSlices indicates an expected call of CompactSlices.
+func (mr *MockQueueMockRecorder) CompactSlices() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactSlices", reflect.TypeOf((*MockQueue)(nil).CompactSlices))
+}
+
 // FailoverNamespace mocks base method.
 func (m *MockQueue) FailoverNamespace(namespaceID string) {
 	m.ctrl.T.Helper()
@@ -37,6 +37,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"golang.org/x/exp/slices"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
@@ -153,6 +154,9 @@ func (s *scheduledQueueSuite) SetupTest() {
 		func() string {
 			return ""
 		},
+		func(enumsspb.TaskType) string {
+			return ""
+		},
 	)
 	s.scheduledQueue = NewScheduledQueue(
 		s.mockShard,
@@ -266,6 +266,23 @@ func (s *readerSuite) TestShrinkSlices() {
 	}
 }
 
+func (s *readerSuite) TestCompactSlices() {
+	numScopes := 10
+	scopes := NewRandomScopes(numScopes)
+
+	reader := s.newTestReader(scopes, nil, NoopReaderCompletionFn)
+	reader.CompactSlices(func(_ Slice) bool { return true })
+
+	actualScopes := reader.Scopes()
+	s.Len(actualScopes, 1)
+	s.True(scopes[0].Range.InclusiveMin.CompareTo(actualScopes[0].Range.InclusiveMin) == 0)
+	s.True(scopes[numScopes-1].Range.ExclusiveMax.CompareTo(actualScopes[0].Range.ExclusiveMax) == 0)
+	s.Equal(1, s.monitor.GetTotalSliceCount())
+
+	reader.CompactSlices(func(_ Slice) bool { return true })
+	s.Len(reader.Scopes(), 1)
+}
+
 func (s *readerSuite) TestNotify() {
 	reader := s.newTestReader([]Scope{}, nil, NoopReaderCompletionFn)
 
@@ -57,7 +57,8 @@ type (
 	}
 
 	TaskStats struct {
-		PendingPerKey map[any]int
+		PendingPerKey       map[any]int
+		PendingPerNamespace map[string]int
 	}
 
 	SliceImpl struct {
@@ -317,7 +318,7 @@ func (s *SliceImpl) ShrinkScope() int {
 
 	// shrinkRange shrinks the executableTracker, which may remove tracked pending executables. Set the
 	// pending task count to reflect that.
-	s.monitor.SetSlicePendingTaskCount(s, len(s.executableTracker.pendingExecutables))
+	s.updatePendingTaskCount()
 
 	return tasksCompleted
 }
@@ -369,7 +370,7 @@ func (s *SliceImpl) SelectTasks(readerID int64, batchSize int) ([]Executable, er
 	}
 
 	defer func() {
-		s.monitor.SetSlicePendingTaskCount(s, len(s.executableTracker.pendingExecutables))
+		s.updatePendingTaskCount()
 	}()
 
 	executables := make([]Executable, 0, batchSize)
@@ -417,7 +418,8 @@ func (s *SliceImpl) TaskStats() TaskStats {
 	s.stateSanityCheck()
 
 	return TaskStats{
-		PendingPerKey: s.executableTracker.pendingPerKey,
+		PendingPerKey:       s.executableTracker.pendingPerKey,
+		PendingPerNamespace: s.executableTracker.pendingPerNamespace,
 	}
 }
 
@@ -431,7 +433,12 @@ func (s *SliceImpl) Clear() {
 	}
 	s.executableTracker.clear()
 
+	s.updatePendingTaskCount()
+}
+
+func (s *SliceImpl) updatePendingTaskCount() {
 	s.monitor.SetSlicePendingTaskCount(s, len(s.executableTracker.pendingExecutables))
+	s.monitor.SetSlicePendingTaskCountPerNamespace(s, s.executableTracker.pendingPerNamespace)
 }
 
 func (s *SliceImpl) destroy() {
@@ -460,7 +467,7 @@ func (s *SliceImpl) newSlice(
 		executableTracker:    tracker,
 		monitor:              s.monitor,
 	}
-	slice.monitor.SetSlicePendingTaskCount(slice, len(slice.executableTracker.pendingExecutables))
+	slice.updatePendingTaskCount()
 
 	return slice
 }
@@ -114,3 +114,8 @@ func (q SpeculativeWorkflowTaskTimeoutQueue) NotifyNewTasks(ts []tasks.Task) {
 
 func (q SpeculativeWorkflowTaskTimeoutQueue) FailoverNamespace(_ string) {
 }
+
+// CompactSlices is a no-op as in-memory tasks are not tracked in slices.
+func (q SpeculativeWorkflowTaskTimeoutQueue) CompactSlices() int {
+	return 0
+}
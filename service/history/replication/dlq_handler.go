@@ -153,6 +153,7 @@ func newDLQHandler(
 			shard.GetPayloadSerializer(),
 			shard.GetConfig().StandbyTaskReReplicationContextTimeout,
 			shard.GetLogger(),
+			shard.GetMetricsHandler(),
 			nil,
 		),
 		taskExecutors:        taskExecutors,
@@ -236,17 +237,40 @@ func (r *dlqHandlerImpl) MergeMessages(
 		return nil, err
 	}
 
+	lastMergedMessageID := ackLevel
 	for _, task := range replicationTasks {
 		if err := taskExecutor.Execute(
 			ctx,
 			task,
 			true,
 		); err != nil {
+			// Remove the tasks that were already merged, so that a retry resumes after them
+			// instead of applying them again.
+			if lastMergedMessageID > ackLevel {
+				if completeErr := r.completeMergedMessages(ctx, sourceCluster, ackLevel, lastMergedMessageID); completeErr != nil {
+					r.logger.Error("Failed to remove merged history replication messages", tag.Error(completeErr))
+				}
+			}
 			return nil, err
 		}
+		lastMergedMessageID = task.GetSourceTaskId()
+	}
+
+	if err := r.completeMergedMessages(ctx, sourceCluster, ackLevel, lastMessageID); err != nil {
+		return nil, err
 	}
+	return token, nil
+}
 
-	err = r.shard.GetExecutionManager().RangeDeleteReplicationTaskFromDLQ(
+// completeMergedMessages deletes the DLQ messages in (ackLevel, lastMessageID] and moves the DLQ ack level to
+// lastMessageID.
+func (r *dlqHandlerImpl) completeMergedMessages(
+	ctx context.Context,
+	sourceCluster string,
+	ackLevel int64,
+	lastMessageID int64,
+) error {
+	err := r.shard.GetExecutionManager().RangeDeleteReplicationTaskFromDLQ(
 		ctx,
 		&persistence.RangeDeleteReplicationTaskFromDLQRequest{
 			RangeCompleteHistoryTasksRequest: persistence.RangeCompleteHistoryTasksRequest{
@@ -259,7 +283,7 @@ func (r *dlqHandlerImpl) MergeMessages(
 		},
 	)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	if err = r.shard.UpdateReplicatorDLQAckLevel(
@@ -269,7 +293,7 @@ func (r *dlqHandlerImpl) MergeMessages(
 		r.logger.Error("Failed to purge history replication message", tag.Error(err))
 		// The update ack level should not block the call. Ignore the error.
 	}
-	return token, nil
+	return nil
 }
 
 func (r *dlqHandlerImpl) readMessagesWithAckLevel(
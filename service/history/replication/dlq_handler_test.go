@@ -26,6 +26,7 @@ package replication
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -313,3 +314,78 @@ func (s *dlqHandlerSuite) TestMergeMessages() {
 	s.NoError(err)
 	s.Equal(pageToken, token)
 }
+
+func (s *dlqHandlerSuite) TestMergeMessages_PartialFailure() {
+	ctx := context.Background()
+
+	namespaceID := uuid.New()
+	workflowID := uuid.New()
+	runID := uuid.New()
+	version := int64(2333)
+	lastMessageID := int64(1394)
+	pageSize := 2
+
+	newRemoteTask := func(taskID int64) *replicationspb.ReplicationTask {
+		return &replicationspb.ReplicationTask{
+			TaskType:     enumsspb.REPLICATION_TASK_TYPE_HISTORY_TASK,
+			SourceTaskId: taskID,
+			Attributes: &replicationspb.ReplicationTask_HistoryTaskAttributes{
+				HistoryTaskAttributes: &replicationspb.HistoryTaskAttributes{
+					NamespaceId: namespaceID,
+					WorkflowId:  workflowID,
+					RunId:       runID,
+					VersionHistoryItems: []*historyspb.VersionHistoryItem{{
+						Version: version,
+						EventId: 10,
+					}},
+					Events: &commonpb.DataBlob{},
+				},
+			},
+		}
+	}
+	mergedTask := newRemoteTask(100)
+	failedTask := newRemoteTask(101)
+
+	s.executionManager.EXPECT().GetReplicationTasksFromDLQ(gomock.Any(), gomock.Any()).Return(&persistence.GetHistoryTasksResponse{
+		Tasks: []tasks.Task{
+			&tasks.HistoryReplicationTask{
+				WorkflowKey:  definition.NewWorkflowKey(namespaceID, workflowID, runID),
+				Version:      version,
+				FirstEventID: 1,
+				NextEventID:  11,
+				TaskID:       mergedTask.GetSourceTaskId(),
+			},
+			&tasks.HistoryReplicationTask{
+				WorkflowKey:  definition.NewWorkflowKey(namespaceID, workflowID, runID),
+				Version:      version,
+				FirstEventID: 1,
+				NextEventID:  11,
+				TaskID:       failedTask.GetSourceTaskId(),
+			},
+		},
+	}, nil)
+
+	s.mockClientBean.EXPECT().GetRemoteAdminClient(s.sourceCluster).Return(s.adminClient, nil).AnyTimes()
+	s.adminClient.EXPECT().GetDLQReplicationMessages(ctx, gomock.Any()).
+		Return(&adminservice.GetDLQReplicationMessagesResponse{
+			ReplicationTasks: []*replicationspb.ReplicationTask{mergedTask, failedTask},
+		}, nil)
+	executeErr := errors.New("some random error")
+	s.taskExecutor.EXPECT().Execute(gomock.Any(), mergedTask, true).Return(nil)
+	s.taskExecutor.EXPECT().Execute(gomock.Any(), failedTask, true).Return(executeErr)
+	// Only the merged prefix is removed, so a retry starts from the failed task.
+	s.executionManager.EXPECT().RangeDeleteReplicationTaskFromDLQ(gomock.Any(), &persistence.RangeDeleteReplicationTaskFromDLQRequest{
+		RangeCompleteHistoryTasksRequest: persistence.RangeCompleteHistoryTasksRequest{
+			ShardID:             s.mockShard.GetShardID(),
+			TaskCategory:        tasks.CategoryReplication,
+			InclusiveMinTaskKey: tasks.NewImmediateKey(persistence.EmptyQueueMessageID + 1),
+			ExclusiveMaxTaskKey: tasks.NewImmediateKey(mergedTask.GetSourceTaskId() + 1),
+		},
+		SourceClusterName: s.sourceCluster,
+	}).Return(nil)
+	s.shardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil)
+
+	_, err := s.replicationMessageHandler.MergeMessages(ctx, s.sourceCluster, lastMessageID, pageSize, nil)
+	s.ErrorIs(err, executeErr)
+	s.Equal(mergedTask.GetSourceTaskId(), s.mockShard.GetReplicatorDLQAckLevel(s.sourceCluster))
+}
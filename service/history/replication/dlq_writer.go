@@ -51,6 +51,8 @@ type (
 		ShardID             int32
 		SourceCluster       string
 		ReplicationTaskInfo *persistencespb.ReplicationTaskInfo
+		// FailureReason is the error that made the task fail. Only QueueV2 stores it.
+		FailureReason string
 	}
 	// ExecutionManager is a trimmed version of [go.temporal.io/server/common/persistence.ExecutionManager] that only
 	// provides the methods we need.
@@ -148,7 +150,7 @@ func (d *DLQWriterAdapter) WriteTaskToDLQ(
 	if err != nil {
 		return err
 	}
-	return d.dlqWriter.WriteTaskToDLQ(ctx, request.SourceCluster, d.currentClusterName, task)
+	return d.dlqWriter.WriteTaskToDLQ(ctx, request.SourceCluster, d.currentClusterName, task, request.FailureReason)
 }
 
 // This is a helper function to make it easier to change the DLQWriteRequest format in the future.
@@ -158,10 +160,12 @@ func writeTaskToDLQ(
 	shardContext shard.Context,
 	sourceClusterName string,
 	replicationTaskInfo *persistencespb.ReplicationTaskInfo,
+	failureReason string,
 ) error {
 	return dlqWriter.WriteTaskToDLQ(ctx, DLQWriteRequest{
 		ShardID:             shardContext.GetShardID(),
 		SourceCluster:       sourceClusterName,
 		ReplicationTaskInfo: replicationTaskInfo,
+		FailureReason:       failureReason,
 	})
 }
@@ -122,6 +122,7 @@ func TestNewDLQWriterAdapter(t *testing.T) {
 				ShardID:             13,
 				SourceCluster:       "test-source-cluster",
 				ReplicationTaskInfo: replicationTaskInfo,
+				FailureReason:       "test-failure-reason",
 			})
 			if tc.expectErr {
 				require.Error(t, err)
@@ -137,6 +138,7 @@ func TestNewDLQWriterAdapter(t *testing.T) {
 				assert.Equal(t, 21, int(request.Task.GetTaskID()))
 				assert.Equal(t, "test-source-cluster", request.SourceCluster)
 				assert.Equal(t, "test-current-cluster", request.TargetCluster)
+				assert.Equal(t, "test-failure-reason", request.FailureReason)
 			}
 		})
 	}
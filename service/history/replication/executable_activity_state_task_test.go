@@ -149,6 +149,7 @@ func (s *executableActivityStateTaskSuite) SetupTest() {
 	)
 	s.task.ExecutableTask = s.executableTask
 	s.executableTask.EXPECT().TaskID().Return(s.taskID).AnyTimes()
+	s.executableTask.EXPECT().FailureReason().Return("").AnyTimes()
 	s.executableTask.EXPECT().SourceClusterName().Return(s.sourceClusterName).AnyTimes()
 }
 
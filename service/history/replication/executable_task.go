@@ -83,6 +83,8 @@ type (
 		State() ctasks.State
 		TerminalState() bool
 		Attempt() int
+		// FailureReason returns the error the task was nacked with, or an empty string if it wasn't nacked.
+		FailureReason() string
 		Resend(
 			ctx context.Context,
 			remoteCluster string,
@@ -110,9 +112,10 @@ type (
 		taskPriority      enumsspb.TaskPriority
 
 		// mutable data
-		taskState int32
-		attempt   int32
-		namespace atomic.Value
+		taskState     int32
+		attempt       int32
+		namespace     atomic.Value
+		failureReason atomic.Value // string
 	}
 )
 
@@ -167,6 +170,10 @@ func (e *ExecutableTaskImpl) Nack(err error) {
 	if atomic.LoadInt32(&e.taskState) != taskStatePending {
 		return
 	}
+	if err != nil {
+		// set before the state changes, so that the failure reason is there once the task is seen as nacked
+		e.failureReason.Store(err.Error())
+	}
 	if !atomic.CompareAndSwapInt32(&e.taskState, taskStatePending, taskStateNacked) {
 		e.Nack(err) // retry nack
 	}
@@ -259,6 +266,11 @@ func (e *ExecutableTaskImpl) Attempt() int {
 	return int(atomic.LoadInt32(&e.attempt))
 }
 
+func (e *ExecutableTaskImpl) FailureReason() string {
+	failureReason, _ := e.failureReason.Load().(string)
+	return failureReason
+}
+
 func (e *ExecutableTaskImpl) emitFinishMetrics(
 	now time.Time,
 ) {
@@ -456,6 +468,28 @@ FilterLoop:
 	return namespaceEntry.Name().String(), shouldProcessTask, nil
 }
 
+// newApplyTaskContext returns a task context bounded by the namespace's replication task apply timeout.
+// The returned cancel func records a metric if applying took more than half of the timeout.
+func newApplyTaskContext(
+	processToolBox ProcessToolBox,
+	operation string,
+	namespaceName string,
+) (context.Context, context.CancelFunc) {
+	timeout := processToolBox.Config.ReplicationTaskApplyTimeout(namespaceName)
+	startTime := time.Now()
+	ctx, cancel := newTaskContext(namespaceName, timeout)
+	return ctx, func() {
+		cancel()
+		if time.Since(startTime) > timeout/2 {
+			metrics.ReplicationTaskApplyNearTimeout.With(processToolBox.MetricsHandler).Record(
+				1,
+				metrics.OperationTag(operation),
+				metrics.NamespaceTag(namespaceName),
+			)
+		}
+	}
+}
+
 func newTaskContext(
 	namespaceName string,
 	timeout time.Duration,
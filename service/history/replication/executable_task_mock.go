@@ -127,6 +127,20 @@ func (mr *MockExecutableTaskMockRecorder) DeleteWorkflow(ctx, workflowKey interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflow", reflect.TypeOf((*MockExecutableTask)(nil).DeleteWorkflow), ctx, workflowKey)
 }
 
+// FailureReason mocks base method.
+func (m *MockExecutableTask) FailureReason() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailureReason")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// FailureReason indicates an expected call of FailureReason.
+func (mr *MockExecutableTaskMockRecorder) FailureReason() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailureReason", reflect.TypeOf((*MockExecutableTask)(nil).FailureReason))
+}
+
 // GetNamespaceInfo mocks base method.
 func (m *MockExecutableTask) GetNamespaceInfo(ctx context.Context, namespaceID string) (string, bool, error) {
 	m.ctrl.T.Helper()
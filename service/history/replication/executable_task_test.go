@@ -50,6 +50,7 @@ import (
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
@@ -191,6 +192,17 @@ func (s *executableTaskSuite) TestNackStateAttempt() {
 	s.True(s.task.TerminalState())
 }
 
+func (s *executableTaskSuite) TestNackFailureReason() {
+	s.Empty(s.task.FailureReason())
+
+	s.task.Nack(serviceerror.NewInternal("some random error"))
+	s.Equal("some random error", s.task.FailureReason())
+
+	// the task is already nacked, so the first failure reason is kept
+	s.task.Nack(serviceerror.NewInternal("another error"))
+	s.Equal("some random error", s.task.FailureReason())
+}
+
 func (s *executableTaskSuite) TestAbortStateAttempt() {
 	s.Equal(ctasks.TaskStatePending, s.task.State())
 	s.False(s.task.TerminalState())
@@ -632,3 +644,49 @@ func (s *executableTaskSuite) TestGetNamespaceInfo_NotFoundOnCurrentCluster_Sync
 	s.Nil(err)
 	s.False(toProcess)
 }
+
+func (s *executableTaskSuite) TestNewApplyTaskContext_NamespaceOverride() {
+	overrideNamespace := "override-namespace"
+	dc := dynamicconfig.NewCollection(dynamicconfig.StaticClient{
+		dynamicconfig.ReplicationTaskApplyTimeout.Key(): []dynamicconfig.ConstrainedValue{
+			{Constraints: dynamicconfig.Constraints{Namespace: overrideNamespace}, Value: time.Hour},
+			{Value: time.Minute},
+		},
+	}, s.logger)
+	toolBox := s.task.ProcessToolBox
+	toolBox.Config = configs.NewConfig(dc, 1)
+
+	ctx, cancel := newApplyTaskContext(toolBox, metrics.HistoryReplicationTaskScope, overrideNamespace)
+	deadline, ok := ctx.Deadline()
+	cancel()
+	s.True(ok)
+	s.WithinDuration(time.Now().Add(time.Hour), deadline, time.Minute)
+
+	ctx, cancel = newApplyTaskContext(toolBox, metrics.HistoryReplicationTaskScope, "other-namespace")
+	deadline, ok = ctx.Deadline()
+	cancel()
+	s.True(ok)
+	s.WithinDuration(time.Now().Add(time.Minute), deadline, 30*time.Second)
+}
+
+func (s *executableTaskSuite) TestNewApplyTaskContext_NearTimeoutMetric() {
+	capture := metricstest.NewCaptureHandler()
+	recording := capture.StartCapture()
+	defer capture.StopCapture(recording)
+
+	toolBox := s.task.ProcessToolBox
+	toolBox.MetricsHandler = capture
+	toolBox.Config = tests.NewDynamicConfig()
+	toolBox.Config.ReplicationTaskApplyTimeout = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(20 * time.Millisecond)
+
+	_, cancel := newApplyTaskContext(toolBox, metrics.HistoryReplicationTaskScope, "some-namespace")
+	cancel()
+	s.Empty(recording.Snapshot()[metrics.ReplicationTaskApplyNearTimeout.Name()])
+
+	_, cancel = newApplyTaskContext(toolBox, metrics.HistoryReplicationTaskScope, "some-namespace")
+	time.Sleep(15 * time.Millisecond)
+	cancel()
+	recordings := recording.Snapshot()[metrics.ReplicationTaskApplyNearTimeout.Name()]
+	s.Len(recordings, 1)
+	s.Equal("some-namespace", recordings[0].Tags[metrics.NamespaceTag("").Key()])
+}
@@ -260,6 +260,7 @@ func ndcHistoryResenderProvider(
 	clientBean client.Bean,
 	serializer serialization.Serializer,
 	logger log.Logger,
+	metricsHandler metrics.Handler,
 	shardController shard.Controller,
 	historyReplicationEventHandler eventhandler.HistoryEventsHandler,
 ) xdc.NDCHistoryResender {
@@ -320,6 +321,7 @@ func ndcHistoryResenderProvider(
 		serializer,
 		config.StandbyTaskReReplicationContextTimeout,
 		logger,
+		metricsHandler,
 		config,
 	)
 }
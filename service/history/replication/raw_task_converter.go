@@ -96,7 +96,7 @@ func (c *SourceTaskConverterImpl) Convert(
 	if namespaceEntry != nil {
 		nsName = namespaceEntry.Name().String()
 	}
-	ctx, cancel = newTaskContext(nsName, c.config.ReplicationTaskApplyTimeout())
+	ctx, cancel = newTaskContext(nsName, c.config.ReplicationTaskApplyTimeout(nsName))
 	defer cancel()
 	replicationTask, err := c.historyEngine.ConvertReplicationTask(ctx, task)
 	if err != nil {
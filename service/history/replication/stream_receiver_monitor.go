@@ -26,13 +26,22 @@ package replication
 
 import (
 	"fmt"
+	"math"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/channel"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
 )
 
 const (
@@ -42,6 +51,11 @@ const (
 type (
 	StreamReceiverMonitor interface {
 		RegisterInboundStream(streamSender StreamSender)
+		ForceResyncNamespace(
+			shardContext shard.Context,
+			targetClusterName string,
+			namespaceID namespace.ID,
+		) (inclusiveBeginTaskID int64, exclusiveEndTaskID int64, retErr error)
 		Start()
 		Stop()
 	}
@@ -131,6 +145,105 @@ func (m *StreamReceiverMonitorImpl) RegisterInboundStream(
 	m.inboundStreams[streamKey] = streamSender
 }
 
+// ForceResyncNamespace rewinds the replication reader states of the given shard towards the target cluster to the
+// oldest replication task still retained by the shard, then resets the corresponding inbound streams. The namespace
+// filter is persisted together with the rewound reader states, so once the target cluster reconnects, the streams
+// only resend the tasks of the namespace until they reach the position each reader had acknowledged before the call,
+// even if the shard moved to another host in between. Tasks above that position were never acknowledged, so they are
+// sent for all namespaces.
+func (m *StreamReceiverMonitorImpl) ForceResyncNamespace(
+	shardContext shard.Context,
+	targetClusterName string,
+	namespaceID namespace.ID,
+) (int64, int64, error) {
+	if !m.enableStreaming {
+		return 0, 0, serviceerror.NewFailedPrecondition("replication streaming is not enabled")
+	}
+	currentClusterName := m.ClusterMetadata.GetCurrentClusterName()
+	allClusterInfo := m.ClusterMetadata.GetAllClusterInfo()
+	targetClusterInfo, ok := allClusterInfo[targetClusterName]
+	if !ok || !targetClusterInfo.Enabled || targetClusterName == currentClusterName {
+		return 0, 0, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid target cluster: %v", targetClusterName))
+	}
+
+	inclusiveBeginTaskID := shardContext.GetQueueExclusiveHighReadWatermark(tasks.CategoryReplication).TaskID
+	queueState, ok := shardContext.GetQueueState(tasks.CategoryReplication)
+	if ok {
+		// tasks below the lowest reader state may already be deleted
+		for _, readerState := range queueState.ReaderStates {
+			if readerMinTaskID, ok := replicationReaderInclusiveMinTaskID(readerState); ok {
+				inclusiveBeginTaskID = min(inclusiveBeginTaskID, readerMinTaskID)
+			}
+		}
+	}
+	exclusiveEndTaskID := inclusiveBeginTaskID
+
+	m.Lock()
+	defer m.Unlock()
+
+	serverClusterID := int32(m.ClusterMetadata.GetClusterID())
+	clientClusterID := int32(targetClusterInfo.InitialFailoverVersion)
+	serverShardID := shardContext.GetShardID()
+	for _, clientShardID := range common.MapShardID(
+		allClusterInfo[currentClusterName].ShardCount,
+		targetClusterInfo.ShardCount,
+		serverShardID,
+	) {
+		streamKey := ClusterShardKeyPair{
+			Client: NewClusterShardKey(clientClusterID, clientShardID),
+			Server: NewClusterShardKey(serverClusterID, serverShardID),
+		}
+		// stop the stream first, so it does not override the rewound reader state with the target cluster ack
+		if stream, ok := m.inboundStreams[streamKey]; ok {
+			stream.Stop()
+			delete(m.inboundStreams, streamKey)
+		}
+
+		readerID := shard.ReplicationReaderIDFromClusterShardID(int64(clientClusterID), clientShardID)
+		var readerState *persistencespb.QueueReaderState
+		if queueState != nil {
+			readerState = queueState.ReaderStates[readerID]
+		}
+		// Without a reader state nothing is known to be acknowledged, so every namespace is resent.
+		resync := &persistencespb.ReplicationNamespaceResync{
+			NamespaceIds:       []string{namespaceID.String()},
+			ExclusiveEndTaskId: inclusiveBeginTaskID,
+		}
+		if readerMinTaskID, ok := replicationReaderInclusiveMinTaskID(readerState); ok {
+			// only the tasks below the read position of the reader were acknowledged by the target cluster
+			resync.ExclusiveEndTaskId = readerMinTaskID
+		}
+		if pendingResync := readerState.GetNamespaceResync(); pendingResync != nil &&
+			resync.ExclusiveEndTaskId < pendingResync.GetExclusiveEndTaskId() {
+			// the target cluster has not caught up with the previous resync yet, the tasks below its end were
+			// acknowledged before it, so keep resending only its namespaces up to there
+			resync.ExclusiveEndTaskId = pendingResync.GetExclusiveEndTaskId()
+			for _, id := range pendingResync.GetNamespaceIds() {
+				if !slices.Contains(resync.NamespaceIds, id) {
+					resync.NamespaceIds = append(resync.NamespaceIds, id)
+				}
+			}
+		}
+		exclusiveEndTaskID = max(exclusiveEndTaskID, resync.ExclusiveEndTaskId)
+
+		if err := shardContext.UpdateReplicationQueueReaderState(
+			readerID,
+			rewindReplicationReaderState(readerState, inclusiveBeginTaskID, resync),
+		); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	m.Logger.Info("Forced namespace resync of replication streams.",
+		tag.WorkflowNamespaceID(namespaceID.String()),
+		tag.TargetCluster(targetClusterName),
+		tag.ShardID(serverShardID),
+		tag.NewInt64("inclusive-begin-task-id", inclusiveBeginTaskID),
+		tag.NewInt64("exclusive-end-task-id", exclusiveEndTaskID),
+	)
+	return inclusiveBeginTaskID, exclusiveEndTaskID, nil
+}
+
 func (m *StreamReceiverMonitorImpl) eventLoop() {
 	defer m.Stop()
 	ticker := time.NewTicker(streamReceiverMonitorInterval)
@@ -296,3 +409,51 @@ func (m *StreamReceiverMonitorImpl) doReconcileOutboundStreams(
 		}
 	}
 }
+
+// replicationReaderInclusiveMinTaskID returns the lowest task ID the reader has not acknowledged yet, it returns false
+// if the reader state has no scope.
+func replicationReaderInclusiveMinTaskID(
+	readerState *persistencespb.QueueReaderState,
+) (int64, bool) {
+	scopes := readerState.GetScopes()
+	if len(scopes) == 0 {
+		return 0, false
+	}
+	return scopes[0].GetRange().GetInclusiveMin().GetTaskId(), true
+}
+
+func rewindReplicationReaderState(
+	readerState *persistencespb.QueueReaderState,
+	inclusiveLowWatermark int64,
+	resync *persistencespb.ReplicationNamespaceResync,
+) *persistencespb.QueueReaderState {
+	inclusiveMin := shard.ConvertToPersistenceTaskKey(tasks.NewImmediateKey(inclusiveLowWatermark))
+	if len(readerState.GetScopes()) == 0 {
+		return &persistencespb.QueueReaderState{
+			Scopes: []*persistencespb.QueueSliceScope{
+				{
+					Range: &persistencespb.QueueSliceRange{
+						InclusiveMin: inclusiveMin,
+						ExclusiveMax: shard.ConvertToPersistenceTaskKey(
+							tasks.NewImmediateKey(math.MaxInt64),
+						),
+					},
+					Predicate: &persistencespb.Predicate{
+						PredicateType: enumsspb.PREDICATE_TYPE_UNIVERSAL,
+						Attributes:    &persistencespb.Predicate_UniversalPredicateAttributes{},
+					},
+				},
+			},
+			NamespaceResync: resync,
+		}
+	}
+	// keep the scope layout of the reader state, it differs between single and tiered stack
+	readerState = common.CloneProto(readerState)
+	for _, scope := range readerState.Scopes {
+		if scope.Range.InclusiveMin.TaskId > inclusiveLowWatermark {
+			scope.Range.InclusiveMin = common.CloneProto(inclusiveMin)
+		}
+	}
+	readerState.NamespaceResync = resync
+	return readerState
+}
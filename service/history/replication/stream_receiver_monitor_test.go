@@ -30,20 +30,25 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/adminservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	repicationpb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/client"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
 )
 
 type (
@@ -378,6 +383,145 @@ func (s *streamReceiverMonitorSuite) TestDoReconcileInboundStreams_Reactivate()
 	s.Equal(streamSenderValid, stream)
 }
 
+func (s *streamReceiverMonitorSuite) TestForceResyncNamespace() {
+	s.clusterMetadata.EXPECT().GetClusterID().Return(cluster.TestCurrentClusterInitialFailoverVersion).AnyTimes()
+	s.clusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.clusterMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		cluster.TestCurrentClusterName: {
+			Enabled:                true,
+			InitialFailoverVersion: cluster.TestCurrentClusterInitialFailoverVersion,
+			ShardCount:             1,
+		},
+		cluster.TestAlternativeClusterName: {
+			Enabled:                true,
+			InitialFailoverVersion: cluster.TestAlternativeClusterInitialFailoverVersion,
+			ShardCount:             1,
+		},
+	}).AnyTimes()
+
+	shardID := int32(1)
+	namespaceID := namespace.ID(uuid.New())
+	pendingNamespaceID := namespace.ID(uuid.New())
+	readerID := shard.ReplicationReaderIDFromClusterShardID(cluster.TestAlternativeClusterInitialFailoverVersion, shardID)
+	otherReaderID := shard.ReplicationReaderIDFromClusterShardID(cluster.TestAlternativeClusterInitialFailoverVersion+1, shardID)
+	shardContext := shard.NewMockContext(s.controller)
+	shardContext.EXPECT().GetShardID().Return(shardID).AnyTimes()
+	shardContext.EXPECT().GetQueueExclusiveHighReadWatermark(tasks.CategoryReplication).Return(tasks.NewImmediateKey(100))
+	shardContext.EXPECT().GetQueueState(tasks.CategoryReplication).Return(&persistencespb.QueueState{
+		ReaderStates: map[int64]*persistencespb.QueueReaderState{
+			// the target cluster has not caught up with a previous resync yet
+			readerID: rewindReplicationReaderState(nil, 80, &persistencespb.ReplicationNamespaceResync{
+				NamespaceIds:       []string{pendingNamespaceID.String()},
+				ExclusiveEndTaskId: 90,
+			}),
+			otherReaderID: rewindReplicationReaderState(nil, 50, nil),
+		},
+	}, true)
+	shardContext.EXPECT().UpdateReplicationQueueReaderState(readerID, gomock.Any()).DoAndReturn(
+		func(_ int64, readerState *persistencespb.QueueReaderState) error {
+			s.Len(readerState.Scopes, 1)
+			s.Equal(int64(50), readerState.Scopes[0].Range.InclusiveMin.TaskId)
+			s.ElementsMatch(
+				[]string{namespaceID.String(), pendingNamespaceID.String()},
+				readerState.GetNamespaceResync().GetNamespaceIds(),
+			)
+			// tasks below the end of the previous resync were acknowledged before it
+			s.Equal(int64(90), readerState.GetNamespaceResync().GetExclusiveEndTaskId())
+			return nil
+		},
+	)
+
+	streamKey := ClusterShardKeyPair{
+		Client: NewClusterShardKey(int32(cluster.TestAlternativeClusterInitialFailoverVersion), shardID),
+		Server: NewClusterShardKey(int32(cluster.TestCurrentClusterInitialFailoverVersion), shardID),
+	}
+	staleSender := NewMockStreamSender(s.controller)
+	staleSender.EXPECT().Key().Return(streamKey).AnyTimes()
+	staleSender.EXPECT().Stop()
+	s.streamReceiverMonitor.RegisterInboundStream(staleSender)
+
+	inclusiveBeginTaskID, exclusiveEndTaskID, err := s.streamReceiverMonitor.ForceResyncNamespace(
+		shardContext,
+		cluster.TestAlternativeClusterName,
+		namespaceID,
+	)
+	s.NoError(err)
+	s.Equal(int64(50), inclusiveBeginTaskID)
+	s.Equal(int64(90), exclusiveEndTaskID)
+
+	s.streamReceiverMonitor.Lock()
+	s.Empty(s.streamReceiverMonitor.inboundStreams)
+	s.streamReceiverMonitor.Unlock()
+}
+
+func (s *streamReceiverMonitorSuite) TestForceResyncNamespace_OtherNamespacesResentAboveAckedPosition() {
+	s.clusterMetadata.EXPECT().GetClusterID().Return(cluster.TestCurrentClusterInitialFailoverVersion).AnyTimes()
+	s.clusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.clusterMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		cluster.TestCurrentClusterName: {
+			Enabled:                true,
+			InitialFailoverVersion: cluster.TestCurrentClusterInitialFailoverVersion,
+			ShardCount:             1,
+		},
+		cluster.TestAlternativeClusterName: {
+			Enabled:                true,
+			InitialFailoverVersion: cluster.TestAlternativeClusterInitialFailoverVersion,
+			ShardCount:             1,
+		},
+	}).AnyTimes()
+
+	shardID := int32(1)
+	namespaceID := namespace.ID(uuid.New())
+	readerID := shard.ReplicationReaderIDFromClusterShardID(cluster.TestAlternativeClusterInitialFailoverVersion, shardID)
+	otherReaderID := shard.ReplicationReaderIDFromClusterShardID(cluster.TestAlternativeClusterInitialFailoverVersion+1, shardID)
+	emptyReaderID := shard.ReplicationReaderIDFromClusterShardID(cluster.TestAlternativeClusterInitialFailoverVersion+2, shardID)
+	shardContext := shard.NewMockContext(s.controller)
+	shardContext.EXPECT().GetShardID().Return(shardID).AnyTimes()
+	shardContext.EXPECT().GetQueueExclusiveHighReadWatermark(tasks.CategoryReplication).Return(tasks.NewImmediateKey(100))
+	shardContext.EXPECT().GetQueueState(tasks.CategoryReplication).Return(&persistencespb.QueueState{
+		ReaderStates: map[int64]*persistencespb.QueueReaderState{
+			readerID:      rewindReplicationReaderState(nil, 80, nil),
+			otherReaderID: rewindReplicationReaderState(nil, 50, nil),
+			// a reader state without scopes does not bound the resync
+			emptyReaderID: {},
+		},
+	}, true)
+	shardContext.EXPECT().UpdateReplicationQueueReaderState(readerID, gomock.Any()).DoAndReturn(
+		func(_ int64, readerState *persistencespb.QueueReaderState) error {
+			s.Equal(int64(50), readerState.Scopes[0].Range.InclusiveMin.TaskId)
+			s.Equal([]string{namespaceID.String()}, readerState.GetNamespaceResync().GetNamespaceIds())
+			// tasks in [80, 100) were never acknowledged by the target cluster, they must be sent for all namespaces
+			s.Equal(int64(80), readerState.GetNamespaceResync().GetExclusiveEndTaskId())
+			return nil
+		},
+	)
+
+	inclusiveBeginTaskID, exclusiveEndTaskID, err := s.streamReceiverMonitor.ForceResyncNamespace(
+		shardContext,
+		cluster.TestAlternativeClusterName,
+		namespaceID,
+	)
+	s.NoError(err)
+	s.Equal(int64(50), inclusiveBeginTaskID)
+	s.Equal(int64(80), exclusiveEndTaskID)
+}
+
+func (s *streamReceiverMonitorSuite) TestForceResyncNamespace_InvalidTargetCluster() {
+	s.clusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.clusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+
+	shardContext := shard.NewMockContext(s.controller)
+	for _, targetCluster := range []string{cluster.TestCurrentClusterName, "unknown-cluster"} {
+		_, _, err := s.streamReceiverMonitor.ForceResyncNamespace(
+			shardContext,
+			targetCluster,
+			namespace.ID(uuid.New()),
+		)
+		var invalidArgument *serviceerror.InvalidArgument
+		s.ErrorAs(err, &invalidArgument)
+	}
+}
+
 func (s *streamReceiverMonitorSuite) TestDoReconcileOutboundStreams_Add() {
 	s.clusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
 	s.clusterMetadata.EXPECT().ClusterNameForFailoverVersion(true, gomock.Any()).Return("some cluster name").AnyTimes()
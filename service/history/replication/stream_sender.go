@@ -30,6 +30,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"slices"
 	"sync/atomic"
 	"time"
 
@@ -78,6 +79,13 @@ type (
 		config                  *configs.Config
 		isTieredStackEnabled    bool
 		flowController          SenderFlowController
+		// namespaceResync is loaded from the persisted reader state when the sender starts, and cleared once the
+		// target cluster acknowledged all the resent tasks
+		namespaceResync atomic.Pointer[persistencespb.ReplicationNamespaceResync]
+		// lowPriorityTaskTypes and lowPriorityCutoffTime are read from ReplicationTaskLowPriorityPolicy once, so that
+		// the high and low priority loops always agree on the priority of a task
+		lowPriorityTaskTypes  map[enumsspb.TaskType]struct{}
+		lowPriorityCutoffTime time.Time
 	}
 )
 
@@ -97,6 +105,20 @@ func NewStreamSender(
 		tag.TargetCluster(clientClusterName), // client is the target cluster (passive cluster)
 		tag.TargetShardID(clientShardKey.ShardID),
 	)
+	lowPriorityPolicy := config.ReplicationTaskLowPriorityPolicy()
+	lowPriorityTaskTypes := make(map[enumsspb.TaskType]struct{}, len(lowPriorityPolicy.TaskTypes))
+	for _, taskTypeName := range lowPriorityPolicy.TaskTypes {
+		taskType, err := enumsspb.TaskTypeFromString(taskTypeName)
+		if err != nil {
+			logger.Warn("StreamSender ignored unknown low priority replication task type", tag.Value(taskTypeName))
+			continue
+		}
+		lowPriorityTaskTypes[taskType] = struct{}{}
+	}
+	var lowPriorityCutoffTime time.Time
+	if lowPriorityPolicy.MinTaskAge > 0 {
+		lowPriorityCutoffTime = shardContext.GetTimeSource().Now().Add(-lowPriorityPolicy.MinTaskAge)
+	}
 	return &StreamSenderImpl{
 		server:                  server,
 		shardContext:            shardContext,
@@ -113,6 +135,8 @@ func NewStreamSender(
 		config:                  config,
 		isTieredStackEnabled:    config.EnableReplicationTaskTieredProcessing(),
 		flowController:          NewSenderFlowController(config, logger),
+		lowPriorityTaskTypes:    lowPriorityTaskTypes,
+		lowPriorityCutoffTime:   lowPriorityCutoffTime,
 	}
 }
 
@@ -124,6 +148,8 @@ func (s *StreamSenderImpl) Start() {
 	) {
 		return
 	}
+	s.loadNamespaceResync()
+
 	getSenderEventLoop := func(priority enumsspb.TaskPriority) func() error {
 		return func() error {
 			return s.sendEventLoop(priority)
@@ -165,6 +191,20 @@ func (s *StreamSenderImpl) Wait() {
 	<-s.shutdownChan.Channel()
 }
 
+func (s *StreamSenderImpl) loadNamespaceResync() {
+	queueState, ok := s.shardContext.GetQueueState(tasks.CategoryReplication)
+	if !ok {
+		return
+	}
+	readerID := shard.ReplicationReaderIDFromClusterShardID(
+		int64(s.clientShardKey.ClusterID),
+		s.clientShardKey.ShardID,
+	)
+	if resync := queueState.ReaderStates[readerID].GetNamespaceResync(); resync != nil {
+		s.namespaceResync.Store(resync)
+	}
+}
+
 func (s *StreamSenderImpl) Key() ClusterShardKeyPair {
 	return ClusterShardKeyPair{
 		Client: s.clientShardKey,
@@ -339,6 +379,19 @@ func (s *StreamSenderImpl) recvSyncReplicationState(
 	inclusiveLowWatermark := attr.GetInclusiveLowWatermark()
 	inclusiveLowWatermarkTime := attr.GetInclusiveLowWatermarkTime()
 
+	if resync := s.namespaceResync.Load(); resync != nil {
+		if inclusiveLowWatermark < resync.GetExclusiveEndTaskId() {
+			// keep the resync with the reader state until the target cluster acknowledged all the resent tasks
+			readerState.NamespaceResync = resync
+		} else {
+			s.namespaceResync.Store(nil)
+		}
+	}
+
+	if s.shutdownChan.IsShutdown() {
+		// the stream may have been stopped to rewind its reader state, do not override it
+		return nil
+	}
 	readerID := shard.ReplicationReaderIDFromClusterShardID(
 		int64(s.clientShardKey.ClusterID),
 		s.clientShardKey.ShardID,
@@ -414,12 +467,12 @@ func (s *StreamSenderImpl) getSendCatchupBeginInclusiveWatermark(readerState *pe
 				In this case, it is safe to use the overall low watermark as the beginInclusiveWatermark, as long as we always guarantee
 				the overall low watermark is Min(lowPriorityLowWatermark, highPriorityLowWatermark)
 			*/
-			if len(readerState.Scopes) != 3 {
+			if len(readerState.Scopes) != 3 || s.hasLowPriorityPolicy() {
 				return 0
 			}
 			return 1
 		case enumsspb.TASK_PRIORITY_LOW:
-			if len(readerState.Scopes) != 3 {
+			if len(readerState.Scopes) != 3 || s.hasLowPriorityPolicy() {
 				return 0
 			}
 			return 2
@@ -600,6 +653,11 @@ func (s *StreamSenderImpl) shouldProcessTask(item tasks.Task) bool {
 	if clientShardID != s.clientShardKey.ShardID {
 		return false
 	}
+	if resync := s.namespaceResync.Load(); resync != nil && item.GetTaskID() < resync.GetExclusiveEndTaskId() {
+		if !slices.Contains(resync.GetNamespaceIds(), item.GetNamespaceID()) {
+			return false
+		}
+	}
 
 	var shouldProcessTask bool
 	namespaceEntry, err := s.shardContext.GetNamespaceRegistry().GetNamespaceByID(
@@ -631,6 +689,23 @@ func (s *StreamSenderImpl) getTaskPriority(task tasks.Task) enumsspb.TaskPriorit
 		}
 		return t.Priority
 	default:
+		if s.isLowPriorityTask(task) {
+			return enumsspb.TASK_PRIORITY_LOW
+		}
 		return enumsspb.TASK_PRIORITY_HIGH
 	}
 }
+
+// isLowPriorityTask applies the low priority policy to tasks created without an explicit priority
+func (s *StreamSenderImpl) isLowPriorityTask(task tasks.Task) bool {
+	if _, ok := s.lowPriorityTaskTypes[task.GetType()]; ok {
+		return true
+	}
+	return !s.lowPriorityCutoffTime.IsZero() && task.GetVisibilityTime().Before(s.lowPriorityCutoffTime)
+}
+
+// hasLowPriorityPolicy returns true if tasks may be classified differently than by a previous stream, in which case
+// the per priority ack levels cannot be trusted on their own
+func (s *StreamSenderImpl) hasLowPriorityPolicy() bool {
+	return len(s.lowPriorityTaskTypes) > 0 || !s.lowPriorityCutoffTime.IsZero()
+}
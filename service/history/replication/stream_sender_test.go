@@ -45,7 +45,9 @@ import (
 	"go.temporal.io/server/api/historyservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/collection"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
@@ -847,6 +849,170 @@ func (s *streamSenderSuite) TestSendTasks_WithTasks() {
 	s.NoError(err)
 }
 
+func (s *streamSenderSuite) TestShouldProcessTask_NamespaceResync() {
+	mockRegistry := namespace.NewMockRegistry(s.controller)
+	mockRegistry.EXPECT().GetNamespaceByID(namespace.ID("1")).Return(namespace.NewGlobalNamespaceForTest(
+		nil, nil, &persistencespb.NamespaceReplicationConfig{
+			Clusters: []string{"source_cluster", "target_cluster"},
+		}, 100), nil).AnyTimes()
+	s.shardContext.EXPECT().GetNamespaceRegistry().Return(mockRegistry).AnyTimes()
+	newTask := func(taskID int64) tasks.Task {
+		item := tasks.NewMockTask(s.controller)
+		item.EXPECT().GetNamespaceID().Return("1").AnyTimes()
+		item.EXPECT().GetWorkflowID().Return("1").AnyTimes()
+		item.EXPECT().GetTaskID().Return(taskID).AnyTimes()
+		return item
+	}
+
+	s.True(s.streamSender.shouldProcessTask(newTask(5)))
+
+	s.streamSender.namespaceResync.Store(&persistencespb.ReplicationNamespaceResync{
+		NamespaceIds:       []string{"1"},
+		ExclusiveEndTaskId: 10,
+	})
+	s.True(s.streamSender.shouldProcessTask(newTask(5)))
+
+	s.streamSender.namespaceResync.Store(&persistencespb.ReplicationNamespaceResync{
+		NamespaceIds:       []string{"2"},
+		ExclusiveEndTaskId: 10,
+	})
+	s.False(s.streamSender.shouldProcessTask(newTask(5)))
+	s.True(s.streamSender.shouldProcessTask(newTask(10)))
+}
+
+func (s *streamSenderSuite) TestRecvSyncReplicationState_NamespaceResync() {
+	s.streamSender.isTieredStackEnabled = false
+	readerID := shard.ReplicationReaderIDFromClusterShardID(
+		int64(s.clientShardKey.ClusterID),
+		s.clientShardKey.ShardID,
+	)
+	resync := &persistencespb.ReplicationNamespaceResync{
+		NamespaceIds:       []string{"1"},
+		ExclusiveEndTaskId: 10,
+	}
+	s.streamSender.namespaceResync.Store(resync)
+
+	s.shardContext.EXPECT().UpdateReplicationQueueReaderState(readerID, gomock.Any()).DoAndReturn(
+		func(_ int64, readerState *persistencespb.QueueReaderState) error {
+			s.Equal(resync, readerState.GetNamespaceResync())
+			return nil
+		},
+	)
+	s.shardContext.EXPECT().UpdateRemoteReaderInfo(readerID, int64(4), gomock.Any()).Return(nil)
+	s.NoError(s.streamSender.recvSyncReplicationState(&replicationspb.SyncReplicationState{
+		InclusiveLowWatermark:     5,
+		InclusiveLowWatermarkTime: timestamppb.New(time.Unix(0, rand.Int63())),
+	}))
+	s.Equal(resync, s.streamSender.namespaceResync.Load())
+
+	// the target cluster acknowledged all the resent tasks, the resync is done
+	s.shardContext.EXPECT().UpdateReplicationQueueReaderState(readerID, gomock.Any()).DoAndReturn(
+		func(_ int64, readerState *persistencespb.QueueReaderState) error {
+			s.Nil(readerState.GetNamespaceResync())
+			return nil
+		},
+	)
+	s.shardContext.EXPECT().UpdateRemoteReaderInfo(readerID, int64(9), gomock.Any()).Return(nil)
+	s.NoError(s.streamSender.recvSyncReplicationState(&replicationspb.SyncReplicationState{
+		InclusiveLowWatermark:     10,
+		InclusiveLowWatermarkTime: timestamppb.New(time.Unix(0, rand.Int63())),
+	}))
+	s.Nil(s.streamSender.namespaceResync.Load())
+}
+
+func (s *streamSenderSuite) newStreamSenderWithLowPriorityPolicy(
+	policy dynamicconfig.ReplicationTaskPriorityPolicy,
+	now time.Time,
+) *StreamSenderImpl {
+	s.config.ReplicationTaskLowPriorityPolicy = func() dynamicconfig.ReplicationTaskPriorityPolicy {
+		return policy
+	}
+	timeSource := clock.NewEventTimeSource()
+	timeSource.Update(now)
+	s.shardContext.EXPECT().GetTimeSource().Return(timeSource).AnyTimes()
+	return NewStreamSender(
+		s.server,
+		s.shardContext,
+		s.historyEngine,
+		s.taskConverter,
+		"target_cluster",
+		2,
+		s.clientShardKey,
+		s.serverShardKey,
+		s.config,
+	)
+}
+
+func (s *streamSenderSuite) TestGetTaskPriority_MinTaskAge() {
+	now := time.Now()
+	minTaskAge := time.Hour
+	streamSender := s.newStreamSenderWithLowPriorityPolicy(
+		dynamicconfig.ReplicationTaskPriorityPolicy{MinTaskAge: minTaskAge},
+		now,
+	)
+	cutoff := now.Add(-minTaskAge)
+
+	s.Equal(enumsspb.TASK_PRIORITY_LOW, streamSender.getTaskPriority(&tasks.HistoryReplicationTask{
+		VisibilityTimestamp: cutoff.Add(-time.Nanosecond),
+	}))
+	s.Equal(enumsspb.TASK_PRIORITY_HIGH, streamSender.getTaskPriority(&tasks.HistoryReplicationTask{
+		VisibilityTimestamp: cutoff,
+	}))
+	s.Equal(enumsspb.TASK_PRIORITY_HIGH, streamSender.getTaskPriority(&tasks.HistoryReplicationTask{
+		VisibilityTimestamp: now,
+	}))
+	// an explicit task priority is not overridden by the policy
+	s.Equal(enumsspb.TASK_PRIORITY_HIGH, streamSender.getTaskPriority(&tasks.SyncWorkflowStateTask{
+		VisibilityTimestamp: cutoff.Add(-time.Nanosecond),
+		Priority:            enumsspb.TASK_PRIORITY_HIGH,
+	}))
+}
+
+func (s *streamSenderSuite) TestGetTaskPriority_TaskTypes() {
+	streamSender := s.newStreamSenderWithLowPriorityPolicy(
+		dynamicconfig.ReplicationTaskPriorityPolicy{
+			TaskTypes: []string{"ReplicationSyncActivity", "TASK_TYPE_REPLICATION_SYNC_HSM", "unknown"},
+		},
+		time.Now(),
+	)
+
+	s.Equal(enumsspb.TASK_PRIORITY_LOW, streamSender.getTaskPriority(&tasks.SyncActivityTask{
+		VisibilityTimestamp: time.Now(),
+	}))
+	s.Equal(enumsspb.TASK_PRIORITY_LOW, streamSender.getTaskPriority(&tasks.SyncHSMTask{}))
+	s.Equal(enumsspb.TASK_PRIORITY_HIGH, streamSender.getTaskPriority(&tasks.HistoryReplicationTask{
+		VisibilityTimestamp: time.Now(),
+	}))
+}
+
+func (s *streamSenderSuite) TestGetTaskPriority_NoPolicy() {
+	s.Equal(enumsspb.TASK_PRIORITY_HIGH, s.streamSender.getTaskPriority(&tasks.SyncActivityTask{}))
+	s.Equal(enumsspb.TASK_PRIORITY_HIGH, s.streamSender.getTaskPriority(&tasks.HistoryReplicationTask{}))
+	s.False(s.streamSender.hasLowPriorityPolicy())
+}
+
+func (s *streamSenderSuite) TestGetSendCatchupBeginInclusiveWatermark_LowPriorityPolicy() {
+	readerState := &persistencespb.QueueReaderState{
+		Scopes: []*persistencespb.QueueSliceScope{
+			{Range: &persistencespb.QueueSliceRange{InclusiveMin: shard.ConvertToPersistenceTaskKey(tasks.NewImmediateKey(10))}},
+			{Range: &persistencespb.QueueSliceRange{InclusiveMin: shard.ConvertToPersistenceTaskKey(tasks.NewImmediateKey(20))}},
+			{Range: &persistencespb.QueueSliceRange{InclusiveMin: shard.ConvertToPersistenceTaskKey(tasks.NewImmediateKey(15))}},
+		},
+	}
+	s.Equal(int64(20), s.streamSender.getSendCatchupBeginInclusiveWatermark(readerState, enumsspb.TASK_PRIORITY_HIGH))
+	s.Equal(int64(15), s.streamSender.getSendCatchupBeginInclusiveWatermark(readerState, enumsspb.TASK_PRIORITY_LOW))
+
+	// tasks may have moved between priorities since the previous stream, so both priorities resume from the overall
+	// low watermark
+	streamSender := s.newStreamSenderWithLowPriorityPolicy(
+		dynamicconfig.ReplicationTaskPriorityPolicy{MinTaskAge: time.Minute},
+		time.Now(),
+	)
+	s.True(streamSender.hasLowPriorityPolicy())
+	s.Equal(int64(10), streamSender.getSendCatchupBeginInclusiveWatermark(readerState, enumsspb.TASK_PRIORITY_HIGH))
+	s.Equal(int64(10), streamSender.getSendCatchupBeginInclusiveWatermark(readerState, enumsspb.TASK_PRIORITY_LOW))
+}
+
 func (s *streamSenderSuite) TestSendTasks_TieredStack_HighPriority() {
 	s.streamSender.isTieredStackEnabled = true
 	beginInclusiveWatermark := rand.Int63()
@@ -294,12 +294,13 @@ func (p *taskProcessorImpl) applyReplicationTask(
 		tag.TaskID(replicationTask.GetSourceTaskId()),
 		tag.Error(err),
 	)
+	failureReason := err.Error()
 	request, err := p.convertTaskToDLQTask(replicationTask)
 	if err != nil {
 		p.logger.Error("failed to generate DLQ replication task", tag.Error(err))
 		return nil
 	}
-	return p.handleReplicationDLQTask(ctx, request)
+	return p.handleReplicationDLQTask(ctx, request, failureReason)
 }
 
 func (p *taskProcessorImpl) handleSyncShardStatus(
@@ -356,6 +357,7 @@ func (p *taskProcessorImpl) handleReplicationTask(
 func (p *taskProcessorImpl) handleReplicationDLQTask(
 	ctx context.Context,
 	request *persistence.PutReplicationTaskToDLQRequest,
+	failureReason string,
 ) error {
 	_ = p.rateLimiter.Wait(ctx)
 
@@ -373,7 +375,7 @@ func (p *taskProcessorImpl) handleReplicationDLQTask(
 		metrics.InstanceTag(convert.Int32ToString(p.shard.GetShardID())))
 	// The following is guaranteed to success or retry forever until processor is shutdown.
 	return backoff.ThrottleRetry(func() error {
-		err := writeTaskToDLQ(ctx, p.dlqWriter, p.shard, request.SourceClusterName, request.TaskInfo)
+		err := writeTaskToDLQ(ctx, p.dlqWriter, p.shard, request.SourceClusterName, request.TaskInfo, failureReason)
 		if err != nil {
 			p.logger.Error("failed to enqueue replication task to DLQ", tag.Error(err))
 			metrics.ReplicationDLQFailed.With(p.metricsHandler).Record(
@@ -134,6 +134,7 @@ func NewTaskProcessorManager(
 			shard.GetPayloadSerializer(),
 			shard.GetConfig().StandbyTaskReReplicationContextTimeout,
 			shard.GetLogger(),
+			shard.GetMetricsHandler(),
 			config,
 		),
 		logger:         shard.GetLogger(),
@@ -270,7 +270,7 @@ func (s *taskProcessorSuite) TestHandleReplicationDLQTask_SyncActivity() {
 	}
 
 	s.mockExecutionManager.EXPECT().PutReplicationTaskToDLQ(gomock.Any(), request).Return(nil)
-	err := s.replicationTaskProcessor.handleReplicationDLQTask(context.Background(), request)
+	err := s.replicationTaskProcessor.handleReplicationDLQTask(context.Background(), request, "some random error")
 	s.NoError(err)
 }
 
@@ -292,7 +292,7 @@ func (s *taskProcessorSuite) TestHandleReplicationDLQTask_SyncWorkflowState() {
 	}
 
 	s.mockExecutionManager.EXPECT().PutReplicationTaskToDLQ(gomock.Any(), request).Return(nil)
-	err := s.replicationTaskProcessor.handleReplicationDLQTask(context.Background(), request)
+	err := s.replicationTaskProcessor.handleReplicationDLQTask(context.Background(), request, "some random error")
 	s.NoError(err)
 }
 
@@ -316,7 +316,7 @@ func (s *taskProcessorSuite) TestHandleReplicationDLQTask_History() {
 	}
 
 	s.mockExecutionManager.EXPECT().PutReplicationTaskToDLQ(gomock.Any(), request).Return(nil)
-	err := s.replicationTaskProcessor.handleReplicationDLQTask(context.Background(), request)
+	err := s.replicationTaskProcessor.handleReplicationDLQTask(context.Background(), request, "some random error")
 	s.NoError(err)
 }
 
@@ -0,0 +1,171 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"sync"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+const (
+	// addTasksDeduperCacheSize is the maximum number of idempotency tokens remembered by a shard. The oldest tokens
+	// are forgotten first, and a retry of a forgotten request adds its tasks again.
+	addTasksDeduperCacheSize = 1000
+	// addTasksDeduperTTL is how long a shard remembers an idempotency token. It should be longer than the time it
+	// takes callers to give up retrying a request.
+	addTasksDeduperTTL = time.Hour
+)
+
+const (
+	addTasksAttemptInFlight addTasksAttemptStatus = iota
+	addTasksAttemptSucceeded
+	// addTasksAttemptUnknown means the write failed with an error that doesn't tell whether the tasks were written.
+	addTasksAttemptUnknown
+)
+
+var errAddTasksAttemptInFlight = serviceerror.NewUnavailable("A request with the same idempotency token is in progress")
+
+type (
+	// addTasksDeduper remembers the outcome of the AddTasks requests that carry an idempotency token, so that retries
+	// of a request usually add its tasks only once. None of the persistence stores keep the token, so the check is
+	// not part of the write transaction and is best effort. A retry adds its tasks again when:
+	//   - it reaches a new shard context, e.g. after the shard moves to another host, since outcomes only live in
+	//     memory
+	//   - its token has been evicted, see addTasksDeduperCacheSize and addTasksDeduperTTL
+	//   - the earlier attempt's outcome was unknown and its task was processed and deleted before the read back
+	addTasksDeduper struct {
+		sync.Mutex
+		attempts cache.Cache // idempotency token -> addTasksAttempt
+	}
+
+	addTasksAttempt struct {
+		status addTasksAttemptStatus
+		// category and key identify one of the tasks of the write. All tasks of a request are written in one
+		// transaction, so finding this task in persistence means the whole write succeeded. The key is unset if
+		// the write was never sent.
+		category tasks.Category
+		key      tasks.Key
+	}
+
+	addTasksAttemptStatus int
+)
+
+func newAddTasksDeduper(
+	timeSource clock.TimeSource,
+) *addTasksDeduper {
+	return &addTasksDeduper{
+		attempts: cache.New(addTasksDeduperCacheSize, &cache.Options{
+			TTL:        addTasksDeduperTTL,
+			TimeSource: timeSource,
+		}),
+	}
+}
+
+// start marks the request with the token as in flight and returns the previous attempt, if any. It returns
+// errAddTasksAttemptInFlight if another attempt with the same token is still in flight. The caller must call finish
+// unless the previous attempt succeeded.
+func (d *addTasksDeduper) start(
+	token string,
+) (addTasksAttempt, bool, error) {
+	d.Lock()
+	defer d.Unlock()
+
+	previous, ok := d.attempts.Get(token).(addTasksAttempt)
+	if !ok {
+		d.attempts.Put(token, addTasksAttempt{status: addTasksAttemptInFlight})
+		return addTasksAttempt{}, false, nil
+	}
+
+	switch previous.status {
+	case addTasksAttemptInFlight:
+		return addTasksAttempt{}, false, errAddTasksAttemptInFlight
+	case addTasksAttemptSucceeded:
+		return previous, true, nil
+	default:
+		inFlight := previous
+		inFlight.status = addTasksAttemptInFlight
+		d.attempts.Put(token, inFlight)
+		return previous, true, nil
+	}
+}
+
+// setTaskKey records the key of one of the tasks about to be written for the request with the token. It must be
+// called after the task keys are set and before the write is sent.
+func (d *addTasksDeduper) setTaskKey(
+	token string,
+	taskMap map[tasks.Category][]tasks.Task,
+) {
+	d.Lock()
+	defer d.Unlock()
+
+	for category, categoryTasks := range taskMap {
+		if len(categoryTasks) == 0 {
+			continue
+		}
+		d.attempts.Put(token, addTasksAttempt{
+			status:   addTasksAttemptInFlight,
+			category: category,
+			key:      categoryTasks[0].GetKey(),
+		})
+		return
+	}
+}
+
+// finish records the outcome of the attempt started for the token.
+func (d *addTasksDeduper) finish(
+	token string,
+	status addTasksAttemptStatus,
+) {
+	d.Lock()
+	defer d.Unlock()
+
+	attempt, ok := d.attempts.Get(token).(addTasksAttempt)
+	if !ok {
+		return
+	}
+	if status == addTasksAttemptUnknown && attempt.key == (tasks.Key{}) {
+		// the write was never sent, so a retry can add the tasks without checking
+		d.attempts.Delete(token)
+		return
+	}
+	attempt.status = status
+	d.attempts.Put(token, attempt)
+}
+
+// forget drops the token, so that a retry adds the tasks without checking.
+func (d *addTasksDeduper) forget(
+	token string,
+) {
+	d.Lock()
+	defer d.Unlock()
+
+	d.attempts.Delete(token)
+}
@@ -29,6 +29,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -94,6 +95,9 @@ const (
 	queueMetricUpdateInterval = 5 * time.Minute
 
 	pendingMaxReplicationTaskID = math.MaxInt64
+
+	// historyTaskReadBackBatchSize is the page size used when reading back a task to check whether it was written
+	historyTaskReadBackBatchSize = 100
 )
 
 var (
@@ -144,6 +148,9 @@ type (
 		// But DO NOT try to acquire ioSemaphore while holding rwLock, as it may cause deadlock.
 		ioSemaphore locks.PrioritySemaphore
 
+		// addTasksDeduper is protected by its own lock.
+		addTasksDeduper *addTasksDeduper
+
 		// state is protected by stateLock
 		stateLock  sync.Mutex
 		state      contextState
@@ -154,6 +161,9 @@ type (
 		lastUpdated                   time.Time
 		tasksCompletedSinceLastUpdate int
 		shardInfo                     *persistencespb.ShardInfo
+		// tasks completed by queues with their own ShardUpdateMinTasksCompletedPerQueue threshold,
+		// these are not counted in tasksCompletedSinceLastUpdate
+		queueTasksCompletedSinceLastUpdate map[tasks.Category]int
 
 		// All methods of the taskKeyManager, except the completionFn returned by
 		// setAndTrackTaskKeys, must be invoked within rwLock.
@@ -214,6 +224,9 @@ const (
 	logWarnScheduledTaskLag = time.Duration(30 * time.Minute)
 	historySizeLogThreshold = 10 * 1024 * 1024
 	minContextTimeout       = 2 * time.Second * debug.TimeoutMultiplier
+
+	// shards with larger IDs share a single shard ID tag value to bound the cardinality of shard lag metrics
+	shardLagMetricsMaxTaggedShardID = 1024
 )
 
 func (s *ContextImpl) String() string {
@@ -384,7 +397,7 @@ func (s *ContextImpl) SetQueueState(
 	tasksCompleted int,
 	state *persistencespb.QueueState,
 ) error {
-	return s.updateShardInfo(tasksCompleted,
+	return s.updateShardInfo(category, tasksCompleted,
 		func() {
 			categoryID := category.ID()
 			s.shardInfo.QueueStates[int32(categoryID)] = state
@@ -396,7 +409,7 @@ func (s *ContextImpl) UpdateReplicationQueueReaderState(
 	readerState *persistencespb.QueueReaderState,
 ) error {
 	// TODO(timods): Determine whether this makes sense for replication
-	return s.updateShardInfo(0, func() {
+	return s.updateShardInfo(tasks.CategoryReplication, 0, func() {
 		categoryID := tasks.CategoryReplication.ID()
 		queueState, ok := s.shardInfo.QueueStates[int32(categoryID)]
 		if !ok {
@@ -470,7 +483,7 @@ func (s *ContextImpl) UpdateReplicatorDLQAckLevel(
 	ackLevel int64,
 ) error {
 	// TODO(timods): Determine whether this makes sense for replication
-	if err := s.updateShardInfo(0, func() {
+	if err := s.updateShardInfo(tasks.CategoryReplication, 0, func() {
 		s.shardInfo.ReplicationDlqAckLevel[sourceCluster] = ackLevel
 	}); err != nil {
 		return err
@@ -544,13 +557,99 @@ func (s *ContextImpl) AddTasks(
 	}
 	defer s.ioSemaphoreRelease()
 
+	if request.IdempotencyToken != "" {
+		written, err := s.previousAddTasksWritten(ctx, request.IdempotencyToken)
+		if err != nil || written {
+			return err
+		}
+	}
+
 	err = s.addTasksSemaphoreAcquired(ctx, request)
+	if request.IdempotencyToken != "" {
+		switch {
+		case err == nil:
+			s.addTasksDeduper.finish(request.IdempotencyToken, addTasksAttemptSucceeded)
+		case OperationPossiblySucceeded(err):
+			s.addTasksDeduper.finish(request.IdempotencyToken, addTasksAttemptUnknown)
+		default:
+			s.addTasksDeduper.forget(request.IdempotencyToken)
+		}
+	}
 	if OperationPossiblySucceeded(err) {
 		engine.NotifyNewTasks(request.Tasks)
 	}
 	return err
 }
 
+// previousAddTasksWritten starts an AddTasks attempt with the idempotency token and reports whether an earlier attempt
+// with the same token already wrote its tasks. If the earlier attempt failed with an error that doesn't tell whether
+// the tasks were written, one of its tasks is read back. Such an error makes the shard re-acquire its rangeID, so once
+// the shard is acquired again the read sees the earlier write if it ever made it to persistence.
+// NOTE: if the task has been processed and deleted in the meantime, the read can't find it and the tasks are added
+// again.
+func (s *ContextImpl) previousAddTasksWritten(
+	ctx context.Context,
+	token string,
+) (bool, error) {
+	previous, ok, err := s.addTasksDeduper.start(token)
+	if err != nil || !ok {
+		return false, err
+	}
+	if previous.status == addTasksAttemptSucceeded {
+		return true, nil
+	}
+
+	written, err := s.historyTaskWritten(ctx, previous.category, previous.key)
+	if err != nil {
+		s.addTasksDeduper.finish(token, addTasksAttemptUnknown)
+		return false, err
+	}
+	if written {
+		s.addTasksDeduper.finish(token, addTasksAttemptSucceeded)
+	}
+	return written, nil
+}
+
+func (s *ContextImpl) historyTaskWritten(
+	ctx context.Context,
+	category tasks.Category,
+	key tasks.Key,
+) (bool, error) {
+	if err := s.errorByState(); err != nil {
+		return false, err
+	}
+
+	request := &persistence.GetHistoryTasksRequest{
+		ShardID:      s.shardID,
+		TaskCategory: category,
+		BatchSize:    historyTaskReadBackBatchSize,
+	}
+	if category.Type() == tasks.CategoryTypeScheduled {
+		// scheduled task ranges can only be bounded by fire time
+		request.InclusiveMinTaskKey = tasks.NewKey(key.FireTime, 0)
+		request.ExclusiveMaxTaskKey = tasks.NewKey(key.FireTime.Add(persistence.ScheduledTaskMinPrecision), 0)
+	} else {
+		request.InclusiveMinTaskKey = tasks.NewImmediateKey(key.TaskID)
+		request.ExclusiveMaxTaskKey = tasks.NewImmediateKey(key.TaskID + 1)
+	}
+
+	for {
+		resp, err := s.executionManager.GetHistoryTasks(ctx, request)
+		if err = s.handleReadError(err); err != nil {
+			return false, err
+		}
+		for _, task := range resp.Tasks {
+			if task.GetTaskID() == key.TaskID {
+				return true, nil
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return false, nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
 func (s *ContextImpl) AddSpeculativeWorkflowTaskTimeoutTask(
 	task *tasks.WorkflowTaskTimeoutTask,
 ) error {
@@ -893,6 +992,10 @@ func (s *ContextImpl) addTasksSemaphoreAcquired(
 		return err
 	}
 
+	if request.IdempotencyToken != "" {
+		s.addTasksDeduper.setTaskKey(request.IdempotencyToken, request.Tasks)
+	}
+
 	request.RangeID = s.getRangeIDLocked()
 	s.wUnlock()
 
@@ -1223,6 +1326,7 @@ func (s *ContextImpl) monitorQueueMetrics() {
 }
 
 func (s *ContextImpl) updateShardInfo(
+	category tasks.Category,
 	tasksCompleted int,
 	updateFnLocked func(),
 ) error {
@@ -1232,16 +1336,21 @@ func (s *ContextImpl) updateShardInfo(
 		return err
 	}
 
-	s.tasksCompletedSinceLastUpdate += tasksCompleted
+	perQueueMinTasks := s.config.ShardUpdateMinTasksCompletedPerQueue()
+	if _, ok := perQueueMinTasks[category.Name()]; ok {
+		if s.queueTasksCompletedSinceLastUpdate == nil {
+			s.queueTasksCompletedSinceLastUpdate = make(map[tasks.Category]int)
+		}
+		s.queueTasksCompletedSinceLastUpdate[category] += tasksCompleted
+	} else {
+		s.tasksCompletedSinceLastUpdate += tasksCompleted
+	}
 	updateFnLocked()
 	s.shardInfo.StolenSinceRenew = 0
 
 	now := s.timeSource.Now()
 	tooEarly := s.lastUpdated.Add(s.config.ShardUpdateMinInterval()).After(now)
-	minTasksUntilUpdate := s.config.ShardUpdateMinTasksCompleted()
-	// If ShardUpdateMinTasksCompleted is set to 0 then we only care about whether enough time has passed
-	tooFewTasksCompleted := minTasksUntilUpdate <= 0 || s.tasksCompletedSinceLastUpdate < minTasksUntilUpdate
-	if tooFewTasksCompleted && tooEarly {
+	if tooEarly && !s.enoughTasksCompletedLocked(perQueueMinTasks) {
 		s.wUnlock()
 		return nil
 	}
@@ -1249,11 +1358,17 @@ func (s *ContextImpl) updateShardInfo(
 	// update lastUpdate here so that we don't have to grab shard lock again if UpdateShard is successful
 	previousLastUpdate := s.lastUpdated
 	prevTasksCompletedSinceLastUpdate := s.tasksCompletedSinceLastUpdate
-	metrics.TasksCompletedPerShardInfoUpdate.With(s.metricsHandler).Record(int64(s.tasksCompletedSinceLastUpdate))
+	prevQueueTasksCompletedSinceLastUpdate := s.queueTasksCompletedSinceLastUpdate
+	totalTasksCompleted := s.tasksCompletedSinceLastUpdate
+	for _, queueTasksCompleted := range s.queueTasksCompletedSinceLastUpdate {
+		totalTasksCompleted += queueTasksCompleted
+	}
+	metrics.TasksCompletedPerShardInfoUpdate.With(s.metricsHandler).Record(int64(totalTasksCompleted))
 	metrics.TimeBetweenShardInfoUpdates.With(s.metricsHandler).Record(now.Sub(previousLastUpdate))
 
 	s.lastUpdated = now
 	s.tasksCompletedSinceLastUpdate = 0
+	s.queueTasksCompletedSinceLastUpdate = nil
 
 	updatedShardInfo := trimShardInfo(s.clusterMetadata.GetAllClusterInfo(), copyShardInfo(s.shardInfo))
 	request := &persistence.UpdateShardRequest{
@@ -1277,12 +1392,67 @@ func (s *ContextImpl) updateShardInfo(
 		// revert update shard properties so that operation can be retried
 		s.lastUpdated = previousLastUpdate
 		s.tasksCompletedSinceLastUpdate = prevTasksCompletedSinceLastUpdate
+		s.queueTasksCompletedSinceLastUpdate = prevQueueTasksCompletedSinceLastUpdate
 		return s.handleWriteErrorLocked(request.PreviousRangeID, err)
 	}
 
+	if s.config.EmitShardLagMetrics() {
+		s.emitShardLagMetrics()
+	}
 	return nil
 }
 
+// enoughTasksCompletedLocked returns true if the shared task count or any queue with its own
+// threshold has reached the number of completed tasks that allows a shard info update.
+// A threshold of 0 or lower means only ShardUpdateMinInterval is considered.
+func (s *ContextImpl) enoughTasksCompletedLocked(perQueueMinTasks map[string]int) bool {
+	if minTasks := s.config.ShardUpdateMinTasksCompleted(); minTasks > 0 && s.tasksCompletedSinceLastUpdate >= minTasks {
+		return true
+	}
+	for category, tasksCompleted := range s.queueTasksCompletedSinceLastUpdate {
+		if minTasks := perQueueMinTasks[category.Name()]; minTasks > 0 && tasksCompleted >= minTasks {
+			return true
+		}
+	}
+	return false
+}
+
+// Take the shard lock and emit per shard queue lag gauges
+func (s *ContextImpl) emitShardLagMetrics() {
+	s.rLock()
+	defer s.rUnlock()
+
+	shardIDTag := metrics.ShardIDExcludedTag()
+	if s.shardID <= shardLagMetricsMaxTaggedShardID {
+		shardIDTag = metrics.ShardIDTag(strconv.Itoa(int(s.shardID)))
+	}
+	metricsHandler := s.GetMetricsHandler().WithTags(
+		metrics.OperationTag(metrics.ShardInfoScope),
+		shardIDTag,
+	)
+
+	for categoryID, queueState := range s.shardInfo.QueueStates {
+		category, ok := s.taskCategoryRegistry.GetCategoryByID(int(categoryID))
+		if !ok {
+			continue
+		}
+		minTaskKey := getMinTaskKey(queueState)
+		if minTaskKey == nil {
+			continue
+		}
+		highWatermark := s.taskKeyManager.getExclusiveReaderHighWatermark(category)
+
+		switch category.Type() {
+		case tasks.CategoryTypeImmediate:
+			metrics.ShardImmediateQueueLag.With(metricsHandler).
+				Record(float64(highWatermark.TaskID-minTaskKey.TaskID), metrics.TaskCategoryTag(category.Name()))
+		case tasks.CategoryTypeScheduled:
+			metrics.ShardScheduledQueueLag.With(metricsHandler).
+				Record(highWatermark.FireTime.Sub(minTaskKey.FireTime).Seconds(), metrics.TaskCategoryTag(category.Name()))
+		}
+	}
+}
+
 // Take the shard lock and update queue metrics
 func (s *ContextImpl) emitShardInfoMetricsLogs() {
 	s.rLock()
@@ -1542,6 +1712,10 @@ func (s *ContextImpl) ioSemaphoreAcquire(
 		}
 	}()
 
+	if s.ioSemaphore.TryAcquire(1) {
+		return nil
+	}
+	metrics.SemaphoreQueued.With(handler).Record(1)
 	return s.ioSemaphore.Acquire(ctx, priority, 1)
 }
 
@@ -2109,6 +2283,7 @@ func newContext(
 		ioSemaphore:             locks.NewPrioritySemaphore(ioConcurrency),
 		stateMachineRegistry:    stateMachineRegistry,
 	}
+	shardContext.addTasksDeduper = newAddTasksDeduper(timeSource)
 	shardContext.taskKeyManager = newTaskKeyManager(
 		shardContext.taskCategoryRegistry,
 		timeSource,
@@ -2139,9 +2314,16 @@ func (s *ContextImpl) initLastUpdatesTime() {
 	// The idea is to allow queue to persist even in the case of (relativly) constantly
 	// moving shards between hosts.
 	// Note: it still may prevent queue from progressing if shard moving rate is too high
+	firstUpdateInterval, clamped := s.config.ResolveShardFirstUpdateInterval()
+	if clamped {
+		s.contextTaggedLogger.Warn("ShardFirstUpdateInterval is larger than ShardUpdateMinInterval, clamping it to ShardUpdateMinInterval",
+			tag.NewDurationTag("shard-first-update-interval", s.config.ShardFirstUpdateInterval()),
+			tag.NewDurationTag("shard-update-min-interval", firstUpdateInterval),
+		)
+	}
 	lastUpdated := s.timeSource.Now()
 	lastUpdated = lastUpdated.Add(-1 * s.config.ShardUpdateMinInterval())
-	lastUpdated = lastUpdated.Add(s.config.ShardFirstUpdateInterval())
+	lastUpdated = lastUpdated.Add(firstUpdateInterval)
 	s.lastUpdated = lastUpdated
 }
 
@@ -36,6 +36,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.temporal.io/server/api/historyservice/v1"
@@ -44,6 +45,10 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/locks"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -200,6 +205,105 @@ func (s *contextSuite) TestAddTasks_Success() {
 	s.NoError(err)
 }
 
+func (s *contextSuite) TestAddTasks_IdempotencyToken_RetryAfterSuccess() {
+	s.mockExecutionManager.EXPECT().AddHistoryTasks(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.mockHistoryEngine.EXPECT().NotifyNewTasks(gomock.Any()).Times(1)
+
+	for i := 0; i < 2; i++ {
+		err := s.mockShard.AddTasks(context.Background(), s.newIdempotentAddTasksRequest())
+		s.NoError(err)
+	}
+}
+
+func (s *contextSuite) TestAddTasks_IdempotencyToken_RetryAfterAmbiguousFailure_TaskWritten() {
+	var writtenTask tasks.Task
+	s.mockExecutionManager.EXPECT().AddHistoryTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.AddHistoryTasksRequest) error {
+			writtenTask = request.Tasks[tasks.CategoryVisibility][0]
+			return &persistence.TimeoutError{Msg: "timeout"}
+		},
+	).Times(1)
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.mockHistoryEngine.EXPECT().NotifyNewTasks(gomock.Any()).AnyTimes()
+
+	err := s.mockShard.AddTasks(context.Background(), s.newIdempotentAddTasksRequest())
+	s.IsType(&persistence.TimeoutError{}, err)
+	s.Eventually(func() bool {
+		return s.mockShard.errorByState() == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	s.mockExecutionManager.EXPECT().GetHistoryTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.GetHistoryTasksRequest) (*persistence.GetHistoryTasksResponse, error) {
+			s.Equal(tasks.CategoryVisibility, request.TaskCategory)
+			s.Equal(tasks.NewImmediateKey(writtenTask.GetTaskID()), request.InclusiveMinTaskKey)
+			return &persistence.GetHistoryTasksResponse{Tasks: []tasks.Task{writtenTask}}, nil
+		},
+	).Times(1)
+
+	err = s.mockShard.AddTasks(context.Background(), s.newIdempotentAddTasksRequest())
+	s.NoError(err)
+}
+
+func (s *contextSuite) TestAddTasks_IdempotencyToken_RetryAfterAmbiguousFailure_TaskNotWritten() {
+	s.mockExecutionManager.EXPECT().AddHistoryTasks(gomock.Any(), gomock.Any()).
+		Return(&persistence.TimeoutError{Msg: "timeout"}).Times(1)
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.mockHistoryEngine.EXPECT().NotifyNewTasks(gomock.Any()).AnyTimes()
+
+	err := s.mockShard.AddTasks(context.Background(), s.newIdempotentAddTasksRequest())
+	s.IsType(&persistence.TimeoutError{}, err)
+	s.Eventually(func() bool {
+		return s.mockShard.errorByState() == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	s.mockExecutionManager.EXPECT().GetHistoryTasks(gomock.Any(), gomock.Any()).
+		Return(&persistence.GetHistoryTasksResponse{}, nil).Times(1)
+	s.mockExecutionManager.EXPECT().AddHistoryTasks(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	err = s.mockShard.AddTasks(context.Background(), s.newIdempotentAddTasksRequest())
+	s.NoError(err)
+
+	// the second attempt succeeded, so there's nothing left to check
+	err = s.mockShard.AddTasks(context.Background(), s.newIdempotentAddTasksRequest())
+	s.NoError(err)
+}
+
+func (s *contextSuite) TestAddTasks_IdempotencyToken_RetryAfterDefiniteFailure() {
+	s.mockExecutionManager.EXPECT().AddHistoryTasks(gomock.Any(), gomock.Any()).
+		Return(&persistence.ConditionFailedError{Msg: "condition failed"}).Times(1)
+	s.mockExecutionManager.EXPECT().AddHistoryTasks(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.mockHistoryEngine.EXPECT().NotifyNewTasks(gomock.Any()).Times(1)
+
+	err := s.mockShard.AddTasks(context.Background(), s.newIdempotentAddTasksRequest())
+	s.IsType(&persistence.ConditionFailedError{}, err)
+
+	err = s.mockShard.AddTasks(context.Background(), s.newIdempotentAddTasksRequest())
+	s.NoError(err)
+}
+
+func (s *contextSuite) TestAddTasks_IdempotencyToken_RetryWhileInFlight() {
+	_, ok, err := s.mockShard.addTasksDeduper.start("test-token")
+	s.NoError(err)
+	s.False(ok)
+
+	err = s.mockShard.AddTasks(context.Background(), s.newIdempotentAddTasksRequest())
+	var unavailable *serviceerror.Unavailable
+	s.ErrorAs(err, &unavailable)
+}
+
+func (s *contextSuite) newIdempotentAddTasksRequest() *persistence.AddHistoryTasksRequest {
+	workflowKey := definition.NewWorkflowKey(tests.NamespaceID.String(), tests.WorkflowID, tests.RunID)
+	return &persistence.AddHistoryTasksRequest{
+		ShardID:     s.mockShard.GetShardID(),
+		NamespaceID: workflowKey.NamespaceID,
+		WorkflowID:  workflowKey.WorkflowID,
+		Tasks: map[tasks.Category][]tasks.Task{
+			tasks.CategoryVisibility: {&tasks.DeleteExecutionVisibilityTask{WorkflowKey: workflowKey}},
+		},
+		IdempotencyToken: "test-token",
+	}
+}
+
 func (s *contextSuite) TestDeleteWorkflowExecution_Success() {
 	workflowKey := definition.WorkflowKey{
 		NamespaceID: tests.NamespaceID.String(),
@@ -479,6 +583,57 @@ func (s *contextSuite) TestHandoverNamespace() {
 	s.False(ok)
 }
 
+func (s *contextSuite) TestEmitShardLagMetrics() {
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	defer metricsHandler.StopCapture(capture)
+	s.mockShard.metricsHandler = metricsHandler
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	lag := int64(100)
+	highWatermark := s.mockShard.GetQueueExclusiveHighReadWatermark(tasks.CategoryTransfer)
+	queueState := &persistencespb.QueueState{
+		ExclusiveReaderHighWatermark: ConvertToPersistenceTaskKey(tasks.NewImmediateKey(highWatermark.TaskID - lag)),
+	}
+
+	// disabled by default
+	s.NoError(s.mockShard.SetQueueState(tasks.CategoryTransfer, 0, queueState))
+	s.Empty(capture.Snapshot()[metrics.ShardImmediateQueueLag.Name()])
+
+	s.mockShard.config.EmitShardLagMetrics = dynamicconfig.GetBoolPropertyFn(true)
+	s.mockShard.config.ShardUpdateMinInterval = dynamicconfig.GetDurationPropertyFn(0)
+	s.NoError(s.mockShard.SetQueueState(tasks.CategoryTransfer, 0, queueState))
+
+	recordings := capture.Snapshot()[metrics.ShardImmediateQueueLag.Name()]
+	s.Len(recordings, 1)
+	s.Equal(float64(lag), recordings[0].Value)
+	s.Equal(fmt.Sprint(s.shardID), recordings[0].Tags["shard_id"])
+	s.Equal(tasks.CategoryTransfer.Name(), recordings[0].Tags[metrics.TaskCategoryTagName])
+}
+
+func (s *contextSuite) TestIOSemaphoreAcquire_RecordsQueuedMetric() {
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	defer metricsHandler.StopCapture(capture)
+	s.mockShard.metricsHandler = metricsHandler
+	s.mockShard.ioSemaphore = locks.NewPrioritySemaphore(1)
+
+	// a free slot is acquired without queueing
+	s.NoError(s.mockShard.ioSemaphoreAcquire(context.Background()))
+	s.Empty(capture.Snapshot()[metrics.SemaphoreQueued.Name()])
+
+	// the only slot is held, so the next request is queued until its context expires
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	s.ErrorIs(s.mockShard.ioSemaphoreAcquire(ctx), context.DeadlineExceeded)
+	s.Len(capture.Snapshot()[metrics.SemaphoreQueued.Name()], 1)
+
+	s.mockShard.ioSemaphoreRelease()
+	s.NoError(s.mockShard.ioSemaphoreAcquire(context.Background()))
+	s.Len(capture.Snapshot()[metrics.SemaphoreQueued.Name()], 1)
+	s.mockShard.ioSemaphoreRelease()
+}
+
 func (s *contextSuite) TestUpdateGetRemoteClusterInfo_Legacy_8_4() {
 	clusterMetadata := cluster.NewMockMetadata(s.controller)
 	clusterMetadata.EXPECT().GetClusterID().Return(cluster.TestCurrentClusterInitialFailoverVersion).AnyTimes()
@@ -720,13 +875,13 @@ func (s *contextSuite) TestUpdateShardInfo_CallbackIsInvoked_EvenWhenNotPersiste
 
 	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).
 		Return(nil).Times(1)
-	err := s.mockShard.updateShardInfo(0, callback)
+	err := s.mockShard.updateShardInfo(tasks.CategoryTransfer, 0, callback)
 	s.NoError(err)
 
 	// No time has passed and too few tasks completed: shouldn't update the database
 	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).
 		Return(nil).Times(0)
-	err = s.mockShard.updateShardInfo(0, callback)
+	err = s.mockShard.updateShardInfo(tasks.CategoryTransfer, 0, callback)
 	s.NoError(err)
 
 	s.Equal(2, timesCalled)
@@ -744,19 +899,19 @@ func (s *contextSuite) TestUpdateShardInfo_PersistsAfterInterval_RegardlessOfTas
 
 	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).
 		Return(nil).Times(1)
-	err := s.mockShard.updateShardInfo(0, callback)
+	err := s.mockShard.updateShardInfo(tasks.CategoryTransfer, 0, callback)
 	s.NoError(err)
 
 	// No time has passed: shouldn't update the database.
 	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).
 		Return(nil).Times(0)
-	err = s.mockShard.updateShardInfo(0, callback)
+	err = s.mockShard.updateShardInfo(tasks.CategoryTransfer, 0, callback)
 	s.NoError(err)
 
 	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).
 		Return(nil).Times(1)
 	s.timeSource.Update(time.Now().Add(s.mockShard.config.ShardUpdateMinInterval()))
-	err = s.mockShard.updateShardInfo(0, callback)
+	err = s.mockShard.updateShardInfo(tasks.CategoryTransfer, 0, callback)
 	s.NoError(err)
 	s.Equal(3, timesCalled)
 }
@@ -770,19 +925,19 @@ func (s *contextSuite) TestUpdateShardInfo_PersistsBeforeInterval_WhenEnoughTask
 	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).
 		Return(nil).Times(1)
 	tasksNecessaryForUpdate := s.mockShard.config.ShardUpdateMinTasksCompleted()
-	err := s.mockShard.updateShardInfo(tasksNecessaryForUpdate, callback)
+	err := s.mockShard.updateShardInfo(tasks.CategoryTransfer, tasksNecessaryForUpdate, callback)
 	s.NoError(err)
 
 	// No time has passed and too few tasks completed: shouldn't update
 	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).
 		Return(nil).Times(0)
-	err = s.mockShard.updateShardInfo(tasksNecessaryForUpdate-1, callback)
+	err = s.mockShard.updateShardInfo(tasks.CategoryTransfer, tasksNecessaryForUpdate-1, callback)
 	s.NoError(err)
 	s.Equal(2, timesCalled, "Should call provided callback even when not persisting updates")
 
 	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).
 		Return(nil).Times(1)
-	err = s.mockShard.updateShardInfo(1, callback)
+	err = s.mockShard.updateShardInfo(tasks.CategoryTransfer, 1, callback)
 	s.NoError(err)
 	s.Equal(3, timesCalled)
 }
@@ -801,14 +956,14 @@ func (s *contextSuite) TestUpdateShardInfo_OnlyPersistsAfterInterval_WhenTaskChe
 	// Initial call to set the last called time
 	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).
 		Return(nil).Times(1)
-	err := s.mockShard.updateShardInfo(0, callback)
+	err := s.mockShard.updateShardInfo(tasks.CategoryTransfer, 0, callback)
 	s.NoError(err)
 	s.Equal(1, timesCalled)
 
 	// Not enough time passed and with task tracking disabled, this is ignored
 	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).
 		Return(nil).Times(0)
-	err = s.mockShard.updateShardInfo(10000000, callback)
+	err = s.mockShard.updateShardInfo(tasks.CategoryTransfer, 10000000, callback)
 	s.NoError(err)
 	s.Equal(2, timesCalled, "Should call provided callback even when not persisting updates")
 
@@ -816,17 +971,67 @@ func (s *contextSuite) TestUpdateShardInfo_OnlyPersistsAfterInterval_WhenTaskChe
 	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).
 		Return(nil).Times(1)
 	s.timeSource.Update(time.Now().Add(s.mockShard.config.ShardUpdateMinInterval()))
-	err = s.mockShard.updateShardInfo(0, callback)
+	err = s.mockShard.updateShardInfo(tasks.CategoryTransfer, 0, callback)
 	s.NoError(err)
 	s.Equal(3, timesCalled)
 }
 
+func (s *contextSuite) TestUpdateShardInfo_PerQueueTasksCompleted() {
+	s.mockShard.state = contextStateAcquired
+
+	s.mockShard.config.ShardUpdateMinTasksCompleted = func() int { return 100 }
+	s.mockShard.config.ShardUpdateMinTasksCompletedPerQueue = func() map[string]int {
+		return map[string]int{
+			tasks.CategoryTransfer.Name():   10,
+			tasks.CategoryVisibility.Name(): 50,
+		}
+	}
+	callback := func() {}
+
+	// Initial call to set the last called time
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).
+		Return(nil).Times(1)
+	err := s.mockShard.updateShardInfo(tasks.CategoryTransfer, 0, callback)
+	s.NoError(err)
+
+	// Completions from different queues don't add up towards each other's threshold
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).
+		Return(nil).Times(0)
+	s.NoError(s.mockShard.updateShardInfo(tasks.CategoryTransfer, 9, callback))
+	s.NoError(s.mockShard.updateShardInfo(tasks.CategoryVisibility, 49, callback))
+	s.NoError(s.mockShard.updateShardInfo(tasks.CategoryTimer, 99, callback))
+
+	// Transfer queue reaches its own threshold
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).
+		Return(nil).Times(1)
+	s.NoError(s.mockShard.updateShardInfo(tasks.CategoryTransfer, 1, callback))
+
+	// All counters were reset by the update
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).
+		Return(nil).Times(0)
+	s.NoError(s.mockShard.updateShardInfo(tasks.CategoryVisibility, 49, callback))
+	s.NoError(s.mockShard.updateShardInfo(tasks.CategoryTimer, 99, callback))
+
+	// Visibility queue reaches its own threshold
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).
+		Return(nil).Times(1)
+	s.NoError(s.mockShard.updateShardInfo(tasks.CategoryVisibility, 1, callback))
+
+	// Queues without an override share the global threshold
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).
+		Return(nil).Times(0)
+	s.NoError(s.mockShard.updateShardInfo(tasks.CategoryTimer, 60, callback))
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).
+		Return(nil).Times(1)
+	s.NoError(s.mockShard.updateShardInfo(tasks.CategoryArchival, 40, callback))
+}
+
 func (s *contextSuite) TestUpdateShardInfo_FailsUnlessShardAcquired() {
 	for _, state := range []contextState{
 		contextStateInitialized, contextStateAcquiring, contextStateStopping, contextStateStopped,
 	} {
 		s.mockShard.state = state
-		s.Error(s.mockShard.updateShardInfo(0, func() {
+		s.Error(s.mockShard.updateShardInfo(tasks.CategoryTransfer, 0, func() {
 			s.Fail("Should not have called update callback when in state %v", state)
 		}))
 
@@ -836,12 +1041,23 @@ func (s *contextSuite) TestUpdateShardInfo_FailsUnlessShardAcquired() {
 	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).
 		Return(nil).Times(1)
 	var called bool
-	s.NoError(s.mockShard.updateShardInfo(0, func() {
+	s.NoError(s.mockShard.updateShardInfo(tasks.CategoryTransfer, 0, func() {
 		called = true
 	}))
 	s.True(called)
 }
 
+func (s *contextSuite) TestInitLastUpdatesTime_ClampsFirstUpdateInterval() {
+	s.mockShard.config.ShardUpdateMinInterval = func() time.Duration { return 5 * time.Minute }
+	s.mockShard.config.ShardFirstUpdateInterval = func() time.Duration { return 10 * time.Minute }
+	now := time.Now()
+	s.timeSource.Update(now)
+
+	s.mockShard.initLastUpdatesTime()
+	// first update must not be delayed beyond ShardUpdateMinInterval
+	s.Equal(now, s.mockShard.lastUpdated)
+}
+
 func (s *contextSuite) TestUpdateShardInfo_FirstUpdate() {
 
 	s.mockShard.config.ShardUpdateMinInterval = func() time.Duration { return 5 * time.Minute }
@@ -857,7 +1073,7 @@ func (s *contextSuite) TestUpdateShardInfo_FirstUpdate() {
 	var called bool
 	updateFunc := func() { called = true }
 
-	err := s.mockShard.updateShardInfo(1, updateFunc)
+	err := s.mockShard.updateShardInfo(tasks.CategoryTransfer, 1, updateFunc)
 
 	s.NoError(err)
 	s.True(called)
@@ -868,7 +1084,7 @@ func (s *contextSuite) TestUpdateShardInfo_FirstUpdate() {
 	s.timeSource.Update(time.Now().Add(s.mockShard.config.ShardFirstUpdateInterval() + 10*time.Second))
 	called = false
 	s.mockShardManager.EXPECT().UpdateShard(_any, _any).Return(nil).Times(1)
-	err = s.mockShard.updateShardInfo(1, updateFunc)
+	err = s.mockShard.updateShardInfo(tasks.CategoryTransfer, 1, updateFunc)
 
 	s.NoError(err)
 	s.True(called)
@@ -878,7 +1094,7 @@ func (s *contextSuite) TestUpdateShardInfo_FirstUpdate() {
 	s.timeSource.Update(time.Now().Add(s.mockShard.config.ShardFirstUpdateInterval() + 15*time.Second))
 	called = false
 	s.mockShardManager.EXPECT().UpdateShard(_any, _any).Times(0)
-	err = s.mockShard.updateShardInfo(1, updateFunc)
+	err = s.mockShard.updateShardInfo(tasks.CategoryTransfer, 1, updateFunc)
 
 	s.NoError(err)
 	s.True(called)
@@ -888,7 +1104,7 @@ func (s *contextSuite) TestUpdateShardInfo_FirstUpdate() {
 	s.timeSource.Update(s.mockShard.lastUpdated.Add(s.mockShard.config.ShardUpdateMinInterval() + 10*time.Second))
 	called = false
 	s.mockShardManager.EXPECT().UpdateShard(_any, _any).Return(nil).Times(1)
-	err = s.mockShard.updateShardInfo(1, updateFunc)
+	err = s.mockShard.updateShardInfo(tasks.CategoryTransfer, 1, updateFunc)
 
 	s.NoError(err)
 	s.True(called)
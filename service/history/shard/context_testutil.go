@@ -66,6 +66,7 @@ func NewTestContextWithTimeSource(
 	result := NewTestContext(ctrl, shardInfo, config)
 	result.timeSource = timeSource
 	result.taskKeyManager.generator.timeSource = timeSource
+	result.addTasksDeduper = newAddTasksDeduper(timeSource)
 	result.Resource.TimeSource = timeSource
 	return result
 }
@@ -192,6 +193,7 @@ func newTestContext(t *resourcetest.Test, eventsCache events.Cache, config Conte
 		},
 	)
 	ctx.taskKeyManager.setRangeID(config.ShardInfo.RangeId)
+	ctx.addTasksDeduper = newAddTasksDeduper(ctx.timeSource)
 	return ctx
 }
 
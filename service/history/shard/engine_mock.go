@@ -86,6 +86,21 @@ func (mr *MockEngineMockRecorder) AddTasks(ctx, request interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTasks", reflect.TypeOf((*MockEngine)(nil).AddTasks), ctx, request)
 }
 
+// CompactQueueSlices mocks base method.
+func (m *MockEngine) CompactQueueSlices(ctx context.Context, request *v12.CompactQueueSlicesRequest) (*v12.CompactQueueSlicesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompactQueueSlices", ctx, request)
+	ret0, _ := ret[0].(*v12.CompactQueueSlicesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompactQueueSlices indicates an expected call of CompactQueueSlices.
+func (mr *MockEngineMockRecorder) CompactQueueSlices(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactQueueSlices", reflect.TypeOf((*MockEngine)(nil).CompactQueueSlices), ctx, request)
+}
+
 // ConvertReplicationTask mocks base method.
 func (m *MockEngine) ConvertReplicationTask(ctx context.Context, task tasks.Task) (*v13.ReplicationTask, error) {
 	m.ctrl.T.Helper()
@@ -146,6 +161,21 @@ func (mr *MockEngineMockRecorder) DescribeWorkflowExecution(ctx, request interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).DescribeWorkflowExecution), ctx, request)
 }
 
+// DescribeWorkflowUpdates mocks base method.
+func (m *MockEngine) DescribeWorkflowUpdates(ctx context.Context, request *v12.DescribeWorkflowUpdatesRequest) (*v12.DescribeWorkflowUpdatesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeWorkflowUpdates", ctx, request)
+	ret0, _ := ret[0].(*v12.DescribeWorkflowUpdatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorkflowUpdates indicates an expected call of DescribeWorkflowUpdates.
+func (mr *MockEngineMockRecorder) DescribeWorkflowUpdates(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowUpdates", reflect.TypeOf((*MockEngine)(nil).DescribeWorkflowUpdates), ctx, request)
+}
+
 // ExecuteMultiOperation mocks base method.
 func (m *MockEngine) ExecuteMultiOperation(ctx context.Context, request *v12.ExecuteMultiOperationRequest) (*v12.ExecuteMultiOperationResponse, error) {
 	m.ctrl.T.Helper()
@@ -513,6 +543,21 @@ func (mr *MockEngineMockRecorder) RebuildMutableState(ctx, namespaceUUID, execut
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockEngine)(nil).RebuildMutableState), ctx, namespaceUUID, execution)
 }
 
+// RecomputeMutableStateChecksum mocks base method.
+func (m *MockEngine) RecomputeMutableStateChecksum(ctx context.Context, request *v12.RecomputeMutableStateChecksumRequest) (*v12.RecomputeMutableStateChecksumResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecomputeMutableStateChecksum", ctx, request)
+	ret0, _ := ret[0].(*v12.RecomputeMutableStateChecksumResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecomputeMutableStateChecksum indicates an expected call of RecomputeMutableStateChecksum.
+func (mr *MockEngineMockRecorder) RecomputeMutableStateChecksum(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecomputeMutableStateChecksum", reflect.TypeOf((*MockEngine)(nil).RecomputeMutableStateChecksum), ctx, request)
+}
+
 // RecordActivityTaskHeartbeat mocks base method.
 func (m *MockEngine) RecordActivityTaskHeartbeat(ctx context.Context, request *v12.RecordActivityTaskHeartbeatRequest) (*v12.RecordActivityTaskHeartbeatResponse, error) {
 	m.ctrl.T.Helper()
@@ -27,6 +27,7 @@ package history
 import (
 	"context"
 	"fmt"
+	"math/rand"
 
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
@@ -364,6 +365,7 @@ func (t *timerQueueActiveTaskExecutor) executeWorkflowTaskTimeoutTask(
 			operationMetricsTag,
 			enumspb.TIMEOUT_TYPE_START_TO_CLOSE,
 		)
+		t.maybeLogWorkflowTaskTimeout(mutableState, workflowTask, enumspb.TIMEOUT_TYPE_START_TO_CLOSE)
 		if _, err := mutableState.AddWorkflowTaskTimedOutEvent(
 			workflowTask,
 		); err != nil {
@@ -382,6 +384,7 @@ func (t *timerQueueActiveTaskExecutor) executeWorkflowTaskTimeoutTask(
 			operationMetricsTag,
 			enumspb.TIMEOUT_TYPE_SCHEDULE_TO_START,
 		)
+		t.maybeLogWorkflowTaskTimeout(mutableState, workflowTask, enumspb.TIMEOUT_TYPE_SCHEDULE_TO_START)
 		_, err := mutableState.AddWorkflowTaskScheduleToStartTimeoutEvent(workflowTask)
 		if err != nil {
 			return err
@@ -783,6 +786,29 @@ func (t *timerQueueActiveTaskExecutor) updateWorkflowExecution(
 	return context.UpdateWorkflowExecutionAsActive(ctx, t.shardContext)
 }
 
+// maybeLogWorkflowTaskTimeout logs the workflow task timeout, sampled by the namespace's WorkflowTaskTimeoutLogRate.
+func (t *timerQueueActiveTaskExecutor) maybeLogWorkflowTaskTimeout(
+	mutableState workflow.MutableState,
+	workflowTask *workflow.WorkflowTaskInfo,
+	timeoutType enumspb.TimeoutType,
+) {
+	namespaceEntry := mutableState.GetNamespaceEntry()
+	if rand.Float64() >= t.config.WorkflowTaskTimeoutLogRate(namespaceEntry.Name().String()) {
+		return
+	}
+	executionInfo := mutableState.GetExecutionInfo()
+	t.logger.Info("Workflow task timed out",
+		tag.WorkflowNamespaceID(namespaceEntry.ID().String()),
+		tag.WorkflowNamespace(namespaceEntry.Name().String()),
+		tag.WorkflowID(executionInfo.WorkflowId),
+		tag.WorkflowRunID(mutableState.GetExecutionState().RunId),
+		tag.WorkflowTaskQueueName(workflowTask.TaskQueue.GetName()),
+		tag.WorkflowScheduledEventID(workflowTask.ScheduledEventID),
+		tag.Attempt(workflowTask.Attempt),
+		tag.WorkflowTimeoutType(timeoutType),
+	)
+}
+
 func (t *timerQueueActiveTaskExecutor) emitTimeoutMetricScopeWithNamespaceTag(
 	namespaceID namespace.ID,
 	operation string,
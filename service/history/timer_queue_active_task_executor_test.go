@@ -50,7 +50,9 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
@@ -1137,6 +1139,52 @@ func (s *timerQueueActiveTaskExecutorSuite) TestWorkflowTaskTimeout_Fire() {
 	s.Equal(int32(2), workflowTask.Attempt)
 }
 
+func (s *timerQueueActiveTaskExecutorSuite) TestWorkflowTaskTimeout_LogSampling() {
+	mutableState := workflow.NewMockMutableState(s.controller)
+	mutableState.EXPECT().GetNamespaceEntry().Return(s.namespaceEntry).AnyTimes()
+	mutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{WorkflowId: "some random workflow ID"}).AnyTimes()
+	mutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{RunId: uuid.New()}).AnyTimes()
+	workflowTask := &workflow.WorkflowTaskInfo{
+		ScheduledEventID: 2,
+		TaskQueue:        &taskqueuepb.TaskQueue{Name: "some random task queue"},
+		Attempt:          3,
+	}
+
+	const numTimeouts = 1000
+	for _, tc := range []struct {
+		name       string
+		rate       float64
+		minSampled int
+		maxSampled int
+	}{
+		{name: "disabled", rate: 0, minSampled: 0, maxSampled: 0},
+		{name: "sampled", rate: 0.5, minSampled: 350, maxSampled: 650},
+		{name: "all", rate: 1, minSampled: numTimeouts, maxSampled: numTimeouts},
+	} {
+		s.Run(tc.name, func() {
+			logger := log.NewMockLogger(s.controller)
+			sampled := 0
+			logger.EXPECT().Info("Workflow task timed out", gomock.Any()).Do(func(_ string, tags ...tag.Tag) {
+				sampled++
+			}).AnyTimes()
+			config := tests.NewDynamicConfig()
+			config.WorkflowTaskTimeoutLogRate = dynamicconfig.GetFloatPropertyFnFilteredByNamespace(tc.rate)
+			executor := &timerQueueActiveTaskExecutor{
+				timerQueueTaskExecutorBase: &timerQueueTaskExecutorBase{
+					stateMachineEnvironment: stateMachineEnvironment{logger: logger},
+					config:                  config,
+				},
+			}
+
+			for i := 0; i < numTimeouts; i++ {
+				executor.maybeLogWorkflowTaskTimeout(mutableState, workflowTask, enumspb.TIMEOUT_TYPE_START_TO_CLOSE)
+			}
+			s.GreaterOrEqual(sampled, tc.minSampled)
+			s.LessOrEqual(sampled, tc.maxSampled)
+		})
+	}
+}
+
 func (s *timerQueueActiveTaskExecutorSuite) TestWorkflowTaskTimeout_Noop() {
 	execution := &commonpb.WorkflowExecution{
 		WorkflowId: "some random workflow ID",
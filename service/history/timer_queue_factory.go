@@ -184,6 +184,7 @@ func (f *timerQueueFactory) CreateQueue(
 			shard.GetPayloadSerializer(),
 			f.Config.StandbyTaskReReplicationContextTimeout,
 			logger,
+			f.MetricsHandler,
 			f.Config,
 		),
 		f.MatchingRawClient,
@@ -223,6 +224,7 @@ func (f *timerQueueFactory) CreateQueue(
 		f.Config.TaskDLQUnexpectedErrorAttempts,
 		f.Config.TaskDLQInternalErrors,
 		f.Config.TaskDLQErrorPattern,
+		f.Config.TaskTypeDLQErrorPattern,
 	)
 	return queues.NewScheduledQueue(
 		shard,
@@ -240,6 +242,10 @@ func (f *timerQueueFactory) CreateQueue(
 				PendingTasksCriticalCount:   f.Config.QueuePendingTaskCriticalCount,
 				ReaderStuckCriticalAttempts: f.Config.QueueReaderStuckCriticalAttempts,
 				SliceCountCriticalThreshold: f.Config.QueueCriticalSlicesCount,
+				PendingTasksPerNamespaceCriticalCount: NewNamespacePendingTaskCriticalCountFn(
+					shard.GetNamespaceRegistry(),
+					f.Config.QueuePendingTaskPerNamespaceCriticalCount,
+				),
 			},
 			MaxPollRPS:                          f.Config.TimerProcessorMaxPollRPS,
 			MaxPollInterval:                     f.Config.TimerProcessorMaxPollInterval,
@@ -247,6 +253,7 @@ func (f *timerQueueFactory) CreateQueue(
 			CheckpointInterval:                  f.Config.TimerProcessorUpdateAckInterval,
 			CheckpointIntervalJitterCoefficient: f.Config.TimerProcessorUpdateAckIntervalJitterCoefficient,
 			MaxReaderCount:                      f.Config.TimerQueueMaxReaderCount,
+			StartupJitter:                       f.Config.ProcessorStartupJitter,
 		},
 		f.HostReaderRateLimiter,
 		logger,
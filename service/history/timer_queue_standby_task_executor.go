@@ -178,8 +178,9 @@ func (t *timerQueueStandbyTaskExecutor) executeUserTimerTimeoutTask(
 		getStandbyPostActionFn(
 			timerTask,
 			t.getCurrentTime,
-			t.config.StandbyTaskMissingEventsResendDelay(timerTask.GetType()),
-			t.config.StandbyTaskMissingEventsDiscardDelay(timerTask.GetType()),
+			t.config,
+			t.registry,
+			t.metricHandler,
 			t.fetchHistoryFromRemote,
 			standbyTimerTaskPostActionTaskDiscarded,
 		),
@@ -285,8 +286,9 @@ func (t *timerQueueStandbyTaskExecutor) executeActivityTimeoutTask(
 		getStandbyPostActionFn(
 			timerTask,
 			t.getCurrentTime,
-			t.config.StandbyTaskMissingEventsResendDelay(timerTask.GetType()),
-			t.config.StandbyTaskMissingEventsDiscardDelay(timerTask.GetType()),
+			t.config,
+			t.registry,
+			t.metricHandler,
 			t.fetchHistoryFromRemote,
 			standbyTimerTaskPostActionTaskDiscarded,
 		),
@@ -331,8 +333,9 @@ func (t *timerQueueStandbyTaskExecutor) executeActivityRetryTimerTask(
 		getStandbyPostActionFn(
 			task,
 			t.getCurrentTime,
-			t.config.StandbyTaskMissingEventsResendDelay(task.GetType()),
-			t.config.StandbyTaskMissingEventsDiscardDelay(task.GetType()),
+			t.config,
+			t.registry,
+			t.metricHandler,
 			t.fetchHistoryFromRemote,
 			t.pushActivity,
 		),
@@ -376,8 +379,9 @@ func (t *timerQueueStandbyTaskExecutor) executeWorkflowTaskTimeoutTask(
 		getStandbyPostActionFn(
 			timerTask,
 			t.getCurrentTime,
-			t.config.StandbyTaskMissingEventsResendDelay(timerTask.GetType()),
-			t.config.StandbyTaskMissingEventsDiscardDelay(timerTask.GetType()),
+			t.config,
+			t.registry,
+			t.metricHandler,
 			t.fetchHistoryFromRemote,
 			standbyTimerTaskPostActionTaskDiscarded,
 		),
@@ -420,8 +424,9 @@ func (t *timerQueueStandbyTaskExecutor) executeWorkflowBackoffTimerTask(
 		getStandbyPostActionFn(
 			timerTask,
 			t.getCurrentTime,
-			t.config.StandbyTaskMissingEventsResendDelay(timerTask.GetType()),
-			t.config.StandbyTaskMissingEventsDiscardDelay(timerTask.GetType()),
+			t.config,
+			t.registry,
+			t.metricHandler,
 			t.fetchHistoryFromRemote,
 			standbyTimerTaskPostActionTaskDiscarded,
 		),
@@ -457,8 +462,9 @@ func (t *timerQueueStandbyTaskExecutor) executeWorkflowRunTimeoutTask(
 		getStandbyPostActionFn(
 			timerTask,
 			t.getCurrentTime,
-			t.config.StandbyTaskMissingEventsResendDelay(timerTask.GetType()),
-			t.config.StandbyTaskMissingEventsDiscardDelay(timerTask.GetType()),
+			t.config,
+			t.registry,
+			t.metricHandler,
 			t.fetchHistoryFromRemote,
 			standbyTimerTaskPostActionTaskDiscarded,
 		),
@@ -494,8 +500,9 @@ func (t *timerQueueStandbyTaskExecutor) executeWorkflowExecutionTimeoutTask(
 		getStandbyPostActionFn(
 			timerTask,
 			t.getCurrentTime,
-			t.config.StandbyTaskMissingEventsResendDelay(timerTask.GetType()),
-			t.config.StandbyTaskMissingEventsDiscardDelay(timerTask.GetType()),
+			t.config,
+			t.registry,
+			t.metricHandler,
 			t.fetchHistoryFromRemote,
 			standbyTimerTaskPostActionTaskDiscarded,
 		),
@@ -556,8 +563,9 @@ func (t *timerQueueStandbyTaskExecutor) executeStateMachineTimerTask(
 		getStandbyPostActionFn(
 			timerTask,
 			t.getCurrentTime,
-			t.config.StandbyTaskMissingEventsResendDelay(timerTask.GetType()),
-			t.config.StandbyTaskMissingEventsDiscardDelay(timerTask.GetType()),
+			t.config,
+			t.registry,
+			t.metricHandler,
 			t.fetchHistoryFromRemote,
 			standbyTimerTaskPostActionTaskDiscarded,
 		),
@@ -666,6 +674,9 @@ func (t *timerQueueStandbyTaskExecutor) fetchHistoryFromRemote(
 		return consts.ErrTaskRetry
 	}
 
+	namespaceName, _ := t.registry.GetNamespaceName(namespace.ID(workflowKey.GetNamespaceID()))
+	metrics.TaskStandbyMissingEventsResend.With(t.metricsHandler).Record(1, metrics.NamespaceTag(namespaceName.String()))
+
 	// NOTE: history resend may take long time and its timeout is currently
 	// controlled by a separate dynamicconfig config: StandbyTaskReReplicationContextTimeout
 	if err = t.nDCHistoryResender.SendSingleWorkflowHistory(
@@ -180,6 +180,7 @@ func (f *transferQueueFactory) CreateQueue(
 			shard.GetPayloadSerializer(),
 			f.Config.StandbyTaskReReplicationContextTimeout,
 			logger,
+			f.MetricsHandler,
 			f.Config,
 		),
 		logger,
@@ -216,6 +217,7 @@ func (f *transferQueueFactory) CreateQueue(
 		f.Config.TaskDLQUnexpectedErrorAttempts,
 		f.Config.TaskDLQInternalErrors,
 		f.Config.TaskDLQErrorPattern,
+		f.Config.TaskTypeDLQErrorPattern,
 	)
 	return queues.NewImmediateQueue(
 		shard,
@@ -232,6 +234,10 @@ func (f *transferQueueFactory) CreateQueue(
 				PendingTasksCriticalCount:   f.Config.QueuePendingTaskCriticalCount,
 				ReaderStuckCriticalAttempts: f.Config.QueueReaderStuckCriticalAttempts,
 				SliceCountCriticalThreshold: f.Config.QueueCriticalSlicesCount,
+				PendingTasksPerNamespaceCriticalCount: NewNamespacePendingTaskCriticalCountFn(
+					shard.GetNamespaceRegistry(),
+					f.Config.QueuePendingTaskPerNamespaceCriticalCount,
+				),
 			},
 			MaxPollRPS:                          f.Config.TransferProcessorMaxPollRPS,
 			MaxPollInterval:                     f.Config.TransferProcessorMaxPollInterval,
@@ -239,6 +245,7 @@ func (f *transferQueueFactory) CreateQueue(
 			CheckpointInterval:                  f.Config.TransferProcessorUpdateAckInterval,
 			CheckpointIntervalJitterCoefficient: f.Config.TransferProcessorUpdateAckIntervalJitterCoefficient,
 			MaxReaderCount:                      f.Config.TransferQueueMaxReaderCount,
+			StartupJitter:                       f.Config.ProcessorStartupJitter,
 		},
 		f.HostReaderRateLimiter,
 		queues.GrouperNamespaceID{},
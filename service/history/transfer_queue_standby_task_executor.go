@@ -172,8 +172,9 @@ func (t *transferQueueStandbyTaskExecutor) processActivityTask(
 		getStandbyPostActionFn(
 			transferTask,
 			t.getCurrentTime,
-			t.config.StandbyTaskMissingEventsResendDelay(transferTask.GetType()),
-			t.config.StandbyTaskMissingEventsDiscardDelay(transferTask.GetType()),
+			t.config,
+			t.registry,
+			t.metricHandler,
 			t.fetchHistoryFromRemote,
 			t.pushActivity,
 		),
@@ -225,8 +226,9 @@ func (t *transferQueueStandbyTaskExecutor) processWorkflowTask(
 		getStandbyPostActionFn(
 			transferTask,
 			t.getCurrentTime,
-			t.config.StandbyTaskMissingEventsResendDelay(transferTask.GetType()),
-			t.config.StandbyTaskMissingEventsDiscardDelay(transferTask.GetType()),
+			t.config,
+			t.registry,
+			t.metricHandler,
 			t.fetchHistoryFromRemote,
 			t.pushWorkflowTask,
 		),
@@ -313,8 +315,9 @@ func (t *transferQueueStandbyTaskExecutor) processCloseExecution(
 		getStandbyPostActionFn(
 			transferTask,
 			t.getCurrentTime,
-			t.config.StandbyTaskMissingEventsResendDelay(transferTask.GetType()),
-			t.config.StandbyTaskMissingEventsDiscardDelay(transferTask.GetType()),
+			t.config,
+			t.registry,
+			t.metricHandler,
 			standbyTaskPostActionNoOp,
 			standbyTransferTaskPostActionTaskDiscarded,
 		),
@@ -348,8 +351,9 @@ func (t *transferQueueStandbyTaskExecutor) processCancelExecution(
 		getStandbyPostActionFn(
 			transferTask,
 			t.getCurrentTime,
-			t.config.StandbyTaskMissingEventsResendDelay(transferTask.GetType()),
-			t.config.StandbyTaskMissingEventsDiscardDelay(transferTask.GetType()),
+			t.config,
+			t.registry,
+			t.metricHandler,
 			t.fetchHistoryFromRemote,
 			standbyTransferTaskPostActionTaskDiscarded,
 		),
@@ -383,8 +387,9 @@ func (t *transferQueueStandbyTaskExecutor) processSignalExecution(
 		getStandbyPostActionFn(
 			transferTask,
 			t.getCurrentTime,
-			t.config.StandbyTaskMissingEventsResendDelay(transferTask.GetType()),
-			t.config.StandbyTaskMissingEventsDiscardDelay(transferTask.GetType()),
+			t.config,
+			t.registry,
+			t.metricHandler,
 			t.fetchHistoryFromRemote,
 			standbyTransferTaskPostActionTaskDiscarded,
 		),
@@ -465,8 +470,9 @@ func (t *transferQueueStandbyTaskExecutor) processStartChildExecution(
 		getStandbyPostActionFn(
 			transferTask,
 			t.getCurrentTime,
-			t.config.StandbyTaskMissingEventsResendDelay(transferTask.GetType()),
-			t.config.StandbyTaskMissingEventsDiscardDelay(transferTask.GetType()),
+			t.config,
+			t.registry,
+			t.metricHandler,
 			t.startChildExecutionResendPostAction,
 			standbyTransferTaskPostActionTaskDiscarded,
 		),
@@ -629,6 +635,9 @@ func (t *transferQueueStandbyTaskExecutor) fetchHistoryFromRemote(
 		return consts.ErrTaskRetry
 	}
 
+	namespaceName, _ := t.registry.GetNamespaceName(namespace.ID(taskInfo.GetNamespaceID()))
+	metrics.TaskStandbyMissingEventsResend.With(t.metricHandler).Record(1, metrics.NamespaceTag(namespaceName.String()))
+
 	// NOTE: history resend may take long time and its timeout is currently
 	// controlled by a separate dynamicconfig config: StandbyTaskReReplicationContextTimeout
 	if err = t.nDCHistoryResender.SendSingleWorkflowHistory(
@@ -144,6 +144,7 @@ func (f *visibilityQueueFactory) CreateQueue(
 		f.Config.TaskDLQUnexpectedErrorAttempts,
 		f.Config.TaskDLQInternalErrors,
 		f.Config.TaskDLQErrorPattern,
+		f.Config.TaskTypeDLQErrorPattern,
 	)
 	return queues.NewImmediateQueue(
 		shard,
@@ -160,6 +161,10 @@ func (f *visibilityQueueFactory) CreateQueue(
 				PendingTasksCriticalCount:   f.Config.QueuePendingTaskCriticalCount,
 				ReaderStuckCriticalAttempts: f.Config.QueueReaderStuckCriticalAttempts,
 				SliceCountCriticalThreshold: f.Config.QueueCriticalSlicesCount,
+				PendingTasksPerNamespaceCriticalCount: NewNamespacePendingTaskCriticalCountFn(
+					shard.GetNamespaceRegistry(),
+					f.Config.QueuePendingTaskPerNamespaceCriticalCount,
+				),
 			},
 			MaxPollRPS:                          f.Config.VisibilityProcessorMaxPollRPS,
 			MaxPollInterval:                     f.Config.VisibilityProcessorMaxPollInterval,
@@ -167,6 +172,7 @@ func (f *visibilityQueueFactory) CreateQueue(
 			CheckpointInterval:                  f.Config.VisibilityProcessorUpdateAckInterval,
 			CheckpointIntervalJitterCoefficient: f.Config.VisibilityProcessorUpdateAckIntervalJitterCoefficient,
 			MaxReaderCount:                      f.Config.VisibilityQueueMaxReaderCount,
+			StartupJitter:                       f.Config.ProcessorStartupJitter,
 		},
 		f.HostReaderRateLimiter,
 		queues.GrouperNamespaceID{},
@@ -123,6 +123,7 @@ func NewHostLevelCache(
 		config.HistoryCacheTTL(),
 		config.HistoryCacheNonUserContextLockTimeout(),
 		handler,
+		metrics.HostCacheLevelTagValue,
 	)
 }
 
@@ -139,6 +140,7 @@ func NewShardLevelCache(
 		config.HistoryCacheTTL(),
 		config.HistoryCacheNonUserContextLockTimeout(),
 		handler,
+		metrics.ShardCacheLevelTagValue,
 	)
 }
 
@@ -147,10 +149,12 @@ func newCache(
 	ttl time.Duration,
 	nonUserContextLockTimeout time.Duration,
 	handler metrics.Handler,
+	cacheLevel string,
 ) Cache {
 	opts := &cache.Options{}
 	opts.TTL = ttl
 	opts.Pin = true
+	opts.Level = cacheLevel
 
 	return &CacheImpl{
 		Cache:                     cache.NewWithMetrics(size, opts, handler.WithTags(metrics.CacheTypeTag(metrics.MutableStateCacheTypeTagValue))),
@@ -38,7 +38,7 @@ const (
 	mutableStateChecksumPayloadV1 = int32(1)
 )
 
-func generateMutableStateChecksum(ms MutableState) (*persistencespb.Checksum, error) {
+func GenerateMutableStateChecksum(ms MutableState) (*persistencespb.Checksum, error) {
 	payload := newMutableStateChecksumPayload(ms)
 	csum, err := checksum.GenerateCRC32(payload, mutableStateChecksumPayloadV1)
 	if err != nil {
@@ -1047,7 +1047,7 @@ func (c *ContextImpl) maxHistoryCountExceeded(shardContext shard.Context) bool {
 // Returns true if execution is forced terminated
 // TODO: ideally this check should be after closing mutable state tx, but that would require a large refactor
 func (c *ContextImpl) enforceMutableStateSizeCheck(ctx context.Context, shardContext shard.Context) (bool, error) {
-	if c.maxMutableStateSizeExceeded() {
+	if c.maxMutableStateSizeExceeded(shardContext) {
 		if err := c.forceTerminateWorkflow(ctx, shardContext, common.FailureReasonMutableStateSizeExceedsLimit); err != nil {
 			return false, err
 		}
@@ -1059,13 +1059,19 @@ func (c *ContextImpl) enforceMutableStateSizeCheck(ctx context.Context, shardCon
 
 // Returns true if the workflow is running and mutable state size should trigger a forced termination
 // Prints a log message if mutable state size is over the error or warn limits
-func (c *ContextImpl) maxMutableStateSizeExceeded() bool {
-	mutableStateSizeLimitError := c.config.MutableStateSizeLimitError()
+func (c *ContextImpl) maxMutableStateSizeExceeded(shardContext shard.Context) bool {
+	namespaceName := c.GetNamespace(shardContext).String()
+	mutableStateSizeLimitError := c.config.MutableStateSizeLimitError(namespaceName)
 	mutableStateSizeLimitWarn := c.config.MutableStateSizeLimitWarn()
 
 	mutableStateSize := c.MutableState.GetApproximatePersistedSize()
 	metrics.PersistedMutableStateSize.With(c.metricsHandler).Record(int64(mutableStateSize))
 
+	// namespace override allows this execution to grow beyond the global default
+	if mutableStateSize > c.config.MutableStateSizeLimitError("") && mutableStateSize <= mutableStateSizeLimitError {
+		metrics.MutableStateSizeOverrideCounter.With(c.metricsHandler).Record(1, metrics.NamespaceTag(namespaceName))
+	}
+
 	if mutableStateSize > mutableStateSizeLimitError {
 		c.logger.Warn("mutable state size exceeds error limit.",
 			tag.WorkflowNamespaceID(c.workflowKey.NamespaceID),
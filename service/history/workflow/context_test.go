@@ -28,6 +28,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -38,7 +39,10 @@ import (
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/tests"
 )
@@ -358,3 +362,50 @@ func (s *contextSuite) TestMergeReplicationTasks_OnlyNewRunHasReplicationTasks()
 	s.Empty(currentWorkflowMutation.Tasks)                         // verify no change to tasks
 	s.Len(newWorkflowSnapshot.Tasks[tasks.CategoryReplication], 1) // verify no change to tasks
 }
+
+func (s *contextSuite) TestMaxMutableStateSizeExceeded_NamespaceOverride() {
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+
+	mockNamespaceRegistry := namespace.NewMockRegistry(controller)
+	mockNamespaceRegistry.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.LocalNamespaceEntry, nil).AnyTimes()
+	mockShard := shard.NewMockContext(controller)
+	mockShard.EXPECT().GetNamespaceRegistry().Return(mockNamespaceRegistry).AnyTimes()
+
+	config := tests.NewDynamicConfig()
+	config.MutableStateSizeLimitError = func(namespaceName string) int {
+		if namespaceName == tests.Namespace.String() {
+			return 2000
+		}
+		return 1000
+	}
+	config.MutableStateSizeLimitWarn = func() int { return 10000 }
+
+	capture := metricstest.NewCaptureHandler()
+	recording := capture.StartCapture()
+	defer capture.StopCapture(recording)
+
+	workflowContext := NewContext(
+		config,
+		tests.WorkflowKey,
+		log.NewNoopLogger(),
+		log.NewNoopLogger(),
+		capture,
+	)
+	mockMutableState := NewMockMutableState(controller)
+	workflowContext.MutableState = mockMutableState
+
+	mockMutableState.EXPECT().GetApproximatePersistedSize().Return(500)
+	s.False(workflowContext.maxMutableStateSizeExceeded(mockShard))
+	s.Empty(recording.Snapshot()[metrics.MutableStateSizeOverrideCounter.Name()])
+
+	mockMutableState.EXPECT().GetApproximatePersistedSize().Return(1500)
+	s.False(workflowContext.maxMutableStateSizeExceeded(mockShard))
+	overrides := recording.Snapshot()[metrics.MutableStateSizeOverrideCounter.Name()]
+	s.Len(overrides, 1)
+	s.Equal(tests.Namespace.String(), overrides[0].Tags[metrics.NamespaceTag("").Key()])
+
+	mockMutableState.EXPECT().GetApproximatePersistedSize().Return(2500)
+	s.True(workflowContext.maxMutableStateSizeExceeded(mockShard))
+	s.Len(recording.Snapshot()[metrics.MutableStateSizeOverrideCounter.Name()], 1)
+}
@@ -96,6 +96,10 @@ const (
 	mutableStateInvalidHistoryActionMsgTemplate = mutableStateInvalidHistoryActionMsg + ": %v, %v"
 
 	int64SizeBytes = 8
+
+	// Valid values of dynamicconfig.BufferedEventsOverflowBehavior.
+	bufferedEventsOverflowForceWorkflowTask = "force-workflow-task"
+	bufferedEventsOverflowReject            = "reject"
 )
 
 var (
@@ -4064,15 +4068,36 @@ func (ms *MutableStateImpl) AddWorkflowExecutionTerminatedEvent(
 	return event, nil
 }
 
+// updateCountSuggestContinueAsNewLimit returns the total number of updates at which continue-as-new is suggested,
+// the configured fraction of WorkflowExecutionMaxTotalUpdates. It returns 0 if the suggestion is disabled.
+func (ms *MutableStateImpl) updateCountSuggestContinueAsNewLimit() int64 {
+	namespaceName := ms.namespaceEntry.Name().String()
+	maxTotalUpdates := ms.config.WorkflowExecutionMaxTotalUpdates(namespaceName)
+	threshold := ms.config.WorkflowExecutionMaxTotalUpdatesSuggestContinueAsNewThreshold(namespaceName)
+	return max(int64(float64(maxTotalUpdates)*threshold), 0)
+}
+
+// recordUpdateCountSuggestContinueAsNew emits UpdateCountSuggestContinueAsNew once, when an update brings the
+// update count to the limit at which continue-as-new is suggested.
+func (ms *MutableStateImpl) recordUpdateCountSuggestContinueAsNew(updateCountBefore int64) {
+	updateCountLimit := ms.updateCountSuggestContinueAsNewLimit()
+	if updateCountLimit > 0 && updateCountBefore < updateCountLimit && ms.executionInfo.GetUpdateCount() >= updateCountLimit {
+		metrics.UpdateCountSuggestContinueAsNew.With(ms.metricsHandler).
+			Record(1, metrics.NamespaceTag(ms.namespaceEntry.Name().String()))
+	}
+}
+
 // AddWorkflowExecutionUpdateAdmittedEvent adds a WorkflowExecutionUpdateAdmittedEvent to in-memory history.
 func (ms *MutableStateImpl) AddWorkflowExecutionUpdateAdmittedEvent(request *updatepb.Request, origin enumspb.UpdateAdmittedEventOrigin) (*historypb.HistoryEvent, error) {
 	if err := ms.checkMutability(tag.WorkflowActionUpdateAdmitted); err != nil {
 		return nil, err
 	}
+	updateCountBefore := ms.executionInfo.GetUpdateCount()
 	event, batchId := ms.hBuilder.AddWorkflowExecutionUpdateAdmittedEvent(request, origin)
 	if err := ms.ApplyWorkflowExecutionUpdateAdmittedEvent(event, batchId); err != nil {
 		return nil, err
 	}
+	ms.recordUpdateCountSuggestContinueAsNew(updateCountBefore)
 	return event, nil
 }
 
@@ -4117,10 +4142,12 @@ func (ms *MutableStateImpl) AddWorkflowExecutionUpdateAcceptedEvent(
 	if err := ms.checkMutability(tag.WorkflowActionUpdateAccepted); err != nil {
 		return nil, err
 	}
+	updateCountBefore := ms.executionInfo.GetUpdateCount()
 	event := ms.hBuilder.AddWorkflowExecutionUpdateAcceptedEvent(protocolInstanceID, acceptedRequestMessageId, acceptedRequestSequencingEventId, acceptedRequest)
 	if err := ms.ApplyWorkflowExecutionUpdateAcceptedEvent(event); err != nil {
 		return nil, err
 	}
+	ms.recordUpdateCountSuggestContinueAsNew(updateCountBefore)
 	return event, nil
 }
 
@@ -5868,6 +5895,15 @@ func (ms *MutableStateImpl) closeTransactionHandleBufferedEventsLimit(
 
 	// Handling buffered events size issue
 	if workflowTask := ms.GetStartedWorkflowTask(); workflowTask != nil {
+		namespaceName := ms.GetNamespaceEntry().Name().String()
+		if ms.config.BufferedEventsOverflowBehavior(namespaceName) == bufferedEventsOverflowReject {
+			metrics.BufferedEventsLimitRejected.With(ms.metricsHandler).
+				Record(1, metrics.NamespaceTag(namespaceName))
+			return consts.ErrBufferedEventsLimitExceeded
+		}
+		metrics.BufferedEventsLimitForcedWorkflowTask.With(ms.metricsHandler).
+			Record(1, metrics.NamespaceTag(namespaceName))
+
 		// we have a workflow task on the fly with a lower version, fail it
 		if _, err := failWorkflowTask(
 			ms,
@@ -6013,7 +6049,7 @@ func (ms *MutableStateImpl) generateChecksum() *persistencespb.Checksum {
 	if !ms.shouldGenerateChecksum() {
 		return nil
 	}
-	csum, err := generateMutableStateChecksum(ms)
+	csum, err := GenerateMutableStateChecksum(ms)
 	if err != nil {
 		ms.logWarn("error generating MutableState checksum", tag.Error(err))
 		return nil
@@ -61,6 +61,7 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence/versionhistory"
@@ -70,6 +71,7 @@ import (
 	"go.temporal.io/server/common/tqid"
 	"go.temporal.io/server/common/worker_versioning"
 	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/history/historybuilder"
 	"go.temporal.io/server/service/history/hsm"
@@ -1375,6 +1377,181 @@ func (s *mutableStateSuite) TestTotalEntitiesCount() {
 	s.Equal(int64(1), mutation.ExecutionInfo.UpdateCount)
 }
 
+func (s *mutableStateSuite) TestCloseTransactionHandleBufferedEventsLimit() {
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+	s.mockConfig.MaximumBufferedEventsBatch = dynamicconfig.GetIntPropertyFn(1)
+
+	counterValue := func(name string) int64 {
+		var value int64
+		for _, counter := range s.testScope.Snapshot().Counters() {
+			if counter.Name() == "test."+name {
+				value += counter.Value()
+			}
+		}
+		return value
+	}
+
+	testCases := []struct {
+		name            string
+		behavior        string
+		expectedErr     error
+		expectedCounter string
+	}{
+		{
+			name:            "ForceWorkflowTask",
+			behavior:        bufferedEventsOverflowForceWorkflowTask,
+			expectedCounter: metrics.BufferedEventsLimitForcedWorkflowTask.Name(),
+		},
+		{
+			name:            "UnknownBehaviorForcesWorkflowTask",
+			behavior:        "unknown",
+			expectedCounter: metrics.BufferedEventsLimitForcedWorkflowTask.Name(),
+		},
+		{
+			name:            "Reject",
+			behavior:        bufferedEventsOverflowReject,
+			expectedErr:     consts.ErrBufferedEventsLimitExceeded,
+			expectedCounter: metrics.BufferedEventsLimitRejected.Name(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.mockConfig.BufferedEventsOverflowBehavior = dynamicconfig.GetStringPropertyFnFilteredByNamespace(tc.behavior)
+			s.mutableState = TestGlobalMutableState(
+				s.mockShard,
+				s.mockEventsCache,
+				s.logger,
+				s.namespaceEntry.FailoverVersion(),
+				tests.WorkflowID,
+				uuid.New(),
+			)
+
+			wft, err := s.mutableState.AddWorkflowTaskScheduledEvent(false, enumsspb.WORKFLOW_TASK_TYPE_NORMAL)
+			s.NoError(err)
+			_, wft, err = s.mutableState.AddWorkflowTaskStartedEvent(
+				wft.ScheduledEventID,
+				"",
+				&taskqueuepb.TaskQueue{Name: "tq"},
+				"",
+				nil,
+				nil,
+				false,
+			)
+			s.NoError(err)
+			// persist the started workflow task, so the version history has an item when the overflow is handled
+			_, _, err = s.mutableState.CloseTransactionAsMutation(TransactionPolicyActive)
+			s.NoError(err)
+
+			// exceed the buffered events batch cap while the workflow task is in flight
+			for i := 0; i < 2; i++ {
+				_, err = s.mutableState.AddWorkflowExecutionSignaled(
+					"signalName",
+					&commonpb.Payloads{},
+					"identity",
+					&commonpb.Header{},
+					false,
+				)
+				s.NoError(err)
+			}
+			s.Equal(2, s.mutableState.hBuilder.NumBufferedEvents())
+
+			counterBefore := counterValue(tc.expectedCounter)
+			mutation, _, err := s.mutableState.CloseTransactionAsMutation(TransactionPolicyActive)
+			s.Equal(counterBefore+1, counterValue(tc.expectedCounter))
+
+			if tc.expectedErr != nil {
+				s.ErrorIs(err, tc.expectedErr)
+				// in-flight workflow task is left running
+				s.Equal(wft.StartedEventID, s.mutableState.GetExecutionInfo().WorkflowTaskStartedEventId)
+				return
+			}
+			s.NoError(err)
+			// in-flight workflow task is failed and a new one is scheduled
+			s.NotEqual(wft.ScheduledEventID, mutation.ExecutionInfo.WorkflowTaskScheduledEventId)
+			s.NotEqual(common.EmptyEventID, mutation.ExecutionInfo.WorkflowTaskScheduledEventId)
+			s.Equal(common.EmptyEventID, mutation.ExecutionInfo.WorkflowTaskStartedEventId)
+		})
+	}
+}
+
+func (s *mutableStateSuite) TestUpdateCountSuggestContinueAsNew() {
+	s.mockConfig.WorkflowExecutionMaxTotalUpdates = dynamicconfig.GetIntPropertyFnFilteredByNamespace(10)
+	s.mockConfig.WorkflowExecutionMaxTotalUpdatesSuggestContinueAsNewThreshold = dynamicconfig.GetFloatPropertyFnFilteredByNamespace(0.5)
+
+	counterValue := func() int64 {
+		var value int64
+		for _, counter := range s.testScope.Snapshot().Counters() {
+			if counter.Name() == "test."+metrics.UpdateCountSuggestContinueAsNew.Name() {
+				value += counter.Value()
+			}
+		}
+		return value
+	}
+
+	s.mutableState = TestGlobalMutableState(
+		s.mockShard,
+		s.mockEventsCache,
+		s.logger,
+		s.namespaceEntry.FailoverVersion(),
+		tests.WorkflowID,
+		uuid.New(),
+	)
+	s.mutableState.GetExecutionInfo().UpdateCount = 3
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+
+	startWorkflowTask := func() bool {
+		wft, err := s.mutableState.AddWorkflowTaskScheduledEvent(false, enumsspb.WORKFLOW_TASK_TYPE_NORMAL)
+		s.NoError(err)
+		event, wft, err := s.mutableState.AddWorkflowTaskStartedEvent(
+			wft.ScheduledEventID,
+			"",
+			&taskqueuepb.TaskQueue{Name: "tq"},
+			"",
+			nil,
+			nil,
+			false,
+		)
+		s.NoError(err)
+		s.Equal(wft.SuggestContinueAsNew, event.GetWorkflowTaskStartedEventAttributes().GetSuggestContinueAsNew())
+		return wft.SuggestContinueAsNew
+	}
+	acceptUpdate := func(updateID string) {
+		_, err := s.mutableState.AddWorkflowExecutionUpdateAcceptedEvent(
+			updateID,
+			updateID+"-msg",
+			1,
+			&updatepb.Request{Meta: &updatepb.Meta{UpdateId: updateID}},
+		)
+		s.NoError(err)
+	}
+
+	// below the threshold
+	counterBefore := counterValue()
+	s.False(startWorkflowTask())
+	acceptUpdate("update-4")
+	s.Equal(counterBefore, counterValue())
+
+	// the update that reaches the threshold emits the metric once
+	acceptUpdate("update-5")
+	s.Equal(counterBefore+1, counterValue())
+	acceptUpdate("update-6")
+	s.Equal(counterBefore+1, counterValue())
+
+	// every workflow task from then on suggests continue-as-new without emitting the metric again
+	_, err := s.mutableState.AddWorkflowTaskCompletedEvent(
+		s.mutableState.GetStartedWorkflowTask(),
+		&workflowservice.RespondWorkflowTaskCompletedRequest{},
+		WorkflowTaskCompletionLimits{
+			MaxResetPoints:              10,
+			MaxSearchAttributeValueSize: 1024,
+		},
+	)
+	s.NoError(err)
+	s.True(startWorkflowTask())
+	s.Equal(counterBefore+1, counterValue())
+}
+
 func (s *mutableStateSuite) TestSpeculativeWorkflowTaskNotPersisted() {
 	testCases := []struct {
 		name                 string
@@ -343,7 +343,9 @@ func (r *TaskGeneratorImpl) GenerateDeleteHistoryEventTask(closeTime time.Time)
 		return err
 	}
 
-	retentionJitterDuration := backoff.FullJitter(r.config.RetentionTimerJitterDuration())
+	retentionJitterDuration := backoff.FullJitter(
+		r.config.RetentionTimerJitterDuration(r.mutableState.GetNamespaceEntry().Name().String()),
+	)
 	deleteTime := closeTime.Add(retention).Add(retentionJitterDuration)
 	r.mutableState.AddTasks(&tasks.DeleteHistoryEventTask{
 		// TaskID is set by shard
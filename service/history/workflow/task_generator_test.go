@@ -250,9 +250,7 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 			mutableState.EXPECT().GetCurrentBranchToken().Return(nil, nil).AnyTimes()
 			retentionTimerDelay := time.Second
 			cfg := &configs.Config{
-				RetentionTimerJitterDuration: func() time.Duration {
-					return retentionTimerDelay
-				},
+				RetentionTimerJitterDuration: dynamicconfig.GetDurationPropertyFnFilteredByNamespace(retentionTimerDelay),
 				ArchivalProcessorArchiveDelay: func() time.Duration {
 					return p.ArchivalProcessorArchiveDelay
 				},
@@ -330,6 +328,59 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 	}
 }
 
+func TestTaskGeneratorImpl_GenerateDeleteHistoryEventTask_NamespaceJitter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	retention := 24 * time.Hour
+	namespaceJitter := time.Minute
+	namespaceEntry := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: tests.NamespaceID.String(), Name: tests.Namespace.String()},
+		&persistencespb.NamespaceConfig{Retention: durationpb.New(retention)},
+		cluster.TestCurrentClusterName,
+	)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	namespaceRegistry.EXPECT().GetNamespaceByID(namespaceEntry.ID()).Return(namespaceEntry, nil).AnyTimes()
+
+	mutableState := NewMockMutableState(ctrl)
+	mutableState.EXPECT().GetNamespaceEntry().Return(namespaceEntry).AnyTimes()
+	mutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+		NamespaceId: namespaceEntry.ID().String(),
+	}).AnyTimes()
+	mutableState.EXPECT().GetWorkflowKey().Return(definition.NewWorkflowKey(
+		namespaceEntry.ID().String(), tests.WorkflowID, tests.RunID,
+	)).AnyTimes()
+	mutableState.EXPECT().GetCloseVersion().Return(int64(0), nil).AnyTimes()
+	mutableState.EXPECT().GetCurrentBranchToken().Return(nil, nil).AnyTimes()
+	var deleteTasks []*tasks.DeleteHistoryEventTask
+	mutableState.EXPECT().AddTasks(gomock.Any()).Do(func(ts ...tasks.Task) {
+		for _, task := range ts {
+			deleteTasks = append(deleteTasks, task.(*tasks.DeleteHistoryEventTask))
+		}
+	}).AnyTimes()
+
+	cfg := &configs.Config{
+		// The global value is far larger than the override so a fallback would be caught by the
+		// upper bound check below.
+		RetentionTimerJitterDuration: func(namespaceName string) time.Duration {
+			if namespaceName == tests.Namespace.String() {
+				return namespaceJitter
+			}
+			return 30 * 24 * time.Hour
+		},
+	}
+	taskGenerator := NewTaskGenerator(namespaceRegistry, mutableState, cfg, nil)
+
+	closeTime := time.Unix(0, 0)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, taskGenerator.GenerateDeleteHistoryEventTask(closeTime))
+	}
+	require.Len(t, deleteTasks, 100)
+	for _, task := range deleteTasks {
+		assert.False(t, task.VisibilityTimestamp.Before(closeTime.Add(retention)))
+		assert.True(t, task.VisibilityTimestamp.Before(closeTime.Add(retention).Add(namespaceJitter)))
+	}
+}
+
 func TestTaskGenerator_GenerateDirtySubStateMachineTasks(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	namespaceRegistry := namespace.NewMockRegistry(ctrl)
@@ -94,6 +94,16 @@ type (
 		// Len observes the number of incomplete updates in this Registry.
 		Len() int
 
+		// TotalLen observes the number of incomplete and completed updates of the
+		// workflow execution, as counted against the total updates limit.
+		TotalLen() int
+
+		// MaxInFlight returns the limit on the number of incomplete updates.
+		MaxInFlight() int
+
+		// MaxTotal returns the limit on the total number of updates.
+		MaxTotal() int
+
 		// GetSize returns the size of the update object
 		GetSize() int
 
@@ -366,6 +376,18 @@ func (r *registry) Len() int {
 	return len(r.updates)
 }
 
+func (r *registry) TotalLen() int {
+	return len(r.updates) + r.completedCount
+}
+
+func (r *registry) MaxInFlight() int {
+	return r.maxInFlight()
+}
+
+func (r *registry) MaxTotal() int {
+	return r.maxTotal()
+}
+
 func (r *registry) remover(id string) updateOpt {
 	return withCompletionCallback(
 		func() {
@@ -388,7 +410,7 @@ func (r *registry) checkLimits() error {
 }
 
 func (r *registry) checkTotalLimit() error {
-	if len(r.updates)+r.completedCount >= r.maxTotal() {
+	if r.TotalLen() >= r.maxTotal() {
 		r.instrumentation.countTooMany()
 		return serviceerror.NewFailedPrecondition(
 			fmt.Sprintf("limit on number of total updates has been reached (%v)", r.maxTotal()),
@@ -267,6 +267,7 @@ func TestFindOrCreate(t *testing.T) {
 		require.NoError(t, err, "creating update #1 should have beeen allowed")
 		require.False(t, existed)
 		require.Equal(t, 1, reg.Len())
+		require.Equal(t, limit, reg.MaxInFlight())
 
 		t.Run("deny new update since it is exceeding the limit", func(t *testing.T) {
 			_, _, err = reg.FindOrCreate(context.Background(), tv.UpdateID("2"))
@@ -334,6 +335,7 @@ func TestFindOrCreate(t *testing.T) {
 		require.NoError(t, err, "creating update #1 should have beeen allowed")
 		require.False(t, existed)
 		require.Equal(t, 1, reg.Len())
+		require.Equal(t, limit, reg.MaxTotal())
 
 		t.Run("deny new update since it is exceeding the limit", func(t *testing.T) {
 			_, _, err = reg.FindOrCreate(context.Background(), tv.UpdateID("2"))
@@ -350,6 +352,7 @@ func TestFindOrCreate(t *testing.T) {
 			var failedPrecon *serviceerror.FailedPrecondition
 			require.ErrorAs(t, err, &failedPrecon)
 			require.Equal(t, 0, reg.Len())
+			require.Equal(t, 1, reg.TotalLen(), "completed update should still count towards the total")
 		})
 
 		t.Run("increasing limit allows new updated to be created", func(t *testing.T) {
@@ -359,6 +362,7 @@ func TestFindOrCreate(t *testing.T) {
 			require.NoError(t, err, "update #2 should be created after the limit increase")
 			require.False(t, existed)
 			require.Equal(t, 1, reg.Len())
+			require.Equal(t, 2, reg.TotalLen())
 		})
 	})
 }
@@ -1140,6 +1140,10 @@ func (m *workflowTaskStateMachine) getHistorySizeInfo() (bool, int64) {
 	sizeLimit := int64(config.HistorySizeSuggestContinueAsNew(namespaceName))
 	countLimit := int64(config.HistoryCountSuggestContinueAsNew(namespaceName))
 	suggestContinueAsNew := historySize >= sizeLimit || historyCount >= countLimit
+	if updateCountLimit := m.ms.updateCountSuggestContinueAsNewLimit(); updateCountLimit > 0 &&
+		m.ms.GetExecutionInfo().GetUpdateCount() >= updateCountLimit {
+		suggestContinueAsNew = true
+	}
 	return suggestContinueAsNew, historySize
 }
 
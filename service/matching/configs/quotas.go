@@ -65,6 +65,7 @@ var (
 		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateWorkerVersioningRules":            1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/GetWorkerVersioningRules":               1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/DescribeTaskQueuePartition":             1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/ListTaskQueuePollers":                   1,
 	}
 
 	APIPrioritiesOrdered = []int{0, 1}
@@ -58,13 +58,15 @@ type (
 		// instance. And channels are used so that the caller
 		// can use them in a select{} block along with other
 		// conditions
-		addReqToken  atomic.Value
-		pollReqToken atomic.Value
+		addReqToken        atomic.Value
+		backlogAddReqToken atomic.Value
+		pollReqToken       atomic.Value
 
 		// cached values of maxOutstanding dynamic config values.
 		// these are used to detect changes
-		outstandingTasksLimit int32
-		outstandingPollsLimit int32
+		outstandingTasksLimit        int32
+		outstandingBacklogTasksLimit int32
+		outstandingPollsLimit        int32
 
 		// todo: implement a rate limiter that automatically
 		// adjusts rate based on ServiceBusy errors from API calls
@@ -102,17 +104,19 @@ func newForwarder(
 	}
 
 	fwdr := &Forwarder{
-		cfg:                   cfg,
-		client:                client,
-		partition:             partition,
-		queue:                 queue,
-		outstandingTasksLimit: int32(cfg.ForwarderMaxOutstandingTasks()),
-		outstandingPollsLimit: int32(cfg.ForwarderMaxOutstandingPolls()),
+		cfg:                          cfg,
+		client:                       client,
+		partition:                    partition,
+		queue:                        queue,
+		outstandingTasksLimit:        int32(cfg.ForwarderMaxOutstandingTasks()),
+		outstandingBacklogTasksLimit: int32(cfg.ForwarderMaxOutstandingBacklogTasks()),
+		outstandingPollsLimit:        int32(cfg.ForwarderMaxOutstandingPolls()),
 		limiter: quotas.NewDefaultOutgoingRateLimiter(
 			func() float64 { return float64(cfg.ForwarderMaxRatePerSecond()) },
 		),
 	}
 	fwdr.addReqToken.Store(newForwarderReqToken(cfg.ForwarderMaxOutstandingTasks()))
+	fwdr.backlogAddReqToken.Store(newForwarderReqToken(cfg.ForwarderMaxOutstandingBacklogTasks()))
 	fwdr.pollReqToken.Store(newForwarderReqToken(cfg.ForwarderMaxOutstandingPolls()))
 	return fwdr, nil
 }
@@ -322,6 +326,14 @@ func (fwdr *Forwarder) AddReqTokenC() <-chan *ForwarderReqToken {
 	return fwdr.addReqToken.Load().(*ForwarderReqToken).ch
 }
 
+// BacklogAddReqTokenC returns a channel that can be used to wait for a token
+// that's necessary before making a ForwardTask API call for a backlog task.
+// After the API call is invoked, token.release() must be invoked
+func (fwdr *Forwarder) BacklogAddReqTokenC() <-chan *ForwarderReqToken {
+	fwdr.refreshTokenC(&fwdr.backlogAddReqToken, &fwdr.outstandingBacklogTasksLimit, int32(fwdr.cfg.ForwarderMaxOutstandingBacklogTasks()))
+	return fwdr.backlogAddReqToken.Load().(*ForwarderReqToken).ch
+}
+
 // PollReqTokenC returns a channel that can be used to wait for a token
 // that's necessary before making a ForwardPoll API call. After the API
 // call is invoked, token.release() must be invoked
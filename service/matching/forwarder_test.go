@@ -66,6 +66,8 @@ func (t *ForwarderTestSuite) SetupTest() {
 		ForwarderMaxRatePerSecond:    func() int { return 2 },
 		ForwarderMaxChildrenPerNode:  func() int { return 20 },
 		ForwarderMaxOutstandingTasks: func() int { return 1 },
+
+		ForwarderMaxOutstandingBacklogTasks: func() int { return 1 },
 	}
 	f, err := tqid.NewTaskQueueFamily("fwdr", "tl0")
 	t.Assert().NoError(err)
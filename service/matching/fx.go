@@ -173,8 +173,10 @@ func VisibilityManagerProvider(
 		serviceConfig.OperatorRPSRatio,
 		serviceConfig.EnableReadFromSecondaryVisibility,
 		serviceConfig.VisibilityEnableShadowReadMode,
+		serviceConfig.VisibilityShadowReadDiffSampleRate,
 		dynamicconfig.GetStringPropertyFn(visibility.SecondaryVisibilityWritingModeOff), // matching visibility never writes
 		serviceConfig.VisibilityDisableOrderByClause,
+		serviceConfig.VisibilityOrderByAllowedFields,
 		serviceConfig.VisibilityEnableManualPagination,
 		metricsHandler,
 		logger,
@@ -324,6 +324,15 @@ func (h *Handler) DescribeTaskQueuePartition(
 	return h.engine.DescribeTaskQueuePartition(ctx, request)
 }
 
+// ListTaskQueuePollers returns the pollers recently seen by the target task queue partition.
+func (h *Handler) ListTaskQueuePollers(
+	ctx context.Context,
+	request *matchingservice.ListTaskQueuePollersRequest,
+) (_ *matchingservice.ListTaskQueuePollersResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.ListTaskQueuePollers(ctx, request)
+}
+
 // ListTaskQueuePartitions returns information about partitions for a taskQueue
 func (h *Handler) ListTaskQueuePartitions(
 	ctx context.Context,
@@ -35,6 +35,7 @@ import (
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
@@ -66,6 +67,8 @@ type TaskMatcher struct {
 	forceRefreshRateOnce sync.Once
 	// rateLimiter that limits the rate at which tasks can be dispatched to consumers
 	rateLimiter quotas.RateLimiter
+	// dispatchLimiters are the individual limiters combined into rateLimiter, kept for introspection
+	dispatchLimiters []*quotas.DynamicRateLimiterImpl
 
 	fwdr                   *Forwarder
 	metricsHandler         metrics.Handler // namespace metric scope
@@ -98,7 +101,7 @@ func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, metricsHandler met
 		dynamicRateBurst,
 		defaultTaskDispatchRPSTTL,
 	)
-	limiter := quotas.NewMultiRateLimiter([]quotas.RateLimiter{
+	dispatchLimiters := []*quotas.DynamicRateLimiterImpl{
 		dynamicRateLimiter,
 		quotas.NewDefaultOutgoingRateLimiter(
 			config.AdminNamespaceTaskQueueToPartitionDispatchRate,
@@ -106,12 +109,18 @@ func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, metricsHandler met
 		quotas.NewDefaultOutgoingRateLimiter(
 			config.AdminNamespaceToPartitionDispatchRate,
 		),
-	})
+	}
+	limiters := make([]quotas.RateLimiter, 0, len(dispatchLimiters))
+	for _, l := range dispatchLimiters {
+		limiters = append(limiters, l)
+	}
+	limiter := quotas.NewMultiRateLimiter(limiters)
 	return &TaskMatcher{
 		config:                 config,
 		dynamicRateBurst:       dynamicRateBurst,
 		dynamicRateLimiter:     dynamicRateLimiter,
 		rateLimiter:            limiter,
+		dispatchLimiters:       dispatchLimiters,
 		metricsHandler:         metricsHandler,
 		fwdr:                   fwdr,
 		taskC:                  make(chan *internalTask),
@@ -336,7 +345,7 @@ func (tm *TaskMatcher) MustOffer(ctx context.Context, task *internalTask, interr
 
 forLoop:
 	for {
-		fwdTokenC := tm.fwdrAddReqTokenC()
+		fwdTokenC := tm.fwdrBacklogAddReqTokenC()
 		reconsiderFwdTimer = nil
 		var reconsiderFwdTimerC <-chan time.Time
 		if fwdTokenC != nil && !tm.isBacklogNegligible() {
@@ -468,6 +477,23 @@ func (tm *TaskMatcher) Rate() float64 {
 	return tm.rateLimiter.Rate()
 }
 
+// RateLimitInfo returns a snapshot of the dispatch and forwarding rate limits applied by this matcher.
+func (tm *TaskMatcher) RateLimitInfo() *taskqueuespb.RateLimitInfo {
+	info := &taskqueuespb.RateLimitInfo{
+		DispatchRatePerSecond: tm.rateLimiter.Rate(),
+		DispatchBurst:         int32(tm.rateLimiter.Burst()),
+		DispatchTokens:        math.Inf(1),
+	}
+	for _, l := range tm.dispatchLimiters {
+		info.DispatchTokens = min(info.DispatchTokens, l.Tokens())
+	}
+	if tm.fwdr != nil {
+		info.ForwarderRatePerSecond = tm.fwdr.limiter.Rate()
+		info.ForwarderRateBinding = info.ForwarderRatePerSecond < info.DispatchRatePerSecond
+	}
+	return info
+}
+
 func (tm *TaskMatcher) poll(
 	ctx context.Context, pollMetadata *pollMetadata, queryOnly bool,
 ) (task *internalTask, forwardedPoll bool, err error) {
@@ -592,6 +618,16 @@ func (tm *TaskMatcher) fwdrAddReqTokenC() <-chan *ForwarderReqToken {
 	return tm.fwdr.AddReqTokenC()
 }
 
+// fwdrBacklogAddReqTokenC returns the forwarder token channel for tasks forwarded from the backlog by
+// MustOffer. Its capacity is MatchingForwarderMaxOutstandingBacklogTasks, so backlog forwarding does not
+// compete with sync match forwarding for the MatchingForwarderMaxOutstandingTasks tokens.
+func (tm *TaskMatcher) fwdrBacklogAddReqTokenC() <-chan *ForwarderReqToken {
+	if tm.fwdr == nil {
+		return nil
+	}
+	return tm.fwdr.BacklogAddReqTokenC()
+}
+
 func (tm *TaskMatcher) isForwardingAllowed() bool {
 	return tm.fwdr != nil
 }
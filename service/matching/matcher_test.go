@@ -40,14 +40,18 @@ import (
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/tqid"
 	"go.uber.org/atomic"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payloads"
 )
 
@@ -89,6 +93,8 @@ func (t *MatcherTestSuite) SetupTest() {
 		ForwarderMaxOutstandingTasks: func() int { return 1 },
 		ForwarderMaxRatePerSecond:    func() int { return 2 },
 		ForwarderMaxChildrenPerNode:  func() int { return 20 },
+
+		ForwarderMaxOutstandingBacklogTasks: func() int { return 1 },
 	}
 	t.cfg = tlCfg
 	t.fwdr, err = newForwarder(&t.cfg.forwarderConfig, t.queue, t.client)
@@ -209,6 +215,29 @@ func (t *MatcherTestSuite) testRemoteSyncMatch(taskSource enumsspb.TaskSource) {
 	t.Equal(mustParent(t.queue.partition.(*tqid.NormalPartition), 20).RpcName(), req.GetTaskQueue().GetName())
 }
 
+func (t *MatcherTestSuite) TestRateLimitInfo() {
+	rootInfo := t.rootMatcher.RateLimitInfo()
+	t.Zero(rootInfo.GetForwarderRatePerSecond())
+	t.False(rootInfo.GetForwarderRateBinding())
+
+	// forwarder limit of 2 rps is below the default dispatch rate
+	info := t.matcher.RateLimitInfo()
+	t.Equal(t.matcher.Rate(), info.GetDispatchRatePerSecond())
+	t.Equal(2.0, info.GetForwarderRatePerSecond())
+	t.True(info.GetForwarderRateBinding())
+
+	rps := float64(t.matcher.numPartitions())
+	t.matcher.UpdateRatelimit(&rps)
+	info = t.matcher.RateLimitInfo()
+	t.Equal(1.0, info.GetDispatchRatePerSecond())
+	t.False(info.GetForwarderRateBinding())
+	t.LessOrEqual(info.GetDispatchTokens(), float64(info.GetDispatchBurst()))
+
+	tokens := info.GetDispatchTokens()
+	t.True(t.matcher.rateLimiter.Allow())
+	t.Less(t.matcher.RateLimitInfo().GetDispatchTokens(), tokens)
+}
+
 func (t *MatcherTestSuite) TestRejectSyncMatchWhenBacklog() {
 	historyTask := newInternalTaskForSyncMatch(randomTaskInfo().Data, nil)
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
@@ -589,6 +618,7 @@ func (t *MatcherTestSuite) TestQueryRemoteSyncMatchError() {
 func (t *MatcherTestSuite) TestMustOfferLocalMatch() {
 	// force disable remote forwarding
 	<-t.fwdr.AddReqTokenC()
+	<-t.fwdr.BacklogAddReqTokenC()
 	<-t.fwdr.PollReqTokenC()
 
 	pollStarted := make(chan struct{})
@@ -680,6 +710,62 @@ func (t *MatcherTestSuite) TestMustOfferRemoteMatch() {
 	t.Equal(mustParent(t.queue.partition.(*tqid.NormalPartition), 20).RpcName(), req.GetTaskQueue().GetName())
 }
 
+func (t *MatcherTestSuite) TestMustOfferConcurrentBacklogForwards() {
+	const (
+		numTasks       = 6
+		maxOutstanding = 3
+	)
+	t.cfg.forwarderConfig.ForwarderMaxOutstandingBacklogTasks = func() int { return maxOutstanding }
+	t.cfg.forwarderConfig.ForwarderMaxRatePerSecond = func() int { return 1000 }
+	fwdr, err := newForwarder(&t.cfg.forwarderConfig, t.queue, t.client)
+	t.NoError(err)
+	matcher := newTaskMatcher(t.cfg, fwdr, metrics.NoopMetricsHandler)
+
+	var inFlight, maxInFlight atomic.Int32
+	releaseC := make(chan struct{})
+	t.client.EXPECT().AddWorkflowTask(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(context.Context, *matchingservice.AddWorkflowTaskRequest, ...grpc.CallOption) (*matchingservice.AddWorkflowTaskResponse, error) {
+			current := inFlight.Add(1)
+			for {
+				prev := maxInFlight.Load()
+				if current <= prev || maxInFlight.CompareAndSwap(prev, current) {
+					break
+				}
+			}
+			<-releaseC
+			inFlight.Add(-1)
+			return &matchingservice.AddWorkflowTaskResponse{}, nil
+		},
+	).Times(numTasks)
+
+	var wg sync.WaitGroup
+	for i := 0; i < numTasks; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			task := newInternalTaskFromBacklog(randomTaskInfo(), func(*persistencespb.AllocatedTaskInfo, error) {})
+			t.NoError(matcher.MustOffer(context.Background(), task, nil))
+		}()
+	}
+
+	// backlog forwards proceed concurrently up to their own limit
+	t.Eventually(func() bool { return inFlight.Load() == maxOutstanding }, time.Second, time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	t.Equal(int32(maxOutstanding), maxInFlight.Load())
+
+	// sync match forwarding still has its token available
+	select {
+	case token := <-fwdr.AddReqTokenC():
+		token.release()
+	default:
+		t.Fail("sync match forwarding token should not be used by backlog forwards")
+	}
+
+	close(releaseC)
+	t.True(common.AwaitWaitGroup(&wg, time.Second))
+	t.Equal(int32(maxOutstanding), maxInFlight.Load())
+}
+
 func (t *MatcherTestSuite) TestRemotePoll() {
 	pollToken := <-t.fwdr.PollReqTokenC()
 
@@ -762,3 +848,32 @@ func randomTaskInfoWithAge(age time.Duration) *persistencespb.AllocatedTaskInfo
 		TaskId: rand.Int63(),
 	}
 }
+
+func (t *MatcherTestSuite) TestBacklogNegligibleAgeNamespaceOverride() {
+	dc := dynamicconfig.StaticClient{
+		dynamicconfig.MatchingBacklogNegligibleAge.Key(): []dynamicconfig.ConstrainedValue{
+			{
+				Constraints: dynamicconfig.Constraints{Namespace: "latency-ns", TaskQueueName: "tl1"},
+				Value:       time.Second,
+			},
+			{
+				Constraints: dynamicconfig.Constraints{Namespace: "latency-ns"},
+				Value:       time.Minute,
+			},
+		},
+	}
+	cfg := NewConfig(dynamicconfig.NewCollection(dc, log.NewNoopLogger()))
+
+	negligibleAge := func(ns namespace.Name, tqName string) time.Duration {
+		f, err := tqid.NewTaskQueueFamily("", tqName)
+		t.NoError(err)
+		tq := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+		return newTaskQueueConfig(tq, cfg, ns).BacklogNegligibleAge()
+	}
+	// task queue constraint takes precedence over the namespace one
+	t.Equal(time.Second, negligibleAge("latency-ns", "tl1"))
+	// namespace constraint takes precedence over the default
+	t.Equal(time.Minute, negligibleAge("latency-ns", "tl0"))
+	defaultAge := dynamicconfig.MatchingBacklogNegligibleAge.Get(dynamicconfig.NewNoopCollection())
+	t.Equal(defaultAge("", "", 0), negligibleAge("other-ns", "tl0"))
+}
@@ -237,10 +237,12 @@ func NewEngine(
 		namespaceUpdateLockMap:    make(map[string]*namespaceUpdateLocks),
 	}
 	e.reachabilityCache = newReachabilityCache(
-		metrics.NoopMetricsHandler,
+		e.metricsHandler,
 		visibilityManager,
 		e.config.ReachabilityCacheOpenWFsTTL(),
-		e.config.ReachabilityCacheClosedWFsTTL())
+		e.config.ReachabilityCacheClosedWFsTTL(),
+		e.config.ReachabilityCacheOpenWFsMaxSize(),
+		e.config.ReachabilityCacheClosedWFsMaxSize())
 	return e
 }
 
@@ -1063,6 +1065,26 @@ func (e *matchingEngineImpl) DescribeTaskQueuePartition(
 	return pm.Describe(buildIds, request.GetVersions().GetAllActive(), request.GetReportStats(), request.GetReportPollers())
 }
 
+func (e *matchingEngineImpl) ListTaskQueuePollers(
+	ctx context.Context,
+	request *matchingservice.ListTaskQueuePollersRequest,
+) (*matchingservice.ListTaskQueuePollersResponse, error) {
+	// Don't load the partition: the poller history of a partition that is not loaded is empty anyway.
+	pm, err := e.getTaskQueuePartitionManager(ctx, tqid.PartitionFromPartitionProto(request.GetTaskQueuePartition(), request.GetNamespaceId()), false, loadCauseOtherRead)
+	if err != nil {
+		return nil, err
+	} else if pm == nil {
+		return &matchingservice.ListTaskQueuePollersResponse{}, nil
+	}
+	maxPollers := int(request.GetMaxPollers())
+	if maxPollers <= 0 || maxPollers > pollerHistoryInitMaxSize {
+		maxPollers = pollerHistoryInitMaxSize
+	}
+	return &matchingservice.ListTaskQueuePollersResponse{
+		Pollers: pm.ListPollers(maxPollers),
+	}, nil
+}
+
 func (e *matchingEngineImpl) getBuildIds(versions *taskqueuepb.TaskQueueVersionSelection) (map[string]bool, error) {
 	buildIds := make(map[string]bool)
 	if versions != nil {
@@ -397,6 +397,54 @@ func (s *matchingEngineSuite) TestOnlyUnloadMatchingInstance() {
 		"Unload call with matching incarnation should have caused unload")
 }
 
+func (s *matchingEngineSuite) TestForwardedPollHonorsTaskQueueLongPollExpirationInterval() {
+	namespaceID := namespace.ID(uuid.New())
+	tl := "shortPollQueue"
+	longPollExpirationInterval := 300 * time.Millisecond
+
+	s.matchingEngine.config.NumTaskqueueReadPartitions = dynamicconfig.GetIntPropertyFnFilteredByTaskQueue(2)
+	s.matchingEngine.config.NumTaskqueueWritePartitions = dynamicconfig.GetIntPropertyFnFilteredByTaskQueue(2)
+	// only this task queue overrides the long poll expiration interval
+	s.matchingEngine.config.LongPollExpirationInterval = func(_ string, taskQueue string, _ enumspb.TaskQueueType) time.Duration {
+		if taskQueue == tl {
+			return longPollExpirationInterval
+		}
+		return time.Minute
+	}
+
+	f, err := tqid.NewTaskQueueFamily(namespaceID.String(), tl)
+	s.NoError(err)
+	childPartition := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW).NormalPartition(1)
+
+	// The child partition has no tasks and forwards the poll to the root partition, which is served by this engine.
+	// The root partition keeps returnEmptyTaskTimeBudget as tailroom out of the forwarded deadline, so it returns
+	// an empty task right away; what matters is the deadline the child partition forwarded with.
+	var forwardedDeadline time.Time
+	s.mockMatchingClient.EXPECT().PollWorkflowTaskQueue(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, req *matchingservice.PollWorkflowTaskQueueRequest, _ ...interface{}) (*matchingservice.PollWorkflowTaskQueueResponse, error) {
+			forwardedDeadline, _ = ctx.Deadline()
+			s.Equal(childPartition.RpcName(), req.GetForwardedSource())
+			return s.matchingEngine.PollWorkflowTaskQueue(ctx, req, metrics.NoopMetricsHandler)
+		},
+	).MinTimes(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	start := time.Now()
+	resp, err := s.matchingEngine.PollWorkflowTaskQueue(ctx, &matchingservice.PollWorkflowTaskQueueRequest{
+		NamespaceId: namespaceID.String(),
+		PollRequest: &workflowservice.PollWorkflowTaskQueueRequest{
+			TaskQueue: &taskqueuepb.TaskQueue{Name: childPartition.RpcName(), Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+			Identity:  "nobody",
+		},
+	}, metrics.NoopMetricsHandler)
+	s.NoError(err)
+	s.Equal(emptyPollWorkflowTaskQueueResponse, resp)
+	// the forwarded poll is bounded by the task queue's interval, not by the caller's 10s or the default of a minute
+	s.WithinDuration(start.Add(longPollExpirationInterval), forwardedDeadline, 200*time.Millisecond)
+	s.Less(time.Since(start), 5*time.Second)
+}
+
 func (s *matchingEngineSuite) TestPollWorkflowTaskQueues() {
 	namespaceID := namespace.ID(uuid.New())
 	tl := "makeToast"
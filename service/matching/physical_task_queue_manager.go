@@ -105,11 +105,15 @@ type (
 		// error is returned, if dispatched to local poller then nil and nil is returned.
 		DispatchNexusTask(ctx context.Context, taskId string, request *matchingservice.DispatchNexusTaskRequest) (*matchingservice.DispatchNexusTaskResponse, error)
 		UpdatePollerInfo(pollerIdentity, *pollMetadata)
+		// RecordPoll updates the poller info and counts a new poll from the poller
+		RecordPoll(pollerIdentity, *pollMetadata)
 		GetAllPollerInfo() []*taskqueuepb.PollerInfo
+		GetAllPollerStats() []*taskqueuespb.PollerStats
 		HasPollerAfter(accessTime time.Time) bool
 		// LegacyDescribeTaskQueue returns pollers info and legacy TaskQueueStatus for this physical queue
 		LegacyDescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse
 		GetStats() *taskqueuepb.TaskQueueStats
+		GetRateLimitInfo() *taskqueuespb.RateLimitInfo
 		UnloadFromPartitionManager(unloadCause)
 		String() string
 		QueueKey() *PhysicalTaskQueueKey
@@ -468,6 +472,10 @@ func (c *physicalTaskQueueManagerImpl) UpdatePollerInfo(id pollerIdentity, pollM
 	c.pollerHistory.updatePollerInfo(id, pollMetadata)
 }
 
+func (c *physicalTaskQueueManagerImpl) RecordPoll(id pollerIdentity, pollMetadata *pollMetadata) {
+	c.pollerHistory.recordPoll(id, pollMetadata)
+}
+
 // GetAllPollerInfo returns all pollers that polled from this taskqueue in last few minutes
 func (c *physicalTaskQueueManagerImpl) GetAllPollerInfo() []*taskqueuepb.PollerInfo {
 	if c.pollerHistory == nil {
@@ -476,6 +484,14 @@ func (c *physicalTaskQueueManagerImpl) GetAllPollerInfo() []*taskqueuepb.PollerI
 	return c.pollerHistory.getPollerInfo(time.Time{})
 }
 
+// GetAllPollerStats returns the poller history of this taskqueue along with per poller poll counts
+func (c *physicalTaskQueueManagerImpl) GetAllPollerStats() []*taskqueuespb.PollerStats {
+	if c.pollerHistory == nil {
+		return nil
+	}
+	return c.pollerHistory.getPollerStats(c.queue.BuildId())
+}
+
 func (c *physicalTaskQueueManagerImpl) HasPollerAfter(accessTime time.Time) bool {
 	if c.currentPolls.Load() > 0 {
 		return true
@@ -514,6 +530,10 @@ func (c *physicalTaskQueueManagerImpl) GetStats() *taskqueuepb.TaskQueueStats {
 	}
 }
 
+func (c *physicalTaskQueueManagerImpl) GetRateLimitInfo() *taskqueuespb.RateLimitInfo {
+	return c.matcher.RateLimitInfo()
+}
+
 func (c *physicalTaskQueueManagerImpl) String() string {
 	buf := new(bytes.Buffer)
 	if c.queue.TaskType() == enumspb.TASK_QUEUE_TYPE_ACTIVITY {
@@ -37,6 +37,7 @@ import (
 	v1 "go.temporal.io/api/taskqueue/v1"
 	v10 "go.temporal.io/server/api/matchingservice/v1"
 	v11 "go.temporal.io/server/api/persistence/v1"
+	v12 "go.temporal.io/server/api/taskqueue/v1"
 )
 
 // MockphysicalTaskQueueManager is a mock of physicalTaskQueueManager interface.
@@ -120,6 +121,34 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) GetAllPollerInfo() *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPollerInfo", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).GetAllPollerInfo))
 }
 
+// GetAllPollerStats mocks base method.
+func (m *MockphysicalTaskQueueManager) GetAllPollerStats() []*v12.PollerStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllPollerStats")
+	ret0, _ := ret[0].([]*v12.PollerStats)
+	return ret0
+}
+
+// GetAllPollerStats indicates an expected call of GetAllPollerStats.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) GetAllPollerStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPollerStats", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).GetAllPollerStats))
+}
+
+// GetRateLimitInfo mocks base method.
+func (m *MockphysicalTaskQueueManager) GetRateLimitInfo() *v12.RateLimitInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRateLimitInfo")
+	ret0, _ := ret[0].(*v12.RateLimitInfo)
+	return ret0
+}
+
+// GetRateLimitInfo indicates an expected call of GetRateLimitInfo.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) GetRateLimitInfo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRateLimitInfo", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).GetRateLimitInfo))
+}
+
 // GetStats mocks base method.
 func (m *MockphysicalTaskQueueManager) GetStats() *v1.TaskQueueStats {
 	m.ctrl.T.Helper()
@@ -217,6 +246,18 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) QueueKey() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueKey", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).QueueKey))
 }
 
+// RecordPoll mocks base method.
+func (m *MockphysicalTaskQueueManager) RecordPoll(arg0 pollerIdentity, arg1 *pollMetadata) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordPoll", arg0, arg1)
+}
+
+// RecordPoll indicates an expected call of RecordPoll.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) RecordPoll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordPoll", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).RecordPoll), arg0, arg1)
+}
+
 // SpoolTask mocks base method.
 func (m *MockphysicalTaskQueueManager) SpoolTask(taskInfo *v11.TaskInfo) error {
 	m.ctrl.T.Helper()
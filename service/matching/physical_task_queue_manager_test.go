@@ -45,6 +45,7 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -503,6 +504,46 @@ func TestTQMDoesFinalUpdateOnIdleUnload(t *testing.T) {
 	require.Equal(t, 1, tm.getUpdateCount(tqCfg.dbq))
 }
 
+func TestTQMIdleUnloadHonorsTaskQueueOverride(t *testing.T) {
+	t.Parallel()
+
+	controller := gomock.NewController(t)
+
+	dc := dynamicconfig.StaticClient{
+		dynamicconfig.MatchingMaxTaskQueueIdleTime.Key(): []dynamicconfig.ConstrainedValue{
+			{
+				Constraints: dynamicconfig.Constraints{Namespace: "ns-name", TaskQueueName: "ephemeral-tq"},
+				Value:       time.Second,
+			},
+			{
+				Constraints: dynamicconfig.Constraints{Namespace: "ns-name", TaskQueueName: "bursty-tq"},
+				Value:       time.Hour,
+			},
+		},
+	}
+	cfg := NewConfig(dynamicconfig.NewCollection(dc, log.NewNoopLogger()))
+
+	ephemeralCfg := defaultTqmTestOpts(controller)
+	ephemeralCfg.config = cfg
+	ephemeralCfg.dbq = newTestUnversionedPhysicalQueueKey(defaultNamespaceId, "ephemeral-tq", enumspb.TASK_QUEUE_TYPE_WORKFLOW, 0)
+	ephemeral := mustCreateTestTaskQueueManagerWithConfig(t, controller, ephemeralCfg)
+	require.Equal(t, time.Second, ephemeral.config.MaxTaskQueueIdleTime())
+
+	burstyCfg := defaultTqmTestOpts(controller)
+	burstyCfg.config = cfg
+	burstyCfg.dbq = newTestUnversionedPhysicalQueueKey(defaultNamespaceId, "bursty-tq", enumspb.TASK_QUEUE_TYPE_WORKFLOW, 0)
+	bursty := mustCreateTestTaskQueueManagerWithConfig(t, controller, burstyCfg)
+	require.Equal(t, time.Hour, bursty.config.MaxTaskQueueIdleTime())
+
+	ephemeral.Start()
+	bursty.Start()
+	time.Sleep(2 * time.Second) // only the ephemeral queue unloads due to idleness
+	require.Equal(t, common.DaemonStatusStopped, atomic.LoadInt32(&ephemeral.status))
+	require.Equal(t, common.DaemonStatusStarted, atomic.LoadInt32(&bursty.status))
+
+	bursty.Stop(unloadCauseUnspecified)
+}
+
 func TestTQMDoesNotDoFinalUpdateOnOwnershipLost(t *testing.T) {
 	// TODO: use mocks instead of testTaskManager so we can do synchronization better instead of sleeps
 	t.Parallel()
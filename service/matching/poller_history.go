@@ -25,11 +25,13 @@
 package matching
 
 import (
+	"sync"
 	"time"
 
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/cache"
 )
 
@@ -43,6 +45,9 @@ type (
 
 	pollerInfo struct {
 		pollMetadata
+		pollCount int64
+		// when the poller was added to the poller history, the observed poll rate is measured from then
+		firstAccessTime time.Time
 	}
 )
 
@@ -50,6 +55,8 @@ type pollerHistory struct {
 	// poller ID -> pollerInfo
 	// pollers map[pollerID]pollerInfo
 	history cache.Cache
+	// serializes the read-modify-write of a poller's info, so concurrent polls are all counted
+	updateLock sync.Mutex
 }
 
 func newPollerHistory() *pollerHistory {
@@ -63,8 +70,26 @@ func newPollerHistory() *pollerHistory {
 	}
 }
 
+// updatePollerInfo refreshes the last access time of the poller and keeps its poll count.
 func (pollers *pollerHistory) updatePollerInfo(id pollerIdentity, pollMetadata *pollMetadata) {
-	pollers.history.Put(id, &pollerInfo{pollMetadata: *pollMetadata})
+	pollers.putPollerInfo(id, pollMetadata, 0)
+}
+
+// recordPoll is like updatePollerInfo but also counts a new poll from the poller.
+func (pollers *pollerHistory) recordPoll(id pollerIdentity, pollMetadata *pollMetadata) {
+	pollers.putPollerInfo(id, pollMetadata, 1)
+}
+
+func (pollers *pollerHistory) putPollerInfo(id pollerIdentity, pollMetadata *pollMetadata, newPolls int64) {
+	pollers.updateLock.Lock()
+	defer pollers.updateLock.Unlock()
+
+	info := &pollerInfo{pollMetadata: *pollMetadata, pollCount: newPolls, firstAccessTime: time.Now().UTC()}
+	if prev, ok := pollers.history.Get(id).(*pollerInfo); ok {
+		info.pollCount += prev.pollCount
+		info.firstAccessTime = prev.firstAccessTime
+	}
+	pollers.history.Put(id, info)
 }
 
 func (pollers *pollerHistory) getPollerInfo(earliestAccessTime time.Time) []*taskqueuepb.PollerInfo {
@@ -90,6 +115,37 @@ func (pollers *pollerHistory) getPollerInfo(earliestAccessTime time.Time) []*tas
 	return result
 }
 
+func (pollers *pollerHistory) getPollerStats(buildId string) []*taskqueuespb.PollerStats {
+	var result []*taskqueuespb.PollerStats
+
+	now := time.Now().UTC()
+	ite := pollers.history.Iterator()
+	defer ite.Close()
+	for ite.HasNext() {
+		entry := ite.Next()
+		value := entry.Value().(*pollerInfo)
+		result = append(result, &taskqueuespb.PollerStats{
+			Identity:       string(entry.Key().(pollerIdentity)),
+			LastAccessTime: timestamppb.New(entry.CreateTime()),
+			RatePerSecond:  value.observedPollRate(now),
+			PollCount:      value.pollCount,
+			BuildId:        buildId,
+		})
+	}
+
+	return result
+}
+
+// observedPollRate returns the polls per second received from the poller since it was added to the poller history.
+// The rate is measured over at least a second, so the first polls of a new poller don't report a burst.
+func (info *pollerInfo) observedPollRate(now time.Time) float64 {
+	elapsed := now.Sub(info.firstAccessTime)
+	if elapsed < time.Second {
+		elapsed = time.Second
+	}
+	return float64(info.pollCount) / elapsed.Seconds()
+}
+
 func defaultValue[T any, P ~*T](p P, def T) T {
 	if p == nil {
 		return def
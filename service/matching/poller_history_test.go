@@ -0,0 +1,65 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPollerHistory_RecordPoll_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	pollers := newPollerHistory()
+	const numPolls = 100
+	var wg sync.WaitGroup
+	for i := 0; i < numPolls; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pollers.recordPoll("poller", &pollMetadata{})
+		}()
+	}
+	wg.Wait()
+
+	stats := pollers.getPollerStats("")
+	require.Len(t, stats, 1)
+	require.Equal(t, int64(numPolls), stats[0].GetPollCount())
+}
+
+func TestPollerInfo_ObservedPollRate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	info := &pollerInfo{pollCount: 30, firstAccessTime: now.Add(-10 * time.Second)}
+	require.Equal(t, 3.0, info.observedPollRate(now))
+
+	// a new poller's rate is measured over at least a second
+	info = &pollerInfo{pollCount: 5, firstAccessTime: now.Add(-100 * time.Millisecond)}
+	require.Equal(t, 5.0, info.observedPollRate(now))
+}
@@ -49,7 +49,9 @@ import (
 )
 
 const (
-	reachabilityCacheMaxSize = 10000
+	reachabilityCacheTypeTagName     = "reachability_cache_type"
+	reachabilityCacheTypeOpenValue   = "open"
+	reachabilityCacheTypeClosedValue = "closed"
 )
 
 type reachabilityExitPoint int32
@@ -327,10 +329,12 @@ func newReachabilityCache(
 	visibilityMgr manager.VisibilityManager,
 	reachabilityCacheOpenWFExecutionTTL,
 	reachabilityCacheClosedWFExecutionTTL time.Duration,
+	reachabilityCacheOpenWFMaxSize,
+	reachabilityCacheClosedWFMaxSize int,
 ) reachabilityCache {
 	return reachabilityCache{
-		openWFCache:    cache.New(reachabilityCacheMaxSize, &cache.Options{TTL: reachabilityCacheOpenWFExecutionTTL}),
-		closedWFCache:  cache.New(reachabilityCacheMaxSize, &cache.Options{TTL: reachabilityCacheClosedWFExecutionTTL}),
+		openWFCache:    cache.New(reachabilityCacheOpenWFMaxSize, &cache.Options{TTL: reachabilityCacheOpenWFExecutionTTL}),
+		closedWFCache:  cache.New(reachabilityCacheClosedWFMaxSize, &cache.Options{TTL: reachabilityCacheClosedWFExecutionTTL}),
 		metricsHandler: handler,
 		visibilityMgr:  visibilityMgr,
 	}
@@ -345,13 +349,19 @@ func (c *reachabilityCache) Get(ctx context.Context, countRequest manager.CountW
 	} else {
 		result = c.closedWFCache.Get(countRequest)
 	}
+	cacheTypeTag := metrics.StringTag(reachabilityCacheTypeTagName, reachabilityCacheTypeClosedValue)
+	if open {
+		cacheTypeTag = metrics.StringTag(reachabilityCacheTypeTagName, reachabilityCacheTypeOpenValue)
+	}
 	if result != nil {
 		// there's no reason that the cache would ever contain a non-bool, but just in case, treat non-bool as a miss
 		exists, ok := result.(bool)
 		if ok {
+			metrics.ReachabilityCacheHitCounter.With(c.metricsHandler).Record(1, cacheTypeTag)
 			return exists, true, nil
 		}
 	}
+	metrics.ReachabilityCacheMissCounter.With(c.metricsHandler).Record(1, cacheTypeTag)
 
 	// cache was cold, ask visibility and put result in cache
 	countResponse, err := c.visibilityMgr.CountWorkflowExecutions(ctx, &countRequest)
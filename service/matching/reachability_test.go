@@ -47,6 +47,7 @@ const (
 	testBuildIdVisibilityGracePeriod  = 2 * time.Minute
 	testReachabilityCacheOpenWFsTTL   = 2 * time.Millisecond
 	testReachabilityCacheClosedWFsTTL = 4 * time.Millisecond
+	testReachabilityCacheMaxSize      = 100
 )
 
 type testReachabilityCalculator struct {
@@ -313,6 +314,47 @@ func TestGetReachability_WithoutVisibility_WithDeletedRules(t *testing.T) {
 	// todo carly
 }
 
+func TestReachabilityCache_EvictsAtMaxSize(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	vm := manager.NewMockVisibilityManager(gomock.NewController(t))
+	vm.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).AnyTimes().Return(mkCountResponse(1))
+	c := newReachabilityCache(metricsHandler, vm, time.Hour, time.Hour, 2, 1)
+
+	mkRequest := func(query string) manager.CountWorkflowExecutionsRequest {
+		return manager.CountWorkflowExecutionsRequest{NamespaceID: "test-namespace-id", Query: query}
+	}
+
+	// fill the open cache to its max size, then add one more entry to evict the oldest
+	for _, query := range []string{"q1", "q2", "q3"} {
+		_, hit, err := c.Get(ctx, mkRequest(query), true)
+		assert.NoError(t, err)
+		assert.False(t, hit)
+	}
+	_, hit, err := c.Get(ctx, mkRequest("q3"), true)
+	assert.NoError(t, err)
+	assert.True(t, hit)
+	_, hit, err = c.Get(ctx, mkRequest("q1"), true)
+	assert.NoError(t, err)
+	assert.False(t, hit)
+
+	// the closed cache is sized independently
+	_, _, err = c.Get(ctx, mkRequest("q1"), false)
+	assert.NoError(t, err)
+	_, _, err = c.Get(ctx, mkRequest("q2"), false)
+	assert.NoError(t, err)
+	_, hit, err = c.Get(ctx, mkRequest("q1"), false)
+	assert.NoError(t, err)
+	assert.False(t, hit)
+
+	snapshot := capture.Snapshot()
+	assert.Len(t, snapshot[metrics.ReachabilityCacheHitCounter.Name()], 1)
+	assert.Len(t, snapshot[metrics.ReachabilityCacheMissCounter.Name()], 7)
+	assert.Equal(t, reachabilityCacheTypeOpenValue, snapshot[metrics.ReachabilityCacheHitCounter.Name()][0].Tags[reachabilityCacheTypeTagName])
+}
+
 func checkReachability(ctx context.Context,
 	t *testing.T,
 	rc *reachabilityCalculator,
@@ -375,6 +417,8 @@ func mkTestReachabilityCalculatorWithEmptyVisibility(t *testing.T) *testReachabi
 				vm,
 				testReachabilityCacheOpenWFsTTL,
 				testReachabilityCacheClosedWFsTTL,
+				testReachabilityCacheMaxSize,
+				testReachabilityCacheMaxSize,
 			),
 		},
 		capture: cacheMetricsHandler.StartCapture(),
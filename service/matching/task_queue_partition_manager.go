@@ -27,6 +27,7 @@ package matching
 import (
 	"context"
 	"errors"
+	"slices"
 	"sync"
 	"time"
 
@@ -76,6 +77,8 @@ type (
 		// MarkAlive updates the liveness timer to keep this partition manager alive.
 		MarkAlive()
 		GetAllPollerInfo() []*taskqueuepb.PollerInfo
+		// ListPollers returns up to maxPollers pollers of all physical queues, most recently seen first
+		ListPollers(maxPollers int) []*taskqueuespb.PollerStats
 		// HasPollerAfter checks pollers on the queue associated with the given buildId, or the unversioned queue if an empty string is given
 		HasPollerAfter(buildId string, accessTime time.Time) bool
 		// HasAnyPollerAfter checks pollers on all versioned and unversioned queues
@@ -337,7 +340,7 @@ func (pm *taskQueuePartitionManagerImpl) PollTask(
 	}
 
 	if identity, ok := ctx.Value(identityKey).(string); ok && identity != "" {
-		dbq.UpdatePollerInfo(pollerIdentity(identity), pollMetadata)
+		dbq.RecordPoll(pollerIdentity(identity), pollMetadata)
 		// update timestamp when long poll ends
 		defer dbq.UpdatePollerInfo(pollerIdentity(identity), pollMetadata)
 	}
@@ -443,6 +446,23 @@ func (pm *taskQueuePartitionManagerImpl) GetAllPollerInfo() []*taskqueuepb.Polle
 	return ret
 }
 
+func (pm *taskQueuePartitionManagerImpl) ListPollers(maxPollers int) []*taskqueuespb.PollerStats {
+	ret := pm.defaultQueue.GetAllPollerStats()
+	pm.versionedQueuesLock.RLock()
+	for _, vq := range pm.versionedQueues {
+		ret = append(ret, vq.GetAllPollerStats()...)
+	}
+	pm.versionedQueuesLock.RUnlock()
+
+	slices.SortFunc(ret, func(a, b *taskqueuespb.PollerStats) int {
+		return b.GetLastAccessTime().AsTime().Compare(a.GetLastAccessTime().AsTime())
+	})
+	if maxPollers > 0 && len(ret) > maxPollers {
+		ret = ret[:maxPollers]
+	}
+	return ret
+}
+
 func (pm *taskQueuePartitionManagerImpl) HasAnyPollerAfter(accessTime time.Time) bool {
 	if pm.defaultQueue.HasPollerAfter(accessTime) {
 		return true
@@ -514,6 +534,7 @@ func (pm *taskQueuePartitionManagerImpl) Describe(
 			}
 			if reportStats {
 				vInfo.PhysicalTaskQueueInfo.TaskQueueStats = physicalQueue.GetStats()
+				vInfo.PhysicalTaskQueueInfo.RateLimitInfo = physicalQueue.GetRateLimitInfo()
 			}
 		}
 		versionsInfo[bid] = vInfo
@@ -285,6 +285,31 @@ func (s *PartitionManagerTestSuite) TestGetAllPollerInfo() {
 	}
 }
 
+func (s *PartitionManagerTestSuite) TestListPollers() {
+	// no pollers
+	s.Empty(s.partitionMgr.ListPollers(0))
+
+	s.pollWithIdentity("uv", "", false)
+	s.pollWithIdentity("uv", "", false)
+	s.pollWithIdentity("v", "bid", true)
+
+	// most recent first, across physical queues
+	pollers := s.partitionMgr.ListPollers(0)
+	s.Len(pollers, 2)
+	s.Equal("v", pollers[0].GetIdentity())
+	s.Equal("bid", pollers[0].GetBuildId())
+	s.Equal(int64(1), pollers[0].GetPollCount())
+	s.Equal("uv", pollers[1].GetIdentity())
+	s.Equal("", pollers[1].GetBuildId())
+	s.Equal(int64(2), pollers[1].GetPollCount())
+	// both polls arrived within the first second, which is the shortest window the rate is measured over
+	s.Equal(2.0, pollers[1].GetRatePerSecond())
+
+	pollers = s.partitionMgr.ListPollers(1)
+	s.Len(pollers, 1)
+	s.Equal("v", pollers[0].GetIdentity())
+}
+
 func (s *PartitionManagerTestSuite) TestHasAnyPollerAfter() {
 	// no pollers
 	s.Assert().False(s.partitionMgr.HasAnyPollerAfter(time.Now().Add(-5 * time.Minute)))
@@ -46,6 +46,9 @@ import (
 const (
 	taskReaderOfferThrottleWait  = time.Second
 	taskReaderThrottleRetryDelay = 3 * time.Second
+
+	// adaptiveBatchMinSize is the floor the adaptive batch size shrinks towards when reads return mostly empty.
+	adaptiveBatchMinSize = 10
 )
 
 type (
@@ -60,6 +63,8 @@ type (
 		backoffTimer          *time.Timer
 		retrier               backoff.Retrier
 		backlogHeadCreateTime atomic.Int64
+		// current batch size when AdaptiveBatchEnabled is set, only accessed by getTasksPump
+		adaptiveBatchSize int
 	}
 )
 
@@ -243,13 +248,45 @@ func (tr *taskReader) getTaskBatchWithRange(
 	readLevel int64,
 	maxReadLevel int64,
 ) ([]*persistencespb.AllocatedTaskInfo, error) {
-	response, err := tr.backlogMgr.db.GetTasks(ctx, readLevel+1, maxReadLevel+1, tr.backlogMgr.config.GetTasksBatchSize())
+	batchSize := tr.getTasksBatchSize(maxReadLevel - readLevel)
+	response, err := tr.backlogMgr.db.GetTasks(ctx, readLevel+1, maxReadLevel+1, batchSize)
 	if err != nil {
 		return nil, err
 	}
+	if tr.backlogMgr.config.AdaptiveBatchEnabled() {
+		tr.adaptiveBatchSize = nextAdaptiveBatchSize(
+			batchSize,
+			tr.backlogMgr.config.GetTasksBatchSize(),
+			len(response.Tasks),
+		)
+	}
 	return response.Tasks, err
 }
 
+// getTasksBatchSize returns the batch size of a read of the given number of task IDs. With adaptive batch sizing,
+// the batch still covers every task ID of the range up to GetTasksBatchSize, so that a burst written after a quiet
+// period is not read in more batches than without it.
+func (tr *taskReader) getTasksBatchSize(rangeSize int64) int {
+	maxBatchSize := tr.backlogMgr.config.GetTasksBatchSize()
+	if !tr.backlogMgr.config.AdaptiveBatchEnabled() || tr.adaptiveBatchSize == 0 {
+		return maxBatchSize
+	}
+	return int(min(max(int64(tr.adaptiveBatchSize), rangeSize), int64(maxBatchSize)))
+}
+
+// nextAdaptiveBatchSize returns the batch size for the next read given the size and the result of the last one.
+// The batch size doubles, up to maxBatchSize, while reads return full batches, i.e. the backlog is non-empty, and
+// halves, down to adaptiveBatchMinSize, while reads return less than half a batch.
+func nextAdaptiveBatchSize(batchSize int, maxBatchSize int, numTasks int) int {
+	next := batchSize
+	if numTasks >= batchSize {
+		next = batchSize * 2
+	} else if numTasks < batchSize/2 {
+		next = batchSize / 2
+	}
+	return max(min(next, maxBatchSize), min(adaptiveBatchMinSize, maxBatchSize))
+}
+
 type getTasksBatchResponse struct {
 	tasks           []*persistencespb.AllocatedTaskInfo
 	readLevel       int64
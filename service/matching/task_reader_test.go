@@ -0,0 +1,186 @@
+// The MIT License
+//
+// Copyright (c) 2024 Temporal Technologies Inc.  All rights reserved.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/tqid"
+)
+
+func TestNextAdaptiveBatchSize(t *testing.T) {
+	testCases := []struct {
+		name         string
+		batchSize    int
+		maxBatchSize int
+		numTasks     int
+		expected     int
+	}{
+		{name: "full batch grows", batchSize: 100, maxBatchSize: 1000, numTasks: 100, expected: 200},
+		{name: "full batch grows up to max", batchSize: 800, maxBatchSize: 1000, numTasks: 800, expected: 1000},
+		{name: "half batch keeps size", batchSize: 100, maxBatchSize: 1000, numTasks: 50, expected: 100},
+		{name: "mostly empty batch shrinks", batchSize: 100, maxBatchSize: 1000, numTasks: 10, expected: 50},
+		{name: "empty batch shrinks down to floor", batchSize: 15, maxBatchSize: 1000, numTasks: 0, expected: adaptiveBatchMinSize},
+		{name: "floor is capped by max", batchSize: 5, maxBatchSize: 5, numTasks: 0, expected: 5},
+		{name: "max decreased", batchSize: 500, maxBatchSize: 100, numTasks: 200, expected: 100},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, nextAdaptiveBatchSize(tc.batchSize, tc.maxBatchSize, tc.numTasks))
+		})
+	}
+}
+
+func TestTaskReader_GetTasksBatchSize(t *testing.T) {
+	backlogMgr := newBacklogMgr(gomock.NewController(t), false)
+	backlogMgr.config.GetTasksBatchSize = func() int { return 1000 }
+	tr := backlogMgr.taskReader
+
+	// without adaptive batch sizing the batch is always the max
+	tr.adaptiveBatchSize = 10
+	assert.Equal(t, 1000, tr.getTasksBatchSize(5))
+
+	backlogMgr.config.AdaptiveBatchEnabled = func() bool { return true }
+	assert.Equal(t, 10, tr.getTasksBatchSize(5))
+	// a range of more task IDs than the adaptive batch size is read in one batch, up to the max
+	assert.Equal(t, 500, tr.getTasksBatchSize(500))
+	assert.Equal(t, 1000, tr.getTasksBatchSize(5000))
+}
+
+// benchmarkTaskStore is an in-memory task store for BenchmarkTaskReader_BatchSize that, unlike testTaskManager,
+// honors the page size of GetTasks, and counts the reads and the tasks requested by them.
+type benchmarkTaskStore struct {
+	persistence.TaskManager
+	taskIDs   []int64
+	reads     int
+	requested int
+}
+
+func (s *benchmarkTaskStore) GetTasks(
+	_ context.Context,
+	request *persistence.GetTasksRequest,
+) (*persistence.GetTasksResponse, error) {
+	s.reads++
+	s.requested += request.PageSize
+	var tasks []*persistencespb.AllocatedTaskInfo
+	for _, taskID := range s.taskIDs {
+		if taskID < request.InclusiveMinTaskID || taskID >= request.ExclusiveMaxTaskID {
+			continue
+		}
+		if len(tasks) == request.PageSize {
+			break
+		}
+		tasks = append(tasks, &persistencespb.AllocatedTaskInfo{TaskId: taskID, Data: &persistencespb.TaskInfo{}})
+	}
+	return &persistence.GetTasksResponse{Tasks: tasks}, nil
+}
+
+// BenchmarkTaskReader_BatchSize runs the task reader over a bursty task queue, which is quiet most of the time and
+// receives a large burst of tasks periodically, and reports the number of persistence reads and the number of tasks
+// requested by them, with and without adaptive batch sizing.
+func BenchmarkTaskReader_BatchSize(b *testing.B) {
+	const (
+		maxBatchSize = 1000
+		steps        = 10000
+		burstPeriod  = 500
+		burstSize    = 5000
+	)
+
+	run := func(b *testing.B, adaptive bool) {
+		ctx := context.Background()
+		controller := gomock.NewController(b)
+		f, err := tqid.NewTaskQueueFamily("", "test-queue")
+		require.NoError(b, err)
+		prtn := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW).NormalPartition(0)
+		pqMgr := NewMockphysicalTaskQueueManager(controller)
+		pqMgr.EXPECT().QueueKey().Return(UnversionedQueueKey(prtn)).AnyTimes()
+		cfg := NewConfig(dynamicconfig.NewNoopCollection())
+		cfg.GetTasksBatchSize = dynamicconfig.GetIntPropertyFnFilteredByTaskQueue(maxBatchSize)
+		cfg.AdaptiveBatchEnabled = dynamicconfig.GetBoolPropertyFnFilteredByTaskQueue(adaptive)
+		tqCfg := newTaskQueueConfig(prtn.TaskQueue(), cfg, "test-namespace")
+
+		var reads, requested int
+		for i := 0; i < b.N; i++ {
+			store := &benchmarkTaskStore{}
+			logger := log.NewNoopLogger()
+			backlogMgr := newBacklogManager(
+				pqMgr, tqCfg, store, logger, logger, nil, metrics.NoopMetricsHandler, defaultContextInfoProvider,
+			)
+			tr := backlogMgr.taskReader
+			nextTaskID := int64(1)
+			for step := 0; step < steps; step++ {
+				numNewTasks := 0
+				if step%burstPeriod == 0 {
+					numNewTasks = burstSize
+				} else if step%10 == 0 {
+					numNewTasks = 1
+				}
+				if numNewTasks == 0 {
+					continue
+				}
+				for j := 0; j < numNewTasks; j++ {
+					store.taskIDs = append(store.taskIDs, nextTaskID)
+					nextTaskID++
+				}
+				backlogMgr.db.SetMaxReadLevel(nextTaskID - 1)
+
+				// The writer signals the reader after writing tasks. Like getTasksPump, keep reading while reads
+				// return tasks, or stop at an empty read that is done. Tasks are dispatched as soon as they are read.
+				for {
+					batch, err := tr.getTaskBatch(ctx)
+					require.NoError(b, err)
+					if len(batch.tasks) == 0 {
+						backlogMgr.taskAckManager.setReadLevelAfterGap(batch.readLevel)
+						if batch.isReadBatchDone {
+							break
+						}
+						continue
+					}
+					for _, task := range batch.tasks {
+						backlogMgr.taskAckManager.addTask(task.GetTaskId())
+						backlogMgr.taskAckManager.completeTask(task.GetTaskId())
+					}
+				}
+				store.taskIDs = store.taskIDs[:0]
+			}
+			reads += store.reads
+			requested += store.requested
+		}
+		b.ReportMetric(float64(reads)/float64(b.N), "reads/op")
+		b.ReportMetric(float64(requested)/float64(b.N), "requested_tasks/op")
+	}
+
+	b.Run("fixed", func(b *testing.B) { run(b, false) })
+	b.Run("adaptive", func(b *testing.B) { run(b, true) })
+}
@@ -144,6 +144,7 @@ func newUserDataManager(
 func (m *userDataManagerImpl) Start() {
 	if m.store != nil {
 		m.goroGroup.Go(m.loadUserData)
+		m.goroGroup.Go(m.compactRuleTombstones)
 	} else {
 		m.goroGroup.Go(m.fetchUserData)
 	}
@@ -343,6 +344,46 @@ func (m *userDataManagerImpl) loadUserDataFromDB(ctx context.Context) error {
 	return nil
 }
 
+// compactRuleTombstones periodically removes versioning rule tombstones that are past their retention time.
+// Tombstones are otherwise only removed on the next versioning data update, so task queues that rarely update
+// their versioning data would keep them indefinitely.
+func (m *userDataManagerImpl) compactRuleTombstones(ctx context.Context) error {
+	ctx = m.callerInfoContext(ctx)
+	if err := m.WaitUntilInitialized(ctx); err != nil {
+		return err
+	}
+
+	for {
+		util.InterruptibleSleep(ctx, m.config.DeletedRuleCompactionInterval())
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := m.compactRuleTombstonesOnce(ctx); err != nil {
+			m.logger.Warn("Failed to compact versioning rule tombstones", tag.Error(err))
+		}
+	}
+}
+
+func (m *userDataManagerImpl) compactRuleTombstonesOnce(ctx context.Context) error {
+	retentionTime := m.config.DeletedRuleRetentionTime()
+	userData, _, err := m.GetUserData()
+	if err != nil {
+		return err
+	}
+	if !hasExpiredRuleTombstones(userData.GetData().GetVersioningData(), retentionTime) {
+		return nil
+	}
+
+	// Tombstone cleanup is based on DeleteTimestamp only, so each cluster compacts its own copy and there is
+	// no need to replicate.
+	return m.UpdateUserData(ctx, UserDataUpdateOptions{}, func(data *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, bool, error) {
+		// Avoid mutation
+		ret := common.CloneProto(data)
+		ret.VersioningData = CleanupRuleTombstones(data.GetVersioningData(), retentionTime)
+		return ret, false, nil
+	})
+}
+
 // UpdateUserData updates user data for this task queue and replicates across clusters if necessary.
 // Extra care should be taken to avoid mutating the existing data in the update function.
 func (m *userDataManagerImpl) UpdateUserData(ctx context.Context, options UserDataUpdateOptions, updateFn UserDataUpdateFunc) error {
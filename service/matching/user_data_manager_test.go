@@ -39,6 +39,8 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/clock"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
@@ -533,6 +535,71 @@ func TestUserData_UpdateOnNonRootFails(t *testing.T) {
 	require.ErrorIs(t, err, errUserDataNoMutateNonRoot)
 }
 
+func TestUserData_CompactsRuleTombstonesWithoutUpdate(t *testing.T) {
+	t.Parallel()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	ctx := context.Background()
+	dbq := newTestUnversionedPhysicalQueueKey(defaultNamespaceId, defaultRootTqID, enumspb.TASK_QUEUE_TYPE_WORKFLOW, 0)
+	tqCfg := defaultTqmTestOpts(controller)
+	tqCfg.dbq = dbq
+	tqCfg.config.DeletedRuleRetentionTime = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(time.Hour)
+	tqCfg.config.DeletedRuleCompactionInterval = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(10 * time.Millisecond)
+
+	// rules created two hours ago, one deleted an hour and a half ago (past retention) and one deleted just now
+	timesource := clock.NewEventTimeSource().Update(time.Now().Add(-2 * time.Hour))
+	createTs := hlc.Next(hlc.Zero(1), timesource)
+	timesource.Advance(30 * time.Minute)
+	expiredDeleteTs := hlc.Next(createTs, timesource)
+	recentDeleteTs := hlc.Next(expiredDeleteTs, clock.NewRealTimeSource())
+
+	data1 := &persistencespb.VersionedTaskQueueUserData{
+		Version: 1,
+		Data: &persistencespb.TaskQueueUserData{
+			Clock: createTs,
+			VersioningData: &persistencespb.VersioningData{
+				AssignmentRules: []*persistencespb.AssignmentRule{
+					mkAssignmentRulePersistence(mkAssignmentRule("1", nil), createTs, nil),
+					mkAssignmentRulePersistence(mkAssignmentRule("2", nil), createTs, expiredDeleteTs),
+				},
+				RedirectRules: []*persistencespb.RedirectRule{
+					mkRedirectRulePersistence(mkRedirectRule("3", "1"), createTs, expiredDeleteTs),
+					mkRedirectRulePersistence(mkRedirectRule("4", "1"), createTs, recentDeleteTs),
+				},
+			},
+		},
+	}
+
+	tqCfg.matchingClientMock.EXPECT().UpdateTaskQueueUserData(gomock.Any(), gomock.Any()).
+		Return(&matchingservice.UpdateTaskQueueUserDataResponse{}, nil).Times(1)
+
+	m := createUserDataManager(t, controller, tqCfg)
+	require.NoError(t, m.store.UpdateTaskQueueUserData(context.Background(),
+		&persistence.UpdateTaskQueueUserDataRequest{
+			NamespaceID: defaultNamespaceId,
+			TaskQueue:   defaultRootTqID,
+			UserData:    data1,
+		}))
+
+	m.Start()
+	defer m.Stop()
+	require.NoError(t, m.WaitUntilInitialized(ctx))
+
+	require.Eventually(t, func() bool {
+		userData, _, err := m.GetUserData()
+		require.NoError(t, err)
+		return len(userData.GetData().GetVersioningData().GetAssignmentRules()) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	userData, _, err := m.GetUserData()
+	require.NoError(t, err)
+	versioningData := userData.GetData().GetVersioningData()
+	require.Equal(t, "1", versioningData.GetAssignmentRules()[0].GetRule().GetTargetBuildId())
+	require.Len(t, versioningData.GetRedirectRules(), 1)
+	require.Equal(t, "4", versioningData.GetRedirectRules()[0].GetRule().GetSourceBuildId())
+}
+
 func newTestUnversionedPhysicalQueueKey(namespaceId string, name string, taskType enumspb.TaskQueueType, partition int) *PhysicalTaskQueueKey {
 	return UnversionedQueueKey(newTestTaskQueue(namespaceId, name, taskType).NormalPartition(partition))
 }
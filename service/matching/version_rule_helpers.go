@@ -270,6 +270,21 @@ func CleanupRuleTombstones(versioningData *persistencespb.VersioningData,
 	return modifiedData
 }
 
+// hasExpiredRuleTombstones returns true if any rule in versioningData was deleted more than retentionTime ago.
+func hasExpiredRuleTombstones(versioningData *persistencespb.VersioningData, retentionTime time.Duration) bool {
+	for _, ar := range versioningData.GetAssignmentRules() {
+		if ar.DeleteTimestamp != nil && hlc.Since(ar.DeleteTimestamp) >= retentionTime {
+			return true
+		}
+	}
+	for _, rr := range versioningData.GetRedirectRules() {
+		if rr.DeleteTimestamp != nil && hlc.Since(rr.DeleteTimestamp) >= retentionTime {
+			return true
+		}
+	}
+	return false
+}
+
 // CommitBuildID makes the following changes. If no worker that can accept tasks for the
 // target build ID has been seen recently, the operation will fail.
 // To override this check, set the force flag:
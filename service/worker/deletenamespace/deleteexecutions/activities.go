@@ -26,6 +26,7 @@ package deleteexecutions
 
 import (
 	"context"
+	"fmt"
 
 	"go.temporal.io/api/serviceerror"
 	workflowpb "go.temporal.io/api/workflow/v1"
@@ -38,6 +39,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/searchattribute"
 )
@@ -56,6 +58,11 @@ type (
 		logger            log.Logger
 	}
 
+	GetConcurrentDeleteExecutionsActivitiesParams struct {
+		Namespace namespace.Name
+		Config    DeleteExecutionsConfig
+	}
+
 	GetNextPageTokenParams struct {
 		Namespace     namespace.Name
 		NamespaceID   namespace.ID
@@ -124,6 +131,27 @@ func (a *LocalActivities) GetNextPageTokenActivity(ctx context.Context, params G
 	return resp.NextPageToken, nil
 }
 
+// GetConcurrentDeleteExecutionsActivitiesActivity returns the number of concurrent delete executions activities
+// the namespace deletion may run, sharing TotalConcurrentDeleteExecutionsActivities with other running deletions.
+func (a *LocalActivities) GetConcurrentDeleteExecutionsActivitiesActivity(ctx context.Context, params GetConcurrentDeleteExecutionsActivitiesParams) (int, error) {
+	ctx = headers.SetCallerName(ctx, primitives.SystemLocalNamespace)
+
+	resp, err := a.visibilityManager.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: primitives.SystemNamespaceID,
+		Namespace:   primitives.SystemLocalNamespace,
+		Query: fmt.Sprintf("%s = '%s' AND %s = 'Running'",
+			searchattribute.WorkflowType, WorkflowName, searchattribute.ExecutionStatus),
+	})
+	if err != nil {
+		// Not being able to count other deletions must not block this one. Use the namespace concurrency.
+		metrics.CountExecutionsFailuresCount.With(a.metricsHandler).Record(1)
+		a.logger.Warn("Unable to count running namespace deletions, using configured concurrency.", tag.WorkflowNamespace(params.Namespace.String()), tag.Error(err))
+		return params.Config.ConcurrentDeleteExecutionsActivities, nil
+	}
+
+	return params.Config.effectiveConcurrentDeleteExecutionsActivities(resp.Count), nil
+}
+
 func (a *Activities) DeleteExecutionsActivity(ctx context.Context, params DeleteExecutionsActivityParams) (DeleteExecutionsActivityResult, error) {
 	ctx = headers.SetCallerName(ctx, params.Namespace.String())
 
@@ -48,6 +48,9 @@ type (
 		// Number of concurrent delete executions activities.
 		// Must be not greater than PagesPerExecution and number of worker cores in the cluster.
 		ConcurrentDeleteExecutionsActivities int
+		// Number of concurrent delete executions activities shared by all namespaces being deleted at the same time.
+		// If set, ConcurrentDeleteExecutionsActivities is lowered to this namespace's share of it. 0 means no shared budget.
+		TotalConcurrentDeleteExecutionsActivities int
 	}
 )
 
@@ -73,6 +76,26 @@ func (cfg *DeleteExecutionsConfig) ApplyDefaults() {
 	}
 }
 
+// effectiveConcurrentDeleteExecutionsActivities returns the namespace's share of TotalConcurrentDeleteExecutionsActivities
+// when runningDeletions namespaces are being deleted at the same time. The share is capped by ConcurrentDeleteExecutionsActivities
+// and is at least 1 so every deletion makes progress.
+func (cfg *DeleteExecutionsConfig) effectiveConcurrentDeleteExecutionsActivities(runningDeletions int64) int {
+	if cfg.TotalConcurrentDeleteExecutionsActivities <= 0 {
+		return cfg.ConcurrentDeleteExecutionsActivities
+	}
+	if runningDeletions < 1 {
+		runningDeletions = 1
+	}
+	share := int(int64(cfg.TotalConcurrentDeleteExecutionsActivities) / runningDeletions)
+	if share < 1 {
+		share = 1
+	}
+	if share > cfg.ConcurrentDeleteExecutionsActivities {
+		share = cfg.ConcurrentDeleteExecutionsActivities
+	}
+	return share
+}
+
 func (cfg DeleteExecutionsConfig) String() string {
 	cfgBytes, _ := json.Marshal(cfg)
 	return string(cfgBytes)
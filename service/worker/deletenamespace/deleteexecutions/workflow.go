@@ -111,6 +111,21 @@ func DeleteExecutionsWorkflow(ctx workflow.Context, params DeleteExecutionsParam
 
 	ctx = workflow.WithTaskQueue(ctx, primitives.DeleteNamespaceActivityTQ)
 
+	// Share the cluster-wide budget with other running namespace deletions.
+	// The share is recomputed on every ContinueAsNew, params are carried over unchanged.
+	concurrentDeleteExecutionsActivities := params.Config.ConcurrentDeleteExecutionsActivities
+	if params.Config.TotalConcurrentDeleteExecutionsActivities > 0 {
+		ctx1 := workflow.WithLocalActivityOptions(ctx, localActivityOptions)
+		err := workflow.ExecuteLocalActivity(ctx1, la.GetConcurrentDeleteExecutionsActivitiesActivity, GetConcurrentDeleteExecutionsActivitiesParams{
+			Namespace: params.Namespace,
+			Config:    params.Config,
+		}).Get(ctx, &concurrentDeleteExecutionsActivities)
+		if err != nil {
+			return result, fmt.Errorf("%w: GetConcurrentDeleteExecutionsActivitiesActivity: %v", errors.ErrUnableToExecuteActivity, err)
+		}
+		logger.Info("Effective concurrent delete executions activities.", tag.WorkflowNamespace(params.Namespace.String()), tag.Value(concurrentDeleteExecutionsActivities))
+	}
+
 	nextPageToken := params.NextPageToken
 	runningDeleteExecutionsActivityCount := 0
 	runningDeleteExecutionsSelector := workflow.NewSelector(ctx)
@@ -156,7 +171,7 @@ func DeleteExecutionsWorkflow(ctx workflow.Context, params DeleteExecutionsParam
 			result.ErrorCount += der.ErrorCount
 		})
 
-		if runningDeleteExecutionsActivityCount >= params.Config.ConcurrentDeleteExecutionsActivities {
+		if runningDeleteExecutionsActivityCount >= concurrentDeleteExecutionsActivities {
 			// Wait for one of running activities to complete.
 			runningDeleteExecutionsSelector.Select(ctx)
 			if lastDeleteExecutionsActivityErr != nil {
@@ -29,6 +29,7 @@ import (
 	stderrors "errors"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/mock"
@@ -47,6 +48,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/searchattribute"
 )
 
@@ -224,6 +226,103 @@ func Test_DeleteExecutionsWorkflow_ManyExecutions_ContinueAsNew(t *testing.T) {
 	require.Equal(t, []byte{3, 22, 83}, newWfParams.NextPageToken)
 }
 
+func Test_DeleteExecutionsWorkflow_SharedConcurrencyBudget(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *Activities
+	var la *LocalActivities
+
+	config := DeleteExecutionsConfig{
+		DeleteActivityRPS:                         100,
+		PageSize:                                  3,
+		PagesPerExecution:                         10,
+		ConcurrentDeleteExecutionsActivities:      8,
+		TotalConcurrentDeleteExecutionsActivities: 8,
+	}
+	env.OnActivity(la.GetConcurrentDeleteExecutionsActivitiesActivity, mock.Anything, GetConcurrentDeleteExecutionsActivitiesParams{
+		Namespace: "namespace",
+		Config:    config,
+	}).Return(2, nil).Once()
+	env.OnActivity(la.GetNextPageTokenActivity, mock.Anything, mock.Anything).Return([]byte{3, 22, 83}, nil).Times(10)
+	runningActivities := atomic.Int32{}
+	maxRunningActivities := atomic.Int32{}
+	env.OnActivity(a.DeleteExecutionsActivity, mock.Anything, mock.Anything).Return(func(_ context.Context, _ DeleteExecutionsActivityParams) (DeleteExecutionsActivityResult, error) {
+		running := runningActivities.Add(1)
+		defer runningActivities.Add(-1)
+		for {
+			maxRunning := maxRunningActivities.Load()
+			if running <= maxRunning || maxRunningActivities.CompareAndSwap(maxRunning, running) {
+				break
+			}
+		}
+		// Keep the activity running long enough for the workflow to start the next ones if the budget allowed it.
+		time.Sleep(10 * time.Millisecond)
+		return DeleteExecutionsActivityResult{SuccessCount: 1, ErrorCount: 0}, nil
+	}).Times(10)
+
+	env.ExecuteWorkflow(DeleteExecutionsWorkflow, DeleteExecutionsParams{
+		NamespaceID: "namespace-id",
+		Namespace:   "namespace",
+		Config:      config,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	wfErr := env.GetWorkflowError()
+	var errContinueAsNew *workflow.ContinueAsNewError
+	require.ErrorAs(t, wfErr, &errContinueAsNew)
+	// The workflow never runs more delete executions activities than its share of the budget.
+	require.LessOrEqual(t, maxRunningActivities.Load(), int32(2))
+
+	// The share is recomputed by the next run, so the configured concurrency is carried over unchanged.
+	var newWfParams DeleteExecutionsParams
+	err := payloads.Decode(errContinueAsNew.Input, &newWfParams)
+	require.NoError(t, err)
+	require.Equal(t, 10, newWfParams.PreviousSuccessCount)
+	require.Equal(t, 8, newWfParams.Config.ConcurrentDeleteExecutionsActivities)
+	require.Equal(t, 8, newWfParams.Config.TotalConcurrentDeleteExecutionsActivities)
+}
+
+func Test_GetConcurrentDeleteExecutionsActivitiesActivity_TwoSimultaneousDeletions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	visibilityManager := manager.NewMockVisibilityManager(ctrl)
+	la := &LocalActivities{
+		visibilityManager: visibilityManager,
+		metricsHandler:    metrics.NoopMetricsHandler,
+		logger:            log.NewNoopLogger(),
+	}
+	params := GetConcurrentDeleteExecutionsActivitiesParams{
+		Namespace: "namespace",
+		Config: DeleteExecutionsConfig{
+			ConcurrentDeleteExecutionsActivities:      6,
+			TotalConcurrentDeleteExecutionsActivities: 8,
+		},
+	}
+
+	// Only this namespace is being deleted: capped by the namespace concurrency.
+	visibilityManager.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.CountWorkflowExecutionsResponse{Count: 1}, nil)
+	concurrency, err := la.GetConcurrentDeleteExecutionsActivitiesActivity(context.Background(), params)
+	require.NoError(t, err)
+	require.Equal(t, 6, concurrency)
+
+	// Two namespaces are being deleted at the same time: each gets half of the budget.
+	visibilityManager.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.CountWorkflowExecutionsRequest) (*manager.CountWorkflowExecutionsResponse, error) {
+			require.Equal(t, namespace.ID(primitives.SystemNamespaceID), request.NamespaceID)
+			require.Contains(t, request.Query, WorkflowName)
+			return &manager.CountWorkflowExecutionsResponse{Count: 2}, nil
+		})
+	concurrency, err = la.GetConcurrentDeleteExecutionsActivitiesActivity(context.Background(), params)
+	require.NoError(t, err)
+	require.Equal(t, 4, concurrency)
+
+	// Failing to count falls back to the namespace concurrency.
+	visibilityManager.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewUnavailable("visibility unavailable"))
+	concurrency, err = la.GetConcurrentDeleteExecutionsActivitiesActivity(context.Background(), params)
+	require.NoError(t, err)
+	require.Equal(t, 6, concurrency)
+}
+
 func Test_DeleteExecutionsWorkflow_ManyExecutions_ActivityError(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestWorkflowEnvironment()
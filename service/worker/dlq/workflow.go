@@ -30,6 +30,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"go.temporal.io/api/serviceerror"
@@ -80,6 +81,9 @@ type (
 		Key
 		// MaxMessageID is inclusive.
 		MaxMessageID int64
+		// FailureReasonPattern only deletes tasks whose failure reason matches it when set. Tasks which don't match are
+		// moved to the end of the DLQ, and the tasks are then processed in batches of DefaultMergeBatchSize.
+		FailureReasonPattern string
 	}
 
 	// MergeParams contain the target DLQ and the max message ID to merge up to.
@@ -90,6 +94,9 @@ type (
 		// BatchSize controls the number of tasks to both read and re-enqueue at a time.
 		// The maximum is MaxMergeBatchSize. The default is DefaultMergeBatchSize.
 		BatchSize int
+		// FailureReasonPattern only re-enqueues tasks whose failure reason matches it when set. Tasks which don't match
+		// are moved to the end of the DLQ.
+		FailureReasonPattern string
 	}
 	// ProgressQueryResponse is the response to progress query.
 	ProgressQueryResponse struct {
@@ -163,7 +170,8 @@ const (
 	// WorkflowName is the name of the DLQ workflow.
 	WorkflowName = "temporal-sys-dlq-workflow"
 	// WorkflowTypeDelete is what the value of WorkflowParams.WorkflowType should be to delete DLQ tasks. When this is
-	// specified, the workflow will simply delete all tasks up to the specified max message ID.
+	// specified, the workflow will simply delete all tasks up to the specified max message ID, unless a failure reason
+	// pattern is set.
 	WorkflowTypeDelete = "delete"
 	// WorkflowTypeMerge is for re-enqueuing DLQ tasks. When this is specified, the workflow will operate in batches.
 	// For each batch, it will read up to MergeParams.BatchSize tasks from the DLQ, re-enqueue them, and then delete
@@ -241,6 +249,14 @@ func (c *workerComponent) workflow(ctx workflow.Context, params WorkflowParams)
 			SourceCluster:  params.DeleteParams.SourceCluster,
 			TargetCluster:  params.DeleteParams.TargetCluster,
 		}
+		if params.DeleteParams.FailureReasonPattern != "" {
+			// Deleting only some of the tasks means reading them, so this is done in batches, like a merge.
+			return c.processTasks(ctx, MergeParams{
+				Key:                  params.DeleteParams.Key,
+				MaxMessageID:         params.DeleteParams.MaxMessageID,
+				FailureReasonPattern: params.DeleteParams.FailureReasonPattern,
+			}, false, &queryResponse.LastProcessedMessageID, &queryResponse.NumberOfMessagesProcessed)
+		}
 		var response historyservice.DeleteDLQTasksResponse
 		err = workflow.ExecuteActivity(
 			workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
@@ -264,7 +280,7 @@ func (c *workerComponent) workflow(ctx workflow.Context, params WorkflowParams)
 			SourceCluster:  params.MergeParams.SourceCluster,
 			TargetCluster:  params.MergeParams.TargetCluster,
 		}
-		return c.mergeTasks(ctx, params.MergeParams, &queryResponse.LastProcessedMessageID, &queryResponse.NumberOfMessagesProcessed)
+		return c.processTasks(ctx, params.MergeParams, true, &queryResponse.LastProcessedMessageID, &queryResponse.NumberOfMessagesProcessed)
 	}
 
 	return temporal.NewNonRetryableApplicationError(params.WorkflowType, errorTypeInvalidWorkflowType, nil)
@@ -280,6 +296,7 @@ func (c *workerComponent) deleteTasks(ctx context.Context, params DeleteParams)
 		InclusiveMaxTaskMetadata: &commonspb.HistoryDLQTaskMetadata{
 			MessageId: params.MaxMessageID,
 		},
+		FailureReasonPattern: params.FailureReasonPattern,
 	}
 
 	resp, err := c.historyClient.DeleteDLQTasks(ctx, req)
@@ -290,9 +307,13 @@ func (c *workerComponent) deleteTasks(ctx context.Context, params DeleteParams)
 	return resp, err
 }
 
-func (c *workerComponent) mergeTasks(
+// processTasks reads the DLQ in batches and deletes each batch, re-enqueueing its tasks first if reEnqueue is true.
+// When a failure reason pattern is set, only the matching tasks of a batch are re-enqueued and deleted, and the rest
+// are moved to the end of the DLQ. The max message ID is then lowered so that the moved tasks aren't processed again.
+func (c *workerComponent) processTasks(
 	ctx workflow.Context,
 	params MergeParams,
+	reEnqueue bool,
 	lastProcessedMessageID *int64,
 	numberOfMessagesProcessed *int64,
 ) error {
@@ -336,13 +357,25 @@ func (c *workerComponent) mergeTasks(
 			}
 		}
 
+		// 1.c. Filter out tasks whose failure reason doesn't match the pattern. The pattern was validated when parsing
+		// the params, so matching can't fail here.
+		if params.FailureReasonPattern != "" {
+			historyTasks = slices.DeleteFunc(historyTasks, func(task *commonspb.HistoryTask) bool {
+				match, _ := persistence.MatchDLQErrorPattern(params.FailureReasonPattern, task.FailureReason)
+				return !match
+			})
+		}
+
 		// 2. Re-enqueue tasks.
-		err = workflow.ExecuteActivity(ctx, reEnqueueTasksActivityName, params, historyTasks).Get(ctx, nil)
-		if err != nil {
-			return err
+		if reEnqueue {
+			err = workflow.ExecuteActivity(ctx, reEnqueueTasksActivityName, params, historyTasks).Get(ctx, nil)
+			if err != nil {
+				return err
+			}
 		}
 
 		// 3. Delete tasks from the DLQ.
+		var deleteResponse historyservice.DeleteDLQTasksResponse
 		err = workflow.ExecuteActivity(
 			workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
 				TaskQueue:           primitives.DLQActivityTQ,
@@ -351,13 +384,17 @@ func (c *workerComponent) mergeTasks(
 			}),
 			deleteTasksActivityName,
 			DeleteParams{
-				Key:          params.Key,
-				MaxMessageID: maxBatchMessageID,
+				Key:                  params.Key,
+				MaxMessageID:         maxBatchMessageID,
+				FailureReasonPattern: params.FailureReasonPattern,
 			},
-		).Get(ctx, nil)
+		).Get(ctx, &deleteResponse)
 		if err != nil {
 			return err
 		}
+		if moved := deleteResponse.FirstMovedTaskMetadata; moved != nil {
+			params.MaxMessageID = min(params.MaxMessageID, moved.MessageId-1)
+		}
 		*lastProcessedMessageID = maxBatchMessageID
 		*numberOfMessagesProcessed += int64(len(historyTasks))
 		// 4. Check if we're done.
@@ -365,7 +402,7 @@ func (c *workerComponent) mergeTasks(
 			return nil
 		}
 
-		if maxBatchMessageID == params.MaxMessageID {
+		if maxBatchMessageID >= params.MaxMessageID {
 			return nil
 		}
 	}
@@ -395,6 +432,14 @@ func parseMergeParams(params MergeParams) (MergeParams, error) {
 		params.BatchSize = DefaultMergeBatchSize
 	}
 
+	if _, err := persistence.MatchDLQErrorPattern(params.FailureReasonPattern, ""); err != nil {
+		return params, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("Invalid failure reason pattern %q", params.FailureReasonPattern),
+			errorTypeInvalidRequest,
+			err,
+		)
+	}
+
 	return params, nil
 }
 
@@ -422,10 +467,14 @@ func (c *workerComponent) reEnqueueTasks(
 		return fmt.Errorf("unable to dial admin service for cluster %q: %w", params.SourceCluster, err)
 	}
 
+	// The token is the same for every attempt of this activity, so the history service doesn't add the tasks twice
+	// when an attempt is retried after an AddTasks call that timed out.
+	info := activity.GetInfo(ctx)
 	for shardID, batch := range tasksByShard {
 		_, err := taskClient.AddTasks(ctx, &adminservice.AddTasksRequest{
-			ShardId: shardID,
-			Tasks:   batch,
+			ShardId:          shardID,
+			Tasks:            batch,
+			IdempotencyToken: fmt.Sprintf("%s/%s/%d", info.WorkflowExecution.RunID, info.ActivityID, shardID),
 		})
 		if err != nil {
 			return c.convertServerErr(err, fmt.Sprintf(
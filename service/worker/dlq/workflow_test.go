@@ -335,6 +335,54 @@ func TestModule(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "merge_add_tasks_retry_reuses_idempotency_token",
+			configure: func(t *testing.T, params *testParams) {
+				params.setDefaultMergeParams(t)
+				params.workflowParams.MergeParams.MaxMessageID = 0
+				params.expectedQueryResp.MaxMessageIDToProcess = 0
+				params.expectedQueryResp.NumberOfMessagesProcessed = 1
+				params.client.getTasksFn = func(
+					*historyservice.GetDLQTasksRequest,
+				) (*historyservice.GetDLQTasksResponse, error) {
+					return &historyservice.GetDLQTasksResponse{
+						DlqTasks: []*commonspb.HistoryDLQTask{
+							{
+								Metadata: &commonspb.HistoryDLQTaskMetadata{
+									MessageId: 0,
+								},
+								Payload: &commonspb.HistoryTask{
+									ShardId: 1,
+								},
+							},
+						},
+					}, nil
+				}
+				var addTasksRequests []*adminservice.AddTasksRequest
+				params.taskClientDialer = dlq.TaskClientDialerFn(func(
+					ctx context.Context,
+					address string,
+				) (dlq.TaskClient, error) {
+					return dlq.AddTasksFn(func(
+						ctx context.Context,
+						req *adminservice.AddTasksRequest,
+					) (*adminservice.AddTasksResponse, error) {
+						addTasksRequests = append(addTasksRequests, req)
+						if len(addTasksRequests) == 1 {
+							return nil, serviceerror.NewUnavailable("timed out")
+						}
+						return &adminservice.AddTasksResponse{}, nil
+					}), nil
+				})
+				params.expectation = func(err error) {
+					require.NoError(t, err)
+					require.Len(t, addTasksRequests, 2)
+					assert.NotEmpty(t, addTasksRequests[0].GetIdempotencyToken())
+					assert.Equal(t, addTasksRequests[0].GetIdempotencyToken(), addTasksRequests[1].GetIdempotencyToken(),
+						"A retried re-enqueue should reuse the idempotency token")
+				}
+			},
+		},
 		{
 			name: "merge_delete_tasks_non-retryable_error",
 			configure: func(t *testing.T, params *testParams) {
@@ -391,6 +439,106 @@ func TestModule(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "merge_failure_reason_pattern",
+			configure: func(t *testing.T, params *testParams) {
+				params.setDefaultMergeParams(t)
+				params.workflowParams.MergeParams.MaxMessageID = 10
+				params.workflowParams.MergeParams.FailureReasonPattern = "transient.*"
+				params.expectedQueryResp.MaxMessageIDToProcess = 10
+				params.expectedQueryResp.LastProcessedMessageID = 2
+				params.expectedQueryResp.NumberOfMessagesProcessed = 2
+				var (
+					getRequests    []*historyservice.GetDLQTasksRequest
+					addRequests    []*adminservice.AddTasksRequest
+					deleteRequests []*historyservice.DeleteDLQTasksRequest
+				)
+				params.client.getTasksFn = func(
+					req *historyservice.GetDLQTasksRequest,
+				) (*historyservice.GetDLQTasksResponse, error) {
+					getRequests = append(getRequests, req)
+					return getFailureReasonResponse(), nil
+				}
+				params.client.deleteTasksFn = func(
+					req *historyservice.DeleteDLQTasksRequest,
+				) (*historyservice.DeleteDLQTasksResponse, error) {
+					deleteRequests = append(deleteRequests, req)
+					// The non-matching task is moved to the end of the DLQ, after the max message ID of the batch.
+					return &historyservice.DeleteDLQTasksResponse{
+						MessagesDeleted:        2,
+						FirstMovedTaskMetadata: &commonspb.HistoryDLQTaskMetadata{MessageId: 3},
+					}, nil
+				}
+				params.taskClientDialer = dlq.TaskClientDialerFn(func(ctx context.Context, address string) (dlq.TaskClient, error) {
+					return dlq.AddTasksFn(func(ctx context.Context, req *adminservice.AddTasksRequest) (*adminservice.AddTasksResponse, error) {
+						addRequests = append(addRequests, req)
+						return nil, nil
+					}), nil
+				})
+				params.expectation = func(err error) {
+					require.NoError(t, err)
+					// The moved task lowers the max message ID, so it isn't read again.
+					assert.Len(t, getRequests, 1)
+					shardIDs := make([]int32, 0, len(addRequests))
+					for _, request := range addRequests {
+						shardIDs = append(shardIDs, request.GetShardId())
+					}
+					assert.ElementsMatch(t, []int32{1, 3}, shardIDs)
+					require.Len(t, deleteRequests, 1)
+					assert.Equal(t, int64(2), deleteRequests[0].GetInclusiveMaxTaskMetadata().GetMessageId())
+					assert.Equal(t, "transient.*", deleteRequests[0].GetFailureReasonPattern())
+				}
+			},
+		},
+		{
+			name: "merge_invalid_failure_reason_pattern",
+			configure: func(t *testing.T, params *testParams) {
+				params.setDefaultMergeParams(t)
+				params.workflowParams.MergeParams.FailureReasonPattern = "("
+				params.expectation = func(err error) {
+					var applicationErr *temporal.ApplicationError
+					require.ErrorAs(t, err, &applicationErr)
+					assert.True(t, applicationErr.NonRetryable(),
+						"Invalid failure reason pattern should be non-retryable")
+					assert.ErrorContains(t, err, "failure reason pattern")
+				}
+			},
+		},
+		{
+			name: "delete_failure_reason_pattern",
+			configure: func(t *testing.T, params *testParams) {
+				params.setDefaultDeleteParams(t)
+				params.workflowParams.DeleteParams.MaxMessageID = 10
+				params.workflowParams.DeleteParams.FailureReasonPattern = "glob:transient*"
+				params.expectedQueryResp.MaxMessageIDToProcess = 10
+				params.expectedQueryResp.LastProcessedMessageID = 2
+				params.expectedQueryResp.NumberOfMessagesProcessed = 2
+				var deleteRequests []*historyservice.DeleteDLQTasksRequest
+				params.client.getTasksFn = func(
+					*historyservice.GetDLQTasksRequest,
+				) (*historyservice.GetDLQTasksResponse, error) {
+					return getFailureReasonResponse(), nil
+				}
+				params.client.deleteTasksFn = func(
+					req *historyservice.DeleteDLQTasksRequest,
+				) (*historyservice.DeleteDLQTasksResponse, error) {
+					deleteRequests = append(deleteRequests, req)
+					return &historyservice.DeleteDLQTasksResponse{
+						MessagesDeleted:        2,
+						FirstMovedTaskMetadata: &commonspb.HistoryDLQTaskMetadata{MessageId: 3},
+					}, nil
+				}
+				params.taskClientDialer = dlq.TaskClientDialerFn(func(ctx context.Context, address string) (dlq.TaskClient, error) {
+					return nil, assert.AnError
+				})
+				params.expectation = func(err error) {
+					require.NoError(t, err)
+					require.Len(t, deleteRequests, 1)
+					assert.Equal(t, int64(2), deleteRequests[0].GetInclusiveMaxTaskMetadata().GetMessageId())
+					assert.Equal(t, "glob:transient*", deleteRequests[0].GetFailureReasonPattern())
+				}
+			},
+		},
 		{
 			name: "merge_replication_tasks_dial_error",
 			configure: func(t *testing.T, params *testParams) {
@@ -534,6 +682,42 @@ func getPaginatedResponse(req *historyservice.GetDLQTasksRequest) (*historyservi
 	}, nil
 }
 
+// getFailureReasonResponse returns a page with two tasks that failed with a transient error and one which didn't.
+func getFailureReasonResponse() *historyservice.GetDLQTasksResponse {
+	return &historyservice.GetDLQTasksResponse{
+		DlqTasks: []*commonspb.HistoryDLQTask{
+			{
+				Metadata: &commonspb.HistoryDLQTaskMetadata{
+					MessageId: 0,
+				},
+				Payload: &commonspb.HistoryTask{
+					ShardId:       1,
+					FailureReason: "transient error",
+				},
+			},
+			{
+				Metadata: &commonspb.HistoryDLQTaskMetadata{
+					MessageId: 1,
+				},
+				Payload: &commonspb.HistoryTask{
+					ShardId:       2,
+					FailureReason: "poisoned task",
+				},
+			},
+			{
+				Metadata: &commonspb.HistoryDLQTaskMetadata{
+					MessageId: 2,
+				},
+				Payload: &commonspb.HistoryTask{
+					ShardId:       3,
+					FailureReason: "transient timeout",
+				},
+			},
+		},
+		NextPageToken: []byte{42},
+	}
+}
+
 func (p *testParams) setDefaultDeleteParams(t *testing.T) {
 	p.setDefaultParams(t)
 	p.workflowParams = dlq.WorkflowParams{
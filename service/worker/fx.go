@@ -170,8 +170,10 @@ func VisibilityManagerProvider(
 		serviceConfig.OperatorRPSRatio,
 		serviceConfig.EnableReadFromSecondaryVisibility,
 		serviceConfig.VisibilityEnableShadowReadMode,
+		serviceConfig.VisibilityShadowReadDiffSampleRate,
 		dynamicconfig.GetStringPropertyFn(visibility.SecondaryVisibilityWritingModeOff), // worker visibility never write
 		serviceConfig.VisibilityDisableOrderByClause,
+		serviceConfig.VisibilityOrderByAllowedFields,
 		serviceConfig.VisibilityEnableManualPagination,
 		metricsHandler,
 		logger,
@@ -475,7 +475,9 @@ func (a *activities) setCallerInfoForGenReplicationTask(
 
 type seedReplicationQueueWithUserDataEntriesHeartbeatDetails struct {
 	NextPageToken []byte
-	IndexInPage   int
+	// IndexInPage is the first entry of the page left to publish. Pages are published in one batch so it's always
+	// recorded as 0, but it is still honored for details recorded by workers that published entries one at a time.
+	IndexInPage int
 }
 
 func (a *activities) SeedReplicationQueueWithUserDataEntries(ctx context.Context, params TaskQueueUserDataReplicationParamsWithNamespace) error {
@@ -520,22 +522,24 @@ func (a *activities) SeedReplicationQueueWithUserDataEntries(ctx context.Context
 			a.logger.Error("List task queue user data failed", tag.WorkflowNamespaceID(request.NamespaceID), tag.Error(err))
 			return err
 		}
-		for idx, entry := range response.Entries {
-			if heartbeatDetails.IndexInPage > idx {
-				continue
-			}
-			heartbeatDetails.IndexInPage = idx
+		// The rest of the page is published as one batch, so a failed attempt publishes it again from IndexInPage.
+		entries := response.Entries[min(heartbeatDetails.IndexInPage, len(response.Entries)):]
+		if len(entries) > 0 {
 			activity.RecordHeartbeat(ctx, heartbeatDetails)
-			err = a.namespaceReplicationQueue.Publish(ctx, &replicationspb.ReplicationTask{
-				TaskType: enumsspb.REPLICATION_TASK_TYPE_TASK_QUEUE_USER_DATA,
-				Attributes: &replicationspb.ReplicationTask_TaskQueueUserDataAttributes{
-					TaskQueueUserDataAttributes: &replicationspb.TaskQueueUserDataAttributes{
-						NamespaceId:   request.NamespaceID,
-						TaskQueueName: entry.TaskQueue,
-						UserData:      entry.UserData.GetData(),
+			tasks := make([]*replicationspb.ReplicationTask, 0, len(entries))
+			for _, entry := range entries {
+				tasks = append(tasks, &replicationspb.ReplicationTask{
+					TaskType: enumsspb.REPLICATION_TASK_TYPE_TASK_QUEUE_USER_DATA,
+					Attributes: &replicationspb.ReplicationTask_TaskQueueUserDataAttributes{
+						TaskQueueUserDataAttributes: &replicationspb.TaskQueueUserDataAttributes{
+							NamespaceId:   request.NamespaceID,
+							TaskQueueName: entry.TaskQueue,
+							UserData:      entry.UserData.GetData(),
+						},
 					},
-				},
-			})
+				})
+			}
+			err = a.namespaceReplicationQueue.PublishBatch(ctx, tasks)
 			if err != nil {
 				a.logger.Error("Inserting into namespace replication queue failed", tag.WorkflowNamespaceID(request.NamespaceID), tag.Error(err))
 				return err
@@ -478,16 +478,15 @@ func TestSeedReplicationQueueWithUserDataEntries_Heartbeats(t *testing.T) {
 	)
 
 	numCalls := 0
-	mockNamespaceReplicationQueue.EXPECT().Publish(gomock.Any(), gomock.Any()).Times(3).DoAndReturn(func(ctx context.Context, task *replicationspb.ReplicationTask) error {
-		assert.Equal(t, namespaceID, task.GetTaskQueueUserDataAttributes().NamespaceId)
+	mockNamespaceReplicationQueue.EXPECT().PublishBatch(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(func(ctx context.Context, tasks []*replicationspb.ReplicationTask) error {
 		numCalls++
-		if numCalls == 1 {
-			assert.Equal(t, "a", task.GetTaskQueueUserDataAttributes().TaskQueueName)
-		} else {
-			// b is published twice
-			assert.Equal(t, "b", task.GetTaskQueueUserDataAttributes().TaskQueueName)
+		// the whole first page is published in one batch, and again after the first attempt fails
+		require.Len(t, tasks, 2)
+		for i, taskQueue := range []string{"a", "b"} {
+			assert.Equal(t, namespaceID, tasks[i].GetTaskQueueUserDataAttributes().NamespaceId)
+			assert.Equal(t, taskQueue, tasks[i].GetTaskQueueUserDataAttributes().TaskQueueName)
 		}
-		if numCalls == 2 {
+		if numCalls == 1 {
 			return errors.New("some random error")
 		}
 		return nil
@@ -501,14 +500,50 @@ func TestSeedReplicationQueueWithUserDataEntries_Heartbeats(t *testing.T) {
 	}
 	_, err := env.ExecuteActivity(a.SeedReplicationQueueWithUserDataEntries, params)
 	assert.Error(t, err)
-	assert.Equal(t, len(iceptor.seedRecordedHeartbeats), 2)
-	assert.Equal(t, []byte(nil), iceptor.seedRecordedHeartbeats[1].NextPageToken)
-	assert.Equal(t, 1, iceptor.seedRecordedHeartbeats[1].IndexInPage)
-	env.SetHeartbeatDetails(iceptor.seedRecordedHeartbeats[1])
+	assert.Equal(t, len(iceptor.seedRecordedHeartbeats), 1)
+	assert.Equal(t, []byte(nil), iceptor.seedRecordedHeartbeats[0].NextPageToken)
+	assert.Equal(t, 0, iceptor.seedRecordedHeartbeats[0].IndexInPage)
+	env.SetHeartbeatDetails(iceptor.seedRecordedHeartbeats[0])
 	_, err = env.ExecuteActivity(a.SeedReplicationQueueWithUserDataEntries, params)
 	assert.NoError(t, err)
 }
 
+func TestSeedReplicationQueueWithUserDataEntries_ResumesFromIndexInPage(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	namespaceID := uuid.New()
+
+	ctrl := gomock.NewController(t)
+	mockFrontendClient := workflowservicemock.NewMockWorkflowServiceClient(ctrl)
+	mockTaskManager := persistence.NewMockTaskManager(ctrl)
+	mockNamespaceReplicationQueue := persistence.NewMockNamespaceReplicationQueue(ctrl)
+	a := &activities{
+		namespaceReplicationQueue: mockNamespaceReplicationQueue,
+		taskManager:               mockTaskManager,
+		frontendClient:            mockFrontendClient,
+		logger:                    log.NewCLILogger(),
+	}
+
+	mockFrontendClient.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).Return(&workflowservice.DescribeNamespaceResponse{NamespaceInfo: &namespace.NamespaceInfo{Id: namespaceID}}, nil)
+	mockTaskManager.EXPECT().ListTaskQueueUserDataEntries(gomock.Any(), gomock.Any()).Return(&persistence.ListTaskQueueUserDataEntriesResponse{
+		Entries: []*persistence.TaskQueueUserDataEntry{{TaskQueue: "a"}, {TaskQueue: "b"}, {TaskQueue: "c"}},
+	}, nil)
+	mockNamespaceReplicationQueue.EXPECT().PublishBatch(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, tasks []*replicationspb.ReplicationTask) error {
+		require.Len(t, tasks, 2)
+		assert.Equal(t, "b", tasks[0].GetTaskQueueUserDataAttributes().TaskQueueName)
+		assert.Equal(t, "c", tasks[1].GetTaskQueueUserDataAttributes().TaskQueueName)
+		return nil
+	})
+	env.RegisterActivity(a)
+	env.SetHeartbeatDetails(seedReplicationQueueWithUserDataEntriesHeartbeatDetails{IndexInPage: 1})
+	_, err := env.ExecuteActivity(a.SeedReplicationQueueWithUserDataEntries, TaskQueueUserDataReplicationParamsWithNamespace{
+		TaskQueueUserDataReplicationParams: TaskQueueUserDataReplicationParams{PageSize: 10, RPS: 1},
+		Namespace:                          "foo",
+	})
+	assert.NoError(t, err)
+}
+
 // The SDK's test environment throttles emitted heartbeat forcing us to use an interceptor to record the heartbeat details
 type heartbeatRecordingInterceptor struct {
 	interceptor.WorkerInterceptorBase
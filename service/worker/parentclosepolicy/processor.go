@@ -48,6 +48,7 @@ type (
 		MaxConcurrentActivityTaskPollers       dynamicconfig.IntPropertyFn
 		MaxConcurrentWorkflowTaskPollers       dynamicconfig.IntPropertyFn
 		NumParentClosePolicySystemWorkflows    dynamicconfig.IntPropertyFn
+		ProcessorConcurrency                   dynamicconfig.IntPropertyFnWithNamespaceFilter
 	}
 
 	// BootstrapParams contains the set of params needed to bootstrap the sub-system
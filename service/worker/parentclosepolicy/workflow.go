@@ -26,6 +26,7 @@ package parentclosepolicy
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -38,6 +39,7 @@ import (
 	"go.temporal.io/sdk/converter"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"go.temporal.io/server/api/historyservice/v1"
@@ -120,56 +122,32 @@ func ProcessorActivity(ctx context.Context, request Request) error {
 	childWorkflowOnly := request.ParentExecution.GetWorkflowId() != "" &&
 		request.ParentExecution.GetRunId() != ""
 
+	var remoteExecutionsLock sync.Mutex
 	remoteExecutions := make(map[string][]RequestDetail)
-	for _, execution := range request.Executions {
-		requestCtx := headers.SetCallerName(ctx, execution.Namespace)
-
-		var err error
-		switch execution.Policy {
-		case enumspb.PARENT_CLOSE_POLICY_ABANDON:
-			// no-op
-			continue
-		case enumspb.PARENT_CLOSE_POLICY_TERMINATE:
-			_, err = client.TerminateWorkflowExecution(requestCtx, &historyservice.TerminateWorkflowExecutionRequest{
-				NamespaceId: execution.NamespaceID,
-				TerminateRequest: &workflowservice.TerminateWorkflowExecutionRequest{
-					Namespace: execution.Namespace,
-					WorkflowExecution: &commonpb.WorkflowExecution{
-						WorkflowId: execution.WorkflowID,
-					},
-					Reason:              "by parent close policy",
-					Identity:            processorWFTypeName,
-					FirstExecutionRunId: execution.RunID,
-				},
-				ExternalWorkflowExecution: request.ParentExecution,
-				ChildWorkflowOnly:         childWorkflowOnly,
-			})
-		case enumspb.PARENT_CLOSE_POLICY_REQUEST_CANCEL:
-			_, err = client.RequestCancelWorkflowExecution(requestCtx, &historyservice.RequestCancelWorkflowExecutionRequest{
-				NamespaceId: execution.NamespaceID,
-				CancelRequest: &workflowservice.RequestCancelWorkflowExecutionRequest{
-					Namespace: execution.Namespace,
-					WorkflowExecution: &commonpb.WorkflowExecution{
-						WorkflowId: execution.WorkflowID,
-					},
-					Identity:            processorWFTypeName,
-					FirstExecutionRunId: execution.RunID,
-				},
-				ExternalWorkflowExecution: request.ParentExecution,
-				ChildWorkflowOnly:         childWorkflowOnly,
+	for _, executions := range groupExecutionsByNamespace(request.Executions) {
+		var g errgroup.Group
+		g.SetLimit(max(1, processor.cfg.ProcessorConcurrency(executions[0].Namespace)))
+		for _, execution := range executions {
+			g.Go(func() error {
+				err := processExecution(ctx, client, request.ParentExecution, childWorkflowOnly, execution)
+				switch typedErr := err.(type) {
+				case nil:
+					metrics.ParentClosePolicyProcessorSuccess.With(processor.metricsHandler).Record(1)
+				case *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
+					// no-op
+				case *serviceerror.NamespaceNotActive:
+					remoteExecutionsLock.Lock()
+					remoteExecutions[typedErr.ActiveCluster] = append(remoteExecutions[typedErr.ActiveCluster], execution)
+					remoteExecutionsLock.Unlock()
+				default:
+					metrics.ParentClosePolicyProcessorFailures.With(processor.metricsHandler).Record(1)
+					getActivityLogger(ctx).Error("failed to process parent close policy", tag.Error(err))
+					return err
+				}
+				return nil
 			})
 		}
-
-		switch typedErr := err.(type) {
-		case nil:
-			metrics.ParentClosePolicyProcessorSuccess.With(processor.metricsHandler).Record(1)
-		case *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
-			// no-op
-		case *serviceerror.NamespaceNotActive:
-			remoteExecutions[typedErr.ActiveCluster] = append(remoteExecutions[typedErr.ActiveCluster], execution)
-		default:
-			metrics.ParentClosePolicyProcessorFailures.With(processor.metricsHandler).Record(1)
-			getActivityLogger(ctx).Error("failed to process parent close policy", tag.Error(err))
+		if err := g.Wait(); err != nil {
 			return err
 		}
 	}
@@ -189,6 +167,70 @@ func ProcessorActivity(ctx context.Context, request Request) error {
 	return nil
 }
 
+// groupExecutionsByNamespace splits executions by namespace, preserving the order in which each
+// namespace first appears, so that each group can be processed with its namespace's concurrency.
+func groupExecutionsByNamespace(executions []RequestDetail) [][]RequestDetail {
+	var groups [][]RequestDetail
+	groupIndex := make(map[string]int)
+	for _, execution := range executions {
+		idx, ok := groupIndex[execution.Namespace]
+		if !ok {
+			idx = len(groups)
+			groupIndex[execution.Namespace] = idx
+			groups = append(groups, nil)
+		}
+		groups[idx] = append(groups[idx], execution)
+	}
+	return groups
+}
+
+func processExecution(
+	ctx context.Context,
+	client historyservice.HistoryServiceClient,
+	parentExecution *commonpb.WorkflowExecution,
+	childWorkflowOnly bool,
+	execution RequestDetail,
+) error {
+	requestCtx := headers.SetCallerName(ctx, execution.Namespace)
+
+	var err error
+	switch execution.Policy {
+	case enumspb.PARENT_CLOSE_POLICY_ABANDON:
+		// no-op
+		return nil
+	case enumspb.PARENT_CLOSE_POLICY_TERMINATE:
+		_, err = client.TerminateWorkflowExecution(requestCtx, &historyservice.TerminateWorkflowExecutionRequest{
+			NamespaceId: execution.NamespaceID,
+			TerminateRequest: &workflowservice.TerminateWorkflowExecutionRequest{
+				Namespace: execution.Namespace,
+				WorkflowExecution: &commonpb.WorkflowExecution{
+					WorkflowId: execution.WorkflowID,
+				},
+				Reason:              "by parent close policy",
+				Identity:            processorWFTypeName,
+				FirstExecutionRunId: execution.RunID,
+			},
+			ExternalWorkflowExecution: parentExecution,
+			ChildWorkflowOnly:         childWorkflowOnly,
+		})
+	case enumspb.PARENT_CLOSE_POLICY_REQUEST_CANCEL:
+		_, err = client.RequestCancelWorkflowExecution(requestCtx, &historyservice.RequestCancelWorkflowExecutionRequest{
+			NamespaceId: execution.NamespaceID,
+			CancelRequest: &workflowservice.RequestCancelWorkflowExecutionRequest{
+				Namespace: execution.Namespace,
+				WorkflowExecution: &commonpb.WorkflowExecution{
+					WorkflowId: execution.WorkflowID,
+				},
+				Identity:            processorWFTypeName,
+				FirstExecutionRunId: execution.RunID,
+			},
+			ExternalWorkflowExecution: parentExecution,
+			ChildWorkflowOnly:         childWorkflowOnly,
+		})
+	}
+	return err
+}
+
 func signalRemoteCluster(
 	ctx context.Context,
 	currentCluster string,
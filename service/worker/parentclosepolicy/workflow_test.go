@@ -26,6 +26,8 @@ package parentclosepolicy
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -88,6 +90,7 @@ func (s *parentClosePolicyWorkflowSuite) SetupTest() {
 			MaxConcurrentActivityTaskPollers:       dynamicconfig.GetIntPropertyFn(4),
 			MaxConcurrentWorkflowTaskPollers:       dynamicconfig.GetIntPropertyFn(4),
 			NumParentClosePolicySystemWorkflows:    dynamicconfig.GetIntPropertyFn(10),
+			ProcessorConcurrency:                   dynamicconfig.GetIntPropertyFnFilteredByNamespace(1),
 		},
 		clientBean: s.mockClientBean,
 	}
@@ -191,3 +194,69 @@ func (s *parentClosePolicyWorkflowSuite) TestProcessorActivity_RemoteCluster() {
 	_, err := env.ExecuteActivity(ProcessorActivity, request)
 	s.NoError(err)
 }
+
+func (s *parentClosePolicyWorkflowSuite) TestProcessorActivity_Concurrency() {
+	const (
+		numChildren = 20
+		concurrency = 4
+	)
+	s.processor.cfg.ProcessorConcurrency = func(namespace string) int {
+		s.Equal(tests.ChildNamespace.String(), namespace)
+		return concurrency
+	}
+
+	env := s.NewTestActivityEnvironment()
+	env.SetWorkerOptions(getWorkerOptions(s.processor))
+	env.RegisterActivity(ProcessorActivity)
+
+	request := Request{
+		ParentExecution: &commonpb.WorkflowExecution{
+			WorkflowId: "parent workflowID",
+			RunId:      "parent runID",
+		},
+	}
+	for i := 0; i < numChildren; i++ {
+		request.Executions = append(request.Executions, RequestDetail{
+			Namespace:   tests.ChildNamespace.String(),
+			NamespaceID: tests.ChildNamespaceID.String(),
+			WorkflowID:  fmt.Sprintf("child workflowID %d", i),
+			RunID:       fmt.Sprintf("childworkflow runID %d", i),
+			Policy:      enums.PARENT_CLOSE_POLICY_TERMINATE,
+		})
+	}
+
+	// Calls are released in batches of concurrency arrivals, so the activity only completes if it
+	// actually issues that many terminations in parallel.
+	var lock sync.Mutex
+	arrivals, inFlight, maxInFlight := 0, 0, 0
+	barrier := make(chan struct{})
+	s.mockHistoryClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(
+			_ context.Context,
+			_ *historyservice.TerminateWorkflowExecutionRequest,
+			_ ...grpc.CallOption,
+		) (*historyservice.TerminateWorkflowExecutionResponse, error) {
+			lock.Lock()
+			arrivals++
+			inFlight++
+			maxInFlight = max(maxInFlight, inFlight)
+			release := barrier
+			if arrivals%concurrency == 0 {
+				close(barrier)
+				barrier = make(chan struct{})
+			}
+			lock.Unlock()
+
+			<-release
+
+			lock.Lock()
+			inFlight--
+			lock.Unlock()
+			return &historyservice.TerminateWorkflowExecutionResponse{}, nil
+		},
+	).Times(numChildren)
+
+	_, err := env.ExecuteActivity(ProcessorActivity, request)
+	s.NoError(err)
+	s.Equal(concurrency, maxInFlight)
+}
@@ -41,6 +41,7 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -87,6 +88,9 @@ type (
 		// The scavenger should allow enough time to pass before cleaning these build ids.
 		removableBuildIdDurationSinceDefault dynamicconfig.DurationPropertyFn
 		buildIdScavengerVisibilityRPS        dynamicconfig.FloatPropertyFn
+		// If set, build ids that would be removed are only logged and counted, versioning data is left untouched.
+		buildIdScavengerDryRun dynamicconfig.BoolPropertyFn
+		metricsHandler         metrics.Handler
 	}
 
 	heartbeatDetails struct {
@@ -107,6 +111,8 @@ func NewActivities(
 	currentClusterName string,
 	removableBuildIdDurationSinceDefault dynamicconfig.DurationPropertyFn,
 	buildIdScavengerVisibilityRPS dynamicconfig.FloatPropertyFn,
+	buildIdScavengerDryRun dynamicconfig.BoolPropertyFn,
+	metricsHandler metrics.Handler,
 ) *Activities {
 	return &Activities{
 		logger:                               logger,
@@ -118,6 +124,8 @@ func NewActivities(
 		currentClusterName:                   currentClusterName,
 		removableBuildIdDurationSinceDefault: removableBuildIdDurationSinceDefault,
 		buildIdScavengerVisibilityRPS:        buildIdScavengerVisibilityRPS,
+		buildIdScavengerDryRun:               buildIdScavengerDryRun,
+		metricsHandler:                       metricsHandler,
 	}
 }
 
@@ -252,6 +260,16 @@ func (a *Activities) processUserDataEntry(
 	if len(buildIdsToRemove) == 0 {
 		return nil
 	}
+	if a.buildIdScavengerDryRun() {
+		a.logger.Info("Dry run, not removing build ids",
+			tag.WorkflowNamespace(ns.Name().String()),
+			tag.WorkflowTaskQueueName(entry.TaskQueue),
+			tag.Value(buildIdsToRemove),
+		)
+		metrics.BuildIdScavengerDryRunRemovableBuildIds.With(a.metricsHandler).
+			Record(int64(len(buildIdsToRemove)), metrics.NamespaceTag(ns.Name().String()))
+		return nil
+	}
 	_, err = a.matchingClient.UpdateWorkerBuildIdCompatibility(ctx, &matchingservice.UpdateWorkerBuildIdCompatibilityRequest{
 		NamespaceId: ns.ID().String(),
 		TaskQueue:   entry.TaskQueue,
@@ -46,6 +46,8 @@ import (
 	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -275,6 +277,7 @@ func Test_ScavengeBuildIds_Heartbeats(t *testing.T) {
 		matchingClient:                       matchingClient,
 		removableBuildIdDurationSinceDefault: dynamicconfig.GetDurationPropertyFn(time.Hour),
 		buildIdScavengerVisibilityRPS:        dynamicconfig.GetFloatPropertyFn(1.0),
+		buildIdScavengerDryRun:               dynamicconfig.GetBoolPropertyFn(false),
 		currentClusterName:                   "test-cluster",
 	}
 
@@ -481,6 +484,82 @@ func Test_ScavengeBuildIds_Heartbeats(t *testing.T) {
 	}, iceptor.recordedHeartbeats)
 }
 
+func Test_processUserDataEntry_DryRunDoesNotRemoveBuildIds(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	ctrl := gomock.NewController(t)
+	visiblityManager := manager.NewMockVisibilityManager(ctrl)
+	rateLimiter := quotas.NewMockRateLimiter(ctrl)
+	// No calls are expected on the matching client in dry run mode.
+	matchingClient := matchingservicemock.NewMockMatchingServiceClient(ctrl)
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	defer metricsHandler.StopCapture(capture)
+
+	a := &Activities{
+		logger:                               log.NewCLILogger(),
+		visibilityManager:                    visiblityManager,
+		matchingClient:                       matchingClient,
+		removableBuildIdDurationSinceDefault: dynamicconfig.GetDurationPropertyFn(time.Hour),
+		buildIdScavengerVisibilityRPS:        dynamicconfig.GetFloatPropertyFn(1.0),
+		buildIdScavengerDryRun:               dynamicconfig.GetBoolPropertyFn(true),
+		metricsHandler:                       metricsHandler,
+	}
+
+	// Reachability checks still go through the rate limiter.
+	rateLimiter.EXPECT().Wait(gomock.Any()).Times(1)
+	visiblityManager.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Times(1).Return(&manager.CountWorkflowExecutionsResponse{
+		Count: 0,
+	}, nil)
+
+	c0 := hlc.Zero(0)
+	ns := namespace.NewNamespaceForTest(&persistencespb.NamespaceInfo{Name: "test-namespace"}, &persistencespb.NamespaceConfig{
+		Retention: durationpb.New(24 * time.Hour),
+	}, false, nil, 0)
+	entry := &persistence.TaskQueueUserDataEntry{
+		TaskQueue: "test",
+		UserData: &persistencespb.VersionedTaskQueueUserData{
+			Version: 1,
+			Data: &persistencespb.TaskQueueUserData{
+				Clock: c0,
+				VersioningData: &persistencespb.VersioningData{
+					VersionSets: []*persistencespb.CompatibleVersionSet{
+						{
+							SetIds: []string{"v1"},
+							BuildIds: []*persistencespb.BuildId{
+								{
+									Id:                     "v1.0",
+									State:                  persistencespb.STATE_ACTIVE,
+									StateUpdateTimestamp:   c0,
+									BecameDefaultTimestamp: c0,
+								},
+								{
+									Id:                     "v1.1",
+									State:                  persistencespb.STATE_ACTIVE,
+									StateUpdateTimestamp:   c0,
+									BecameDefaultTimestamp: c0,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	act := func(ctx context.Context) error {
+		return a.processUserDataEntry(ctx, rateLimiter, BuildIdScavangerInput{}, heartbeatDetails{}, ns, entry)
+	}
+	env.RegisterActivity(act)
+	_, err := env.ExecuteActivity(act)
+	require.NoError(t, err)
+
+	recordings := capture.Snapshot()[metrics.BuildIdScavengerDryRunRemovableBuildIds.Name()]
+	require.Len(t, recordings, 1)
+	require.Equal(t, int64(1), recordings[0].Value)
+	require.Equal(t, "test-namespace", recordings[0].Tags[metrics.NamespaceTag("").Key()])
+}
+
 // The SDK's test environment throttles emitted heartbeat forcing us to use an interceptor to record the heartbeat details
 type heartbeatRecordingInterceptor struct {
 	interceptor.WorkerInterceptorBase
@@ -32,6 +32,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/backoff"
@@ -53,6 +54,16 @@ const (
 	taskStartupDelayRandomizationRatio = 1.0
 )
 
+// scannedExecutionStates are the states an execution can be persisted in. Rows in any other state, e.g. void, are not
+// valid executions and are filtered out before their mutable state is deserialized.
+var scannedExecutionStates = []enumsspb.WorkflowExecutionState{
+	enumsspb.WORKFLOW_EXECUTION_STATE_CREATED,
+	enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+	enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+	enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE,
+	enumsspb.WORKFLOW_EXECUTION_STATE_CORRUPTED,
+}
+
 type (
 	// task is a runnable task that adheres to the executor.Task interface
 	// for the scavenger, each of this task processes a single workflow mutableState
@@ -215,6 +226,7 @@ func (t *task) getPaginationFn() collection.PaginationFn[*persistencespb.Workflo
 			ShardID:   t.shardID,
 			PageSize:  executionsPageSize,
 			PageToken: paginationToken,
+			States:    scannedExecutionStates,
 		}
 		resp, err := t.executionManager.ListConcreteExecutions(t.ctx, req)
 		if err != nil {
@@ -30,6 +30,7 @@ import (
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/activity"
 
@@ -76,7 +77,7 @@ type (
 		// Our history archiver delete mutable state, and then upload history to blob store and then delete history.
 		historyDataMinAge           dynamicconfig.DurationPropertyFn
 		executionDataDurationBuffer dynamicconfig.DurationPropertyFn
-		enableRetentionVerification dynamicconfig.BoolPropertyFn
+		enableRetentionVerification dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 		sync.WaitGroup
 		sync.Mutex
@@ -95,6 +96,10 @@ type (
 const (
 	pageSize  = 100
 	numWorker = 10
+
+	// archivalRetentionMultiplier extends the retention verification window of namespaces with history
+	// archival enabled, so that workflows are not deleted before they are archived.
+	archivalRetentionMultiplier = 2
 )
 
 // NewScavenger returns an instance of history scavenger daemon
@@ -114,7 +119,7 @@ func NewScavenger(
 	hbd ScavengerHeartbeatDetails,
 	historyDataMinAge dynamicconfig.DurationPropertyFn,
 	executionDataDurationBuffer dynamicconfig.DurationPropertyFn,
-	enableRetentionVerification dynamicconfig.BoolPropertyFn,
+	enableRetentionVerification dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) *Scavenger {
@@ -286,10 +291,7 @@ func (s *Scavenger) handleTask(
 	})
 	switch err.(type) {
 	case nil:
-		if s.enableRetentionVerification() {
-			return s.cleanUpWorkflowPastRetention(ctx, ms.GetDatabaseMutableState())
-		}
-		return nil
+		return s.cleanUpWorkflowPastRetention(ctx, ms.GetDatabaseMutableState())
 	case *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
 		// case handled below
 	default:
@@ -369,7 +371,11 @@ func (s *Scavenger) cleanUpWorkflowPastRetention(
 		return err
 	}
 
-	retention := ns.Retention()
+	if !s.enableRetentionVerification(ns.Name().String()) {
+		return nil
+	}
+
+	retention := retentionVerificationWindow(ns)
 	finalUpdateTime := executionInfo.GetLastUpdateTime()
 	age := time.Now().UTC().Sub(timestamp.TimeValue(finalUpdateTime))
 	if age > retention+s.executionDataDurationBuffer() {
@@ -399,6 +405,15 @@ func (s *Scavenger) cleanUpWorkflowPastRetention(
 	return nil
 }
 
+// retentionVerificationWindow returns how long a closed workflow is kept before the scavenger deletes it.
+func retentionVerificationWindow(ns *namespace.Namespace) time.Duration {
+	retention := ns.Retention()
+	if ns.HistoryArchivalState().State == enumspb.ARCHIVAL_STATE_ENABLED {
+		return retention * archivalRetentionMultiplier
+	}
+	return retention
+}
+
 func getTaskLoggingTags(err error, task taskDetail) []tag.Tag {
 	if err != nil {
 		return []tag.Tag{
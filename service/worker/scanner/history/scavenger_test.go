@@ -34,6 +34,7 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -113,7 +114,7 @@ func (s *ScavengerTestSuite) createTestScavenger(
 	s.mockRegistry = namespace.NewMockRegistry(s.controller)
 	dataAge := dynamicconfig.GetDurationPropertyFn(time.Hour)
 	executionDataAge := dynamicconfig.GetDurationPropertyFn(time.Second)
-	enableRetentionVerification := dynamicconfig.GetBoolPropertyFn(true)
+	enableRetentionVerification := dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
 	s.scavenger = NewScavenger(
 		s.numShards,
 		s.mockExecutionManager,
@@ -747,3 +748,80 @@ func (s *ScavengerTestSuite) TestDeleteWorkflowAfterRetention() {
 	s.Equal(2, hbd.CurrentPage)
 	s.Equal(0, len(hbd.NextPageToken))
 }
+
+func (s *ScavengerTestSuite) TestCleanUpWorkflowPastRetention_NamespaceAware() {
+	retention := time.Hour
+	// Past the namespace retention, but within the extended window of archival-enabled namespaces.
+	mutableState := &persistencepb.WorkflowMutableState{
+		ExecutionInfo: &persistencepb.WorkflowExecutionInfo{
+			WorkflowId:     "workflowID",
+			NamespaceId:    "namespaceID",
+			LastUpdateTime: timestamppb.New(time.Now().UTC().Add(-retention * 3 / 2)),
+		},
+		ExecutionState: &persistencepb.WorkflowExecutionState{
+			RunId: "runID",
+			State: enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		archivalState        enumspb.ArchivalState
+		verifyRetention      bool
+		expectDeleteWorkflow bool
+	}{
+		{
+			name:                 "ArchivalDisabled",
+			archivalState:        enumspb.ARCHIVAL_STATE_DISABLED,
+			verifyRetention:      true,
+			expectDeleteWorkflow: true,
+		},
+		{
+			name:                 "ArchivalEnabled",
+			archivalState:        enumspb.ARCHIVAL_STATE_ENABLED,
+			verifyRetention:      true,
+			expectDeleteWorkflow: false,
+		},
+		{
+			name:                 "VerificationDisabledForNamespace",
+			archivalState:        enumspb.ARCHIVAL_STATE_DISABLED,
+			verifyRetention:      false,
+			expectDeleteWorkflow: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.createTestScavenger(100)
+			defer s.controller.Finish()
+
+			mockedNamespace := namespace.NewNamespaceForTest(
+				&persistencepb.NamespaceInfo{Id: "namespaceID", Name: "namespace"},
+				&persistencepb.NamespaceConfig{
+					Retention:            durationpb.New(retention),
+					HistoryArchivalState: tc.archivalState,
+				},
+				false,
+				nil,
+				0,
+			)
+			s.scavenger.enableRetentionVerification = func(namespaceName string) bool {
+				s.Equal("namespace", namespaceName)
+				return tc.verifyRetention
+			}
+			s.mockRegistry.EXPECT().GetNamespaceByID(namespace.ID("namespaceID")).Return(mockedNamespace, nil)
+			if tc.expectDeleteWorkflow {
+				s.mockAdminClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), protomock.Eq(&adminservice.DeleteWorkflowExecutionRequest{
+					Namespace: "namespace",
+					Execution: &commonpb.WorkflowExecution{
+						WorkflowId: "workflowID",
+						RunId:      "runID",
+					},
+				})).Return(nil, nil)
+			}
+
+			err := s.scavenger.cleanUpWorkflowPastRetention(context.Background(), mutableState)
+			s.NoError(err)
+		})
+	}
+}
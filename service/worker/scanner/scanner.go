@@ -68,6 +68,10 @@ type (
 		Persistence *config.Persistence
 		// TaskQueueScannerEnabled indicates if taskQueue scanner should be started as part of scanner
 		TaskQueueScannerEnabled dynamicconfig.BoolPropertyFn
+		// TaskQueueScannerPerHostQPS the max rate of calls to persistence per host from taskQueue scanner
+		TaskQueueScannerPerHostQPS dynamicconfig.IntPropertyFn
+		// TaskQueueScannerWorkerCount is the number of task queues processed concurrently by taskQueue scanner
+		TaskQueueScannerWorkerCount dynamicconfig.IntPropertyFn
 		// BuildIdScavengerEnabled indicates if the build ID scavenger should be started as part of scanner
 		BuildIdScavengerEnabled dynamicconfig.BoolPropertyFn
 		// HistoryScannerEnabled indicates if history scanner should be started as part of scanner
@@ -78,7 +82,7 @@ type (
 		// Only clean up history branches that older than this threshold
 		HistoryScannerDataMinAge dynamicconfig.DurationPropertyFn
 		// HistoryScannerVerifyRetention indicates if the history scavenger to do retention verification
-		HistoryScannerVerifyRetention dynamicconfig.BoolPropertyFn
+		HistoryScannerVerifyRetention dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		// ExecutionScannerPerHostQPS the max rate of calls to scan execution data per host
 		ExecutionScannerPerHostQPS dynamicconfig.IntPropertyFn
 		// ExecutionScannerPerShardQPS the max rate of calls to scan execution data per shard
@@ -95,6 +99,8 @@ type (
 		RemovableBuildIdDurationSinceDefault dynamicconfig.DurationPropertyFn
 		// BuildIdScavengerVisibilityRPS is the rate limit for visibility calls from the build ID scavenger
 		BuildIdScavengerVisibilityRPS dynamicconfig.FloatPropertyFn
+		// BuildIdScavengerDryRun makes the build ID scavenger report removable build ids without removing them
+		BuildIdScavengerDryRun dynamicconfig.BoolPropertyFn
 	}
 
 	// scannerContext is the context object that gets
@@ -212,6 +218,8 @@ func (s *Scanner) Start() error {
 			s.context.currentClusterName,
 			s.context.cfg.RemovableBuildIdDurationSinceDefault,
 			s.context.cfg.BuildIdScavengerVisibilityRPS,
+			s.context.cfg.BuildIdScavengerDryRun,
+			s.context.metricsHandler,
 		)
 
 		work := s.context.sdkClientFactory.NewWorker(s.context.sdkClientFactory.GetSystemClient(), build_ids.BuildIdScavengerTaskQueueName, workerOpts)
@@ -50,6 +50,9 @@ func (s *Scavenger) completeTasks(
 	var n int
 	var err error
 	err = s.retryForever(func() error {
+		if err = s.rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
 		n, err = s.db.CompleteTasksLessThan(ctx, &p.CompleteTasksLessThanRequest{
 			NamespaceID:        key.NamespaceID,
 			TaskQueueName:      key.TaskQueueName,
@@ -70,6 +73,9 @@ func (s *Scavenger) getTasks(
 	var err error
 	var resp *p.GetTasksResponse
 	err = s.retryForever(func() error {
+		if err = s.rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
 		resp, err = s.db.GetTasks(ctx, &p.GetTasksRequest{
 			NamespaceID:        key.NamespaceID,
 			TaskQueue:          key.TaskQueueName,
@@ -91,6 +97,9 @@ func (s *Scavenger) listTaskQueue(
 	var err error
 	var resp *p.ListTaskQueueResponse
 	err = s.retryForever(func() error {
+		if err = s.rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
 		resp, err = s.db.ListTaskQueue(ctx, &p.ListTaskQueueRequest{
 			PageSize:  pageSize,
 			PageToken: pageToken,
@@ -107,6 +116,9 @@ func (s *Scavenger) deleteTaskQueue(
 ) error {
 	// retry only on service busy errors
 	return backoff.ThrottleRetry(func() error {
+		if err := s.rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
 		return s.db.DeleteTaskQueue(ctx, &p.DeleteTaskQueueRequest{
 			TaskQueue: &p.TaskQueueKey{
 				NamespaceID:   key.NamespaceID,
@@ -31,11 +31,13 @@ import (
 	"time"
 
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/service/worker/scanner/executor"
 )
 
@@ -44,6 +46,7 @@ type (
 	Scavenger struct {
 		db             p.TaskManager
 		executor       executor.Executor
+		rateLimiter    quotas.RateLimiter
 		metricsHandler metrics.Handler
 		logger         log.Logger
 		stats          stats
@@ -81,7 +84,7 @@ type (
 )
 
 var (
-	taskQueueBatchSize       = 32             // maximum number of task queue we process concurrently
+	taskQueueBatchSize       = 32             // number of task queues we read from persistence in one call
 	taskBatchSize            = 16             // number of tasks we read from persistence in one call
 	maxTasksPerJob           = 256            // maximum number of tasks we process for a executorTask queue as part of a single job
 	taskQueueGracePeriod     = 48 * time.Hour // amount of time a executorTask queue has to be idle before it becomes a candidate for deletion
@@ -101,10 +104,16 @@ var (
 // two conditions
 //   - either all task queues are processed successfully (or)
 //   - Stop() method is called to stop the scavenger
-func NewScavenger(db p.TaskManager, metricsHandler metrics.Handler, logger log.Logger) *Scavenger {
+func NewScavenger(
+	db p.TaskManager,
+	perHostQPS dynamicconfig.IntPropertyFn,
+	workerCount dynamicconfig.IntPropertyFn,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *Scavenger {
 	stopC := make(chan struct{})
 	taskExecutor := executor.NewFixedSizePoolExecutor(
-		taskQueueBatchSize, executorMaxDeferredTasks, metricsHandler, metrics.TaskQueueScavengerScope)
+		workerCount(), executorMaxDeferredTasks, metricsHandler, metrics.TaskQueueScavengerScope)
 	lifecycleCtx, lifecycleCancel := context.WithCancel(
 		headers.SetCallerInfo(
 			context.Background(),
@@ -119,6 +128,9 @@ func NewScavenger(db p.TaskManager, metricsHandler metrics.Handler, logger log.L
 		executor:        taskExecutor,
 		lifecycleCtx:    lifecycleCtx,
 		lifecycleCancel: lifecycleCancel,
+		rateLimiter: quotas.NewDefaultOutgoingRateLimiter(
+			func() float64 { return float64(perHostQPS()) },
+		),
 	}
 }
 
@@ -28,12 +28,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
@@ -64,7 +66,13 @@ func (s *ScavengerTestSuite) SetupTest() {
 	s.taskQueueTable = &mockTaskQueueTable{}
 	s.taskTables = make(map[string]*mockTaskTable)
 	logger := log.NewTestLogger()
-	s.scvgr = NewScavenger(s.taskMgr, metrics.NoopMetricsHandler, logger)
+	s.scvgr = NewScavenger(
+		s.taskMgr,
+		dynamicconfig.GetIntPropertyFn(1000),
+		dynamicconfig.GetIntPropertyFn(32),
+		metrics.NoopMetricsHandler,
+		logger,
+	)
 	maxTasksPerJob = 4
 	executorPollInterval = time.Millisecond * 50
 }
@@ -172,6 +180,50 @@ func (s *ScavengerTestSuite) TestAllExpiredTasksWithErrors() {
 	s.Equal(1, len(result), "expected partial deletion due to transient errors")
 }
 
+func (s *ScavengerTestSuite) TestConcurrency() {
+	workerCount := 2
+	s.scvgr = NewScavenger(
+		s.taskMgr,
+		dynamicconfig.GetIntPropertyFn(1000),
+		dynamicconfig.GetIntPropertyFn(workerCount),
+		metrics.NoopMetricsHandler,
+		log.NewTestLogger(),
+	)
+
+	nTaskQueues := 8
+	for i := 0; i < nTaskQueues; i++ {
+		name := fmt.Sprintf("test-concurrency-tq-%v", i)
+		s.taskQueueTable.generate(name, true)
+		tt := newMockTaskTable()
+		tt.generate(4, true)
+		s.taskTables[name] = tt
+	}
+
+	var inflight, maxInflight int32
+	s.taskMgr.EXPECT().GetTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *p.GetTasksRequest) (*p.GetTasksResponse, error) {
+			current := atomic.AddInt32(&inflight, 1)
+			defer atomic.AddInt32(&inflight, -1)
+			for {
+				prev := atomic.LoadInt32(&maxInflight)
+				if current <= prev || atomic.CompareAndSwapInt32(&maxInflight, prev, current) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			return &p.GetTasksResponse{Tasks: s.taskTables[req.TaskQueue].get(req.PageSize)}, nil
+		}).AnyTimes()
+	s.setupTaskMgrMocks()
+	s.runScavenger()
+
+	s.Positive(atomic.LoadInt32(&maxInflight))
+	s.LessOrEqual(atomic.LoadInt32(&maxInflight), int32(workerCount))
+	for tl, tbl := range s.taskTables {
+		s.Equal(0, len(tbl.get(100)), "failed to delete all expired tasks")
+		s.Nil(s.taskQueueTable.get(tl), "failed to delete expired executorTask queue")
+	}
+}
+
 func (s *ScavengerTestSuite) runScavenger() {
 	s.scvgr.Start()
 	timer := time.NewTimer(10 * time.Second)
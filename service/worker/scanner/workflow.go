@@ -167,7 +167,13 @@ func TaskQueueScavengerActivity(
 	activityCtx context.Context,
 ) error {
 	ctx := activityCtx.Value(scannerContextKey).(scannerContext)
-	scavenger := taskqueue.NewScavenger(ctx.taskManager, ctx.metricsHandler, ctx.logger)
+	scavenger := taskqueue.NewScavenger(
+		ctx.taskManager,
+		ctx.cfg.TaskQueueScannerPerHostQPS,
+		ctx.cfg.TaskQueueScannerWorkerCount,
+		ctx.metricsHandler,
+		ctx.logger,
+	)
 	ctx.logger.Info("Starting task queue scavenger")
 	scavenger.Start()
 	for scavenger.Alive() {
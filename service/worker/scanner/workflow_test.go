@@ -39,6 +39,7 @@ import (
 	"go.temporal.io/sdk/testsuite"
 	"go.temporal.io/sdk/worker"
 
+	"go.temporal.io/server/common/dynamicconfig"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/resourcetest"
 )
@@ -82,6 +83,10 @@ func (s *scannerWorkflowTestSuite) TestScavengerActivity() {
 	mockResource.TaskMgr.EXPECT().ListTaskQueue(gomock.Any(), gomock.Any()).Return(&p.ListTaskQueueResponse{}, nil)
 
 	ctx := scannerContext{
+		cfg: &Config{
+			TaskQueueScannerPerHostQPS:  dynamicconfig.GetIntPropertyFn(100),
+			TaskQueueScannerWorkerCount: dynamicconfig.GetIntPropertyFn(1),
+		},
 		logger:           mockResource.GetLogger(),
 		metricsHandler:   mockResource.GetMetricsHandler(),
 		executionManager: mockResource.GetExecutionManager(),
@@ -107,12 +107,14 @@ type (
 		PerNamespaceWorkerOptions            dynamicconfig.TypedPropertyFnWithNamespaceFilter[sdkworker.Options]
 		PerNamespaceWorkerStartRate          dynamicconfig.FloatPropertyFn
 
-		VisibilityPersistenceMaxReadQPS   dynamicconfig.IntPropertyFn
-		VisibilityPersistenceMaxWriteQPS  dynamicconfig.IntPropertyFn
-		EnableReadFromSecondaryVisibility dynamicconfig.BoolPropertyFnWithNamespaceFilter
-		VisibilityEnableShadowReadMode    dynamicconfig.BoolPropertyFn
-		VisibilityDisableOrderByClause    dynamicconfig.BoolPropertyFnWithNamespaceFilter
-		VisibilityEnableManualPagination  dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		VisibilityPersistenceMaxReadQPS    dynamicconfig.IntPropertyFn
+		VisibilityPersistenceMaxWriteQPS   dynamicconfig.IntPropertyFn
+		EnableReadFromSecondaryVisibility  dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		VisibilityEnableShadowReadMode     dynamicconfig.BoolPropertyFn
+		VisibilityShadowReadDiffSampleRate dynamicconfig.FloatPropertyFn
+		VisibilityDisableOrderByClause     dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		VisibilityOrderByAllowedFields     dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]string]
+		VisibilityEnableManualPagination   dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	}
 )
 
@@ -187,6 +189,7 @@ func NewConfig(
 			MaxConcurrentActivityTaskPollers:       dynamicconfig.WorkerParentCloseMaxConcurrentActivityTaskPollers.Get(dc),
 			MaxConcurrentWorkflowTaskPollers:       dynamicconfig.WorkerParentCloseMaxConcurrentWorkflowTaskPollers.Get(dc),
 			NumParentClosePolicySystemWorkflows:    dynamicconfig.NumParentClosePolicySystemWorkflows.Get(dc),
+			ProcessorConcurrency:                   dynamicconfig.WorkerParentCloseProcessorConcurrency.Get(dc),
 		},
 		ScannerCfg: &scanner.Config{
 			MaxConcurrentActivityExecutionSize:     dynamicconfig.WorkerScannerMaxConcurrentActivityExecutionSize.Get(dc),
@@ -197,6 +200,8 @@ func NewConfig(
 			PersistenceMaxQPS:                       dynamicconfig.ScannerPersistenceMaxQPS.Get(dc),
 			Persistence:                             persistenceConfig,
 			TaskQueueScannerEnabled:                 dynamicconfig.TaskQueueScannerEnabled.Get(dc),
+			TaskQueueScannerPerHostQPS:              dynamicconfig.TaskQueueScannerPerHostQPS.Get(dc),
+			TaskQueueScannerWorkerCount:             dynamicconfig.TaskQueueScannerWorkerCount.Get(dc),
 			BuildIdScavengerEnabled:                 dynamicconfig.BuildIdScavengerEnabled.Get(dc),
 			HistoryScannerEnabled:                   dynamicconfig.HistoryScannerEnabled.Get(dc),
 			ExecutionsScannerEnabled:                dynamicconfig.ExecutionsScannerEnabled.Get(dc),
@@ -209,6 +214,7 @@ func NewConfig(
 			ExecutionScannerHistoryEventIdValidator: dynamicconfig.ExecutionScannerHistoryEventIdValidator.Get(dc),
 			RemovableBuildIdDurationSinceDefault:    dynamicconfig.RemovableBuildIdDurationSinceDefault.Get(dc),
 			BuildIdScavengerVisibilityRPS:           dynamicconfig.BuildIdScavengerVisibilityRPS.Get(dc),
+			BuildIdScavengerDryRun:                  dynamicconfig.BuildIdScavengerDryRun.Get(dc),
 		},
 		EnableBatcher:                        dynamicconfig.EnableBatcherGlobal.Get(dc),
 		BatcherRPS:                           dynamicconfig.BatcherRPS.Get(dc),
@@ -227,12 +233,14 @@ func NewConfig(
 		PersistenceQPSBurstRatio:             dynamicconfig.PersistenceQPSBurstRatio.Get(dc),
 		OperatorRPSRatio:                     dynamicconfig.OperatorRPSRatio.Get(dc),
 
-		VisibilityPersistenceMaxReadQPS:   dynamicconfig.VisibilityPersistenceMaxReadQPS.Get(dc),
-		VisibilityPersistenceMaxWriteQPS:  dynamicconfig.VisibilityPersistenceMaxWriteQPS.Get(dc),
-		EnableReadFromSecondaryVisibility: dynamicconfig.EnableReadFromSecondaryVisibility.Get(dc),
-		VisibilityEnableShadowReadMode:    dynamicconfig.VisibilityEnableShadowReadMode.Get(dc),
-		VisibilityDisableOrderByClause:    dynamicconfig.VisibilityDisableOrderByClause.Get(dc),
-		VisibilityEnableManualPagination:  dynamicconfig.VisibilityEnableManualPagination.Get(dc),
+		VisibilityPersistenceMaxReadQPS:    dynamicconfig.VisibilityPersistenceMaxReadQPS.Get(dc),
+		VisibilityPersistenceMaxWriteQPS:   dynamicconfig.VisibilityPersistenceMaxWriteQPS.Get(dc),
+		EnableReadFromSecondaryVisibility:  dynamicconfig.EnableReadFromSecondaryVisibility.Get(dc),
+		VisibilityEnableShadowReadMode:     dynamicconfig.VisibilityEnableShadowReadMode.Get(dc),
+		VisibilityShadowReadDiffSampleRate: dynamicconfig.VisibilityShadowReadDiffSampleRate.Get(dc),
+		VisibilityDisableOrderByClause:     dynamicconfig.VisibilityDisableOrderByClause.Get(dc),
+		VisibilityOrderByAllowedFields:     dynamicconfig.VisibilityOrderByAllowedFields.Get(dc),
+		VisibilityEnableManualPagination:   dynamicconfig.VisibilityEnableManualPagination.Get(dc),
 	}
 	return config
 }
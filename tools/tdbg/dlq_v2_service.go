@@ -65,6 +65,8 @@ type (
 		MessageID int64 `json:"message_id"`
 		// ShardID is only used for non-namespace replication tasks.
 		ShardID int32 `json:"shard_id"`
+		// FailureReason is the error that caused the task to be moved to the DLQ, if it was recorded.
+		FailureReason string `json:"failure_reason,omitempty"`
 		// Payload contains the parsed task metadata from the server.
 		Payload *TaskPayload `json:"payload"`
 	}
@@ -189,8 +191,9 @@ func (ac *DLQV2Service) ReadMessages(c *cli.Context) (err error) {
 					SourceCluster: ac.sourceCluster,
 					TargetCluster: ac.targetCluster,
 				},
-				PageSize:      int32(pageSize),
-				NextPageToken: paginationToken,
+				PageSize:             int32(pageSize),
+				NextPageToken:        paginationToken,
+				FailureReasonPattern: c.String(FlagFailureReasonPattern),
 			}
 			res, err := adminClient.GetDLQTasks(ctx, request)
 			if err != nil {
@@ -218,9 +221,10 @@ func (ac *DLQV2Service) ReadMessages(c *cli.Context) (err error) {
 			taskCategoryID:  ac.category.ID(),
 		}
 		message := DLQMessage{
-			MessageID: dlqTask.Metadata.MessageId,
-			ShardID:   dlqTask.Payload.ShardId,
-			Payload:   payload,
+			MessageID:     dlqTask.Metadata.MessageId,
+			ShardID:       dlqTask.Payload.ShardId,
+			FailureReason: dlqTask.Payload.FailureReason,
+			Payload:       payload,
 		}
 		err = newEncoder(outputFile).Encode(message)
 		if err != nil {
@@ -248,6 +252,7 @@ func (ac *DLQV2Service) PurgeMessages(c *cli.Context) error {
 		InclusiveMaxTaskMetadata: &commonspb.HistoryDLQTaskMetadata{
 			MessageId: lastMessageID,
 		},
+		FailureReasonPattern: c.String(FlagFailureReasonPattern),
 	})
 	if err != nil {
 		return fmt.Errorf("call to PurgeDLQTasks failed: %w", err)
@@ -273,7 +278,8 @@ func (ac *DLQV2Service) MergeMessages(c *cli.Context) error {
 		InclusiveMaxTaskMetadata: &commonspb.HistoryDLQTaskMetadata{
 			MessageId: lastMessageID,
 		},
-		BatchSize: int32(c.Int(FlagPageSize)), // let the server handle validation and defaulting of batch size.
+		BatchSize:            int32(c.Int(FlagPageSize)), // let the server handle validation and defaulting of batch size.
+		FailureReasonPattern: c.String(FlagFailureReasonPattern),
 	})
 	if err != nil {
 		return fmt.Errorf("call to MergeDLQTasks failed: %w", err)
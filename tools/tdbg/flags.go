@@ -86,4 +86,5 @@ var (
 	FlagBase64File                 = "base64-file"
 	FlagTaskCategoryID             = "task-category-id"
 	FlagEncoding                   = "encoding"
+	FlagFailureReasonPattern       = "failure-reason-pattern"
 )
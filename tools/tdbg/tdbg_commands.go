@@ -536,6 +536,7 @@ func newAdminDLQCommands(
 					Usage: "Page size to use when reading messages from the DB, v2 only",
 					Value: defaultPageSize,
 				},
+				newFailureReasonPatternFlag("Only read messages whose failure reason matches this pattern."),
 			),
 			Action: func(c *cli.Context) error {
 				ac, err := dlqServiceProvider.GetDLQService(c)
@@ -549,7 +550,10 @@ func newAdminDLQCommands(
 			Name:    "purge",
 			Aliases: []string{"p"},
 			Usage:   "Delete DLQ messages with equal or smaller ids than the provided task id",
-			Flags:   getDLQFlags(taskCategoryRegistry),
+			Flags: append(getDLQFlags(taskCategoryRegistry),
+				newFailureReasonPatternFlag("Only delete messages whose failure reason matches this pattern. "+
+					"The other messages are moved to the end of the DLQ."),
+			),
 			Action: func(c *cli.Context) error {
 				ac, err := dlqServiceProvider.GetDLQService(c)
 				if err != nil {
@@ -569,6 +573,8 @@ func newAdminDLQCommands(
 					Usage: "Batch size to use when purging messages from the DB, v2 only. Will use server default if " +
 						"not provided.",
 				},
+				newFailureReasonPatternFlag("Only merge messages whose failure reason matches this pattern. "+
+					"The other messages are moved to the end of the DLQ."),
 			),
 			Action: func(c *cli.Context) error {
 				ac, err := dlqServiceProvider.GetDLQService(c)
@@ -613,6 +619,13 @@ func newAdminDLQCommands(
 	}
 }
 
+func newFailureReasonPatternFlag(usage string) cli.Flag {
+	return &cli.StringFlag{
+		Name:  FlagFailureReasonPattern,
+		Usage: usage + ` The pattern is a regular expression, or a glob if it is prefixed with "glob:". v2 only.`,
+	}
+}
+
 func newAdminDLQJobCommands(
 	dlqServiceProvider *DLQServiceProvider,
 ) []*cli.Command {